@@ -2,31 +2,46 @@ package delivery_auth_grpc
 
 import (
 	"context"
+	"database/sql"
+	"errors"
 	"fmt"
 	"log/slog"
 	"net"
 
 	"github.com/go-park-mail-ru/2023_2_Vkladyshi/authorization/repository/profile"
 	"github.com/go-park-mail-ru/2023_2_Vkladyshi/authorization/repository/session"
+	"github.com/go-park-mail-ru/2023_2_Vkladyshi/authorization/usecase"
 	"github.com/go-park-mail-ru/2023_2_Vkladyshi/configs"
+	"github.com/go-park-mail-ru/2023_2_Vkladyshi/pkg/healthcheck"
+	"github.com/go-park-mail-ru/2023_2_Vkladyshi/pkg/interceptors"
+	"github.com/go-park-mail-ru/2023_2_Vkladyshi/pkg/lifecycle"
+	"github.com/go-redis/redis/v8"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/reflection"
+	"google.golang.org/grpc/status"
 
 	pb "github.com/go-park-mail-ru/2023_2_Vkladyshi/authorization/proto"
 )
 
 type authGrpc struct {
 	grpcServ *grpc.Server
+	health   *health.Server
+	sessions session.ISessionRepo
+	users    profile.IUserRepo
 	lg       *slog.Logger
 }
 
 type server struct {
 	pb.UnimplementedAuthorizationServer
-	userRepo    *profile.RepoPostgre
-	sessionRepo *session.SessionRepo
+	userRepo    profile.IUserRepo
+	sessionRepo session.ISessionRepo
 	lg          *slog.Logger
 }
 
-func NewServer(l *slog.Logger) (*authGrpc, error) {
+func NewServer(l *slog.Logger, checker *healthcheck.Checker, opts ...grpc.ServerOption) (*authGrpc, error) {
 	config, err := configs.ReadConfig()
 	if err != nil {
 		l.Error("read config error", "err", err.Error())
@@ -39,27 +54,39 @@ func NewServer(l *slog.Logger) (*authGrpc, error) {
 		return nil, fmt.Errorf("listen and serve grpc error: %w", err)
 	}
 
-	session, err := session.GetSessionRepo(*configSession, l)
+	session, err := session.NewSessionRepo(*configSession, l)
 
 	if err != nil {
 		l.Error("Session repository is not responding")
 		return nil, fmt.Errorf("listen and serve grpc error: %w", err)
 	}
 
-	users, err := profile.GetUserRepo(config, l)
+	users, err := profile.NewUserRepo(config, l)
 	if err != nil {
 		l.Error("cant create repo")
 		return nil, fmt.Errorf("listen and serve grpc error: %w", err)
 	}
 
-	s := grpc.NewServer()
+	s := grpc.NewServer(append(interceptors.ServerOptions("auth", l, interceptors.Codes{
+		redis.Nil:             codes.NotFound,
+		sql.ErrNoRows:         codes.NotFound,
+		usecase.ErrNotFound:   codes.NotFound,
+		usecase.ErrNotAllowed: codes.PermissionDenied,
+	}), opts...)...)
 	pb.RegisterAuthorizationServer(s, &server{
 		lg:          l,
 		sessionRepo: session,
 		userRepo:    users,
 	})
 
-	return &authGrpc{grpcServ: s, lg: l}, nil
+	// The health service follows the dependencies of checker, reflection
+	// lets grpcurl list the services.
+	healthServer := health.NewServer()
+	healthpb.RegisterHealthServer(s, healthServer)
+	checker.ServeGrpc(healthServer, pb.Authorization_ServiceDesc.ServiceName)
+	reflection.Register(s)
+
+	return &authGrpc{grpcServ: s, health: healthServer, sessions: session, users: users, lg: l}, nil
 }
 
 func (s *server) GetId(ctx context.Context, req *pb.FindIdRequest) (*pb.FindIdResponse, error) {
@@ -70,18 +97,19 @@ func (s *server) GetId(ctx context.Context, req *pb.FindIdRequest) (*pb.FindIdRe
 
 	id, err := s.userRepo.GetUserProfileId(login)
 	if err != nil {
-		s.lg.Error("failed get user profile id: %v", err)
+		s.lg.Error("failed get user profile id", "err", err.Error())
 		return nil, err
 	}
 	return &pb.FindIdResponse{
 		Value: id,
+		Login: login,
 	}, nil
 }
 
 func (s *server) GetIdsAndPaths(ctx context.Context, req *pb.NamesAndPathsListRequest) (*pb.NamesAndPathsResponse, error) {
 	names, paths, err := s.userRepo.GetNamesAndPaths(req.Ids)
 	if err != nil {
-		s.lg.Error("failed get users ids and photo: %v", err)
+		s.lg.Error("failed get users ids and photo", "err", err.Error())
 		return nil, err
 	}
 	return &pb.NamesAndPathsResponse{
@@ -93,7 +121,7 @@ func (s *server) GetIdsAndPaths(ctx context.Context, req *pb.NamesAndPathsListRe
 func (s *server) GetAuthorizationStatus(ctx context.Context, req *pb.AuthorizationCheckRequest) (*pb.AuthorizationCheckResponse, error) {
 	status, err := s.sessionRepo.CheckActiveSession(ctx, req.Sid, s.lg)
 	if err != nil {
-		s.lg.Error("failed to check auth status: %v", err)
+		s.lg.Error("failed to check auth status", "err", err.Error())
 		return nil, err
 	}
 	return &pb.AuthorizationCheckResponse{
@@ -102,34 +130,80 @@ func (s *server) GetAuthorizationStatus(ctx context.Context, req *pb.Authorizati
 }
 
 func (s *server) GetRole(ctx context.Context, req *pb.RoleRequest) (*pb.RoleResponse, error) {
-    role, err := s.userRepo.GetUserRole(req.Login)
+	role, err := s.userRepo.GetUserRole(req.Login)
 	if err != nil {
-		s.lg.Error("failed to get user role: %v", err)
+		s.lg.Error("failed to get user role", "err", err.Error())
 		return nil, err
 	}
 
 	return &pb.RoleResponse{
 		Role: role,
-	},nil
+	}, nil
 }
 
-func (s *authGrpc) ListenAndServeGrpc() error {
-	grpcConfig, err := configs.ReadGrpcConfig()
+// ValidateSession resolves a session into its user in one call, an unknown
+// or expired session is reported as Unauthenticated.
+func (s *server) ValidateSession(ctx context.Context, req *pb.ValidateSessionRequest) (*pb.ValidateSessionResponse, error) {
+	active, err := s.sessionRepo.GetSession(ctx, req.Sid, s.lg)
 	if err != nil {
-		s.lg.Error("failed to parse grpc config file: %v", err)
-		return fmt.Errorf("listen and serve grpc error: %w", err)
+		if errors.Is(err, redis.Nil) {
+			return nil, status.Error(codes.Unauthenticated, "session not found")
+		}
+		return nil, err
+	}
+	if err = s.sessionRepo.Touch(ctx, active.Login, req.Sid); err != nil {
+		s.lg.Error("failed to touch session", "err", err.Error())
 	}
 
+	user, err := s.userRepo.GetUserIdentity(active.Login)
+	if err != nil {
+		s.lg.Error("failed to get user identity", "err", err.Error())
+		return nil, err
+	}
+
+	response := &pb.ValidateSessionResponse{
+		Id:    int64(user.Id),
+		Login: user.Login,
+		Name:  user.Name,
+		Role:  user.Role,
+	}
+	if !active.ExpiresAt.IsZero() {
+		response.ExpiresAt = active.ExpiresAt.Unix()
+	}
+
+	return response, nil
+}
+
+func (s *authGrpc) ListenAndServeGrpc(grpcConfig *configs.GrpcConfig) error {
 	lis, err := net.Listen(grpcConfig.ConnectionType, ":"+grpcConfig.Port)
 	if err != nil {
-		s.lg.Error("failed to listen: %v", err)
+		s.lg.Error("failed to listen", "err", err.Error())
 		return fmt.Errorf("listen and serve grpc error: %w", err)
 	}
 
+	return s.Serve(lis)
+}
+
+// Serve accepts connections on lis, which lets the all-in-one binary serve
+// over an in-process listener.
+func (s *authGrpc) Serve(lis net.Listener) error {
 	if err := s.grpcServ.Serve(lis); err != nil {
-		s.lg.Error("failed to serve: %v", err)
+		s.lg.Error("failed to serve", "err", err.Error())
 		return fmt.Errorf("listen and serve grpc error: %w", err)
 	}
 
 	return nil
 }
+
+// Shutdown reports the server as not serving, so that clients move away,
+// and stops it once the calls in flight are done or ctx is.
+func (s *authGrpc) Shutdown(ctx context.Context) error {
+	s.health.Shutdown()
+
+	return lifecycle.StopGrpc(ctx, s.grpcServ)
+}
+
+// Close closes the database clients the server has opened for itself.
+func (s *authGrpc) Close() error {
+	return lifecycle.Close(s.users, s.sessions)
+}
@@ -0,0 +1,48 @@
+package delivery_auth_grpc
+
+import (
+	"context"
+	"io"
+	"log/slog"
+	"testing"
+	"time"
+
+	pb "github.com/go-park-mail-ru/2023_2_Vkladyshi/authorization/proto"
+	"github.com/go-park-mail-ru/2023_2_Vkladyshi/authorization/repository/profile"
+	"github.com/go-park-mail-ru/2023_2_Vkladyshi/authorization/repository/session"
+	"github.com/go-park-mail-ru/2023_2_Vkladyshi/configs"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestValidateSession(t *testing.T) {
+	lg := slog.New(slog.NewTextHandler(io.Discard, nil))
+	users, err := profile.GetUserMemoryRepo(&configs.DbDsnCfg{})
+	if err != nil {
+		t.Fatalf("cant create users repo: %s", err)
+	}
+	err = users.CreateUser("viewer", "secret", "Зритель", "2000-06-15", "viewer@example.com")
+	if err != nil {
+		t.Fatalf("CreateUser error: %s", err)
+	}
+	sessions := session.GetSessionMemoryRepo(configs.DbRedisCfg{Host: t.Name()})
+	_, _ = sessions.AddSession(context.Background(), session.Session{Login: "viewer", SID: "sid"}, lg)
+
+	s := &server{userRepo: users, sessionRepo: sessions, lg: lg}
+
+	response, err := s.ValidateSession(context.Background(), &pb.ValidateSessionRequest{Sid: "sid"})
+	if err != nil {
+		t.Fatalf("ValidateSession error: %s", err)
+	}
+	if response.Login != "viewer" || response.Name != "Зритель" || response.Role != "user" || response.Id == 0 {
+		t.Errorf("unexpected principal %v", response)
+	}
+	if expiresAt := time.Unix(response.ExpiresAt, 0); expiresAt.Before(time.Now().Add(23 * time.Hour)) {
+		t.Errorf("unexpected expiry %v", expiresAt)
+	}
+
+	_, err = s.ValidateSession(context.Background(), &pb.ValidateSessionRequest{Sid: "unknown"})
+	if status.Code(err) != codes.Unauthenticated {
+		t.Errorf("expected unauthenticated, got %v", err)
+	}
+}
@@ -2,16 +2,19 @@ package delivery
 
 import (
 	"errors"
-	"fmt"
 	"io"
 	"log/slog"
+	"net"
 	"net/http"
-	"os"
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/go-park-mail-ru/2023_2_Vkladyshi/authorization/usecase"
+	"github.com/go-park-mail-ru/2023_2_Vkladyshi/pkg/healthcheck"
+	"github.com/go-park-mail-ru/2023_2_Vkladyshi/pkg/images"
 	"github.com/go-park-mail-ru/2023_2_Vkladyshi/pkg/requests"
+	"github.com/go-park-mail-ru/2023_2_Vkladyshi/pkg/storage"
 	"github.com/mailru/easyjson"
 
 	"github.com/prometheus/client_golang/prometheus/promhttp"
@@ -33,17 +36,12 @@ type API struct {
 	mx   *http.ServeMux
 }
 
-func (a *API) ListenAndServe() error {
-	err := http.ListenAndServe(":8081", a.mx)
-	if err != nil {
-		a.lg.Error("ListenAndServe error", "err", err.Error())
-		return fmt.Errorf("listen and serve error: %w", err)
-	}
-
-	return nil
+// Server serves the routes of the API, the caller starts and stops it.
+func (a *API) Server() *http.Server {
+	return &http.Server{Addr: ":8081", Handler: a.mx}
 }
 
-func GetApi(c *usecase.Core, l *slog.Logger) *API {
+func GetApi(c *usecase.Core, l *slog.Logger, store storage.Storage, checker *healthcheck.Checker) *API {
 	api := &API{
 		core: c,
 		lg:   l.With("module", "api"),
@@ -52,20 +50,34 @@ func GetApi(c *usecase.Core, l *slog.Logger) *API {
 	}
 
 	api.mx.Handle("/metrics", promhttp.Handler())
-	api.mx.HandleFunc("/signin", api.Signin)
-	api.mx.HandleFunc("/signup", api.Signup)
-	api.mx.HandleFunc("/logout", api.LogoutSession)
-	api.mx.HandleFunc("/authcheck", api.AuthAccept)
-	api.mx.HandleFunc("/api/v1/csrf", api.GetCsrfToken)
-	api.mx.HandleFunc("/api/v1/settings", api.Profile)
-	api.mx.HandleFunc("/api/v1/user/subscribePush", api.SubcribePush)
-	api.mx.HandleFunc("/api/v1/user/isSubscribed", api.IsSubcribed)
-	api.mx.HandleFunc("/api/v1/users/list", api.GetUsers)
-	api.mx.HandleFunc("/api/v1/users/updateRole", api.ChangeUserRole)
+	checker.Register(api.mx)
+	api.Register(api.mx)
+
+	if local, ok := store.(*storage.Local); ok && local.BaseURL() != "" {
+		api.mx.Handle(local.BaseURL()+"/", local)
+	}
 
 	return api
 }
 
+// Register adds the authorization routes to mx, so the services can share
+// one listener.
+func (a *API) Register(mx *http.ServeMux) {
+	mx.HandleFunc("/signin", a.Signin)
+	mx.HandleFunc("/signup", a.Signup)
+	mx.HandleFunc("/logout", a.LogoutSession)
+	mx.HandleFunc("/authcheck", a.AuthAccept)
+	mx.HandleFunc("/api/v1/csrf", a.GetCsrfToken)
+	mx.HandleFunc("/api/v1/settings", a.Profile)
+	mx.HandleFunc("/api/v1/sessions", a.Sessions)
+	mx.HandleFunc("/api/v1/sessions/revoke", a.RevokeSession)
+	mx.HandleFunc("/api/v1/sessions/revoke_others", a.RevokeOtherSessions)
+	mx.HandleFunc("/api/v1/user/subscribePush", a.SubcribePush)
+	mx.HandleFunc("/api/v1/user/isSubscribed", a.IsSubcribed)
+	mx.HandleFunc("/api/v1/users/list", a.GetUsers)
+	mx.HandleFunc("/api/v1/users/updateRole", a.ChangeUserRole)
+}
+
 func (a *API) LogoutSession(w http.ResponseWriter, r *http.Request) {
 	response := requests.Response{Status: http.StatusOK, Body: nil}
 
@@ -178,7 +190,7 @@ func (a *API) Signin(w http.ResponseWriter, r *http.Request) {
 		a.ct.SendResponse(w, r, response, a.lg, start)
 		return
 	} else {
-		sid, session, _ := a.core.CreateSession(r.Context(), user.Login)
+		sid, session, _ := a.core.CreateSession(r.Context(), user.Login, r.UserAgent(), clientIp(r))
 		cookie := &http.Cookie{
 			Name:     "session_id",
 			Value:    sid,
@@ -434,6 +446,8 @@ func (a *API) Profile(w http.ResponseWriter, r *http.Request) {
 			Login:     profile.Login,
 			Photo:     profile.Photo,
 			BirthDate: profile.Birthdate,
+
+			PhotoVariants: profile.PhotoVariants,
 		}
 
 		response.Body = profileResponse
@@ -458,10 +472,11 @@ func (a *API) Profile(w http.ResponseWriter, r *http.Request) {
 		a.lg.Error("Get Profile error", "err", err.Error())
 	}
 
-	err1 := r.ParseMultipartForm(10 << 20)
+	r.Body = http.MaxBytesReader(w, r.Body, images.MaxUploadSize)
+	err1 := r.ParseMultipartForm(images.MaxUploadSize)
 	if err1 != nil {
-		a.lg.Error("Post profile error", "err", err.Error())
-		response.Status = http.StatusBadRequest
+		a.lg.Error("Post profile error", "err", err1.Error())
+		response.Status = images.FormStatus(err1)
 		a.ct.SendResponse(w, r, response, a.lg, start)
 		return
 	}
@@ -490,53 +505,210 @@ func (a *API) Profile(w http.ResponseWriter, r *http.Request) {
 	if handler == nil {
 		filename = ""
 
-		err = a.core.EditProfile(prevLogin, login, password, email, birthDate, filename)
+		err = a.core.EditProfile(r.Context(), prevLogin, login, password, email, birthDate, filename)
 		if err != nil {
 			a.lg.Error("Post profile error", "err", err.Error())
 			response.Status = http.StatusInternalServerError
 			a.ct.SendResponse(w, r, response, a.lg, start)
 			return
 		}
+		if password != "" {
+			expireCookie(w, session)
+		}
 		a.ct.SendResponse(w, r, response, a.lg, start)
 		return
 	}
 
-	filename = "/avatars/" + handler.Filename
+	defer photo.Close()
 
-	if err != nil && handler != nil && photo != nil {
+	img, err := images.Process(photo)
+	if err != nil {
 		a.lg.Error("Post profile error", "err", err.Error())
-		response.Status = http.StatusBadRequest
+		response.Status = images.StatusCode(err)
 		a.ct.SendResponse(w, r, response, a.lg, start)
 		return
 	}
-
-	filePhoto, err := os.OpenFile("/home/ubuntu/frontend-project"+filename, os.O_WRONLY|os.O_CREATE, 0666)
+	filename, err = a.core.SaveAvatar(r.Context(), img)
 	if err != nil {
 		a.lg.Error("Post profile error", "err", err.Error())
 		response.Status = http.StatusInternalServerError
 		a.ct.SendResponse(w, r, response, a.lg, start)
 		return
 	}
-	defer filePhoto.Close()
 
-	_, err = io.Copy(filePhoto, photo)
+	err = a.core.EditProfile(r.Context(), prevLogin, login, password, email, birthDate, filename)
 	if err != nil {
 		a.lg.Error("Post profile error", "err", err.Error())
 		response.Status = http.StatusInternalServerError
 		a.ct.SendResponse(w, r, response, a.lg, start)
 		return
 	}
+	if password != "" {
+		expireCookie(w, session)
+	}
+	a.ct.SendResponse(w, r, response, a.lg, start)
+}
+
+func (a *API) Sessions(w http.ResponseWriter, r *http.Request) {
+	response := requests.Response{Status: http.StatusOK, Body: nil}
+	start := time.Now()
+	if r.Method != http.MethodGet {
+		response.Status = http.StatusMethodNotAllowed
+		a.ct.SendResponse(w, r, response, a.lg, start)
+		return
+	}
 
-	err = a.core.EditProfile(prevLogin, login, password, email, birthDate, filename)
+	session, err := r.Cookie("session_id")
+	if errors.Is(err, http.ErrNoCookie) {
+		response.Status = http.StatusUnauthorized
+		a.ct.SendResponse(w, r, response, a.lg, start)
+		return
+	}
+	found, _ := a.core.FindActiveSession(r.Context(), session.Value)
+	if !found {
+		response.Status = http.StatusUnauthorized
+		a.ct.SendResponse(w, r, response, a.lg, start)
+		return
+	}
+
+	sessions, err := a.core.ListSessions(r.Context(), session.Value)
 	if err != nil {
-		a.lg.Error("Post profile error", "err", err.Error())
+		a.lg.Error("list sessions error", "err", err.Error())
+		response.Status = http.StatusInternalServerError
+		a.ct.SendResponse(w, r, response, a.lg, start)
+		return
+	}
+
+	response.Body = requests.SessionsResponse{Sessions: sessions}
+	a.ct.SendResponse(w, r, response, a.lg, start)
+}
+
+func (a *API) RevokeSession(w http.ResponseWriter, r *http.Request) {
+	response := requests.Response{Status: http.StatusOK, Body: nil}
+	start := time.Now()
+	if r.Method != http.MethodPost {
+		response.Status = http.StatusMethodNotAllowed
+		a.ct.SendResponse(w, r, response, a.lg, start)
+		return
+	}
+
+	found, err := a.core.CheckCsrfToken(r.Context(), r.Header.Get("x-csrf-token"))
+	if err != nil || !found {
+		w.Header().Set("X-CSRF-Token", "null")
+		response.Status = http.StatusPreconditionFailed
+		a.ct.SendResponse(w, r, response, a.lg, start)
+		return
+	}
+
+	session, err := r.Cookie("session_id")
+	if errors.Is(err, http.ErrNoCookie) {
+		response.Status = http.StatusUnauthorized
+		a.ct.SendResponse(w, r, response, a.lg, start)
+		return
+	}
+	found, _ = a.core.FindActiveSession(r.Context(), session.Value)
+	if !found {
+		response.Status = http.StatusUnauthorized
+		a.ct.SendResponse(w, r, response, a.lg, start)
+		return
+	}
+
+	var request requests.RevokeSessionRequest
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		response.Status = http.StatusBadRequest
+		a.ct.SendResponse(w, r, response, a.lg, start)
+		return
+	}
+	if err = easyjson.Unmarshal(body, &request); err != nil || request.Id == "" {
+		response.Status = http.StatusBadRequest
+		a.ct.SendResponse(w, r, response, a.lg, start)
+		return
+	}
+
+	err = a.core.RevokeSession(r.Context(), session.Value, request.Id)
+	if err != nil {
+		if errors.Is(err, usecase.ErrNotFound) {
+			response.Status = http.StatusNotFound
+			a.ct.SendResponse(w, r, response, a.lg, start)
+			return
+		}
+		a.lg.Error("revoke session error", "err", err.Error())
 		response.Status = http.StatusInternalServerError
 		a.ct.SendResponse(w, r, response, a.lg, start)
 		return
 	}
+
 	a.ct.SendResponse(w, r, response, a.lg, start)
 }
 
+// RevokeOtherSessions signs the user out everywhere but the current
+// session.
+func (a *API) RevokeOtherSessions(w http.ResponseWriter, r *http.Request) {
+	response := requests.Response{Status: http.StatusOK, Body: nil}
+	start := time.Now()
+	if r.Method != http.MethodPost {
+		response.Status = http.StatusMethodNotAllowed
+		a.ct.SendResponse(w, r, response, a.lg, start)
+		return
+	}
+
+	found, err := a.core.CheckCsrfToken(r.Context(), r.Header.Get("x-csrf-token"))
+	if err != nil || !found {
+		w.Header().Set("X-CSRF-Token", "null")
+		response.Status = http.StatusPreconditionFailed
+		a.ct.SendResponse(w, r, response, a.lg, start)
+		return
+	}
+
+	session, err := r.Cookie("session_id")
+	if errors.Is(err, http.ErrNoCookie) {
+		response.Status = http.StatusUnauthorized
+		a.ct.SendResponse(w, r, response, a.lg, start)
+		return
+	}
+	found, _ = a.core.FindActiveSession(r.Context(), session.Value)
+	if !found {
+		response.Status = http.StatusUnauthorized
+		a.ct.SendResponse(w, r, response, a.lg, start)
+		return
+	}
+
+	revoked, err := a.core.RevokeOtherSessions(r.Context(), session.Value)
+	if err != nil {
+		a.lg.Error("revoke other sessions error", "err", err.Error())
+		response.Status = http.StatusInternalServerError
+		a.ct.SendResponse(w, r, response, a.lg, start)
+		return
+	}
+
+	response.Body = requests.RevokeSessionsResponse{Revoked: revoked}
+	a.ct.SendResponse(w, r, response, a.lg, start)
+}
+
+// expireCookie tells the browser to drop a cookie of a session that has
+// ended.
+func expireCookie(w http.ResponseWriter, cookie *http.Cookie) {
+	cookie.Path = "/"
+	cookie.Expires = time.Now().AddDate(0, 0, -1)
+	http.SetCookie(w, cookie)
+}
+
+// clientIp is the address the request came from, the first one of
+// X-Forwarded-For when the service is behind a proxy.
+func clientIp(r *http.Request) string {
+	if forwarded := r.Header.Get("X-Forwarded-For"); forwarded != "" {
+		ip, _, _ := strings.Cut(forwarded, ",")
+		return strings.TrimSpace(ip)
+	}
+	ip, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		return r.RemoteAddr
+	}
+
+	return ip
+}
+
 func (a *API) SubcribePush(w http.ResponseWriter, r *http.Request) {
 	response := requests.Response{Status: http.StatusOK, Body: nil}
 	start := time.Now()
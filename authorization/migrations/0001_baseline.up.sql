@@ -0,0 +1,19 @@
+-- Baseline of the auth database as it was built by hand before migrations.
+-- Every statement is IF NOT EXISTS, so running it against such a database
+-- only records the version.
+
+CREATE TABLE IF NOT EXISTS profile (
+    id                SERIAL PRIMARY KEY,
+    name              TEXT NOT NULL,
+    birth_date        DATE,
+    photo             TEXT NOT NULL DEFAULT '/avatars/default.jpg',
+    login             TEXT NOT NULL UNIQUE,
+    password          TEXT NOT NULL,
+    email             TEXT NOT NULL,
+    registration_date TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    role              TEXT NOT NULL DEFAULT 'user',
+    -- The "с" in the name is Cyrillic, the queries use it as is.
+    "is_subsсribed"   BOOLEAN NOT NULL DEFAULT FALSE
+);
+
+CREATE INDEX IF NOT EXISTS profile_role_idx ON profile (role);
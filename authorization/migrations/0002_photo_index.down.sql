@@ -0,0 +1 @@
+DROP INDEX IF EXISTS profile_photo_idx;
@@ -0,0 +1,2 @@
+-- Storage cleanup counts the profiles still using an avatar before deleting it.
+CREATE INDEX IF NOT EXISTS profile_photo_idx ON profile (photo);
@@ -61,6 +61,7 @@ func (m *FindIdRequest) GetSid() string {
 
 type FindIdResponse struct {
 	Value                int64    `protobuf:"varint,1,opt,name=value,proto3" json:"value,omitempty"`
+	Login                string   `protobuf:"bytes,2,opt,name=login,proto3" json:"login,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -98,6 +99,13 @@ func (m *FindIdResponse) GetValue() int64 {
 	return 0
 }
 
+func (m *FindIdResponse) GetLogin() string {
+	if m != nil {
+		return m.Login
+	}
+	return ""
+}
+
 type NamesAndPathsListRequest struct {
 	Ids                  []int32  `protobuf:"varint,1,rep,packed,name=ids,proto3" json:"ids,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
@@ -340,6 +348,116 @@ func (m *RoleResponse) GetRole() string {
 	return ""
 }
 
+type ValidateSessionRequest struct {
+	Sid                  string   `protobuf:"bytes,1,opt,name=sid,proto3" json:"sid,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ValidateSessionRequest) Reset()         { *m = ValidateSessionRequest{} }
+func (m *ValidateSessionRequest) String() string { return proto.CompactTextString(m) }
+func (*ValidateSessionRequest) ProtoMessage()    {}
+func (*ValidateSessionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8bbd6f3875b0e874, []int{8}
+}
+
+func (m *ValidateSessionRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ValidateSessionRequest.Unmarshal(m, b)
+}
+func (m *ValidateSessionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ValidateSessionRequest.Marshal(b, m, deterministic)
+}
+func (m *ValidateSessionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidateSessionRequest.Merge(m, src)
+}
+func (m *ValidateSessionRequest) XXX_Size() int {
+	return xxx_messageInfo_ValidateSessionRequest.Size(m)
+}
+func (m *ValidateSessionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidateSessionRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ValidateSessionRequest proto.InternalMessageInfo
+
+func (m *ValidateSessionRequest) GetSid() string {
+	if m != nil {
+		return m.Sid
+	}
+	return ""
+}
+
+type ValidateSessionResponse struct {
+	Id                   int64    `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Login                string   `protobuf:"bytes,2,opt,name=login,proto3" json:"login,omitempty"`
+	Name                 string   `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Role                 string   `protobuf:"bytes,4,opt,name=role,proto3" json:"role,omitempty"`
+	ExpiresAt            int64    `protobuf:"varint,5,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ValidateSessionResponse) Reset()         { *m = ValidateSessionResponse{} }
+func (m *ValidateSessionResponse) String() string { return proto.CompactTextString(m) }
+func (*ValidateSessionResponse) ProtoMessage()    {}
+func (*ValidateSessionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8bbd6f3875b0e874, []int{9}
+}
+
+func (m *ValidateSessionResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ValidateSessionResponse.Unmarshal(m, b)
+}
+func (m *ValidateSessionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ValidateSessionResponse.Marshal(b, m, deterministic)
+}
+func (m *ValidateSessionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidateSessionResponse.Merge(m, src)
+}
+func (m *ValidateSessionResponse) XXX_Size() int {
+	return xxx_messageInfo_ValidateSessionResponse.Size(m)
+}
+func (m *ValidateSessionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidateSessionResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ValidateSessionResponse proto.InternalMessageInfo
+
+func (m *ValidateSessionResponse) GetId() int64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *ValidateSessionResponse) GetLogin() string {
+	if m != nil {
+		return m.Login
+	}
+	return ""
+}
+
+func (m *ValidateSessionResponse) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *ValidateSessionResponse) GetRole() string {
+	if m != nil {
+		return m.Role
+	}
+	return ""
+}
+
+func (m *ValidateSessionResponse) GetExpiresAt() int64 {
+	if m != nil {
+		return m.ExpiresAt
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*FindIdRequest)(nil), "auth.FindIdRequest")
 	proto.RegisterType((*FindIdResponse)(nil), "auth.FindIdResponse")
@@ -349,6 +467,8 @@ func init() {
 	proto.RegisterType((*AuthorizationCheckResponse)(nil), "auth.AuthorizationCheckResponse")
 	proto.RegisterType((*RoleRequest)(nil), "auth.RoleRequest")
 	proto.RegisterType((*RoleResponse)(nil), "auth.RoleResponse")
+	proto.RegisterType((*ValidateSessionRequest)(nil), "auth.ValidateSessionRequest")
+	proto.RegisterType((*ValidateSessionResponse)(nil), "auth.ValidateSessionResponse")
 }
 
 func init() {
@@ -356,31 +476,33 @@ func init() {
 }
 
 var fileDescriptor_8bbd6f3875b0e874 = []byte{
-	// 416 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x92, 0x4f, 0x8e, 0xd3, 0x30,
-	0x14, 0xc6, 0xe9, 0x74, 0x32, 0x30, 0x0f, 0x66, 0x04, 0xa6, 0x8c, 0x42, 0x90, 0xa0, 0x18, 0x09,
-	0xcd, 0x82, 0x69, 0x50, 0xda, 0x0b, 0x94, 0x4a, 0x54, 0x95, 0x10, 0x54, 0x46, 0x62, 0x81, 0x84,
-	0x2a, 0x83, 0x0d, 0xb1, 0x1a, 0xe2, 0x12, 0x3b, 0x48, 0xb0, 0xe2, 0x06, 0x6c, 0xb9, 0x02, 0x3b,
-	0x58, 0xf0, 0x67, 0x03, 0x67, 0x70, 0x6f, 0x34, 0xb2, 0x9d, 0x56, 0x89, 0xd4, 0xac, 0xf2, 0xbe,
-	0xe7, 0xdf, 0xfb, 0x6c, 0x7f, 0x0e, 0x00, 0x2d, 0x75, 0x3a, 0x58, 0x15, 0x52, 0x4b, 0xb4, 0x6f,
-	0x6b, 0x7c, 0x17, 0x8e, 0x1e, 0x8b, 0x9c, 0xcd, 0x18, 0xe1, 0x1f, 0x4a, 0xae, 0x34, 0xba, 0x0a,
-	0x5d, 0x25, 0x58, 0xd8, 0xe9, 0x77, 0x4e, 0x0f, 0x89, 0x2d, 0xf1, 0x7d, 0x38, 0xde, 0x20, 0x6a,
-	0x25, 0x73, 0xc5, 0x51, 0x0f, 0x82, 0x8f, 0x34, 0x2b, 0xb9, 0xa3, 0xba, 0xc4, 0x0b, 0xfc, 0x00,
-	0xc2, 0xa7, 0xf4, 0x3d, 0x57, 0xe3, 0x9c, 0xcd, 0xa9, 0x4e, 0xd5, 0x13, 0xa1, 0x74, 0xcd, 0x55,
-	0x30, 0x15, 0x76, 0xfa, 0xdd, 0xd3, 0x80, 0xd8, 0x12, 0x4f, 0xe0, 0x46, 0x83, 0xae, 0x9b, 0xe7,
-	0x76, 0xc1, 0xc1, 0x87, 0xc4, 0x0b, 0xdb, 0x5d, 0x59, 0x2c, 0xdc, 0xf3, 0x5d, 0x27, 0xf0, 0x19,
-	0xdc, 0x1c, 0x97, 0x3a, 0x95, 0x85, 0xf8, 0x4c, 0xb5, 0x90, 0xf9, 0x24, 0xe5, 0x6f, 0x96, 0xed,
-	0x37, 0x19, 0x41, 0xb4, 0x0b, 0xaf, 0x36, 0x3e, 0x81, 0x03, 0xa5, 0xa9, 0x2e, 0x95, 0x1b, 0xb9,
-	0x44, 0x2a, 0x85, 0xef, 0xc1, 0x65, 0x22, 0x33, 0xbe, 0xb1, 0xed, 0x41, 0x90, 0xc9, 0x77, 0x22,
-	0xaf, 0x8c, 0xbd, 0xc0, 0x18, 0xae, 0x78, 0xa8, 0x32, 0x43, 0xb0, 0x5f, 0xc8, 0x8c, 0x57, 0x90,
-	0xab, 0x93, 0xef, 0x7b, 0x70, 0xd4, 0xd8, 0x1f, 0x8d, 0x20, 0x98, 0x72, 0x3d, 0x63, 0xe8, 0xfa,
-	0xc0, 0xbd, 0x4c, 0xe3, 0x29, 0xa2, 0x5e, 0xb3, 0xe9, 0x9d, 0xf1, 0x05, 0xf4, 0x0c, 0x8e, 0xdd,
-	0xd4, 0x36, 0x3b, 0x74, 0xdb, 0x93, 0x6d, 0xf1, 0x47, 0xb7, 0x76, 0xac, 0xd7, 0x0c, 0x5f, 0xc1,
-	0xc9, 0x94, 0xeb, 0xc6, 0xd1, 0x9e, 0xbb, 0xbb, 0xa3, 0x3b, 0x7e, 0xb0, 0x35, 0xe4, 0xa8, 0xdf,
-	0x0e, 0x6c, 0xed, 0x13, 0xb8, 0x38, 0xe5, 0xda, 0xc6, 0x83, 0xae, 0x79, 0xbc, 0x96, 0x67, 0x84,
-	0xea, 0xad, 0xcd, 0xcc, 0x23, 0xf2, 0x72, 0x1e, 0x9b, 0x6f, 0xe6, 0x8f, 0xf9, 0x6f, 0x7e, 0xae,
-	0xbf, 0x98, 0x1f, 0xe6, 0xb7, 0xf9, 0x65, 0xfe, 0x99, 0xbf, 0xeb, 0xaf, 0x71, 0xf2, 0x30, 0x19,
-	0x2e, 0x92, 0xc5, 0x8b, 0x65, 0x46, 0xd9, 0x27, 0x95, 0x8a, 0x33, 0xca, 0x98, 0xb0, 0xfb, 0xd2,
-	0x6c, 0xf1, 0x96, 0x53, 0x5d, 0x16, 0x5c, 0xc5, 0xb4, 0x7e, 0xa0, 0xd8, 0xfd, 0xf1, 0xaf, 0x0f,
-	0xdc, 0x67, 0x78, 0x1e, 0x00, 0x00, 0xff, 0xff, 0xad, 0x7f, 0x03, 0x4a, 0x06, 0x03, 0x00, 0x00,
+	// 439 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x93, 0xc1, 0x8e, 0xd3, 0x30,
+	0x10, 0x86, 0x49, 0xd2, 0x2c, 0x74, 0x60, 0x0b, 0x98, 0x12, 0x42, 0x60, 0xa1, 0x98, 0xcb, 0x0a,
+	0x41, 0x2b, 0x95, 0x1e, 0xb9, 0x94, 0x4a, 0x54, 0x95, 0x10, 0x54, 0xae, 0xc4, 0x01, 0x09, 0x21,
+	0x83, 0x2d, 0x62, 0x11, 0xe2, 0x10, 0x3b, 0x08, 0xf1, 0x00, 0xbc, 0x34, 0x17, 0x64, 0x3b, 0x0d,
+	0x09, 0x9b, 0x9c, 0x3a, 0x33, 0xfe, 0xe6, 0xf7, 0xef, 0xcc, 0x14, 0x80, 0x56, 0x3a, 0x9d, 0x17,
+	0xa5, 0xd4, 0x12, 0x8d, 0x4c, 0x8c, 0x1f, 0xc1, 0xe9, 0x2b, 0x91, 0xb3, 0x1d, 0x23, 0xfc, 0x7b,
+	0xc5, 0x95, 0x46, 0x37, 0x20, 0x50, 0x82, 0xc5, 0xde, 0xcc, 0x3b, 0x1f, 0x13, 0x13, 0xe2, 0x17,
+	0x30, 0x39, 0x22, 0xaa, 0x90, 0xb9, 0xe2, 0x68, 0x0a, 0xe1, 0x0f, 0x9a, 0x55, 0xdc, 0x52, 0x01,
+	0x71, 0x89, 0xa9, 0x66, 0xf2, 0x8b, 0xc8, 0x63, 0xdf, 0xf6, 0xba, 0x04, 0x3f, 0x85, 0xf8, 0x0d,
+	0xfd, 0xc6, 0xd5, 0x3a, 0x67, 0x7b, 0xaa, 0x53, 0xf5, 0x5a, 0x28, 0xdd, 0xba, 0x4b, 0x30, 0x15,
+	0x7b, 0xb3, 0xe0, 0x3c, 0x24, 0x26, 0xc4, 0x1b, 0xb8, 0xdd, 0xa1, 0xdb, 0x57, 0xe6, 0xe6, 0xc0,
+	0xc2, 0x63, 0xe2, 0x12, 0x53, 0x2d, 0x0c, 0x16, 0xfb, 0xae, 0x6a, 0x13, 0xfc, 0x0c, 0xee, 0xae,
+	0x2b, 0x9d, 0xca, 0x52, 0xfc, 0xa2, 0x5a, 0xc8, 0x7c, 0x93, 0xf2, 0xcf, 0x5f, 0x87, 0xdf, 0xb7,
+	0x82, 0xa4, 0x0f, 0xaf, 0x2f, 0x8e, 0xe0, 0x44, 0x69, 0xaa, 0x2b, 0x65, 0x5b, 0xae, 0x90, 0x3a,
+	0xc3, 0x8f, 0xe1, 0x2a, 0x91, 0x19, 0x3f, 0xca, 0x36, 0x8f, 0xf7, 0xda, 0x8f, 0xc7, 0x70, 0xcd,
+	0x41, 0xb5, 0x18, 0x82, 0x51, 0x29, 0x33, 0x5e, 0x43, 0x36, 0xc6, 0x4f, 0x20, 0x7a, 0x47, 0x33,
+	0xc1, 0xa8, 0xe6, 0x07, 0xae, 0x94, 0x90, 0xf9, 0xb0, 0xd5, 0xdf, 0x1e, 0xdc, 0xb9, 0x00, 0xd7,
+	0xda, 0x13, 0xf0, 0x6b, 0x38, 0x20, 0xbe, 0x60, 0xfd, 0xe3, 0x30, 0x0e, 0xcc, 0xa7, 0x8b, 0x03,
+	0xe7, 0xc0, 0xc4, 0x8d, 0xab, 0xd1, 0x3f, 0x57, 0xe8, 0x0c, 0x80, 0xff, 0x2c, 0x44, 0xc9, 0xd5,
+	0x47, 0xaa, 0xe3, 0xd0, 0xaa, 0x8e, 0xeb, 0xca, 0x5a, 0x2f, 0xff, 0xf8, 0x70, 0xda, 0xf9, 0x68,
+	0x68, 0x05, 0xe1, 0x96, 0xeb, 0x1d, 0x43, 0xb7, 0xe6, 0x76, 0xc9, 0x3a, 0x5b, 0x95, 0x4c, 0xbb,
+	0x45, 0x67, 0x19, 0x5f, 0x42, 0x6f, 0x61, 0x62, 0xbb, 0x9a, 0x81, 0xa3, 0x07, 0x8e, 0x1c, 0xda,
+	0x99, 0xe4, 0x5e, 0xcf, 0x79, 0x4b, 0xf0, 0x03, 0x44, 0x5b, 0xae, 0x3b, 0xd6, 0x0e, 0x76, 0x60,
+	0xe8, 0xa1, 0x6b, 0x1c, 0xdc, 0x8c, 0x64, 0x36, 0x0c, 0x34, 0xf2, 0x4b, 0xb8, 0xbc, 0xe5, 0xda,
+	0xcc, 0x14, 0xdd, 0x74, 0x78, 0x6b, 0x09, 0x12, 0xd4, 0x2e, 0x35, 0x3d, 0x7b, 0xb8, 0xfe, 0xdf,
+	0xcc, 0xd0, 0x7d, 0x07, 0xf6, 0xcf, 0x3d, 0x39, 0x1b, 0x38, 0x3d, 0x2a, 0xbe, 0x8c, 0xde, 0x4f,
+	0x17, 0xb4, 0x6d, 0x73, 0x61, 0xff, 0xd2, 0x9f, 0x4e, 0xec, 0xcf, 0xf3, 0xbf, 0x03, 0x00, 0x62,
+	0x84, 0x9a, 0xa4, 0xe7, 0x03, 0x00, 0x00,
 }
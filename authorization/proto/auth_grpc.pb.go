@@ -23,6 +23,7 @@ const (
 	Authorization_GetIdsAndPaths_FullMethodName         = "/auth.Authorization/GetIdsAndPaths"
 	Authorization_GetAuthorizationStatus_FullMethodName = "/auth.Authorization/GetAuthorizationStatus"
 	Authorization_GetRole_FullMethodName                = "/auth.Authorization/GetRole"
+	Authorization_ValidateSession_FullMethodName        = "/auth.Authorization/ValidateSession"
 )
 
 // AuthorizationClient is the client API for Authorization service.
@@ -33,6 +34,7 @@ type AuthorizationClient interface {
 	GetIdsAndPaths(ctx context.Context, in *NamesAndPathsListRequest, opts ...grpc.CallOption) (*NamesAndPathsResponse, error)
 	GetAuthorizationStatus(ctx context.Context, in *AuthorizationCheckRequest, opts ...grpc.CallOption) (*AuthorizationCheckResponse, error)
 	GetRole(ctx context.Context, in *RoleRequest, opts ...grpc.CallOption) (*RoleResponse, error)
+	ValidateSession(ctx context.Context, in *ValidateSessionRequest, opts ...grpc.CallOption) (*ValidateSessionResponse, error)
 }
 
 type authorizationClient struct {
@@ -79,6 +81,15 @@ func (c *authorizationClient) GetRole(ctx context.Context, in *RoleRequest, opts
 	return out, nil
 }
 
+func (c *authorizationClient) ValidateSession(ctx context.Context, in *ValidateSessionRequest, opts ...grpc.CallOption) (*ValidateSessionResponse, error) {
+	out := new(ValidateSessionResponse)
+	err := c.cc.Invoke(ctx, Authorization_ValidateSession_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // AuthorizationServer is the server API for Authorization service.
 // All implementations must embed UnimplementedAuthorizationServer
 // for forward compatibility
@@ -87,6 +98,7 @@ type AuthorizationServer interface {
 	GetIdsAndPaths(context.Context, *NamesAndPathsListRequest) (*NamesAndPathsResponse, error)
 	GetAuthorizationStatus(context.Context, *AuthorizationCheckRequest) (*AuthorizationCheckResponse, error)
 	GetRole(context.Context, *RoleRequest) (*RoleResponse, error)
+	ValidateSession(context.Context, *ValidateSessionRequest) (*ValidateSessionResponse, error)
 	mustEmbedUnimplementedAuthorizationServer()
 }
 
@@ -106,6 +118,9 @@ func (UnimplementedAuthorizationServer) GetAuthorizationStatus(context.Context,
 func (UnimplementedAuthorizationServer) GetRole(context.Context, *RoleRequest) (*RoleResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetRole not implemented")
 }
+func (UnimplementedAuthorizationServer) ValidateSession(context.Context, *ValidateSessionRequest) (*ValidateSessionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ValidateSession not implemented")
+}
 func (UnimplementedAuthorizationServer) mustEmbedUnimplementedAuthorizationServer() {}
 
 // UnsafeAuthorizationServer may be embedded to opt out of forward compatibility for this service.
@@ -191,6 +206,24 @@ func _Authorization_GetRole_Handler(srv interface{}, ctx context.Context, dec fu
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Authorization_ValidateSession_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ValidateSessionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthorizationServer).ValidateSession(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Authorization_ValidateSession_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthorizationServer).ValidateSession(ctx, req.(*ValidateSessionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Authorization_ServiceDesc is the grpc.ServiceDesc for Authorization service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -214,6 +247,10 @@ var Authorization_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetRole",
 			Handler:    _Authorization_GetRole_Handler,
 		},
+		{
+			MethodName: "ValidateSession",
+			Handler:    _Authorization_ValidateSession_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "auth.proto",
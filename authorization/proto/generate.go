@@ -0,0 +1,5 @@
+package proto
+
+// The messages and the gRPC service are generated from auth.proto with
+// protoc-gen-go v1.3.5 and protoc-gen-go-grpc v1.3.0, never edit them by hand.
+//go:generate protoc --go_out=../.. --go-grpc_out=../.. auth.proto
@@ -0,0 +1,54 @@
+package csrf
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/go-park-mail-ru/2023_2_Vkladyshi/configs"
+	"github.com/go-park-mail-ru/2023_2_Vkladyshi/pkg/memory"
+	"github.com/go-park-mail-ru/2023_2_Vkladyshi/pkg/models"
+	"github.com/go-park-mail-ru/2023_2_Vkladyshi/pkg/tokens"
+)
+
+type CsrfMemory struct {
+	kv *memory.KV
+}
+
+func GetCsrfMemoryRepo(csrfConfigs configs.DbRedisCfg) *CsrfMemory {
+	return &CsrfMemory{kv: memory.OpenKV(fmt.Sprintf("%s/%d", csrfConfigs.Host, csrfConfigs.DbNumber))}
+}
+
+func (repo *CsrfMemory) AddCsrf(ctx context.Context, active models.Csrf, lg *slog.Logger) (bool, error) {
+	repo.kv.Set(tokens.Key(active.SID), "1", 3*time.Hour)
+
+	return repo.CheckActiveCsrf(ctx, active.SID, lg)
+}
+
+func (repo *CsrfMemory) CheckActiveCsrf(ctx context.Context, sid string, lg *slog.Logger) (bool, error) {
+	_, ok := repo.kv.Get(tokens.Key(sid))
+	if !ok {
+		lg.Error("Csrf token not found")
+	}
+
+	return ok, nil
+}
+
+func (repo *CsrfMemory) DeleteSession(ctx context.Context, sid string, lg *slog.Logger) (bool, error) {
+	repo.kv.Del(tokens.Key(sid))
+
+	return true, nil
+}
+
+// NewCsrfRepo creates the csrf repository of the configured backend.
+func NewCsrfRepo(csrfConfigs configs.DbRedisCfg, lg *slog.Logger) (ICsrfRepo, error) {
+	switch csrfConfigs.Backend {
+	case "redis":
+		return GetCsrfRepo(csrfConfigs, lg)
+	case "memory":
+		return GetCsrfMemoryRepo(csrfConfigs), nil
+	}
+
+	return nil, fmt.Errorf("unknown csrf backend %q", csrfConfigs.Backend)
+}
@@ -3,33 +3,38 @@ package csrf
 import (
 	"context"
 	"log/slog"
-	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/go-park-mail-ru/2023_2_Vkladyshi/configs"
 	"github.com/go-park-mail-ru/2023_2_Vkladyshi/pkg/models"
+	"github.com/go-park-mail-ru/2023_2_Vkladyshi/pkg/tokens"
 	"github.com/go-redis/redis/v8"
 )
 
-var mutex sync.RWMutex
+type ICsrfRepo interface {
+	AddCsrf(ctx context.Context, active models.Csrf, lg *slog.Logger) (bool, error)
+	CheckActiveCsrf(ctx context.Context, sid string, lg *slog.Logger) (bool, error)
+	DeleteSession(ctx context.Context, sid string, lg *slog.Logger) (bool, error)
+}
 
 type CsrfRepo struct {
 	csrfRedisClient *redis.Client
-	Connection      bool
+	connection      atomic.Bool
 }
 
-func (redisRepo *CsrfRepo) CheckRedisCsrfConnection(csrfCfg configs.DbRedisCfg) {
-	ctx := context.Background()
-	for {
-		_, err := redisRepo.csrfRedisClient.Ping(ctx).Result()
-		mutex.RLock()
-		mutex.Lock()
-		redisRepo.Connection = err == nil
-		mutex.Unlock()
-		mutex.RUnlock()
-
-		time.Sleep(time.Duration(csrfCfg.Timer) * time.Second)
-	}
+// Ping lets the health checker tell whether Redis is reachable. Calls made
+// while the last ping failed return at once.
+func (redisRepo *CsrfRepo) Ping(ctx context.Context) error {
+	_, err := redisRepo.csrfRedisClient.Ping(ctx).Result()
+	redisRepo.connection.Store(err == nil)
+
+	return err
+}
+
+// Close closes the client once the service has stopped.
+func (redisRepo *CsrfRepo) Close() error {
+	return redisRepo.csrfRedisClient.Close()
 }
 
 func GetCsrfRepo(csrfConfigs configs.DbRedisCfg, lg *slog.Logger) (*CsrfRepo, error) {
@@ -48,21 +53,19 @@ func GetCsrfRepo(csrfConfigs configs.DbRedisCfg, lg *slog.Logger) (*CsrfRepo, er
 
 	csrfRepo := CsrfRepo{
 		csrfRedisClient: redisClient,
-		Connection:      true,
 	}
-
-	go csrfRepo.CheckRedisCsrfConnection(csrfConfigs)
+	csrfRepo.connection.Store(true)
 
 	return &csrfRepo, nil
 }
 
 func (redisRepo *CsrfRepo) AddCsrf(ctx context.Context, active models.Csrf, lg *slog.Logger) (bool, error) {
-	if !redisRepo.Connection {
+	if !redisRepo.connection.Load() {
 		lg.Error("Redis csrf connection lost")
 		return false, nil
 	}
 
-	redisRepo.csrfRedisClient.Set(ctx, active.SID, active.SID, 3*time.Hour)
+	redisRepo.csrfRedisClient.Set(ctx, tokens.Key(active.SID), 1, 3*time.Hour)
 
 	csrfAdded, err_check := redisRepo.CheckActiveCsrf(ctx, active.SID, lg)
 
@@ -75,19 +78,19 @@ func (redisRepo *CsrfRepo) AddCsrf(ctx context.Context, active models.Csrf, lg *
 }
 
 func (redisRepo *CsrfRepo) CheckActiveCsrf(ctx context.Context, sid string, lg *slog.Logger) (bool, error) {
-	if !redisRepo.Connection {
+	if !redisRepo.connection.Load() {
 		lg.Error("Redis csrf connection lost")
 		return false, nil
 	}
 
-	_, err := redisRepo.csrfRedisClient.Get(ctx, sid).Result()
+	_, err := redisRepo.csrfRedisClient.Get(ctx, tokens.Key(sid)).Result()
 	if err == redis.Nil {
-		lg.Error("Key " + sid + " not found")
+		lg.Error("Csrf token not found")
 		return false, nil
 	}
 
 	if err != nil {
-		lg.Error("Get request could not be completed ", err)
+		lg.Error("Get request could not be completed", "err", err.Error())
 		return false, err
 	}
 
@@ -95,9 +98,9 @@ func (redisRepo *CsrfRepo) CheckActiveCsrf(ctx context.Context, sid string, lg *
 }
 
 func (redisRepo *CsrfRepo) DeleteSession(ctx context.Context, sid string, lg *slog.Logger) (bool, error) {
-	_, err := redisRepo.csrfRedisClient.Del(ctx, sid).Result()
+	_, err := redisRepo.csrfRedisClient.Del(ctx, tokens.Key(sid)).Result()
 	if err != nil {
-		lg.Error("Delete request could not be completed:", err)
+		lg.Error("Delete request could not be completed", "err", err.Error())
 		return false, err
 	}
 
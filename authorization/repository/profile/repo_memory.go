@@ -0,0 +1,257 @@
+package profile
+
+import (
+	"fmt"
+	"log/slog"
+	"slices"
+	"time"
+
+	"github.com/go-park-mail-ru/2023_2_Vkladyshi/configs"
+	"github.com/go-park-mail-ru/2023_2_Vkladyshi/pkg/memory"
+	"github.com/go-park-mail-ru/2023_2_Vkladyshi/pkg/models"
+)
+
+type RepoMemory struct {
+	store *memory.Store
+}
+
+func GetUserMemoryRepo(config *configs.DbDsnCfg) (*RepoMemory, error) {
+	store, err := memory.Open(config.Seed)
+	if err != nil {
+		return nil, fmt.Errorf("get user repo err: %w", err)
+	}
+
+	return &RepoMemory{store: store}, nil
+}
+
+// NewUserRepo creates the users repository of the configured backend.
+func NewUserRepo(config *configs.DbDsnCfg, lg *slog.Logger) (IUserRepo, error) {
+	switch config.UsersDb {
+	case "postgres":
+		return GetUserRepo(config, lg)
+	case "memory":
+		return GetUserMemoryRepo(config)
+	}
+
+	return nil, fmt.Errorf("unknown users db %q", config.UsersDb)
+}
+
+func (repo *RepoMemory) GetUser(login string) (*models.UserItem, bool, error) {
+	repo.store.RLock()
+	defer repo.store.RUnlock()
+
+	profile := repo.store.Profile(login)
+	if profile == nil {
+		return nil, false, nil
+	}
+
+	return &models.UserItem{Login: profile.Login, Photo: profile.Photo, Password: profile.Password}, true, nil
+}
+
+func (repo *RepoMemory) SetPassword(login string, password string) error {
+	repo.store.Lock()
+	defer repo.store.Unlock()
+
+	if profile := repo.store.Profile(login); profile != nil {
+		profile.Password = password
+	}
+
+	return nil
+}
+
+func (repo *RepoMemory) FindUser(login string) (bool, error) {
+	repo.store.RLock()
+	defer repo.store.RUnlock()
+
+	return repo.store.Profile(login) != nil, nil
+}
+
+func (repo *RepoMemory) GetUserProfileId(login string) (int64, error) {
+	repo.store.RLock()
+	defer repo.store.RUnlock()
+
+	profile := repo.store.Profile(login)
+	if profile == nil {
+		return 0, fmt.Errorf("User not found for login: %s", login)
+	}
+
+	return int64(profile.Id), nil
+}
+
+func (repo *RepoMemory) CreateUser(login string, password string, name string, birthDate string, email string) error {
+	repo.store.Lock()
+	defer repo.store.Unlock()
+
+	if repo.store.Profile(login) != nil {
+		return fmt.Errorf("CreateUser err: login %s is taken", login)
+	}
+	repo.store.Profiles = append(repo.store.Profiles, memory.Profile{
+		Id:               memory.NextId(repo.store.Profiles, func(p memory.Profile) uint64 { return p.Id }),
+		Name:             name,
+		BirthDate:        birthDate,
+		Photo:            "/avatars/default.jpg",
+		Login:            login,
+		Password:         password,
+		Email:            email,
+		RegistrationDate: time.Now(),
+		Role:             "user",
+	})
+
+	return nil
+}
+
+func (repo *RepoMemory) CountPhotoUsage(photo string) (uint64, error) {
+	repo.store.RLock()
+	defer repo.store.RUnlock()
+
+	var count uint64
+	for _, profile := range repo.store.Profiles {
+		if profile.Photo == photo {
+			count++
+		}
+	}
+
+	return count, nil
+}
+
+func (repo *RepoMemory) GetNamesAndPaths(ids []int32) ([]string, []string, error) {
+	repo.store.RLock()
+	defer repo.store.RUnlock()
+
+	var names []string
+	var paths []string
+	for i, id := range ids {
+		if slices.Contains(ids[:i], id) {
+			continue
+		}
+		for _, profile := range repo.store.Profiles {
+			if profile.Id == uint64(id) {
+				names = append(names, profile.Login)
+				paths = append(paths, profile.Photo)
+			}
+		}
+	}
+
+	return names, paths, nil
+}
+
+func (repo *RepoMemory) GetUserProfile(login string) (*models.UserItem, error) {
+	repo.store.RLock()
+	defer repo.store.RUnlock()
+
+	profile := repo.store.Profile(login)
+	if profile == nil {
+		return nil, fmt.Errorf("GetUserProfile err: user %s not found", login)
+	}
+
+	return &models.UserItem{
+		Name:      profile.Name,
+		Birthdate: memory.Date(profile.BirthDate),
+		Login:     profile.Login,
+		Email:     profile.Email,
+		Photo:     profile.Photo,
+	}, nil
+}
+
+func (repo *RepoMemory) EditProfile(prevLogin string, login string, password string, email string, birthDate string, photo string) error {
+	repo.store.Lock()
+	defer repo.store.Unlock()
+
+	profile := repo.store.Profile(prevLogin)
+	if profile == nil {
+		return nil
+	}
+	if login != "" && login != prevLogin && repo.store.Profile(login) != nil {
+		return fmt.Errorf("failed to edit profile in db: login %s is taken", login)
+	}
+
+	if login != "" {
+		profile.Login = login
+	}
+	if photo != "" {
+		profile.Photo = photo
+	}
+	if email != "" {
+		profile.Email = email
+	}
+	if password != "" {
+		profile.Password = password
+	}
+	if birthDate != "" {
+		profile.BirthDate = birthDate
+	}
+
+	return nil
+}
+
+func (repo *RepoMemory) GetUserRole(login string) (string, error) {
+	repo.store.RLock()
+	defer repo.store.RUnlock()
+
+	profile := repo.store.Profile(login)
+	if profile == nil {
+		return "", fmt.Errorf("get user role err: user %s not found", login)
+	}
+
+	return profile.Role, nil
+}
+
+func (repo *RepoMemory) GetUserIdentity(login string) (*models.UserItem, error) {
+	repo.store.RLock()
+	defer repo.store.RUnlock()
+
+	profile := repo.store.Profile(login)
+	if profile == nil {
+		return nil, fmt.Errorf("get user identity err: user %s not found", login)
+	}
+
+	return &models.UserItem{Id: profile.Id, Login: profile.Login, Name: profile.Name, Role: profile.Role}, nil
+}
+
+func (repo *RepoMemory) IsSubscribed(login string) (bool, error) {
+	repo.store.RLock()
+	defer repo.store.RUnlock()
+
+	profile := repo.store.Profile(login)
+	if profile == nil {
+		return false, fmt.Errorf("is subscribed err: user %s not found", login)
+	}
+
+	return profile.IsSubscribed, nil
+}
+
+func (repo *RepoMemory) ChangeSubsribe(login string, isSubscribed bool) error {
+	repo.store.Lock()
+	defer repo.store.Unlock()
+
+	if profile := repo.store.Profile(login); profile != nil {
+		profile.IsSubscribed = isSubscribed
+	}
+
+	return nil
+}
+
+func (repo *RepoMemory) FindUsers(login string, role string, first, limit uint64) ([]models.UserItem, error) {
+	repo.store.RLock()
+	defer repo.store.RUnlock()
+
+	users := []models.UserItem{}
+	for _, profile := range repo.store.Profiles {
+		if (login == "" || profile.Login == login) && (role == "" || profile.Role == role) {
+			users = append(users, models.UserItem{Id: profile.Id, Login: profile.Login, Photo: profile.Photo, Role: profile.Role})
+		}
+	}
+
+	return memory.Paginate(users, first, limit), nil
+}
+
+func (repo *RepoMemory) ChangeUsersRole(login string, role string) error {
+	repo.store.Lock()
+	defer repo.store.Unlock()
+
+	if profile := repo.store.Profile(login); profile != nil {
+		profile.Role = role
+	}
+
+	return nil
+}
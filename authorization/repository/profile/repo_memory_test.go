@@ -0,0 +1,76 @@
+package profile
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/go-park-mail-ru/2023_2_Vkladyshi/pkg/memory"
+	"github.com/go-park-mail-ru/2023_2_Vkladyshi/pkg/models"
+)
+
+func TestMemoryUsers(t *testing.T) {
+	repo := &RepoMemory{store: &memory.Store{Seed: memory.Seed{Profiles: []memory.Profile{
+		{Id: 1, Login: "admin", Password: "admin", Photo: "/avatars/admin.jpg", Role: "admin"},
+	}}}}
+
+	err := repo.CreateUser("viewer", "secret", "Зритель", "2000-06-15", "viewer@example.com")
+	if err != nil {
+		t.Errorf("CreateUser error: %s", err)
+	}
+	if err := repo.CreateUser("admin", "secret", "", "", ""); err == nil {
+		t.Errorf("expected error for taken login")
+	}
+
+	id, err := repo.GetUserProfileId("viewer")
+	if err != nil || id != 2 {
+		t.Errorf("GetUserProfileId = %d, %v", id, err)
+	}
+	if _, err := repo.GetUserProfileId("nobody"); err == nil {
+		t.Errorf("expected error for unknown login")
+	}
+
+	identity, err := repo.GetUserIdentity("viewer")
+	if err != nil || !reflect.DeepEqual(identity, &models.UserItem{Id: 2, Login: "viewer", Name: "Зритель", Role: "user"}) {
+		t.Errorf("GetUserIdentity = %v, %v", identity, err)
+	}
+
+	user, found, _ := repo.GetUser("viewer")
+	if !found || !reflect.DeepEqual(user, &models.UserItem{Login: "viewer", Photo: "/avatars/default.jpg", Password: "secret"}) {
+		t.Errorf("GetUser = %v, %v", user, found)
+	}
+	if _, found, _ := repo.GetUser("nobody"); found {
+		t.Errorf("expected unknown login to fail")
+	}
+	repo.SetPassword("viewer", "hash")
+	if user, _, _ := repo.GetUser("viewer"); user.Password != "hash" {
+		t.Errorf("SetPassword did not change the password")
+	}
+
+	profile, err := repo.GetUserProfile("viewer")
+	if err != nil || profile.Birthdate != "2000-06-15T00:00:00Z" || profile.Email != "viewer@example.com" {
+		t.Errorf("GetUserProfile = %v, %v", profile, err)
+	}
+
+	if err := repo.EditProfile("viewer", "admin", "", "", "", ""); err == nil {
+		t.Errorf("expected error for taken login")
+	}
+	err = repo.EditProfile("viewer", "watcher", "", "", "", "/avatars/watcher.jpg")
+	if err != nil {
+		t.Errorf("EditProfile error: %s", err)
+	}
+	if found, _ := repo.FindUser("viewer"); found {
+		t.Errorf("expected old login to be free")
+	}
+
+	_ = repo.ChangeUsersRole("watcher", "admin")
+	users, _ := repo.FindUsers("", "admin", 0, 10)
+	if len(users) != 2 || users[1].Login != "watcher" {
+		t.Errorf("FindUsers = %v", users)
+	}
+
+	names, paths, _ := repo.GetNamesAndPaths([]int32{2, 1})
+	if !reflect.DeepEqual(names, []string{"watcher", "admin"}) ||
+		!reflect.DeepEqual(paths, []string{"/avatars/watcher.jpg", "/avatars/admin.jpg"}) {
+		t.Errorf("GetNamesAndPaths = %v, %v", names, paths)
+	}
+}
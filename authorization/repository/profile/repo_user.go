@@ -1,13 +1,13 @@
 package profile
 
 import (
+	"context"
 	"database/sql"
 	"errors"
 	"fmt"
 	"log/slog"
 	"strconv"
 	"strings"
-	"time"
 
 	"github.com/go-park-mail-ru/2023_2_Vkladyshi/configs"
 	"github.com/go-park-mail-ru/2023_2_Vkladyshi/pkg/models"
@@ -16,19 +16,21 @@ import (
 )
 
 type IUserRepo interface {
-	GetUser(login string, password string) (*models.UserItem, bool, error)
+	GetUser(login string) (*models.UserItem, bool, error)
+	SetPassword(login string, password string) error
 	GetUserProfileId(login string) (int64, error)
 	FindUser(login string) (bool, error)
 	CreateUser(login string, password string, name string, birthDate string, email string) error
 	GetUserProfile(login string) (*models.UserItem, error)
 	EditProfile(prevLogin string, login string, password string, email string, birthDate string, photo string) error
+	CountPhotoUsage(photo string) (uint64, error)
 	GetNamesAndPaths(ids []int32) ([]string, []string, error)
-	CheckUserPassword(login string, password string) (bool, error)
 	GetUserRole(login string) (string, error)
 	IsSubscribed(login string) (bool, error)
 	ChangeSubsribe(login string, isSubscribed bool) error
 	FindUsers(login string, role string, first, limit uint64) ([]models.UserItem, error)
 	ChangeUsersRole(login string, role string) error
+	GetUserIdentity(login string) (*models.UserItem, error)
 }
 
 type RepoPostgre struct {
@@ -52,51 +54,69 @@ func GetUserRepo(config *configs.DbDsnCfg, lg *slog.Logger) (*RepoPostgre, error
 
 	postgreDb := RepoPostgre{db: db}
 
-	go postgreDb.pingDb(config.Timer, lg)
 	return &postgreDb, nil
 }
 
-func (repo *RepoPostgre) pingDb(timer uint32, lg *slog.Logger) {
-	for {
-		err := repo.db.Ping()
-		if err != nil {
-			lg.Error("Repo Profile db ping error", "err", err.Error())
-		}
+// Ping lets the health checker tell whether the database is reachable.
+func (repo *RepoPostgre) Ping(ctx context.Context) error {
+	return repo.db.PingContext(ctx)
+}
 
-		time.Sleep(time.Duration(timer) * time.Second)
-	}
+// Close closes the connection pool once the service has stopped.
+func (repo *RepoPostgre) Close() error {
+	return repo.db.Close()
 }
 
-func (repo *RepoPostgre) CheckUserPassword(login string, password string) (bool, error) {
+// GetUser returns the login, photo and stored password of the user, the
+// password is checked by the caller.
+func (repo *RepoPostgre) GetUser(login string) (*models.UserItem, bool, error) {
 	post := &models.UserItem{}
 
 	err := repo.db.QueryRow(
-		"SELECT login FROM profile "+
-			"WHERE login = $1 AND password = $2", login, password).Scan(&post.Login)
+		"SELECT login, photo, password FROM profile "+
+			"WHERE login = $1", login).Scan(&post.Login, &post.Photo, &post.Password)
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
-			return false, nil
+			return nil, false, nil
 		}
-		return false, fmt.Errorf("CheckUserPassword err: %w", err)
+		return nil, false, fmt.Errorf("GetUser err: %w", err)
 	}
 
-	return true, nil
+	return post, true, nil
 }
 
-func (repo *RepoPostgre) GetUser(login string, password string) (*models.UserItem, bool, error) {
-	post := &models.UserItem{}
+func (repo *RepoPostgre) SetPassword(login string, password string) error {
+	_, err := repo.db.Exec("UPDATE profile SET password = $1 WHERE login = $2", password, login)
+	if err != nil {
+		return fmt.Errorf("SetPassword err: %w", err)
+	}
 
-	err := repo.db.QueryRow(
-		"SELECT login, photo FROM profile "+
-			"WHERE login = $1 AND password = $2", login, password).Scan(&post.Login, &post.Photo)
+	return nil
+}
+
+// UnhashedLogins returns the logins whose stored password does not start
+// with prefix.
+func (repo *RepoPostgre) UnhashedLogins(prefix string) ([]string, error) {
+	rows, err := repo.db.Query(
+		"SELECT login FROM profile WHERE left(password, $1) <> $2 ORDER BY id", len(prefix), prefix)
 	if err != nil {
-		if errors.Is(err, sql.ErrNoRows) {
-			return nil, false, nil
+		return nil, fmt.Errorf("UnhashedLogins err: %w", err)
+	}
+	defer rows.Close()
+
+	logins := []string{}
+	for rows.Next() {
+		var login string
+		if err := rows.Scan(&login); err != nil {
+			return nil, fmt.Errorf("UnhashedLogins scan err: %w", err)
 		}
-		return nil, false, fmt.Errorf("GetUser err: %w", err)
+		logins = append(logins, login)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("UnhashedLogins err: %w", err)
 	}
 
-	return post, true, nil
+	return logins, nil
 }
 
 func (repo *RepoPostgre) FindUser(login string) (bool, error) {
@@ -142,6 +162,16 @@ func (repo *RepoPostgre) CreateUser(login string, password string, name string,
 	return nil
 }
 
+func (repo *RepoPostgre) CountPhotoUsage(photo string) (uint64, error) {
+	var count uint64
+	err := repo.db.QueryRow("SELECT COUNT(*) FROM profile WHERE photo = $1", photo).Scan(&count)
+	if err != nil {
+		return 0, fmt.Errorf("CountPhotoUsage err: %w", err)
+	}
+
+	return count, nil
+}
+
 func (repo *RepoPostgre) GetNamesAndPaths(ids []int32) ([]string, []string, error) {
 	var s strings.Builder
 	s.WriteString("SELECT login, photo FROM profile WHERE id = ANY ($1::INTEGER[]) " +
@@ -247,6 +277,20 @@ func (repo *RepoPostgre) GetUserRole(login string) (string, error) {
 	return role, nil
 }
 
+// GetUserIdentity returns the id, login, name and role of the user in one query.
+func (repo *RepoPostgre) GetUserIdentity(login string) (*models.UserItem, error) {
+	user := &models.UserItem{}
+
+	err := repo.db.QueryRow(
+		"SELECT id, login, name, role FROM profile "+
+			"WHERE login = $1", login).Scan(&user.Id, &user.Login, &user.Name, &user.Role)
+	if err != nil {
+		return nil, fmt.Errorf("get user identity err: %w", err)
+	}
+
+	return user, nil
+}
+
 func (repo *RepoPostgre) IsSubscribed(login string) (bool, error) {
 	var isSubcribed bool
 
@@ -17,25 +17,26 @@ func TestGetUser(t *testing.T) {
 	}
 	defer db.Close()
 
-	rows := sqlmock.NewRows([]string{"photo", "login"})
+	rows := sqlmock.NewRows([]string{"login", "photo", "password"})
 
 	testUser := models.UserItem{
-		Photo: "url1",
-		Login: "l1",
+		Photo:    "url1",
+		Login:    "l1",
+		Password: "$argon2id$v=19$m=19456,t=2,p=1$c2FsdA$a2V5",
 	}
 	expect := []*models.UserItem{&testUser}
 
 	for _, item := range expect {
-		rows = rows.AddRow(item.Login, item.Photo)
+		rows = rows.AddRow(item.Login, item.Photo, item.Password)
 	}
 
-	mock.ExpectQuery("SELECT login, photo FROM profile WHERE").WithArgs(expect[0].Login, expect[0].Password).WillReturnRows(rows)
+	mock.ExpectQuery("SELECT login, photo, password FROM profile WHERE").WithArgs(expect[0].Login).WillReturnRows(rows)
 
 	repo := &RepoPostgre{
 		db: db,
 	}
 
-	user, foundAccount, err := repo.GetUser(expect[0].Login, expect[0].Password)
+	user, foundAccount, err := repo.GetUser(expect[0].Login)
 	if err != nil {
 		t.Errorf("GetUser error: %s", err)
 	}
@@ -54,11 +55,11 @@ func TestGetUser(t *testing.T) {
 	}
 
 	mock.
-		ExpectQuery("SELECT login, photo FROM profile WHERE").
-		WithArgs(expect[0].Login, expect[0].Password).
+		ExpectQuery("SELECT login, photo, password FROM profile WHERE").
+		WithArgs(expect[0].Login).
 		WillReturnError(fmt.Errorf("db_error"))
 
-	_, found, err := repo.GetUser(expect[0].Login, expect[0].Password)
+	_, found, err := repo.GetUser(expect[0].Login)
 	if err := mock.ExpectationsWereMet(); err != nil {
 		t.Errorf("there were unfulfilled expectations: %s", err)
 		return
@@ -238,3 +239,118 @@ func TestEditProfile(t *testing.T) {
 		return
 	}
 }
+
+func TestCountPhotoUsage(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("cant create mock: %s", err)
+	}
+	defer db.Close()
+
+	mock.ExpectQuery(
+		regexp.QuoteMeta("SELECT COUNT(*) FROM profile WHERE photo = $1")).
+		WithArgs("ph1").
+		WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(1))
+
+	repo := &RepoPostgre{
+		db: db,
+	}
+
+	count, err := repo.CountPhotoUsage("ph1")
+	if err != nil {
+		t.Errorf("unexpected error: %s", err)
+		return
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("there were unfulfilled expectations: %s", err)
+		return
+	}
+	if count != 1 {
+		t.Errorf("wanted 1, got %d", count)
+		return
+	}
+
+	mock.ExpectQuery(
+		regexp.QuoteMeta("SELECT COUNT(*) FROM profile WHERE photo = $1")).
+		WithArgs("ph1").
+		WillReturnError(fmt.Errorf("db_error"))
+
+	_, err = repo.CountPhotoUsage("ph1")
+	if err == nil {
+		t.Errorf("expected error, got nil")
+		return
+	}
+}
+
+func TestGetUserIdentity(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("cant create mock: %s", err)
+	}
+	defer db.Close()
+
+	mock.ExpectQuery(
+		regexp.QuoteMeta("SELECT id, login, name, role FROM profile WHERE login = $1")).
+		WithArgs("l1").
+		WillReturnRows(sqlmock.NewRows([]string{"id", "login", "name", "role"}).AddRow(1, "l1", "n1", "admin"))
+
+	repo := &RepoPostgre{
+		db: db,
+	}
+
+	user, err := repo.GetUserIdentity("l1")
+	if err != nil {
+		t.Errorf("unexpected error: %s", err)
+		return
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("there were unfulfilled expectations: %s", err)
+		return
+	}
+	expect := &models.UserItem{Id: 1, Login: "l1", Name: "n1", Role: "admin"}
+	if !reflect.DeepEqual(user, expect) {
+		t.Errorf("results not match, want %v, have %v", expect, user)
+		return
+	}
+
+	mock.ExpectQuery(
+		regexp.QuoteMeta("SELECT id, login, name, role FROM profile WHERE login = $1")).
+		WithArgs("l1").
+		WillReturnError(fmt.Errorf("db_error"))
+
+	_, err = repo.GetUserIdentity("l1")
+	if err == nil {
+		t.Errorf("expected error, got nil")
+		return
+	}
+}
+
+func TestUnhashedLogins(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("cant create mock: %s", err)
+	}
+	defer db.Close()
+
+	mock.ExpectQuery(
+		regexp.QuoteMeta("SELECT login FROM profile WHERE left(password, $1) <> $2 ORDER BY id")).
+		WithArgs(10, "$argon2id$").
+		WillReturnRows(sqlmock.NewRows([]string{"login"}).AddRow("admin").AddRow("l1"))
+
+	repo := &RepoPostgre{
+		db: db,
+	}
+
+	logins, err := repo.UnhashedLogins("$argon2id$")
+	if err != nil {
+		t.Errorf("unexpected error: %s", err)
+		return
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("there were unfulfilled expectations: %s", err)
+		return
+	}
+	if !reflect.DeepEqual(logins, []string{"admin", "l1"}) {
+		t.Errorf("wanted [admin l1], got %v", logins)
+	}
+}
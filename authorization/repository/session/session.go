@@ -1,9 +1,97 @@
 package session
 
-import "time"
+import (
+	"encoding/json"
+	"sort"
+	"strconv"
+	"time"
+)
+
+// lifetime is how long a session lasts after login.
+const lifetime = 24 * time.Hour
 
 type Session struct {
 	Login     string
 	SID       string
 	ExpiresAt time.Time
+
+	// Id names the session in the list of its user, it is the storage key of
+	// SID and cannot be used in its place.
+	Id         string
+	UserAgent  string
+	Ip         string
+	CreatedAt  time.Time
+	LastActive time.Time
+}
+
+// The sessions of a user are indexed in two hashes keyed by the session id:
+// one holds the device the session was created on, the other the time of
+// its last check. Entries of expired sessions are dropped when the list is
+// read.
+func indexKey(login string) string {
+	return "sessions:" + login
+}
+
+func activityKey(login string) string {
+	return "sessions:" + login + ":active"
+}
+
+type device struct {
+	UserAgent string    `json:"user_agent"`
+	Ip        string    `json:"ip"`
+	CreatedAt time.Time `json:"created_at"`
+	ExpiresAt time.Time `json:"expires_at"`
+}
+
+// legacySession describes a session created before the index from what its
+// key still tells: the login and the remaining lifetime. Those sessions were
+// created with the same lifetime, the device they were created on is unknown.
+func legacySession(login string, ttl time.Duration) Session {
+	expiresAt := time.Now().Add(ttl)
+
+	return Session{Login: login, CreatedAt: expiresAt.Add(-lifetime), ExpiresAt: expiresAt}
+}
+
+func encodeDevice(active Session) string {
+	data, _ := json.Marshal(device{
+		UserAgent: active.UserAgent,
+		Ip:        active.Ip,
+		CreatedAt: active.CreatedAt,
+		ExpiresAt: active.ExpiresAt,
+	})
+
+	return string(data)
+}
+
+// decodeSessions builds the sessions of login from the two hashes, alive
+// tells whether the session of an id still exists.
+func decodeSessions(login string, devices map[string]string, activity map[string]string, alive func(id string) bool) []Session {
+	sessions := []Session{}
+	for id, data := range devices {
+		if !alive(id) {
+			continue
+		}
+
+		var d device
+		_ = json.Unmarshal([]byte(data), &d)
+		active := Session{
+			Login:      login,
+			Id:         id,
+			UserAgent:  d.UserAgent,
+			Ip:         d.Ip,
+			CreatedAt:  d.CreatedAt,
+			ExpiresAt:  d.ExpiresAt,
+			LastActive: d.CreatedAt,
+		}
+		if seen, err := strconv.ParseInt(activity[id], 10, 64); err == nil {
+			active.LastActive = time.Unix(seen, 0)
+		}
+		sessions = append(sessions, active)
+	}
+
+	sort.Slice(sessions, func(i, j int) bool {
+		return sessions[i].LastActive.After(sessions[j].LastActive)
+	})
+
+	return sessions
 }
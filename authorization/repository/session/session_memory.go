@@ -0,0 +1,162 @@
+package session
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"strconv"
+	"time"
+
+	"github.com/go-park-mail-ru/2023_2_Vkladyshi/configs"
+	"github.com/go-park-mail-ru/2023_2_Vkladyshi/pkg/memory"
+	"github.com/go-park-mail-ru/2023_2_Vkladyshi/pkg/middleware"
+	"github.com/go-park-mail-ru/2023_2_Vkladyshi/pkg/tokens"
+	"github.com/go-redis/redis/v8"
+)
+
+type SessionMemory struct {
+	kv *memory.KV
+}
+
+func GetSessionMemoryRepo(sessionCfg configs.DbRedisCfg) *SessionMemory {
+	return &SessionMemory{kv: memory.OpenKV(fmt.Sprintf("%s/%d", sessionCfg.Host, sessionCfg.DbNumber))}
+}
+
+func (repo *SessionMemory) AddSession(ctx context.Context, active Session, lg *slog.Logger) (bool, error) {
+	key := tokens.Key(active.SID)
+	active.CreatedAt = time.Now()
+	active.ExpiresAt = active.CreatedAt.Add(lifetime)
+	repo.kv.Set(key, active.Login, lifetime)
+	repo.kv.HSet(indexKey(active.Login), key, encodeDevice(active))
+	repo.kv.HSet(activityKey(active.Login), key, strconv.FormatInt(active.CreatedAt.Unix(), 10))
+
+	return repo.CheckActiveSession(ctx, active.SID, lg)
+}
+
+func (repo *SessionMemory) GetUserLogin(ctx context.Context, sid string, lg *slog.Logger) (string, error) {
+	login, _, ok := repo.lookup(tokens.Key(sid))
+	if !ok {
+		lg.Error("Error, cannot find session")
+		return "", redis.Nil
+	}
+
+	return login, nil
+}
+
+func (repo *SessionMemory) GetSession(ctx context.Context, sid string, lg *slog.Logger) (*Session, error) {
+	key := tokens.Key(sid)
+	login, expiresAt, ok := repo.lookup(key)
+	if !ok {
+		return nil, redis.Nil
+	}
+
+	return &Session{Login: login, SID: sid, Id: key, ExpiresAt: expiresAt}, nil
+}
+
+func (repo *SessionMemory) CheckActiveSession(ctx context.Context, sid string, lg *slog.Logger) (bool, error) {
+	_, _, ok := repo.lookup(tokens.Key(sid))
+	if !ok {
+		lg.Error("Session not found")
+	}
+
+	return ok, nil
+}
+
+// lookup returns the login and the expiry of the session stored under key.
+// Sessions created before the index are indexed on their first use, like in
+// Redis.
+func (repo *SessionMemory) lookup(key string) (string, time.Time, bool) {
+	login, expiresAt, ok := repo.kv.GetWithExpiry(key)
+	if !ok {
+		return "", time.Time{}, false
+	}
+	if !repo.kv.HExists(indexKey(login), key) {
+		repo.kv.HSet(indexKey(login), key, encodeDevice(legacySession(login, time.Until(expiresAt))))
+		repo.kv.HSet(activityKey(login), key, strconv.FormatInt(time.Now().Unix(), 10))
+	}
+
+	return login, expiresAt, true
+}
+
+func (repo *SessionMemory) DeleteSession(ctx context.Context, sid string, lg *slog.Logger) (bool, error) {
+	key := tokens.Key(sid)
+	if login, ok := repo.kv.Get(key); ok {
+		repo.kv.HDel(indexKey(login), key)
+		repo.kv.HDel(activityKey(login), key)
+	}
+	repo.kv.Del(key)
+
+	return true, nil
+}
+
+func (repo *SessionMemory) ListSessions(ctx context.Context, login string, lg *slog.Logger) ([]Session, error) {
+	sessions := decodeSessions(login, repo.kv.HGetAll(indexKey(login)), repo.kv.HGetAll(activityKey(login)), func(id string) bool {
+		if _, ok := repo.kv.Get(id); ok {
+			return true
+		}
+		repo.kv.HDel(indexKey(login), id)
+		repo.kv.HDel(activityKey(login), id)
+		return false
+	})
+
+	return sessions, nil
+}
+
+func (repo *SessionMemory) Touch(ctx context.Context, login string, sid string) error {
+	key := tokens.Key(sid)
+	if repo.kv.HExists(indexKey(login), key) {
+		repo.kv.HSet(activityKey(login), key, strconv.FormatInt(time.Now().Unix(), 10))
+	}
+
+	return nil
+}
+
+func (repo *SessionMemory) DeleteUserSession(ctx context.Context, login string, id string, lg *slog.Logger) (bool, error) {
+	if !repo.kv.HExists(indexKey(login), id) {
+		return false, nil
+	}
+	repo.deleteSession(login, id)
+
+	return true, nil
+}
+
+func (repo *SessionMemory) DeleteUserSessions(ctx context.Context, login string, keepSid string, lg *slog.Logger) (int, error) {
+	keep := ""
+	if keepSid != "" {
+		keep = tokens.Key(keepSid)
+	}
+
+	revoked := 0
+	for id := range repo.kv.HGetAll(indexKey(login)) {
+		if id != keep {
+			repo.deleteSession(login, id)
+			revoked++
+		}
+	}
+
+	return revoked, nil
+}
+
+func (repo *SessionMemory) deleteSession(login string, id string) {
+	repo.kv.Del(id)
+	repo.kv.HDel(indexKey(login), id)
+	repo.kv.HDel(activityKey(login), id)
+}
+
+func (repo *SessionMemory) PublishEvent(ctx context.Context, event middleware.SessionEvent) error {
+	repo.kv.Publish(middleware.SessionEventsChannel, event.String())
+
+	return nil
+}
+
+// NewSessionRepo creates the session repository of the configured backend.
+func NewSessionRepo(sessionCfg configs.DbRedisCfg, lg *slog.Logger) (ISessionRepo, error) {
+	switch sessionCfg.Backend {
+	case "redis":
+		return GetSessionRepo(sessionCfg, lg)
+	case "memory":
+		return GetSessionMemoryRepo(sessionCfg), nil
+	}
+
+	return nil, fmt.Errorf("unknown session backend %q", sessionCfg.Backend)
+}
@@ -0,0 +1,143 @@
+package session
+
+import (
+	"context"
+	"io"
+	"log/slog"
+	"testing"
+	"time"
+
+	"github.com/go-park-mail-ru/2023_2_Vkladyshi/pkg/memory"
+	"github.com/go-park-mail-ru/2023_2_Vkladyshi/pkg/tokens"
+)
+
+func TestMemorySessions(t *testing.T) {
+	lg := slog.New(slog.NewTextHandler(io.Discard, nil))
+	ctx := context.Background()
+	kv := memory.NewKV()
+	repo := &SessionMemory{kv: kv}
+
+	sid, _ := tokens.New()
+	added, err := repo.AddSession(ctx, Session{Login: "viewer", SID: sid}, lg)
+	if !added || err != nil {
+		t.Fatalf("AddSession = %v, %v", added, err)
+	}
+	if _, ok := kv.Get(sid); ok {
+		t.Errorf("expected the session id not to be stored as it is")
+	}
+	if login, err := repo.GetUserLogin(ctx, sid, lg); login != "viewer" || err != nil {
+		t.Errorf("GetUserLogin = %q, %v", login, err)
+	}
+	if _, err := repo.GetSession(ctx, tokens.Key(sid), lg); err == nil {
+		t.Errorf("expected the stored key not to work as a session id")
+	}
+
+	// A session created before the ids were hashed stays valid.
+	legacy := "abcdefghijklmnopqrstuvwxyzABCDEF"
+	kv.Set(legacy, "admin", time.Hour)
+	if session, err := repo.GetSession(ctx, legacy, lg); err != nil || session.Login != "admin" {
+		t.Errorf("GetSession = %v, %v", session, err)
+	}
+	repo.DeleteSession(ctx, legacy, lg)
+	if found, _ := repo.CheckActiveSession(ctx, legacy, lg); found {
+		t.Errorf("expected the legacy session to be deleted")
+	}
+}
+
+func TestMemoryUserSessions(t *testing.T) {
+	lg := slog.New(slog.NewTextHandler(io.Discard, nil))
+	ctx := context.Background()
+	kv := memory.NewKV()
+	repo := &SessionMemory{kv: kv}
+
+	var sids []string
+	for _, agent := range []string{"phone", "laptop", "tablet"} {
+		sid, _ := tokens.New()
+		repo.AddSession(ctx, Session{Login: "viewer", SID: sid, UserAgent: agent, Ip: "10.0.0.1"}, lg)
+		sids = append(sids, sid)
+	}
+	other, _ := tokens.New()
+	repo.AddSession(ctx, Session{Login: "admin", SID: other}, lg)
+
+	kv.HSet(activityKey("viewer"), tokens.Key(sids[0]), "1")
+	if err := repo.Touch(ctx, "viewer", sids[1]); err != nil {
+		t.Fatalf("Touch = %v", err)
+	}
+	sessions, err := repo.ListSessions(ctx, "viewer", lg)
+	if err != nil || len(sessions) != 3 {
+		t.Fatalf("ListSessions = %v, %v", sessions, err)
+	}
+	if sessions[2].UserAgent != "phone" || sessions[2].Ip != "10.0.0.1" || sessions[2].Id != tokens.Key(sids[0]) {
+		t.Errorf("expected the least recently active session last, got %+v", sessions[2])
+	}
+
+	// An expired session is dropped from the list.
+	kv.Del(tokens.Key(sids[2]))
+	if sessions, _ = repo.ListSessions(ctx, "viewer", lg); len(sessions) != 2 {
+		t.Errorf("expected the expired session to be dropped, got %d sessions", len(sessions))
+	}
+	if kv.HExists(indexKey("viewer"), tokens.Key(sids[2])) {
+		t.Errorf("expected the expired session to leave the index")
+	}
+
+	if found, _ := repo.DeleteUserSession(ctx, "viewer", tokens.Key(other), lg); found {
+		t.Errorf("expected the session of another user not to be deleted")
+	}
+	if found, _ := repo.DeleteUserSession(ctx, "viewer", tokens.Key(sids[0]), lg); !found {
+		t.Errorf("expected the session to be deleted")
+	}
+	if active, _ := repo.CheckActiveSession(ctx, sids[0], lg); active {
+		t.Errorf("expected the revoked session to end")
+	}
+
+	sid, _ := tokens.New()
+	repo.AddSession(ctx, Session{Login: "viewer", SID: sid}, lg)
+	revoked, err := repo.DeleteUserSessions(ctx, "viewer", sid, lg)
+	if revoked != 1 || err != nil {
+		t.Errorf("DeleteUserSessions = %d, %v", revoked, err)
+	}
+	if sessions, _ = repo.ListSessions(ctx, "viewer", lg); len(sessions) != 1 || sessions[0].Id != tokens.Key(sid) {
+		t.Errorf("expected only the kept session, got %+v", sessions)
+	}
+	if active, _ := repo.CheckActiveSession(ctx, other, lg); !active {
+		t.Errorf("expected the sessions of other users to stay")
+	}
+
+	repo.DeleteSession(ctx, sid, lg)
+	if kv.HExists(indexKey("viewer"), tokens.Key(sid)) {
+		t.Errorf("expected a logged out session to leave the index")
+	}
+}
+
+func TestMemoryLegacySessions(t *testing.T) {
+	lg := slog.New(slog.NewTextHandler(io.Discard, nil))
+	ctx := context.Background()
+	kv := memory.NewKV()
+	repo := &SessionMemory{kv: kv}
+
+	// A session created before the index stays valid and is indexed on its
+	// first use.
+	legacy := "abcdefghijklmnopqrstuvwxyzABCDEF"
+	kv.Set(legacy, "viewer", time.Hour)
+	if login, err := repo.GetUserLogin(ctx, legacy, lg); login != "viewer" || err != nil {
+		t.Fatalf("GetUserLogin = %q, %v", login, err)
+	}
+	sessions, err := repo.ListSessions(ctx, "viewer", lg)
+	if err != nil || len(sessions) != 1 || sessions[0].Id != legacy {
+		t.Fatalf("ListSessions = %+v, %v", sessions, err)
+	}
+	if expires := time.Until(sessions[0].ExpiresAt); expires <= 0 || expires > time.Hour {
+		t.Errorf("expected the legacy session to keep its expiry, got %s", sessions[0].ExpiresAt)
+	}
+
+	// A password change ends it like any other session.
+	sid, _ := tokens.New()
+	repo.AddSession(ctx, Session{Login: "viewer", SID: sid}, lg)
+	revoked, err := repo.DeleteUserSessions(ctx, "viewer", "", lg)
+	if revoked != 2 || err != nil {
+		t.Errorf("DeleteUserSessions = %d, %v", revoked, err)
+	}
+	if active, _ := repo.CheckActiveSession(ctx, legacy, lg); active {
+		t.Errorf("expected the legacy session to end")
+	}
+}
@@ -2,32 +2,48 @@ package session
 
 import (
 	"context"
+	"errors"
+	"fmt"
 	"log/slog"
-	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/go-park-mail-ru/2023_2_Vkladyshi/configs"
+	"github.com/go-park-mail-ru/2023_2_Vkladyshi/pkg/middleware"
+	"github.com/go-park-mail-ru/2023_2_Vkladyshi/pkg/tokens"
 	"github.com/go-redis/redis/v8"
 )
 
-var mutex sync.RWMutex
+type ISessionRepo interface {
+	AddSession(ctx context.Context, active Session, lg *slog.Logger) (bool, error)
+	GetUserLogin(ctx context.Context, sid string, lg *slog.Logger) (string, error)
+	GetSession(ctx context.Context, sid string, lg *slog.Logger) (*Session, error)
+	CheckActiveSession(ctx context.Context, sid string, lg *slog.Logger) (bool, error)
+	DeleteSession(ctx context.Context, sid string, lg *slog.Logger) (bool, error)
+	ListSessions(ctx context.Context, login string, lg *slog.Logger) ([]Session, error)
+	Touch(ctx context.Context, login string, sid string) error
+	DeleteUserSession(ctx context.Context, login string, id string, lg *slog.Logger) (bool, error)
+	DeleteUserSessions(ctx context.Context, login string, keepSid string, lg *slog.Logger) (int, error)
+	PublishEvent(ctx context.Context, event middleware.SessionEvent) error
+}
 
 type SessionRepo struct {
 	sessionRedisClient *redis.Client
-	Connection         bool
+	connection         atomic.Bool
 }
 
-func (redisRepo *SessionRepo) CheckRedisSessionConnection(sessionCfg configs.DbRedisCfg) {
-	ctx := context.Background()
-	for {
-		_, err := redisRepo.sessionRedisClient.Ping(ctx).Result()
-		mutex.Lock()
-		mutex.RLock()
-		redisRepo.Connection = err == nil
-		mutex.Unlock()
-		mutex.RUnlock()
-		time.Sleep(time.Duration(sessionCfg.Timer) * time.Second)
-	}
+// Ping lets the health checker tell whether Redis is reachable. Calls made
+// while the last ping failed return at once.
+func (redisRepo *SessionRepo) Ping(ctx context.Context) error {
+	_, err := redisRepo.sessionRedisClient.Ping(ctx).Result()
+	redisRepo.connection.Store(err == nil)
+
+	return err
+}
+
+// Close closes the client once the service has stopped.
+func (redisRepo *SessionRepo) Close() error {
+	return redisRepo.sessionRedisClient.Close()
 }
 
 func GetSessionRepo(sessionCfg configs.DbRedisCfg, lg *slog.Logger) (*SessionRepo, error) {
@@ -45,21 +61,33 @@ func GetSessionRepo(sessionCfg configs.DbRedisCfg, lg *slog.Logger) (*SessionRep
 
 	sessionRepo := SessionRepo{
 		sessionRedisClient: redisClient,
-		Connection:         true,
 	}
-
-	go sessionRepo.CheckRedisSessionConnection(sessionCfg)
+	sessionRepo.connection.Store(true)
 
 	return &sessionRepo, nil
 }
 
 func (redisRepo *SessionRepo) AddSession(ctx context.Context, active Session, lg *slog.Logger) (bool, error) {
-	if !redisRepo.Connection {
+	if !redisRepo.connection.Load() {
 		lg.Error("Redis session connection lost")
 		return false, nil
 	}
 
-	redisRepo.sessionRedisClient.Set(ctx, active.SID, active.Login, 24*time.Hour)
+	key := tokens.Key(active.SID)
+	active.CreatedAt = time.Now()
+	active.ExpiresAt = active.CreatedAt.Add(lifetime)
+	_, err := redisRepo.sessionRedisClient.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		pipe.Set(ctx, key, active.Login, lifetime)
+		pipe.HSet(ctx, indexKey(active.Login), key, encodeDevice(active))
+		pipe.HSet(ctx, activityKey(active.Login), key, active.CreatedAt.Unix())
+		pipe.Expire(ctx, indexKey(active.Login), lifetime)
+		pipe.Expire(ctx, activityKey(active.Login), lifetime)
+		return nil
+	})
+	if err != nil {
+		lg.Error("add session error", "err", err.Error())
+		return false, err
+	}
 
 	sessionAdded, err_check := redisRepo.CheckActiveSession(ctx, active.SID, lg)
 
@@ -71,46 +99,267 @@ func (redisRepo *SessionRepo) AddSession(ctx context.Context, active Session, lg
 }
 
 func (redisRepo *SessionRepo) GetUserLogin(ctx context.Context, sid string, lg *slog.Logger) (string, error) {
-	if !redisRepo.Connection {
+	if !redisRepo.connection.Load() {
 		lg.Error("Redis session connection lost")
 		return "", nil
 	}
 
-	value, err := redisRepo.sessionRedisClient.Get(ctx, sid).Result()
+	value, _, err := redisRepo.lookup(ctx, tokens.Key(sid), lg)
 	if err != nil {
-		lg.Error("Error, cannot find session " + sid)
+		lg.Error("Error, cannot find session")
 		return "", err
 	}
 
 	return value, nil
 }
 
+// GetSession returns the login and the expiry of the session, redis.Nil
+// when there is no such session.
+func (redisRepo *SessionRepo) GetSession(ctx context.Context, sid string, lg *slog.Logger) (*Session, error) {
+	if !redisRepo.connection.Load() {
+		lg.Error("Redis session connection lost")
+		return nil, fmt.Errorf("get session err: redis connection lost")
+	}
+
+	key := tokens.Key(sid)
+	login, ttl, err := redisRepo.lookup(ctx, key, lg)
+	if err != nil {
+		if !errors.Is(err, redis.Nil) {
+			lg.Error("get session error", "err", err.Error())
+		}
+		return nil, err
+	}
+
+	session := &Session{Login: login, SID: sid, Id: key}
+	if ttl > 0 {
+		session.ExpiresAt = time.Now().Add(ttl)
+	}
+
+	return session, nil
+}
+
 func (redisRepo *SessionRepo) CheckActiveSession(ctx context.Context, sid string, lg *slog.Logger) (bool, error) {
-	if !redisRepo.Connection {
+	if !redisRepo.connection.Load() {
 		lg.Error("Redis session connection lost")
 		return false, nil
 	}
 
-	_, err := redisRepo.sessionRedisClient.Get(ctx, sid).Result()
+	_, _, err := redisRepo.lookup(ctx, tokens.Key(sid), lg)
 	if err == redis.Nil {
-		lg.Error("Key " + sid + " not found")
+		lg.Error("Session not found")
 		return false, nil
 	}
 
 	if err != nil {
-		lg.Error("Get request could not be completed ", err)
+		lg.Error("Get request could not be completed", "err", err.Error())
 		return false, err
 	}
 
 	return true, nil
 }
 
+// lookup returns the login and the remaining lifetime of the session stored
+// under key, redis.Nil when there is none. Sessions created before the index
+// stay valid until they expire; they are indexed on their first use, so their
+// user can list and revoke them from then on.
+func (redisRepo *SessionRepo) lookup(ctx context.Context, key string, lg *slog.Logger) (string, time.Duration, error) {
+	pipe := redisRepo.sessionRedisClient.Pipeline()
+	login := pipe.Get(ctx, key)
+	ttl := pipe.TTL(ctx, key)
+	_, err := pipe.Exec(ctx)
+	if err != nil {
+		return "", 0, err
+	}
+
+	indexed, err := redisRepo.sessionRedisClient.HExists(ctx, indexKey(login.Val()), key).Result()
+	if err != nil {
+		return "", 0, err
+	}
+	if !indexed {
+		err = redisRepo.index(ctx, key, legacySession(login.Val(), ttl.Val()))
+		if err != nil {
+			lg.Error("index session error", "err", err.Error())
+			return "", 0, err
+		}
+	}
+
+	return login.Val(), ttl.Val(), nil
+}
+
+// index adds the session stored under key to the index of its user.
+func (redisRepo *SessionRepo) index(ctx context.Context, key string, active Session) error {
+	_, err := redisRepo.sessionRedisClient.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		pipe.HSet(ctx, indexKey(active.Login), key, encodeDevice(active))
+		pipe.HSet(ctx, activityKey(active.Login), key, time.Now().Unix())
+		pipe.Expire(ctx, indexKey(active.Login), lifetime)
+		pipe.Expire(ctx, activityKey(active.Login), lifetime)
+		return nil
+	})
+
+	return err
+}
+
 func (redisRepo *SessionRepo) DeleteSession(ctx context.Context, sid string, lg *slog.Logger) (bool, error) {
-	_, err := redisRepo.sessionRedisClient.Del(ctx, sid).Result()
+	key := tokens.Key(sid)
+	login, err := redisRepo.sessionRedisClient.Get(ctx, key).Result()
+	if err != nil && !errors.Is(err, redis.Nil) {
+		lg.Error("Delete request could not be completed", "err", err.Error())
+		return false, err
+	}
+
+	_, err = redisRepo.sessionRedisClient.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		pipe.Del(ctx, key)
+		if login != "" {
+			pipe.HDel(ctx, indexKey(login), key)
+			pipe.HDel(ctx, activityKey(login), key)
+		}
+		return nil
+	})
 	if err != nil {
-		lg.Error("Delete request could not be completed:", err)
+		lg.Error("Delete request could not be completed", "err", err.Error())
 		return false, err
 	}
 
 	return true, nil
 }
+
+// ListSessions returns the sessions of login, the most recently active
+// first. Sessions created before the index are not listed.
+func (redisRepo *SessionRepo) ListSessions(ctx context.Context, login string, lg *slog.Logger) ([]Session, error) {
+	if !redisRepo.connection.Load() {
+		lg.Error("Redis session connection lost")
+		return nil, fmt.Errorf("list sessions err: redis connection lost")
+	}
+
+	pipe := redisRepo.sessionRedisClient.Pipeline()
+	devices := pipe.HGetAll(ctx, indexKey(login))
+	activity := pipe.HGetAll(ctx, activityKey(login))
+	_, err := pipe.Exec(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("list sessions err: %w", err)
+	}
+
+	pipe = redisRepo.sessionRedisClient.Pipeline()
+	exists := map[string]*redis.IntCmd{}
+	for id := range devices.Val() {
+		exists[id] = pipe.Exists(ctx, id)
+	}
+	if len(exists) > 0 {
+		if _, err = pipe.Exec(ctx); err != nil {
+			return nil, fmt.Errorf("list sessions err: %w", err)
+		}
+	}
+
+	var expired []string
+	sessions := decodeSessions(login, devices.Val(), activity.Val(), func(id string) bool {
+		if exists[id].Val() == 0 {
+			expired = append(expired, id)
+			return false
+		}
+		return true
+	})
+	if len(expired) > 0 {
+		redisRepo.unindex(ctx, login, expired, lg)
+	}
+
+	return sessions, nil
+}
+
+// Touch records that the session sid of login has just been used.
+func (redisRepo *SessionRepo) Touch(ctx context.Context, login string, sid string) error {
+	if !redisRepo.connection.Load() {
+		return fmt.Errorf("touch session err: redis connection lost")
+	}
+
+	key := tokens.Key(sid)
+	indexed, err := redisRepo.sessionRedisClient.HExists(ctx, indexKey(login), key).Result()
+	if err != nil {
+		return fmt.Errorf("touch session err: %w", err)
+	}
+	if !indexed {
+		return nil
+	}
+	err = redisRepo.sessionRedisClient.HSet(ctx, activityKey(login), key, time.Now().Unix()).Err()
+	if err != nil {
+		return fmt.Errorf("touch session err: %w", err)
+	}
+
+	return nil
+}
+
+// DeleteUserSession deletes the session with the given id, false when login
+// has no such session.
+func (redisRepo *SessionRepo) DeleteUserSession(ctx context.Context, login string, id string, lg *slog.Logger) (bool, error) {
+	indexed, err := redisRepo.sessionRedisClient.HExists(ctx, indexKey(login), id).Result()
+	if err != nil {
+		lg.Error("Delete request could not be completed", "err", err.Error())
+		return false, err
+	}
+	if !indexed {
+		return false, nil
+	}
+
+	return true, redisRepo.deleteSessions(ctx, login, []string{id}, lg)
+}
+
+// DeleteUserSessions deletes every session of login except keepSid, which
+// may be empty, and returns how many were deleted.
+func (redisRepo *SessionRepo) DeleteUserSessions(ctx context.Context, login string, keepSid string, lg *slog.Logger) (int, error) {
+	ids, err := redisRepo.sessionRedisClient.HKeys(ctx, indexKey(login)).Result()
+	if err != nil {
+		lg.Error("Delete request could not be completed", "err", err.Error())
+		return 0, err
+	}
+
+	keep := ""
+	if keepSid != "" {
+		keep = tokens.Key(keepSid)
+	}
+	var revoked []string
+	for _, id := range ids {
+		if id != keep {
+			revoked = append(revoked, id)
+		}
+	}
+	if len(revoked) == 0 {
+		return 0, nil
+	}
+
+	return len(revoked), redisRepo.deleteSessions(ctx, login, revoked, lg)
+}
+
+func (redisRepo *SessionRepo) deleteSessions(ctx context.Context, login string, ids []string, lg *slog.Logger) error {
+	_, err := redisRepo.sessionRedisClient.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		pipe.Del(ctx, ids...)
+		pipe.HDel(ctx, indexKey(login), ids...)
+		pipe.HDel(ctx, activityKey(login), ids...)
+		return nil
+	})
+	if err != nil {
+		lg.Error("Delete request could not be completed", "err", err.Error())
+	}
+
+	return err
+}
+
+func (redisRepo *SessionRepo) unindex(ctx context.Context, login string, ids []string, lg *slog.Logger) {
+	_, err := redisRepo.sessionRedisClient.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		pipe.HDel(ctx, indexKey(login), ids...)
+		pipe.HDel(ctx, activityKey(login), ids...)
+		return nil
+	})
+	if err != nil {
+		lg.Error("unindex sessions error", "err", err.Error())
+	}
+}
+
+// PublishEvent tells the services caching sessions that the session or the
+// user has changed.
+func (redisRepo *SessionRepo) PublishEvent(ctx context.Context, event middleware.SessionEvent) error {
+	err := redisRepo.sessionRedisClient.Publish(ctx, middleware.SessionEventsChannel, event.String()).Err()
+	if err != nil {
+		return fmt.Errorf("publish session event err: %w", err)
+	}
+
+	return nil
+}
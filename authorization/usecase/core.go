@@ -5,7 +5,6 @@ import (
 	"errors"
 	"fmt"
 	"log/slog"
-	"math/rand"
 	"regexp"
 	"sync"
 	"time"
@@ -14,19 +13,30 @@ import (
 	"github.com/go-park-mail-ru/2023_2_Vkladyshi/authorization/repository/profile"
 	"github.com/go-park-mail-ru/2023_2_Vkladyshi/authorization/repository/session"
 	"github.com/go-park-mail-ru/2023_2_Vkladyshi/configs"
+	"github.com/go-park-mail-ru/2023_2_Vkladyshi/pkg/healthcheck"
+	"github.com/go-park-mail-ru/2023_2_Vkladyshi/pkg/images"
+	"github.com/go-park-mail-ru/2023_2_Vkladyshi/pkg/lifecycle"
+	"github.com/go-park-mail-ru/2023_2_Vkladyshi/pkg/middleware"
 	"github.com/go-park-mail-ru/2023_2_Vkladyshi/pkg/models"
+	"github.com/go-park-mail-ru/2023_2_Vkladyshi/pkg/password"
+	"github.com/go-park-mail-ru/2023_2_Vkladyshi/pkg/storage"
+	"github.com/go-park-mail-ru/2023_2_Vkladyshi/pkg/tokens"
 )
 
 type ICore interface {
-	CreateSession(ctx context.Context, login string) (string, session.Session, error)
+	CreateSession(ctx context.Context, login string, userAgent string, ip string) (string, session.Session, error)
 	KillSession(ctx context.Context, sid string) error
+	ListSessions(ctx context.Context, sid string) ([]models.SessionItem, error)
+	RevokeSession(ctx context.Context, sid string, id string) error
+	RevokeOtherSessions(ctx context.Context, sid string) (int, error)
 	FindActiveSession(ctx context.Context, sid string) (bool, error)
 	CreateUserAccount(login string, password string, name string, birthDate string, email string) error
 	FindUserAccount(login string, password string) (*models.UserItem, bool, error)
 	FindUserByLogin(login string) (bool, error)
 	GetUserName(ctx context.Context, sid string) (string, error)
 	GetUserProfile(login string) (*models.UserItem, error)
-	EditProfile(prevLogin string, login string, password string, email string, birthDate string, photo string) error
+	EditProfile(ctx context.Context, prevLogin string, login string, password string, email string, birthDate string, photo string) error
+	SaveAvatar(ctx context.Context, img *images.Image) (string, error)
 	CheckCsrfToken(ctx context.Context, token string) (bool, error)
 	CreateCsrfToken(ctx context.Context) (string, error)
 	CheckPassword(login string, password string) (bool, error)
@@ -38,11 +48,13 @@ type ICore interface {
 }
 
 type Core struct {
-	sessions   session.SessionRepo
+	sessions   session.ISessionRepo
 	mutex      sync.RWMutex
 	lg         *slog.Logger
 	users      profile.IUserRepo
-	csrfTokens csrf.CsrfRepo
+	csrfTokens csrf.ICsrfRepo
+	storage    storage.Storage
+	hasher     *password.Hasher
 }
 
 var (
@@ -52,56 +64,136 @@ var (
 	InvalideEmail  = errors.New("invalide email")
 )
 
-var letterRunes = []rune("abcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZ")
-
-func GetCore(cfg_sql *configs.DbDsnCfg, cfg_csrf configs.DbRedisCfg, cfg_sessions configs.DbRedisCfg, lg *slog.Logger) (*Core, error) {
-	session, err := session.GetSessionRepo(cfg_sessions, lg)
+func GetCore(cfg_sql *configs.DbDsnCfg, cfg_csrf configs.DbRedisCfg, cfg_sessions configs.DbRedisCfg, store storage.Storage, lg *slog.Logger,
+	checker *healthcheck.Checker) (*Core, error) {
+	session, err := session.NewSessionRepo(cfg_sessions, lg)
 
 	if err != nil {
 		lg.Error("Session repository is not responding")
 		return nil, err
 	}
 
-	users, err := profile.GetUserRepo(cfg_sql, lg)
+	users, err := profile.NewUserRepo(cfg_sql, lg)
 	if err != nil {
 		lg.Error("cant create repo")
 		return nil, err
 	}
 
-	csrf, err := csrf.GetCsrfRepo(cfg_csrf, lg)
+	csrf, err := csrf.NewCsrfRepo(cfg_csrf, lg)
 	if err != nil {
 		lg.Error("Csrf repository is not responding")
 		return nil, err
 	}
+	checker.AddPinger("redis sessions", session)
+	checker.AddPinger("postgres users", users)
+	checker.AddPinger("redis csrf", csrf)
 
 	core := Core{
-		sessions:   *session,
+		sessions:   session,
 		lg:         lg.With("module", "core"),
 		users:      users,
-		csrfTokens: *csrf,
+		csrfTokens: csrf,
+		storage:    store,
+		hasher:     password.NewHasher(cfg_sql.PasswordHash),
 	}
 	return &core, nil
 }
 
+// Close closes the database clients of the repositories once the servers
+// have stopped.
+func (core *Core) Close() error {
+	return lifecycle.Close(core.csrfTokens, core.users, core.sessions)
+}
+
 func (core *Core) CheckPassword(login string, password string) (bool, error) {
-	found, err := core.users.CheckUserPassword(login, password)
+	user, found, err := core.users.GetUser(login)
 	if err != nil {
 		core.lg.Error("find user error", "err", err.Error())
 		return false, fmt.Errorf("FindUserAccount err: %w", err)
 	}
-	return found, nil
+	if !found {
+		return false, nil
+	}
+
+	ok, _, err := core.hasher.Verify(user.Password, password)
+	if err != nil {
+		core.lg.Error("verify password error", "err", err.Error())
+		return false, fmt.Errorf("CheckPassword err: %w", err)
+	}
+	return ok, nil
 }
 
-func (core *Core) EditProfile(prevLogin string, login string, password string, email string, birthDate string, photo string) error {
+func (core *Core) EditProfile(ctx context.Context, prevLogin string, login string, password string, email string, birthDate string, photo string) error {
+	var prevPhoto string
+	if photo != "" {
+		prev, err := core.users.GetUserProfile(prevLogin)
+		if err != nil {
+			core.lg.Error("Edit profile error", "err", err.Error())
+			return fmt.Errorf("Edit profile error: %w", err)
+		}
+		prevPhoto = prev.Photo
+	}
+
+	if password != "" {
+		hash, err := core.hasher.Hash(password)
+		if err != nil {
+			core.lg.Error("Edit profile error", "err", err.Error())
+			return fmt.Errorf("Edit profile error: %w", err)
+		}
+		password = hash
+	}
+
 	err := core.users.EditProfile(prevLogin, login, password, email, birthDate, photo)
 	if err != nil {
 		core.lg.Error("Edit profile error", "err", err.Error())
 		return fmt.Errorf("Edit profile error: %w", err)
 	}
 
+	if password != "" {
+		core.mutex.Lock()
+		_, err = core.sessions.DeleteUserSessions(ctx, prevLogin, "", core.lg)
+		core.mutex.Unlock()
+		if err != nil {
+			core.lg.Error("revoke sessions error", "err", err.Error())
+		}
+	}
+	if password != "" || login != "" && login != prevLogin {
+		core.publishSessionEvent(ctx, middleware.SessionEvent{Login: prevLogin})
+	}
+	if prevPhoto != "" && prevPhoto != photo {
+		core.removeOrphanAvatar(ctx, prevPhoto)
+	}
+
 	return nil
 }
 
+func (core *Core) SaveAvatar(ctx context.Context, img *images.Image) (string, error) {
+	photo, err := images.Save(ctx, core.storage, "avatars", img)
+	if err != nil {
+		core.lg.Error("Save avatar error", "err", err.Error())
+		return "", fmt.Errorf("Save avatar error: %w", err)
+	}
+
+	return photo, nil
+}
+
+// removeOrphanAvatar deletes a replaced avatar once no profile refers to it.
+func (core *Core) removeOrphanAvatar(ctx context.Context, photo string) {
+	count, err := core.users.CountPhotoUsage(photo)
+	if err != nil {
+		core.lg.Error("Count photo usage error", "err", err.Error())
+		return
+	}
+	if count > 0 {
+		return
+	}
+
+	err = images.Delete(ctx, core.storage, photo)
+	if err != nil {
+		core.lg.Error("Delete avatar error", "err", err.Error())
+	}
+}
+
 func (core *Core) GetUserName(ctx context.Context, sid string) (string, error) {
 	core.mutex.RLock()
 	login, err := core.sessions.GetUserLogin(ctx, sid, core.lg)
@@ -110,17 +202,26 @@ func (core *Core) GetUserName(ctx context.Context, sid string) (string, error) {
 	if err != nil {
 		return "", err
 	}
+	if err = core.sessions.Touch(ctx, login, sid); err != nil {
+		core.lg.Error("touch session error", "err", err.Error())
+	}
 
 	return login, nil
 }
 
-func (core *Core) CreateSession(ctx context.Context, login string) (string, session.Session, error) {
-	sid := RandStringRunes(32)
+func (core *Core) CreateSession(ctx context.Context, login string, userAgent string, ip string) (string, session.Session, error) {
+	sid, err := tokens.New()
+	if err != nil {
+		core.lg.Error("create session error", "err", err.Error())
+		return "", session.Session{}, err
+	}
 
 	newSession := session.Session{
 		Login:     login,
 		SID:       sid,
 		ExpiresAt: time.Now().Add(24 * time.Hour),
+		UserAgent: userAgent,
+		Ip:        ip,
 	}
 
 	core.mutex.Lock()
@@ -158,15 +259,104 @@ func (core *Core) KillSession(ctx context.Context, sid string) error {
 	if err != nil {
 		return err
 	}
+	core.publishSessionEvent(ctx, middleware.SessionEvent{Sid: sid})
+
+	return nil
+}
+
+// ListSessions returns the sessions of the user of sid, the current one
+// marked.
+func (core *Core) ListSessions(ctx context.Context, sid string) ([]models.SessionItem, error) {
+	core.mutex.RLock()
+	defer core.mutex.RUnlock()
+
+	current, err := core.sessions.GetSession(ctx, sid, core.lg)
+	if err != nil {
+		return nil, fmt.Errorf("ListSessions err: %w", err)
+	}
+	sessions, err := core.sessions.ListSessions(ctx, current.Login, core.lg)
+	if err != nil {
+		core.lg.Error("list sessions error", "err", err.Error())
+		return nil, fmt.Errorf("ListSessions err: %w", err)
+	}
+
+	items := make([]models.SessionItem, 0, len(sessions))
+	for _, active := range sessions {
+		items = append(items, models.SessionItem{
+			Id:         active.Id,
+			UserAgent:  active.UserAgent,
+			Ip:         active.Ip,
+			CreatedAt:  active.CreatedAt,
+			LastActive: active.LastActive,
+			Current:    active.Id == current.Id,
+		})
+	}
+
+	return items, nil
+}
+
+// RevokeSession signs the user of sid out of the session with the given id,
+// ErrNotFound when the user has no such session.
+func (core *Core) RevokeSession(ctx context.Context, sid string, id string) error {
+	core.mutex.Lock()
+	defer core.mutex.Unlock()
+
+	current, err := core.sessions.GetSession(ctx, sid, core.lg)
+	if err != nil {
+		return fmt.Errorf("RevokeSession err: %w", err)
+	}
+	found, err := core.sessions.DeleteUserSession(ctx, current.Login, id, core.lg)
+	if err != nil {
+		return fmt.Errorf("RevokeSession err: %w", err)
+	}
+	if !found {
+		return ErrNotFound
+	}
+	core.publishSessionEvent(ctx, middleware.SessionEvent{Login: current.Login})
 
 	return nil
 }
 
+// RevokeOtherSessions signs the user of sid out everywhere but sid and
+// returns the number of sessions ended.
+func (core *Core) RevokeOtherSessions(ctx context.Context, sid string) (int, error) {
+	core.mutex.Lock()
+	defer core.mutex.Unlock()
+
+	current, err := core.sessions.GetSession(ctx, sid, core.lg)
+	if err != nil {
+		return 0, fmt.Errorf("RevokeOtherSessions err: %w", err)
+	}
+	revoked, err := core.sessions.DeleteUserSessions(ctx, current.Login, sid, core.lg)
+	if err != nil {
+		return 0, fmt.Errorf("RevokeOtherSessions err: %w", err)
+	}
+	if revoked > 0 {
+		core.publishSessionEvent(ctx, middleware.SessionEvent{Login: current.Login})
+	}
+
+	return revoked, nil
+}
+
+// publishSessionEvent is best effort: a service that misses the event drops
+// the session from its cache when the cache ttl runs out.
+func (core *Core) publishSessionEvent(ctx context.Context, event middleware.SessionEvent) {
+	err := core.sessions.PublishEvent(ctx, event)
+	if err != nil {
+		core.lg.Error("publish session event error", "err", err.Error())
+	}
+}
+
 func (core *Core) CreateUserAccount(login string, password string, name string, birthDate string, email string) error {
 	if matched, _ := regexp.MatchString(`@`, email); !matched {
 		return InvalideEmail
 	}
-	err := core.users.CreateUser(login, password, name, birthDate, email)
+	hash, err := core.hasher.Hash(password)
+	if err != nil {
+		core.lg.Error("create user error", "err", err.Error())
+		return fmt.Errorf("CreateUserAccount err: %w", err)
+	}
+	err = core.users.CreateUser(login, hash, name, birthDate, email)
 	if err != nil {
 		core.lg.Error("create user error", "err", err.Error())
 		return fmt.Errorf("CreateUserAccount err: %w", err)
@@ -175,13 +365,44 @@ func (core *Core) CreateUserAccount(login string, password string, name string,
 	return nil
 }
 
+// FindUserAccount returns the user when password is right. A password still
+// stored in plain text or hashed with an old cost is hashed again.
 func (core *Core) FindUserAccount(login string, password string) (*models.UserItem, bool, error) {
-	user, found, err := core.users.GetUser(login, password)
+	user, found, err := core.users.GetUser(login)
 	if err != nil {
 		core.lg.Error("find user error", "err", err.Error())
 		return nil, false, fmt.Errorf("FindUserAccount err: %w", err)
 	}
-	return user, found, nil
+	if !found {
+		return nil, false, nil
+	}
+
+	ok, rehash, err := core.hasher.Verify(user.Password, password)
+	if err != nil {
+		core.lg.Error("verify password error", "err", err.Error())
+		return nil, false, fmt.Errorf("FindUserAccount err: %w", err)
+	}
+	if !ok {
+		return nil, false, nil
+	}
+	if rehash {
+		core.rehashPassword(login, password)
+	}
+
+	user.Password = ""
+	return user, true, nil
+}
+
+// rehashPassword only logs its errors, the login succeeds anyway and the
+// password is hashed on the next one.
+func (core *Core) rehashPassword(login string, password string) {
+	hash, err := core.hasher.Hash(password)
+	if err == nil {
+		err = core.users.SetPassword(login, hash)
+	}
+	if err != nil {
+		core.lg.Error("rehash password error", "err", err.Error())
+	}
 }
 
 func (core *Core) FindUserByLogin(login string) (bool, error) {
@@ -194,20 +415,13 @@ func (core *Core) FindUserByLogin(login string) (bool, error) {
 	return found, nil
 }
 
-func RandStringRunes(seed int) string {
-	symbols := make([]rune, seed)
-	for i := range symbols {
-		symbols[i] = letterRunes[rand.Intn(len(letterRunes))]
-	}
-	return string(symbols)
-}
-
 func (core *Core) GetUserProfile(login string) (*models.UserItem, error) {
 	profile, err := core.users.GetUserProfile(login)
 	if err != nil {
 		core.lg.Error("GetUserProfile error", "err", err.Error())
 		return nil, fmt.Errorf("GetUserProfile err: %w", err)
 	}
+	profile.PhotoVariants = images.Variants(profile.Photo)
 
 	return profile, nil
 }
@@ -225,7 +439,11 @@ func (core *Core) CheckCsrfToken(ctx context.Context, token string) (bool, error
 }
 
 func (core *Core) CreateCsrfToken(ctx context.Context) (string, error) {
-	sid := RandStringRunes(32)
+	sid, err := tokens.New()
+	if err != nil {
+		core.lg.Error("create csrf token error", "err", err.Error())
+		return "", err
+	}
 
 	core.mutex.Lock()
 	csrfAdded, err := core.csrfTokens.AddCsrf(
@@ -294,6 +512,9 @@ func (core *Core) FindUsers(login string, role string, first, limit uint64) ([]m
 	if len(users) == 0 {
 		return nil, ErrNotFound
 	}
+	for i := range users {
+		users[i].PhotoVariants = images.Variants(users[i].Photo)
+	}
 
 	return users, nil
 }
@@ -308,5 +529,7 @@ func (core *Core) ChangeUsersRole(login string, role string, currentUserRole str
 		core.lg.Error("change user role error", "err:", err.Error())
 		return fmt.Errorf("change user role error: %w", err)
 	}
+	core.publishSessionEvent(context.Background(), middleware.SessionEvent{Login: login})
+
 	return nil
 }
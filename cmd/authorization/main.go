@@ -1,17 +1,32 @@
 package main
 
 import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
 	"log/slog"
 	"os"
+	"time"
 
 	delivery_auth "github.com/go-park-mail-ru/2023_2_Vkladyshi/authorization/delivery/http"
 	"github.com/go-park-mail-ru/2023_2_Vkladyshi/configs"
 
 	delivery_auth_grpc "github.com/go-park-mail-ru/2023_2_Vkladyshi/authorization/delivery/grpc"
+	"github.com/go-park-mail-ru/2023_2_Vkladyshi/authorization/migrations"
+	"github.com/go-park-mail-ru/2023_2_Vkladyshi/authorization/repository/profile"
 	"github.com/go-park-mail-ru/2023_2_Vkladyshi/authorization/usecase"
+	"github.com/go-park-mail-ru/2023_2_Vkladyshi/pkg/healthcheck"
+	"github.com/go-park-mail-ru/2023_2_Vkladyshi/pkg/lifecycle"
+	"github.com/go-park-mail-ru/2023_2_Vkladyshi/pkg/migrate"
+	"github.com/go-park-mail-ru/2023_2_Vkladyshi/pkg/mtls"
+	"github.com/go-park-mail-ru/2023_2_Vkladyshi/pkg/password"
+	"github.com/go-park-mail-ru/2023_2_Vkladyshi/pkg/storage"
 )
 
 func main() {
+	flag.Parse()
 	logFile, _ := os.Create("auth_log.log")
 	lg := slog.New(slog.NewJSONHandler(logFile, nil))
 
@@ -21,6 +36,32 @@ func main() {
 		return
 	}
 
+	if flag.Arg(0) == "migrate" {
+		err = migrateSchema(config, lg, flag.Args()[1:])
+		if errors.Is(err, errUnhashed) {
+			os.Exit(2)
+		}
+		if err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			os.Exit(1)
+		}
+		return
+	}
+
+	if config.UsesPostgres() {
+		migrator, err := migrations.GetMigrator(config)
+		if err != nil {
+			lg.Error("cant create migrator", "err", err.Error())
+			return
+		}
+		err = migrator.Check()
+		migrator.Close()
+		if err != nil {
+			lg.Error("schema check error", "err", err.Error())
+			return
+		}
+	}
+
 	configCsrf, err := configs.ReadCsrfRedisConfig()
 	if err != nil {
 		lg.Error("read config error", "err", err.Error())
@@ -33,31 +74,116 @@ func main() {
 		return
 	}
 
-	core, err := usecase.GetCore(config, *configCsrf, *configSession, lg)
+	storageConfig, err := configs.ReadStorageConfig()
+	if err != nil {
+		lg.Error("read config error", "err", err.Error())
+		return
+	}
+
+	store, err := storage.New(storageConfig)
+	if err != nil {
+		lg.Error("cant create storage", "err", err.Error())
+		return
+	}
+
+	checker := healthcheck.NewChecker(lg)
+	runner := lifecycle.New(lg, lifecycle.DefaultTimeout)
+	core, err := usecase.GetCore(config, *configCsrf, *configSession, store, lg, checker)
 	if err != nil {
 		lg.Error("cant create core")
 		return
 	}
+	runner.OnClose("auth repositories", core.Close)
 
-	api := delivery_auth.GetApi(core, lg)
+	api := delivery_auth.GetApi(core, lg, store, checker)
 
-	errs := make(chan error, 2)
+	grpcConfig, err := configs.ReadGrpcConfig()
+	if err != nil {
+		lg.Error("read grpc config error", "err", err.Error())
+		return
+	}
+	grpcOpts, err := mtls.ServerOptions(grpcConfig.Tls)
+	if err != nil {
+		lg.Error("grpc tls error", "err", err.Error())
+		return
+	}
 
-	grpcServ, err := delivery_auth_grpc.NewServer(lg)
+	grpcServ, err := delivery_auth_grpc.NewServer(lg, checker, grpcOpts...)
 	if err != nil {
 		lg.Error("cant create server")
 		return
 	}
+	runner.OnClose("grpc repositories", grpcServ.Close)
 
-	go func() {
-		errs <- api.ListenAndServe()
-	}()
-	go func() {
-		errs <- grpcServ.ListenAndServeGrpc()
-	}()
+	runner.Go("health checks", func(ctx context.Context) {
+		checker.Run(ctx, time.Duration(config.Timer)*time.Second)
+	})
+	runner.Serve("grpc", func() error {
+		return grpcServ.ListenAndServeGrpc(grpcConfig)
+	}, grpcServ.Shutdown)
+	runner.ServeHttp("http", api.Server())
 
-	err = <-errs
+	err = runner.Run(context.Background())
 	if err != nil {
 		lg.Error("listen and serve error", "err", err.Error())
 	}
 }
+
+// errUnhashed makes migrate passwords exit with status 2 once the accounts
+// have been listed.
+var errUnhashed = errors.New("accounts with unhashed passwords left")
+
+// migrateSchema runs the migrate subcommand. Besides the schema commands it
+// knows passwords, which lists the accounts still to be hashed.
+func migrateSchema(config *configs.DbDsnCfg, lg *slog.Logger, args []string) error {
+	passwords := len(args) == 1 && args[0] == "passwords"
+	if !passwords {
+		err := migrate.CheckCommand(args)
+		if err != nil {
+			return err
+		}
+	}
+	if !config.UsesPostgres() {
+		return errors.New("authorization keeps no data in postgres, nothing to migrate")
+	}
+
+	if passwords {
+		unhashed, err := reportPasswords(config, lg, os.Stdout)
+		if err != nil {
+			return err
+		}
+		if unhashed != 0 {
+			return errUnhashed
+		}
+		return nil
+	}
+
+	migrator, err := migrations.GetMigrator(config)
+	if err != nil {
+		return err
+	}
+	defer migrator.Close()
+
+	return migrate.Command(migrator, args, os.Stdout)
+}
+
+// reportPasswords lists the accounts whose password is still stored in
+// plain text, they are hashed when their users log in next time.
+func reportPasswords(config *configs.DbDsnCfg, lg *slog.Logger, out io.Writer) (int, error) {
+	users, err := profile.GetUserRepo(config, lg)
+	if err != nil {
+		return 0, err
+	}
+	defer users.Close()
+
+	logins, err := users.UnhashedLogins(password.Prefix)
+	if err != nil {
+		return 0, err
+	}
+	for _, login := range logins {
+		fmt.Fprintln(out, login)
+	}
+	fmt.Fprintf(out, "%d accounts with unhashed passwords\n", len(logins))
+
+	return len(logins), nil
+}
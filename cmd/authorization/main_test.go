@@ -0,0 +1,52 @@
+package main
+
+import (
+	"bytes"
+	"errors"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/go-park-mail-ru/2023_2_Vkladyshi/pkg/migrate"
+)
+
+// TestMigrateSubcommand runs the binary with an unknown migrate subcommand,
+// which has to be rejected before the service starts. The binary logs to
+// its working directory, so it runs in a copy of the layout it expects.
+func TestMigrateSubcommand(t *testing.T) {
+	if os.Getenv("AUTH_MIGRATE") != "" {
+		os.Args = []string{"authorization", "migrate", "sideways"}
+		main()
+		return
+	}
+
+	configs, err := filepath.Abs("../../configs")
+	if err != nil {
+		t.Fatal(err)
+	}
+	root := t.TempDir()
+	dir := filepath.Join(root, "cmd", "authorization")
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.Symlink(configs, filepath.Join(root, "configs")); err != nil {
+		t.Fatal(err)
+	}
+
+	cmd := exec.Command(os.Args[0], "-test.run=^TestMigrateSubcommand$")
+	cmd.Dir = dir
+	cmd.Env = append(os.Environ(), "AUTH_MIGRATE=1")
+	var stderr bytes.Buffer
+	cmd.Stderr = &stderr
+
+	err = cmd.Run()
+	var exitErr *exec.ExitError
+	if !errors.As(err, &exitErr) || exitErr.ExitCode() != 1 {
+		t.Fatalf("expected exit status 1, got %v", err)
+	}
+	if !strings.Contains(stderr.String(), migrate.ErrBadCommand.Error()) {
+		t.Errorf("expected usage error, got %q", stderr.String())
+	}
+}
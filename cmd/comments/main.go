@@ -1,19 +1,31 @@
 package main
 
 import (
+	"context"
+	"errors"
 	"flag"
+	"fmt"
 	"log/slog"
 	"os"
+	"time"
 
+	"github.com/go-park-mail-ru/2023_2_Vkladyshi/comments/app"
 	"github.com/go-park-mail-ru/2023_2_Vkladyshi/comments/delivery"
-	"github.com/go-park-mail-ru/2023_2_Vkladyshi/comments/repository/comment"
+	delivery_comments_grpc "github.com/go-park-mail-ru/2023_2_Vkladyshi/comments/delivery/grpc"
+	"github.com/go-park-mail-ru/2023_2_Vkladyshi/comments/migrations"
 	"github.com/go-park-mail-ru/2023_2_Vkladyshi/comments/usecase"
 	"github.com/go-park-mail-ru/2023_2_Vkladyshi/configs"
+	"github.com/go-park-mail-ru/2023_2_Vkladyshi/pkg/healthcheck"
+	"github.com/go-park-mail-ru/2023_2_Vkladyshi/pkg/lifecycle"
+	"github.com/go-park-mail-ru/2023_2_Vkladyshi/pkg/middleware"
+	"github.com/go-park-mail-ru/2023_2_Vkladyshi/pkg/migrate"
+	"github.com/go-park-mail-ru/2023_2_Vkladyshi/pkg/mtls"
 )
 
 func main() {
 	var path string
 	flag.StringVar(&path, "comments_log_path", "comment_log.log", "Путь к логу комментов")
+	flag.Parse()
 	logFile, _ := os.Create(path)
 	lg := slog.New(slog.NewJSONHandler(logFile, nil))
 
@@ -23,18 +35,103 @@ func main() {
 		return
 	}
 
-	var comments comment.ICommentRepo
-	switch config.CommentsDb {
-	case "postgres":
-		comments, err = comment.GetCommentRepo(config, lg)
+	if flag.Arg(0) == "migrate" {
+		err = migrateSchema(config, flag.Args()[1:])
+		if err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			os.Exit(1)
+		}
+		return
+	}
+
+	if config.UsesPostgres() {
+		migrator, err := migrations.GetMigrator(config)
+		if err != nil {
+			lg.Error("cant create migrator", "err", err.Error())
+			return
+		}
+		err = migrator.Check()
+		migrator.Close()
+		if err != nil {
+			lg.Error("schema check error", "err", err.Error())
+			return
+		}
+	}
+
+	checker := healthcheck.NewChecker(lg)
+	authOpts, err := mtls.DialOptions(config.Tls, "auth")
+	if err != nil {
+		lg.Error("grpc tls error", "err", err.Error())
+		return
+	}
+	client, err := usecase.GetClient(config.GrpcPort, checker, authOpts...)
+	if err != nil {
+		lg.Error("get client error", "err", err.Error())
+		return
+	}
+
+	filmsOpts, err := mtls.DialOptions(config.Tls, "films")
+	if err != nil {
+		lg.Error("grpc tls error", "err", err.Error())
+		return
 	}
+	filmsClient, err := usecase.GetFilmsClient(config.FilmsGrpc, filmsOpts...)
 	if err != nil {
-		lg.Error("cant create repo")
+		lg.Error("get films client error", "err", err.Error())
 		return
 	}
 
-	core := usecase.GetCore(config, lg, comments)
-	api := delivery.GetApi(core, lg, config)
+	runner := lifecycle.New(lg, lifecycle.DefaultTimeout)
+	core, err := app.GetCore(config, client, filmsClient, lg, checker)
+	if err != nil {
+		lg.Error("cant create core", "err", err.Error())
+		return
+	}
+	runner.OnClose("comments repository", core.Close)
+	sessionConfig, err := configs.ReadSessionRedisConfig()
+	if err != nil {
+		lg.Error("read session config error", "err", err.Error())
+		return
+	}
+	sessions := middleware.StartSessionCache(runner.Go, config.SessionCache, *sessionConfig, lg)
+	api := delivery.GetApi(core, lg, config, sessions, checker)
+	grpcOpts, err := mtls.ServerOptions(config.Tls)
+	if err != nil {
+		lg.Error("grpc tls error", "err", err.Error())
+		return
+	}
+	grpcServ := delivery_comments_grpc.NewServer(core, lg, checker, grpcOpts...)
+
+	runner.Go("health checks", func(ctx context.Context) {
+		checker.Run(ctx, time.Duration(config.Timer)*time.Second)
+	})
+	runner.Serve("grpc", func() error {
+		return grpcServ.ListenAndServeGrpc(config.GrpcAdress)
+	}, grpcServ.Shutdown)
+	runner.ServeHttp("http", api.Server())
+
+	err = runner.Run(context.Background())
+	if err != nil {
+		lg.Error("listen and serve error", "err", err.Error())
+	}
+}
+
+// migrateSchema runs the migrate subcommand. The arguments are checked before
+// connecting, so a typo is reported without a database.
+func migrateSchema(config *configs.CommentCfg, args []string) error {
+	err := migrate.CheckCommand(args)
+	if err != nil {
+		return err
+	}
+	if !config.UsesPostgres() {
+		return errors.New("comments keeps no data in postgres, nothing to migrate")
+	}
+
+	migrator, err := migrations.GetMigrator(config)
+	if err != nil {
+		return err
+	}
+	defer migrator.Close()
 
-	api.ListenAndServe()
+	return migrate.Command(migrator, args, os.Stdout)
 }
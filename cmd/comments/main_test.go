@@ -0,0 +1,41 @@
+package main
+
+import (
+	"bytes"
+	"errors"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/go-park-mail-ru/2023_2_Vkladyshi/pkg/migrate"
+)
+
+// TestMigrateSubcommand runs the binary with a flag before an unknown
+// migrate subcommand, which has to be rejected before the service starts.
+func TestMigrateSubcommand(t *testing.T) {
+	if log := os.Getenv("COMMENTS_MIGRATE_LOG"); log != "" {
+		os.Args = []string{"comments", "-comments_log_path", log, "migrate", "sideways"}
+		main()
+		return
+	}
+
+	log := filepath.Join(t.TempDir(), "comments.log")
+	cmd := exec.Command(os.Args[0], "-test.run=^TestMigrateSubcommand$")
+	cmd.Env = append(os.Environ(), "COMMENTS_MIGRATE_LOG="+log)
+	var stderr bytes.Buffer
+	cmd.Stderr = &stderr
+
+	err := cmd.Run()
+	var exitErr *exec.ExitError
+	if !errors.As(err, &exitErr) || exitErr.ExitCode() != 1 {
+		t.Fatalf("expected exit status 1, got %v", err)
+	}
+	if !strings.Contains(stderr.String(), migrate.ErrBadCommand.Error()) {
+		t.Errorf("expected usage error, got %q", stderr.String())
+	}
+	if _, err := os.Stat(log); err != nil {
+		t.Errorf("expected the log path flag to be used: %s", err)
+	}
+}
@@ -1,23 +1,32 @@
 package main
 
 import (
+	"context"
+	"errors"
 	"flag"
+	"fmt"
 	"log/slog"
 	"os"
+	"time"
 
 	"github.com/go-park-mail-ru/2023_2_Vkladyshi/configs"
+	"github.com/go-park-mail-ru/2023_2_Vkladyshi/films/app"
 	"github.com/go-park-mail-ru/2023_2_Vkladyshi/films/delivery"
-	"github.com/go-park-mail-ru/2023_2_Vkladyshi/films/repository/calendar"
-	"github.com/go-park-mail-ru/2023_2_Vkladyshi/films/repository/crew"
-	"github.com/go-park-mail-ru/2023_2_Vkladyshi/films/repository/film"
-	"github.com/go-park-mail-ru/2023_2_Vkladyshi/films/repository/genre"
-	"github.com/go-park-mail-ru/2023_2_Vkladyshi/films/repository/profession"
+	delivery_films_grpc "github.com/go-park-mail-ru/2023_2_Vkladyshi/films/delivery/grpc"
+	"github.com/go-park-mail-ru/2023_2_Vkladyshi/films/migrations"
 	"github.com/go-park-mail-ru/2023_2_Vkladyshi/films/usecase"
+	"github.com/go-park-mail-ru/2023_2_Vkladyshi/pkg/healthcheck"
+	"github.com/go-park-mail-ru/2023_2_Vkladyshi/pkg/lifecycle"
+	"github.com/go-park-mail-ru/2023_2_Vkladyshi/pkg/middleware"
+	"github.com/go-park-mail-ru/2023_2_Vkladyshi/pkg/migrate"
+	"github.com/go-park-mail-ru/2023_2_Vkladyshi/pkg/mtls"
+	"github.com/go-park-mail-ru/2023_2_Vkladyshi/pkg/storage"
 )
 
 func main() {
 	var path string
 	flag.StringVar(&path, "films_log_path", "films_log.log", "Путь к логу фильмов")
+	flag.Parse()
 	logFile, _ := os.Create(path)
 	lg := slog.New(slog.NewJSONHandler(logFile, nil))
 
@@ -27,69 +36,119 @@ func main() {
 		return
 	}
 
-	var (
-		films       film.IFilmsRepo
-		genres      genre.IGenreRepo
-		actors      crew.ICrewRepo
-		professions profession.IProfessionRepo
-		news        calendar.ICalendarRepo
-	)
-	switch config.FilmsDb {
-	case "postgres":
-		films, err = film.GetFilmRepo(config, lg)
+	if flag.Arg(0) == "migrate" {
+		err = migrateSchema(config, flag.Args()[1:])
+		if err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			os.Exit(1)
+		}
+		return
+	}
+
+	if config.UsesPostgres() {
+		migrator, err := migrations.GetMigrator(config)
+		if err != nil {
+			lg.Error("cant create migrator", "err", err.Error())
+			return
+		}
+		err = migrator.Check()
+		migrator.Close()
+		if err != nil {
+			lg.Error("schema check error", "err", err.Error())
+			return
+		}
 	}
+
+	redisConfig, err := configs.ReadNearFilmRedisConfig()
 	if err != nil {
-		lg.Error("cant create repo")
+		lg.Error("cant read redis config")
 		return
 	}
-
-	switch config.GenresDb {
-	case "postgres":
-		genres, err = genre.GetGenreRepo(config, lg)
+	storageConfig, err := configs.ReadStorageConfig()
+	if err != nil {
+		lg.Error("read storage config error", "err", err.Error())
+		return
 	}
+	store, err := storage.New(storageConfig)
 	if err != nil {
-		lg.Error("cant create repo")
+		lg.Error("cant create storage", "err", err.Error())
 		return
 	}
 
-	switch config.CrewDb {
-	case "postgres":
-		actors, err = crew.GetCrewRepo(config, lg)
+	checker := healthcheck.NewChecker(lg)
+	authOpts, err := mtls.DialOptions(config.Tls, "auth")
+	if err != nil {
+		lg.Error("grpc tls error", "err", err.Error())
+		return
 	}
+	client, err := usecase.GetClient(config.GrpcPort, checker, authOpts...)
 	if err != nil {
-		lg.Error("cant create repo")
+		lg.Error("get client error", "err", err.Error())
 		return
 	}
 
-	switch config.ProfessionDb {
-	case "postgres":
-		professions, err = profession.GetProfessionRepo(config, lg)
+	commentsOpts, err := mtls.DialOptions(config.Tls, "comments")
+	if err != nil {
+		lg.Error("grpc tls error", "err", err.Error())
+		return
 	}
+	commentsClient, err := usecase.GetCommentsClient(config.CommentsGrpc, commentsOpts...)
 	if err != nil {
-		lg.Error("cant create repo")
+		lg.Error("get comments client error", "err", err.Error())
 		return
 	}
 
-	switch config.CalendarDb {
-	case "postgres":
-		news, err = calendar.GetCalendarRepo(config, lg)
-	}
+	runner := lifecycle.New(lg, lifecycle.DefaultTimeout)
+	core, err := app.GetCore(config, redisConfig, client, commentsClient, store, lg, checker)
 	if err != nil {
-		lg.Error("cant creare calendar repo")
+		lg.Error("cant create core", "err", err.Error())
 		return
 	}
-	redisConfig, err := configs.ReadNearFilmRedisConfig()
+	runner.OnClose("films repositories", core.Close)
+	sessionConfig, err := configs.ReadSessionRedisConfig()
 	if err != nil {
-		lg.Error("cant read redis config")
+		lg.Error("read session config error", "err", err.Error())
 		return
 	}
-	redisFilms, err := film.GetFilmRedisRepo(*redisConfig, lg)
+	sessions := middleware.StartSessionCache(runner.Go, config.SessionCache, *sessionConfig, lg)
+	api := delivery.GetApi(core, lg, config, store, sessions, checker)
+	grpcOpts, err := mtls.ServerOptions(config.Tls)
 	if err != nil {
-		lg.Error("cant create redis repo")
+		lg.Error("grpc tls error", "err", err.Error())
 		return
 	}
-	core := usecase.GetCore(config, lg, films, genres, actors, professions, news, redisFilms)
-	api := delivery.GetApi(core, lg, config)
+	grpcServ := delivery_films_grpc.NewServer(core, lg, checker, grpcOpts...)
+
+	runner.Go("health checks", func(ctx context.Context) {
+		checker.Run(ctx, time.Duration(config.Timer)*time.Second)
+	})
+	runner.Serve("grpc", func() error {
+		return grpcServ.ListenAndServeGrpc(config.GrpcAdress)
+	}, grpcServ.Shutdown)
+	runner.ServeHttp("http", api.Server())
+
+	err = runner.Run(context.Background())
+	if err != nil {
+		lg.Error("listen and serve error", "err", err.Error())
+	}
+}
+
+// migrateSchema runs the migrate subcommand. The arguments are checked before
+// connecting, so a typo is reported without a database.
+func migrateSchema(config *configs.DbDsnCfg, args []string) error {
+	err := migrate.CheckCommand(args)
+	if err != nil {
+		return err
+	}
+	if !config.UsesPostgres() {
+		return errors.New("films keeps no data in postgres, nothing to migrate")
+	}
+
+	migrator, err := migrations.GetMigrator(config)
+	if err != nil {
+		return err
+	}
+	defer migrator.Close()
 
-	api.ListenAndServe()
+	return migrate.Command(migrator, args, os.Stdout)
 }
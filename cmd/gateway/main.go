@@ -0,0 +1,67 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"log/slog"
+	"net/http"
+	"os"
+
+	auth "github.com/go-park-mail-ru/2023_2_Vkladyshi/authorization/proto"
+	"github.com/go-park-mail-ru/2023_2_Vkladyshi/configs"
+	"github.com/go-park-mail-ru/2023_2_Vkladyshi/gateway"
+	"github.com/go-park-mail-ru/2023_2_Vkladyshi/pkg/grpcclient"
+	"github.com/go-park-mail-ru/2023_2_Vkladyshi/pkg/healthcheck"
+	"github.com/go-park-mail-ru/2023_2_Vkladyshi/pkg/lifecycle"
+	"github.com/go-park-mail-ru/2023_2_Vkladyshi/pkg/mtls"
+)
+
+func main() {
+	var path string
+	flag.StringVar(&path, "gateway_log_path", "gateway_log.log", "Путь к логу шлюза")
+	flag.Parse()
+	logFile, _ := os.Create(path)
+	lg := slog.New(slog.NewJSONHandler(logFile, nil))
+
+	config, err := configs.ReadGatewayConfig()
+	if err != nil {
+		lg.Error("read config error", "err", err.Error())
+		return
+	}
+
+	checker := healthcheck.NewChecker(lg)
+	authOpts, err := mtls.DialOptions(config.Tls, "auth")
+	if err != nil {
+		lg.Error("grpc tls error", "err", err.Error())
+		return
+	}
+	conn, err := grpcclient.Dial(config.GrpcPort, grpcclient.DefaultConfig("auth", auth.Authorization_ServiceDesc.ServiceName), authOpts...)
+	if err != nil {
+		lg.Error("grpc connect error", "err", err.Error())
+		return
+	}
+	checker.Add("grpc auth", grpcclient.HealthCheck(conn, auth.Authorization_ServiceDesc.ServiceName))
+
+	gw, err := gateway.New(config, auth.NewAuthorizationClient(conn), lg)
+	if err != nil {
+		lg.Error("cant create gateway", "err", err.Error())
+		return
+	}
+
+	// The probes answer for the gateway itself, every other path is routed.
+	mx := http.NewServeMux()
+	checker.Register(mx)
+	mx.Handle("/", gw)
+
+	runner := lifecycle.New(lg, lifecycle.DefaultTimeout)
+	runner.OnClose("grpc auth", conn.Close)
+	runner.Go("health checks", func(ctx context.Context) {
+		checker.Run(ctx, healthcheck.DefaultInterval)
+	})
+	runner.ServeHttp("http", &http.Server{Addr: config.ServerAdress, Handler: mx})
+
+	err = runner.Run(context.Background())
+	if err != nil {
+		lg.Error("listen and serve error", "err", err.Error())
+	}
+}
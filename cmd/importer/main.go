@@ -0,0 +1,96 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"log/slog"
+	"os"
+
+	"github.com/go-park-mail-ru/2023_2_Vkladyshi/configs"
+	"github.com/go-park-mail-ru/2023_2_Vkladyshi/films/importer"
+	"github.com/go-park-mail-ru/2023_2_Vkladyshi/films/repository/catalog"
+	"github.com/mailru/easyjson"
+)
+
+// Imports catalogue dumps, e.g.
+//
+//	importer -genres genres.csv -people people.jsonl -films films.jsonl -credits credits.csv -dry-run
+func main() {
+	var genres, people, films, credits, calendar string
+	var dryRun bool
+	flag.StringVar(&genres, "genres", "", "Дамп жанров (.jsonl или .csv)")
+	flag.StringVar(&people, "people", "", "Дамп людей (.jsonl или .csv)")
+	flag.StringVar(&films, "films", "", "Дамп фильмов (.jsonl или .csv)")
+	flag.StringVar(&credits, "credits", "", "Дамп участия людей в фильмах (.jsonl или .csv)")
+	flag.StringVar(&calendar, "calendar", "", "Дамп дат календаря (.jsonl или .csv)")
+	flag.BoolVar(&dryRun, "dry-run", false, "Только показать изменения, ничего не записывая")
+	flag.Parse()
+
+	lg := slog.New(slog.NewTextHandler(os.Stderr, nil))
+
+	var dump importer.Dump
+	var err error
+	if dump.Genres, err = read[catalog.Genre](genres); err != nil {
+		lg.Error("read genres error", "err", err.Error())
+		os.Exit(1)
+	}
+	if dump.People, err = read[catalog.Person](people); err != nil {
+		lg.Error("read people error", "err", err.Error())
+		os.Exit(1)
+	}
+	if dump.Films, err = read[catalog.Film](films); err != nil {
+		lg.Error("read films error", "err", err.Error())
+		os.Exit(1)
+	}
+	if dump.Credits, err = read[catalog.Credit](credits); err != nil {
+		lg.Error("read credits error", "err", err.Error())
+		os.Exit(1)
+	}
+	if dump.Calendar, err = read[catalog.CalendarDate](calendar); err != nil {
+		lg.Error("read calendar error", "err", err.Error())
+		os.Exit(1)
+	}
+
+	config, err := configs.ReadFilmConfig()
+	if err != nil {
+		lg.Error("read config error", "err", err.Error())
+		os.Exit(1)
+	}
+	repo, err := catalog.GetCatalogRepo(config, lg)
+	if err != nil {
+		lg.Error("cant create catalog repo", "err", err.Error())
+		os.Exit(1)
+	}
+
+	report, err := importer.New(repo, os.Stdout, dryRun).Run(dump)
+	fmt.Printf("created %d, updated %d, unchanged %d, conflicts %d\n",
+		report.Created, report.Updated, report.Unchanged, len(report.Conflicts))
+	if err != nil {
+		lg.Error("import error", "err", err.Error())
+		os.Exit(1)
+	}
+	if len(report.Conflicts) != 0 {
+		os.Exit(2)
+	}
+}
+
+func read[T any, P interface {
+	*T
+	easyjson.Unmarshaler
+}](path string) ([]T, error) {
+	if path == "" {
+		return nil, nil
+	}
+
+	format, err := importer.FormatOf(path)
+	if err != nil {
+		return nil, err
+	}
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	return importer.Read[T, P](file, format)
+}
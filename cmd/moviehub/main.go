@@ -0,0 +1,264 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"log/slog"
+	"net"
+	"net/http"
+	"os"
+	"time"
+
+	delivery_auth_grpc "github.com/go-park-mail-ru/2023_2_Vkladyshi/authorization/delivery/grpc"
+	delivery_auth "github.com/go-park-mail-ru/2023_2_Vkladyshi/authorization/delivery/http"
+	auth_migrations "github.com/go-park-mail-ru/2023_2_Vkladyshi/authorization/migrations"
+	auth "github.com/go-park-mail-ru/2023_2_Vkladyshi/authorization/proto"
+	auth_usecase "github.com/go-park-mail-ru/2023_2_Vkladyshi/authorization/usecase"
+	comments_app "github.com/go-park-mail-ru/2023_2_Vkladyshi/comments/app"
+	comments_delivery "github.com/go-park-mail-ru/2023_2_Vkladyshi/comments/delivery"
+	delivery_comments_grpc "github.com/go-park-mail-ru/2023_2_Vkladyshi/comments/delivery/grpc"
+	comments_migrations "github.com/go-park-mail-ru/2023_2_Vkladyshi/comments/migrations"
+	comments "github.com/go-park-mail-ru/2023_2_Vkladyshi/comments/proto"
+	"github.com/go-park-mail-ru/2023_2_Vkladyshi/configs"
+	films_app "github.com/go-park-mail-ru/2023_2_Vkladyshi/films/app"
+	films_delivery "github.com/go-park-mail-ru/2023_2_Vkladyshi/films/delivery"
+	delivery_films_grpc "github.com/go-park-mail-ru/2023_2_Vkladyshi/films/delivery/grpc"
+	films_migrations "github.com/go-park-mail-ru/2023_2_Vkladyshi/films/migrations"
+	films "github.com/go-park-mail-ru/2023_2_Vkladyshi/films/proto"
+	films_usecase "github.com/go-park-mail-ru/2023_2_Vkladyshi/films/usecase"
+	"github.com/go-park-mail-ru/2023_2_Vkladyshi/pkg/grpcclient"
+	"github.com/go-park-mail-ru/2023_2_Vkladyshi/pkg/healthcheck"
+	"github.com/go-park-mail-ru/2023_2_Vkladyshi/pkg/lifecycle"
+	"github.com/go-park-mail-ru/2023_2_Vkladyshi/pkg/middleware"
+	"github.com/go-park-mail-ru/2023_2_Vkladyshi/pkg/migrate"
+	"github.com/go-park-mail-ru/2023_2_Vkladyshi/pkg/storage"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+// schema is the database of one service that has to be migrated.
+type schema struct {
+	service  string
+	postgres bool
+	open     func() (*migrate.Migrator, error)
+}
+
+func main() {
+	var path, adress string
+	flag.StringVar(&path, "moviehub_log_path", "moviehub_log.log", "Путь к логу")
+	flag.StringVar(&adress, "adress", ":8080", "Адрес HTTP сервера всех сервисов")
+	flag.Parse()
+	logFile, _ := os.Create(path)
+	lg := slog.New(slog.NewJSONHandler(logFile, nil))
+
+	filmsConfig, err := configs.ReadFilmConfig()
+	if err != nil {
+		lg.Error("read films config error", "err", err.Error())
+		return
+	}
+	commentsConfig, err := configs.ReadCommentConfig()
+	if err != nil {
+		lg.Error("read comments config error", "err", err.Error())
+		return
+	}
+	authConfig, err := configs.ReadConfig()
+	if err != nil {
+		lg.Error("read auth config error", "err", err.Error())
+		return
+	}
+
+	schemas := []schema{
+		{auth_migrations.Service, authConfig.UsesPostgres(), func() (*migrate.Migrator, error) {
+			return auth_migrations.GetMigrator(authConfig)
+		}},
+		{films_migrations.Service, filmsConfig.UsesPostgres(), func() (*migrate.Migrator, error) {
+			return films_migrations.GetMigrator(filmsConfig)
+		}},
+		{comments_migrations.Service, commentsConfig.UsesPostgres(), func() (*migrate.Migrator, error) {
+			return comments_migrations.GetMigrator(commentsConfig)
+		}},
+	}
+	if flag.Arg(0) == "migrate" {
+		err = migrateAll(schemas, flag.Args()[1:])
+		if err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			os.Exit(1)
+		}
+		return
+	}
+	err = checkAll(schemas)
+	if err != nil {
+		lg.Error("schema check error", "err", err.Error())
+		return
+	}
+
+	csrfConfig, err := configs.ReadCsrfRedisConfig()
+	if err != nil {
+		lg.Error("read csrf config error", "err", err.Error())
+		return
+	}
+	sessionConfig, err := configs.ReadSessionRedisConfig()
+	if err != nil {
+		lg.Error("read session config error", "err", err.Error())
+		return
+	}
+	nearConfig, err := configs.ReadNearFilmRedisConfig()
+	if err != nil {
+		lg.Error("read near films config error", "err", err.Error())
+		return
+	}
+	storageConfig, err := configs.ReadStorageConfig()
+	if err != nil {
+		lg.Error("read storage config error", "err", err.Error())
+		return
+	}
+	store, err := storage.New(storageConfig)
+	if err != nil {
+		lg.Error("cant create storage", "err", err.Error())
+		return
+	}
+
+	// One checker watches the databases of every service, the services
+	// themselves run in this process.
+	checker := healthcheck.NewChecker(lg)
+	runner := lifecycle.New(lg, lifecycle.DefaultTimeout)
+	authCore, err := auth_usecase.GetCore(authConfig, *csrfConfig, *sessionConfig, store, lg, checker)
+	if err != nil {
+		lg.Error("cant create auth core", "err", err.Error())
+		return
+	}
+	runner.OnClose("auth repositories", authCore.Close)
+	grpcServ, err := delivery_auth_grpc.NewServer(lg, checker)
+	if err != nil {
+		lg.Error("cant create grpc server", "err", err.Error())
+		return
+	}
+	runner.OnClose("auth grpc repositories", grpcServ.Close)
+
+	// The services reach each other's gRPC servers through in-process
+	// listeners, the same way they do over the network but without TLS, as
+	// the calls never leave the process.
+	authLis := bufconn.Listen(1 << 20)
+	conn, err := dialInProcess(authLis, grpcclient.DefaultConfig("auth", auth.Authorization_ServiceDesc.ServiceName))
+	if err != nil {
+		lg.Error("grpc connect error", "err", err.Error())
+		return
+	}
+	runner.OnClose("grpc auth", conn.Close)
+	client := auth.NewAuthorizationClient(conn)
+
+	// Comments and films call each other, dialing does not wait for the
+	// films server that is created last.
+	filmsLis := bufconn.Listen(1 << 20)
+	filmsConn, err := dialInProcess(filmsLis, grpcclient.DefaultConfig("films", films.Films_ServiceDesc.ServiceName))
+	if err != nil {
+		lg.Error("grpc connect error", "err", err.Error())
+		return
+	}
+	runner.OnClose("grpc films", filmsConn.Close)
+
+	commentsCore, err := comments_app.GetCore(commentsConfig, client, films.NewFilmsClient(filmsConn), lg, checker)
+	if err != nil {
+		lg.Error("cant create comments core", "err", err.Error())
+		return
+	}
+	runner.OnClose("comments repository", commentsCore.Close)
+	commentsServ := delivery_comments_grpc.NewServer(commentsCore, lg, checker)
+	commentsLis := bufconn.Listen(1 << 20)
+	commentsConn, err := dialInProcess(commentsLis, films_usecase.CommentsClientConfig())
+	if err != nil {
+		lg.Error("grpc connect error", "err", err.Error())
+		return
+	}
+	runner.OnClose("grpc comments", commentsConn.Close)
+
+	filmsCore, err := films_app.GetCore(filmsConfig, nearConfig, client, comments.NewCommentsClient(commentsConn), store, lg, checker)
+	if err != nil {
+		lg.Error("cant create films core", "err", err.Error())
+		return
+	}
+	runner.OnClose("films repositories", filmsCore.Close)
+	filmsServ := delivery_films_grpc.NewServer(filmsCore, lg, checker)
+
+	// Films and comments share one cache, they check the same sessions.
+	sessions := middleware.StartSessionCache(runner.Go, filmsConfig.SessionCache, *sessionConfig, lg)
+
+	mx := http.NewServeMux()
+	mx.Handle("/metrics", promhttp.Handler())
+	checker.Register(mx)
+	delivery_auth.GetApi(authCore, lg, store, checker).Register(mx)
+	films_delivery.GetApi(filmsCore, lg, filmsConfig, store, sessions, checker).Register(mx)
+	comments_delivery.GetApi(commentsCore, lg, commentsConfig, sessions, checker).Register(mx)
+	if local, ok := store.(*storage.Local); ok && local.BaseURL() != "" {
+		mx.Handle(local.BaseURL()+"/", local)
+	}
+
+	// The HTTP server is added last, so it drains its requests before the
+	// gRPC servers they call stop.
+	runner.Go("health checks", func(ctx context.Context) {
+		checker.Run(ctx, time.Duration(filmsConfig.Timer)*time.Second)
+	})
+	runner.Serve("grpc auth", func() error {
+		return grpcServ.Serve(authLis)
+	}, grpcServ.Shutdown)
+	runner.Serve("grpc comments", func() error {
+		return commentsServ.Serve(commentsLis)
+	}, commentsServ.Shutdown)
+	runner.Serve("grpc films", func() error {
+		return filmsServ.Serve(filmsLis)
+	}, filmsServ.Shutdown)
+	runner.ServeHttp("http", &http.Server{Addr: adress, Handler: middleware.TrustGateway(mx, filmsConfig.GatewaySecret)})
+
+	err = runner.Run(context.Background())
+	if err != nil {
+		lg.Error("listen and serve error", "err", err.Error())
+	}
+}
+
+func dialInProcess(lis *bufconn.Listener, config grpcclient.Config) (*grpc.ClientConn, error) {
+	return grpcclient.Dial("moviehub", config,
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+			return lis.DialContext(ctx)
+		}))
+}
+
+func migrateAll(schemas []schema, args []string) error {
+	for _, schema := range schemas {
+		if !schema.postgres {
+			continue
+		}
+		migrator, err := schema.open()
+		if err != nil {
+			return fmt.Errorf("%s: %w", schema.service, err)
+		}
+		fmt.Printf("%s:\n", schema.service)
+		err = migrate.Command(migrator, args, os.Stdout)
+		migrator.Close()
+		if err != nil {
+			return fmt.Errorf("%s: %w", schema.service, err)
+		}
+	}
+
+	return nil
+}
+
+func checkAll(schemas []schema) error {
+	for _, schema := range schemas {
+		if !schema.postgres {
+			continue
+		}
+		migrator, err := schema.open()
+		if err != nil {
+			return fmt.Errorf("%s: %w", schema.service, err)
+		}
+		err = migrator.Check()
+		migrator.Close()
+		if err != nil {
+			return fmt.Errorf("%s: %w", schema.service, err)
+		}
+	}
+
+	return nil
+}
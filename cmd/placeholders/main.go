@@ -0,0 +1,115 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"log/slog"
+	"os"
+
+	"github.com/go-park-mail-ru/2023_2_Vkladyshi/configs"
+	"github.com/go-park-mail-ru/2023_2_Vkladyshi/films/repository/placeholder"
+	"github.com/go-park-mail-ru/2023_2_Vkladyshi/pkg/images"
+	"github.com/go-park-mail-ru/2023_2_Vkladyshi/pkg/models"
+	"github.com/go-park-mail-ru/2023_2_Vkladyshi/pkg/storage"
+)
+
+type source struct {
+	name   string
+	images func(after uint64, limit uint64, missingOnly bool) ([]placeholder.Image, error)
+	save   func(id uint64, placeholder models.Placeholder) error
+}
+
+// Backfill computes placeholders for posters and actor photos stored before
+// placeholders were generated on upload.
+func main() {
+	var force bool
+	var batch uint64
+	flag.BoolVar(&force, "force", false, "Пересчитать уже посчитанные заглушки")
+	flag.Uint64Var(&batch, "batch", 100, "Сколько изображений брать из базы за раз")
+	flag.Parse()
+
+	lg := slog.New(slog.NewTextHandler(os.Stderr, nil))
+
+	config, err := configs.ReadFilmConfig()
+	if err != nil {
+		lg.Error("read config error", "err", err.Error())
+		os.Exit(1)
+	}
+	storageConfig, err := configs.ReadStorageConfig()
+	if err != nil {
+		lg.Error("read storage config error", "err", err.Error())
+		os.Exit(1)
+	}
+
+	store, err := storage.New(storageConfig)
+	if err != nil {
+		lg.Error("cant create storage", "err", err.Error())
+		os.Exit(1)
+	}
+	repo, err := placeholder.GetPlaceholderRepo(config, lg)
+	if err != nil {
+		lg.Error("cant create placeholder repo", "err", err.Error())
+		os.Exit(1)
+	}
+
+	sources := []source{
+		{name: "films", images: repo.GetFilmImages, save: repo.SetFilmPlaceholder},
+		{name: "persons", images: repo.GetPersonImages, save: repo.SetPersonPlaceholder},
+	}
+	for _, src := range sources {
+		done, failed, err := backfill(context.Background(), lg, store, src, batch, !force)
+		if err != nil {
+			lg.Error("backfill error", "source", src.name, "err", err.Error())
+			os.Exit(1)
+		}
+		fmt.Printf("%s: %d processed, %d failed\n", src.name, done, failed)
+	}
+}
+
+// backfill walks the images in id order. Broken or missing files are
+// reported and skipped so one bad image does not stop the run.
+func backfill(ctx context.Context, lg *slog.Logger, store storage.Storage, src source, batch uint64, missingOnly bool) (uint64, uint64, error) {
+	var done, failed, after uint64
+
+	for {
+		list, err := src.images(after, batch, missingOnly)
+		if err != nil {
+			return done, failed, err
+		}
+		if len(list) == 0 {
+			return done, failed, nil
+		}
+
+		for _, image := range list {
+			after = image.Id
+
+			err := process(ctx, store, src, image)
+			if err != nil {
+				lg.Error("placeholder error", "source", src.name, "id", image.Id, "url", image.Url, "err", err.Error())
+				failed++
+				continue
+			}
+			done++
+		}
+	}
+}
+
+func process(ctx context.Context, store storage.Storage, src source, image placeholder.Image) error {
+	key, ok := store.Key(image.Url)
+	if !ok {
+		return fmt.Errorf("url is outside of the storage")
+	}
+
+	data, err := store.Get(ctx, key)
+	if err != nil {
+		return err
+	}
+
+	result, err := images.DescribeBytes(data)
+	if err != nil {
+		return err
+	}
+
+	return src.save(image.Id, result)
+}
@@ -0,0 +1,35 @@
+package app
+
+import (
+	"fmt"
+	"log/slog"
+
+	auth "github.com/go-park-mail-ru/2023_2_Vkladyshi/authorization/proto"
+	"github.com/go-park-mail-ru/2023_2_Vkladyshi/comments/repository/comment"
+	"github.com/go-park-mail-ru/2023_2_Vkladyshi/comments/usecase"
+	"github.com/go-park-mail-ru/2023_2_Vkladyshi/configs"
+	films "github.com/go-park-mail-ru/2023_2_Vkladyshi/films/proto"
+	"github.com/go-park-mail-ru/2023_2_Vkladyshi/pkg/healthcheck"
+)
+
+// GetCore creates the repository chosen in the config and the core of the
+// comments service on top of it. A Postgres repository is added to checker.
+func GetCore(config *configs.CommentCfg, client auth.AuthorizationClient, filmsClient films.FilmsClient, lg *slog.Logger,
+	checker *healthcheck.Checker) (*usecase.Core, error) {
+	var (
+		comments comment.ICommentRepo
+		err      error
+	)
+	switch config.CommentsDb {
+	case "postgres":
+		comments, err = comment.GetCommentRepo(config, lg)
+	case "memory":
+		comments, err = comment.GetCommentMemoryRepo(config)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("create comments repo err: %w", err)
+	}
+	checker.AddPinger("postgres comments", comments)
+
+	return usecase.GetCore(client, filmsClient, lg, comments), nil
+}
@@ -1,6 +1,7 @@
 package delivery
 
 import (
+	"errors"
 	"io"
 	"log/slog"
 	"net/http"
@@ -9,6 +10,7 @@ import (
 
 	"github.com/go-park-mail-ru/2023_2_Vkladyshi/comments/usecase"
 	"github.com/go-park-mail-ru/2023_2_Vkladyshi/configs"
+	"github.com/go-park-mail-ru/2023_2_Vkladyshi/pkg/healthcheck"
 	"github.com/go-park-mail-ru/2023_2_Vkladyshi/pkg/middleware"
 	"github.com/go-park-mail-ru/2023_2_Vkladyshi/pkg/requests"
 	"github.com/mailru/easyjson"
@@ -17,35 +19,44 @@ import (
 
 type API struct {
 	core   usecase.ICore
+	auth   middleware.Core
 	lg     *slog.Logger
 	mx     *http.ServeMux
 	ct     *requests.Collector
 	adress string
+	// gateway is the secret of requests forwarded by the gateway.
+	gateway string
 }
 
-func GetApi(c *usecase.Core, l *slog.Logger, cfg *configs.CommentCfg) *API {
+func GetApi(c *usecase.Core, l *slog.Logger, cfg *configs.CommentCfg, sessions *middleware.SessionCache, checker *healthcheck.Checker) *API {
 
 	api := &API{
-		core:   c,
-		lg:     l.With("module", "api"),
-		mx:     http.NewServeMux(),
-		ct:     requests.GetCollector(),
-		adress: cfg.ServerAdress,
+		core:    c,
+		auth:    middleware.CacheSessions(c, sessions),
+		lg:      l.With("module", "api"),
+		mx:      http.NewServeMux(),
+		ct:      requests.GetCollector(),
+		adress:  cfg.ServerAdress,
+		gateway: cfg.GatewaySecret,
 	}
 
 	api.mx.Handle("/metrics", promhttp.Handler())
-	api.mx.HandleFunc("/api/v1/comment", api.Comment)
-	api.mx.Handle("/api/v1/comment/add", middleware.AuthCheck(http.HandlerFunc(api.AddComment), c, l))
-	api.mx.Handle("/api/v1/comment/delete", middleware.AuthCheck(http.HandlerFunc(api.DeleteComment), c, l))
+	checker.Register(api.mx)
+	api.Register(api.mx)
 
 	return api
 }
 
-func (a *API) ListenAndServe() {
-	err := http.ListenAndServe(a.adress, a.mx)
-	if err != nil {
-		a.lg.Error("listen and serve error", "err", err.Error())
-	}
+// Register adds the comment routes to mx, so the services can share one listener.
+func (a *API) Register(mx *http.ServeMux) {
+	mx.HandleFunc("/api/v1/comment", a.Comment)
+	mx.Handle("/api/v1/comment/add", middleware.AuthCheck(http.HandlerFunc(a.AddComment), a.auth, a.lg))
+	mx.Handle("/api/v1/comment/delete", middleware.AuthCheck(http.HandlerFunc(a.DeleteComment), a.auth, a.lg))
+}
+
+// Server serves the routes of the API, the caller starts and stops it.
+func (a *API) Server() *http.Server {
+	return &http.Server{Addr: a.adress, Handler: middleware.TrustGateway(a.mx, a.gateway)}
 }
 
 func (a *API) Comment(w http.ResponseWriter, r *http.Request) {
@@ -96,7 +107,13 @@ func (a *API) AddComment(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	userId := r.Context().Value(middleware.UserIDKey).(uint64)
+	user, isAuth := middleware.PrincipalFrom(r.Context())
+	if !isAuth {
+		response.Status = http.StatusUnauthorized
+		a.ct.SendResponse(w, r, response, a.lg, start)
+		return
+	}
+	userId := user.Id
 
 	var commentRequest requests.CommentRequest
 
@@ -114,6 +131,11 @@ func (a *API) AddComment(w http.ResponseWriter, r *http.Request) {
 	}
 
 	found, err := a.core.AddComment(commentRequest.FilmId, userId, commentRequest.Rating, commentRequest.Text)
+	if errors.Is(err, usecase.ErrFilmNotFound) {
+		response.Status = http.StatusNotFound
+		a.ct.SendResponse(w, r, response, a.lg, start)
+		return
+	}
 	if err != nil {
 		a.lg.Error("Add Comment error", "err", err.Error())
 		response.Status = http.StatusInternalServerError
@@ -2,7 +2,6 @@ package delivery
 
 import (
 	"bytes"
-	"context"
 	"fmt"
 	"io"
 	"log/slog"
@@ -12,6 +11,7 @@ import (
 	"testing"
 
 	"github.com/go-park-mail-ru/2023_2_Vkladyshi/comments/mocks"
+	"github.com/go-park-mail-ru/2023_2_Vkladyshi/comments/usecase"
 	"github.com/go-park-mail-ru/2023_2_Vkladyshi/pkg/middleware"
 	"github.com/go-park-mail-ru/2023_2_Vkladyshi/pkg/requests"
 	"github.com/golang/mock/gomock"
@@ -136,6 +136,11 @@ func TestCommentAdd(t *testing.T) {
 			result: requests.Response{Status: http.StatusOK, Body: nil},
 			body:   createBody(requests.CommentRequest{Rating: 10, FilmId: 3, Text: ""}),
 		},
+		"film not found": {
+			method: http.MethodPost,
+			result: requests.Response{Status: http.StatusNotFound, Body: nil},
+			body:   createBody(requests.CommentRequest{Rating: 10, FilmId: 4, Text: ""}),
+		},
 	}
 
 	mockCtrl := gomock.NewController(t)
@@ -145,6 +150,7 @@ func TestCommentAdd(t *testing.T) {
 	mockCore.EXPECT().AddComment(uint64(1), uint64(1), uint16(10), string("")).Return(false, fmt.Errorf("core_err")).Times(1)
 	mockCore.EXPECT().AddComment(uint64(2), uint64(1), uint16(10), string("")).Return(true, nil).Times(1)
 	mockCore.EXPECT().AddComment(uint64(3), uint64(1), uint16(10), string("")).Return(false, nil).Times(1)
+	mockCore.EXPECT().AddComment(uint64(4), uint64(1), uint16(10), string("")).Return(false, usecase.ErrFilmNotFound).Times(1)
 	var buff bytes.Buffer
 	logger := slog.New(slog.NewJSONHandler(&buff, nil))
 
@@ -152,7 +158,7 @@ func TestCommentAdd(t *testing.T) {
 
 	for _, curr := range testCases {
 		r := httptest.NewRequest(curr.method, "/api/v1/comment/add", curr.body)
-		newReq := r.WithContext(context.WithValue(r.Context(), middleware.UserIDKey, uint64(1)))
+		newReq := r.WithContext(middleware.WithPrincipal(r.Context(), &middleware.Principal{Id: 1}))
 		w := httptest.NewRecorder()
 
 		api.AddComment(w, newReq)
@@ -213,7 +219,7 @@ func TestDeleteComment(t *testing.T) {
 
 	for _, curr := range testCases {
 		r := httptest.NewRequest(curr.method, "/api/v1/comment/delete", curr.body)
-		newReq := r.WithContext(context.WithValue(r.Context(), middleware.UserIDKey, uint64(1)))
+		newReq := r.WithContext(middleware.WithPrincipal(r.Context(), &middleware.Principal{Id: 1}))
 
 		w := httptest.NewRecorder()
 
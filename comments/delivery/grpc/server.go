@@ -0,0 +1,198 @@
+package delivery_comments_grpc
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"net"
+
+	pb "github.com/go-park-mail-ru/2023_2_Vkladyshi/comments/proto"
+	"github.com/go-park-mail-ru/2023_2_Vkladyshi/comments/usecase"
+	"github.com/go-park-mail-ru/2023_2_Vkladyshi/pkg/healthcheck"
+	"github.com/go-park-mail-ru/2023_2_Vkladyshi/pkg/interceptors"
+	"github.com/go-park-mail-ru/2023_2_Vkladyshi/pkg/lifecycle"
+	"github.com/go-park-mail-ru/2023_2_Vkladyshi/pkg/models"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/reflection"
+)
+
+const (
+	defaultPageSize = 10
+	maxPageSize     = 100
+)
+
+type commentsGrpc struct {
+	grpcServ *grpc.Server
+	health   *health.Server
+	lg       *slog.Logger
+}
+
+type server struct {
+	pb.UnimplementedCommentsServer
+	core usecase.ICore
+	lg   *slog.Logger
+}
+
+func NewServer(core usecase.ICore, l *slog.Logger, checker *healthcheck.Checker, opts ...grpc.ServerOption) *commentsGrpc {
+	s := grpc.NewServer(append(interceptors.ServerOptions("comments", l, interceptors.Codes{
+		usecase.ErrFilmNotFound: codes.NotFound,
+	}), opts...)...)
+	pb.RegisterCommentsServer(s, &server{
+		core: core,
+		lg:   l,
+	})
+
+	// The health service follows the dependencies of checker, reflection
+	// lets grpcurl list the services.
+	healthServer := health.NewServer()
+	healthpb.RegisterHealthServer(s, healthServer)
+	checker.ServeGrpc(healthServer, pb.Comments_ServiceDesc.ServiceName)
+	reflection.Register(s)
+
+	return &commentsGrpc{grpcServ: s, health: healthServer, lg: l}
+}
+
+func toProto(comment models.CommentItem) *pb.Comment {
+	return &pb.Comment{
+		IdUser: comment.IdUser,
+		Name:   comment.Username,
+		Photo:  comment.Photo,
+		IdFilm: comment.IdFilm,
+		Rating: uint32(comment.Rating),
+		Text:   comment.Comment,
+	}
+}
+
+func listToProto(comments []models.CommentItem) []*pb.Comment {
+	result := make([]*pb.Comment, 0, len(comments))
+	for _, comment := range comments {
+		result = append(result, toProto(comment))
+	}
+
+	return result
+}
+
+// pageBounds turns a page number counted from 1 into an offset and a limit.
+func pageBounds(page uint64, pageSize uint64) (uint64, uint64) {
+	if page == 0 {
+		page = 1
+	}
+	if pageSize == 0 {
+		pageSize = defaultPageSize
+	}
+	pageSize = min(pageSize, maxPageSize)
+
+	return (page - 1) * pageSize, pageSize
+}
+
+// GetFilmComments returns a page of comments with their authors and, for a
+// signed in viewer, the viewer's own rating and comment.
+func (s *server) GetFilmComments(ctx context.Context, req *pb.FilmCommentsRequest) (*pb.FilmCommentsResponse, error) {
+	first, limit := pageBounds(req.Page, req.PerPage)
+	comments, err := s.core.GetFilmComments(req.FilmId, first, limit)
+	if err != nil {
+		s.lg.Error("failed to get film comments", "err", err.Error())
+		return nil, err
+	}
+
+	response := &pb.FilmCommentsResponse{Comments: listToProto(comments)}
+
+	if req.ViewerId != 0 {
+		own, err := s.core.GetUserComment(req.ViewerId, req.FilmId)
+		if err != nil {
+			s.lg.Error("failed to get user comment", "err", err.Error())
+			return nil, err
+		}
+		if own != nil {
+			response.Own = toProto(*own)
+		}
+	}
+
+	return response, nil
+}
+
+// GetFilmsStats returns the number of ratings and the average rating of
+// each film, films nobody has rated are left out.
+func (s *server) GetFilmsStats(ctx context.Context, req *pb.FilmsStatsRequest) (*pb.FilmsStatsResponse, error) {
+	stats, err := s.core.GetFilmsStats(req.FilmIds)
+	if err != nil {
+		s.lg.Error("failed to get films stats", "err", err.Error())
+		return nil, err
+	}
+
+	response := &pb.FilmsStatsResponse{Stats: make([]*pb.FilmStats, 0, len(stats))}
+	for _, item := range stats {
+		response.Stats = append(response.Stats, &pb.FilmStats{
+			FilmId:  item.IdFilm,
+			Count:   item.Count,
+			Average: item.Average,
+		})
+	}
+
+	return response, nil
+}
+
+// GetUserComments returns the ratings and comments of a user, the latest first.
+func (s *server) GetUserComments(ctx context.Context, req *pb.UserCommentsRequest) (*pb.CommentsResponse, error) {
+	first, limit := pageBounds(req.Page, req.PerPage)
+	comments, err := s.core.GetUserComments(req.UserId, first, limit)
+	if err != nil {
+		s.lg.Error("failed to get user comments", "err", err.Error())
+		return nil, err
+	}
+
+	return &pb.CommentsResponse{Comments: listToProto(comments)}, nil
+}
+
+func (s *server) GetLatestComments(ctx context.Context, req *pb.LatestCommentsRequest) (*pb.CommentsResponse, error) {
+	_, limit := pageBounds(1, req.Limit)
+	comments, err := s.core.GetLatestComments(limit)
+	if err != nil {
+		s.lg.Error("failed to get latest comments", "err", err.Error())
+		return nil, err
+	}
+
+	return &pb.CommentsResponse{Comments: listToProto(comments)}, nil
+}
+
+// DeleteUserComments removes every rating and comment of a user, for
+// example when the account is deleted.
+func (s *server) DeleteUserComments(ctx context.Context, req *pb.DeleteUserCommentsRequest) (*pb.DeleteUserCommentsResponse, error) {
+	deleted, err := s.core.DeleteUserComments(req.UserId)
+	if err != nil {
+		s.lg.Error("failed to delete user comments", "err", err.Error())
+		return nil, err
+	}
+
+	return &pb.DeleteUserCommentsResponse{Deleted: deleted}, nil
+}
+
+func (s *commentsGrpc) ListenAndServeGrpc(adress string) error {
+	lis, err := net.Listen("tcp", adress)
+	if err != nil {
+		s.lg.Error("failed to listen", "err", err.Error())
+		return fmt.Errorf("listen and serve grpc error: %w", err)
+	}
+
+	return s.Serve(lis)
+}
+
+func (s *commentsGrpc) Serve(lis net.Listener) error {
+	if err := s.grpcServ.Serve(lis); err != nil {
+		s.lg.Error("failed to serve", "err", err.Error())
+		return fmt.Errorf("listen and serve grpc error: %w", err)
+	}
+
+	return nil
+}
+
+// Shutdown reports the server as not serving, so that clients move away,
+// and stops it once the calls in flight are done or ctx is.
+func (s *commentsGrpc) Shutdown(ctx context.Context) error {
+	s.health.Shutdown()
+
+	return lifecycle.StopGrpc(ctx, s.grpcServ)
+}
@@ -0,0 +1,106 @@
+package delivery_comments_grpc
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"log/slog"
+	"testing"
+
+	"github.com/go-park-mail-ru/2023_2_Vkladyshi/comments/mocks"
+	pb "github.com/go-park-mail-ru/2023_2_Vkladyshi/comments/proto"
+	"github.com/go-park-mail-ru/2023_2_Vkladyshi/pkg/models"
+	"github.com/golang/mock/gomock"
+)
+
+func TestGetFilmComments(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	mockCore := mocks.NewMockICore(mockCtrl)
+	s := &server{core: mockCore, lg: slog.New(slog.NewTextHandler(io.Discard, nil))}
+
+	comments := []models.CommentItem{{IdUser: 2, Username: "viewer", Photo: "p", IdFilm: 1, Rating: 7, Comment: "c"}}
+	mockCore.EXPECT().GetFilmComments(uint64(1), uint64(0), uint64(defaultPageSize)).Return(comments, nil)
+
+	response, err := s.GetFilmComments(context.Background(), &pb.FilmCommentsRequest{FilmId: 1})
+	if err != nil {
+		t.Errorf("GetFilmComments error: %s", err)
+	}
+	if len(response.Comments) != 1 || response.Comments[0].Name != "viewer" || response.Comments[0].Rating != 7 {
+		t.Errorf("unexpected comments %v", response.Comments)
+	}
+	if response.Own != nil {
+		t.Errorf("anonymous viewer got own comment %v", response.Own)
+	}
+
+	mockCore.EXPECT().GetFilmComments(uint64(1), uint64(5), uint64(5)).Return(comments, nil)
+	mockCore.EXPECT().GetUserComment(uint64(2), uint64(1)).Return(&comments[0], nil)
+
+	response, err = s.GetFilmComments(context.Background(), &pb.FilmCommentsRequest{FilmId: 1, ViewerId: 2, Page: 2, PerPage: 5})
+	if err != nil {
+		t.Errorf("GetFilmComments error: %s", err)
+	}
+	if response.Own == nil || response.Own.Text != "c" {
+		t.Errorf("unexpected own comment %v", response.Own)
+	}
+
+	mockCore.EXPECT().GetFilmComments(uint64(1), uint64(0), uint64(defaultPageSize)).Return(nil, fmt.Errorf("repo err"))
+
+	_, err = s.GetFilmComments(context.Background(), &pb.FilmCommentsRequest{FilmId: 1})
+	if err == nil {
+		t.Errorf("expected error, got nil")
+	}
+}
+
+func TestGetFilmsStats(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	mockCore := mocks.NewMockICore(mockCtrl)
+	s := &server{core: mockCore, lg: slog.New(slog.NewTextHandler(io.Discard, nil))}
+
+	mockCore.EXPECT().GetFilmsStats([]uint64{1, 2}).Return([]models.FilmStats{{IdFilm: 1, Count: 2, Average: 7.5}}, nil)
+	mockCore.EXPECT().GetFilmsStats([]uint64{3}).Return(nil, fmt.Errorf("repo err"))
+
+	response, err := s.GetFilmsStats(context.Background(), &pb.FilmsStatsRequest{FilmIds: []uint64{1, 2}})
+	if err != nil {
+		t.Errorf("GetFilmsStats error: %s", err)
+	}
+	if len(response.Stats) != 1 || response.Stats[0].FilmId != 1 || response.Stats[0].Average != 7.5 {
+		t.Errorf("unexpected stats %v", response.Stats)
+	}
+
+	_, err = s.GetFilmsStats(context.Background(), &pb.FilmsStatsRequest{FilmIds: []uint64{3}})
+	if err == nil {
+		t.Errorf("expected error, got nil")
+	}
+}
+
+func TestUserComments(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	mockCore := mocks.NewMockICore(mockCtrl)
+	s := &server{core: mockCore, lg: slog.New(slog.NewTextHandler(io.Discard, nil))}
+
+	comments := []models.CommentItem{{IdUser: 2, IdFilm: 1, Rating: 7, Comment: "c"}}
+	mockCore.EXPECT().GetUserComments(uint64(2), uint64(maxPageSize), uint64(maxPageSize)).Return(comments, nil)
+	mockCore.EXPECT().GetLatestComments(uint64(defaultPageSize)).Return(comments, nil)
+	mockCore.EXPECT().DeleteUserComments(uint64(2)).Return(uint64(4), nil)
+
+	response, err := s.GetUserComments(context.Background(), &pb.UserCommentsRequest{UserId: 2, Page: 2, PerPage: 1000})
+	if err != nil || len(response.Comments) != 1 || response.Comments[0].IdFilm != 1 {
+		t.Errorf("unexpected user comments %v, %v", response, err)
+	}
+
+	response, err = s.GetLatestComments(context.Background(), &pb.LatestCommentsRequest{})
+	if err != nil || len(response.Comments) != 1 {
+		t.Errorf("unexpected latest comments %v, %v", response, err)
+	}
+
+	deleted, err := s.DeleteUserComments(context.Background(), &pb.DeleteUserCommentsRequest{UserId: 2})
+	if err != nil || deleted.Deleted != 4 {
+		t.Errorf("unexpected delete result %v, %v", deleted, err)
+	}
+}
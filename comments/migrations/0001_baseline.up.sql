@@ -0,0 +1,17 @@
+-- Baseline of the comments database as it was built by hand before
+-- migrations. Every statement is IF NOT EXISTS, so running it against such a
+-- database only records the version.
+
+-- id_user and id_film refer to the auth and films databases, so there are no
+-- foreign keys. The films service reads ratings from the same table.
+CREATE TABLE IF NOT EXISTS users_comment (
+    id_user INTEGER NOT NULL,
+    id_film INTEGER NOT NULL,
+    rating  SMALLINT NOT NULL,
+    comment TEXT NOT NULL DEFAULT '',
+    date    TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (id_user, id_film)
+);
+
+CREATE INDEX IF NOT EXISTS users_comment_film_idx ON users_comment (id_film);
+CREATE INDEX IF NOT EXISTS users_comment_date_idx ON users_comment (date);
@@ -0,0 +1,21 @@
+package migrations
+
+import (
+	"embed"
+	"fmt"
+
+	"github.com/go-park-mail-ru/2023_2_Vkladyshi/configs"
+	"github.com/go-park-mail-ru/2023_2_Vkladyshi/pkg/migrate"
+)
+
+const Service = "comments"
+
+//go:embed *.sql
+var Files embed.FS
+
+func GetMigrator(config *configs.CommentCfg) (*migrate.Migrator, error) {
+	dsn := fmt.Sprintf("user=%s dbname=%s password= %s host=%s port=%d sslmode=%s",
+		config.User, config.DbName, config.Password, config.Host, config.Port, config.Sslmode)
+
+	return migrate.Open(dsn, Service, Files)
+}
@@ -8,6 +8,7 @@ import (
 	context "context"
 	reflect "reflect"
 
+	middleware "github.com/go-park-mail-ru/2023_2_Vkladyshi/pkg/middleware"
 	models "github.com/go-park-mail-ru/2023_2_Vkladyshi/pkg/models"
 	gomock "github.com/golang/mock/gomock"
 )
@@ -64,6 +65,21 @@ func (mr *MockICoreMockRecorder) DeleteComment(idUser, idFilm interface{}) *gomo
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteComment", reflect.TypeOf((*MockICore)(nil).DeleteComment), idUser, idFilm)
 }
 
+// DeleteUserComments mocks base method.
+func (m *MockICore) DeleteUserComments(userId uint64) (uint64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteUserComments", userId)
+	ret0, _ := ret[0].(uint64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteUserComments indicates an expected call of DeleteUserComments.
+func (mr *MockICoreMockRecorder) DeleteUserComments(userId interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteUserComments", reflect.TypeOf((*MockICore)(nil).DeleteUserComments), userId)
+}
+
 // GetFilmComments mocks base method.
 func (m *MockICore) GetFilmComments(filmId, first, limit uint64) ([]models.CommentItem, error) {
 	m.ctrl.T.Helper()
@@ -79,17 +95,77 @@ func (mr *MockICoreMockRecorder) GetFilmComments(filmId, first, limit interface{
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFilmComments", reflect.TypeOf((*MockICore)(nil).GetFilmComments), filmId, first, limit)
 }
 
-// GetUserId mocks base method.
-func (m *MockICore) GetUserId(ctx context.Context, sid string) (uint64, error) {
+// GetFilmsStats mocks base method.
+func (m *MockICore) GetFilmsStats(filmIds []uint64) ([]models.FilmStats, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetUserId", ctx, sid)
-	ret0, _ := ret[0].(uint64)
+	ret := m.ctrl.Call(m, "GetFilmsStats", filmIds)
+	ret0, _ := ret[0].([]models.FilmStats)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetFilmsStats indicates an expected call of GetFilmsStats.
+func (mr *MockICoreMockRecorder) GetFilmsStats(filmIds interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFilmsStats", reflect.TypeOf((*MockICore)(nil).GetFilmsStats), filmIds)
+}
+
+// GetLatestComments mocks base method.
+func (m *MockICore) GetLatestComments(limit uint64) ([]models.CommentItem, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetLatestComments", limit)
+	ret0, _ := ret[0].([]models.CommentItem)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetLatestComments indicates an expected call of GetLatestComments.
+func (mr *MockICoreMockRecorder) GetLatestComments(limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLatestComments", reflect.TypeOf((*MockICore)(nil).GetLatestComments), limit)
+}
+
+// GetUserComment mocks base method.
+func (m *MockICore) GetUserComment(userId, filmId uint64) (*models.CommentItem, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUserComment", userId, filmId)
+	ret0, _ := ret[0].(*models.CommentItem)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUserComment indicates an expected call of GetUserComment.
+func (mr *MockICoreMockRecorder) GetUserComment(userId, filmId interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserComment", reflect.TypeOf((*MockICore)(nil).GetUserComment), userId, filmId)
+}
+
+// GetUserComments mocks base method.
+func (m *MockICore) GetUserComments(userId, first, limit uint64) ([]models.CommentItem, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUserComments", userId, first, limit)
+	ret0, _ := ret[0].([]models.CommentItem)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUserComments indicates an expected call of GetUserComments.
+func (mr *MockICoreMockRecorder) GetUserComments(userId, first, limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserComments", reflect.TypeOf((*MockICore)(nil).GetUserComments), userId, first, limit)
+}
+
+// ValidateSession mocks base method.
+func (m *MockICore) ValidateSession(ctx context.Context, sid string) (*middleware.Principal, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ValidateSession", ctx, sid)
+	ret0, _ := ret[0].(*middleware.Principal)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetUserId indicates an expected call of GetUserId.
-func (mr *MockICoreMockRecorder) GetUserId(ctx, sid interface{}) *gomock.Call {
+// ValidateSession indicates an expected call of ValidateSession.
+func (mr *MockICoreMockRecorder) ValidateSession(ctx, sid interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserId", reflect.TypeOf((*MockICore)(nil).GetUserId), ctx, sid)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ValidateSession", reflect.TypeOf((*MockICore)(nil).ValidateSession), ctx, sid)
 }
@@ -62,6 +62,21 @@ func (mr *MockICommentRepoMockRecorder) DeleteComment(idUser, idFilm interface{}
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteComment", reflect.TypeOf((*MockICommentRepo)(nil).DeleteComment), idUser, idFilm)
 }
 
+// DeleteUserComments mocks base method.
+func (m *MockICommentRepo) DeleteUserComments(userId uint64) (uint64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteUserComments", userId)
+	ret0, _ := ret[0].(uint64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteUserComments indicates an expected call of DeleteUserComments.
+func (mr *MockICommentRepoMockRecorder) DeleteUserComments(userId interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteUserComments", reflect.TypeOf((*MockICommentRepo)(nil).DeleteUserComments), userId)
+}
+
 // GetFilmComments mocks base method.
 func (m *MockICommentRepo) GetFilmComments(filmId, first, limit uint64) ([]models.CommentItem, error) {
 	m.ctrl.T.Helper()
@@ -77,6 +92,66 @@ func (mr *MockICommentRepoMockRecorder) GetFilmComments(filmId, first, limit int
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFilmComments", reflect.TypeOf((*MockICommentRepo)(nil).GetFilmComments), filmId, first, limit)
 }
 
+// GetFilmsStats mocks base method.
+func (m *MockICommentRepo) GetFilmsStats(filmIds []uint64) ([]models.FilmStats, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetFilmsStats", filmIds)
+	ret0, _ := ret[0].([]models.FilmStats)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetFilmsStats indicates an expected call of GetFilmsStats.
+func (mr *MockICommentRepoMockRecorder) GetFilmsStats(filmIds interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFilmsStats", reflect.TypeOf((*MockICommentRepo)(nil).GetFilmsStats), filmIds)
+}
+
+// GetLatestComments mocks base method.
+func (m *MockICommentRepo) GetLatestComments(limit uint64) ([]models.CommentItem, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetLatestComments", limit)
+	ret0, _ := ret[0].([]models.CommentItem)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetLatestComments indicates an expected call of GetLatestComments.
+func (mr *MockICommentRepoMockRecorder) GetLatestComments(limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLatestComments", reflect.TypeOf((*MockICommentRepo)(nil).GetLatestComments), limit)
+}
+
+// GetUserComment mocks base method.
+func (m *MockICommentRepo) GetUserComment(userId, filmId uint64) (*models.CommentItem, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUserComment", userId, filmId)
+	ret0, _ := ret[0].(*models.CommentItem)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUserComment indicates an expected call of GetUserComment.
+func (mr *MockICommentRepoMockRecorder) GetUserComment(userId, filmId interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserComment", reflect.TypeOf((*MockICommentRepo)(nil).GetUserComment), userId, filmId)
+}
+
+// GetUserComments mocks base method.
+func (m *MockICommentRepo) GetUserComments(userId, first, limit uint64) ([]models.CommentItem, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUserComments", userId, first, limit)
+	ret0, _ := ret[0].([]models.CommentItem)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUserComments indicates an expected call of GetUserComments.
+func (mr *MockICommentRepoMockRecorder) GetUserComments(userId, first, limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserComments", reflect.TypeOf((*MockICommentRepo)(nil).GetUserComments), userId, first, limit)
+}
+
 // HasUsersComment mocks base method.
 func (m *MockICommentRepo) HasUsersComment(userId, filmId uint64) (bool, error) {
 	m.ctrl.T.Helper()
@@ -0,0 +1,610 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// source: comments.proto
+
+package proto
+
+import (
+	fmt "fmt"
+	proto "github.com/golang/protobuf/proto"
+	math "math"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.ProtoPackageIsVersion3 // please upgrade the proto package
+
+type FilmCommentsRequest struct {
+	FilmId               uint64   `protobuf:"varint,1,opt,name=film_id,json=filmId,proto3" json:"film_id,omitempty"`
+	ViewerId             uint64   `protobuf:"varint,2,opt,name=viewer_id,json=viewerId,proto3" json:"viewer_id,omitempty"`
+	Page                 uint64   `protobuf:"varint,3,opt,name=page,proto3" json:"page,omitempty"`
+	PerPage              uint64   `protobuf:"varint,4,opt,name=per_page,json=perPage,proto3" json:"per_page,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *FilmCommentsRequest) Reset()         { *m = FilmCommentsRequest{} }
+func (m *FilmCommentsRequest) String() string { return proto.CompactTextString(m) }
+func (*FilmCommentsRequest) ProtoMessage()    {}
+func (*FilmCommentsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c79ba7e4af40529a, []int{0}
+}
+
+func (m *FilmCommentsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_FilmCommentsRequest.Unmarshal(m, b)
+}
+func (m *FilmCommentsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_FilmCommentsRequest.Marshal(b, m, deterministic)
+}
+func (m *FilmCommentsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FilmCommentsRequest.Merge(m, src)
+}
+func (m *FilmCommentsRequest) XXX_Size() int {
+	return xxx_messageInfo_FilmCommentsRequest.Size(m)
+}
+func (m *FilmCommentsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_FilmCommentsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FilmCommentsRequest proto.InternalMessageInfo
+
+func (m *FilmCommentsRequest) GetFilmId() uint64 {
+	if m != nil {
+		return m.FilmId
+	}
+	return 0
+}
+
+func (m *FilmCommentsRequest) GetViewerId() uint64 {
+	if m != nil {
+		return m.ViewerId
+	}
+	return 0
+}
+
+func (m *FilmCommentsRequest) GetPage() uint64 {
+	if m != nil {
+		return m.Page
+	}
+	return 0
+}
+
+func (m *FilmCommentsRequest) GetPerPage() uint64 {
+	if m != nil {
+		return m.PerPage
+	}
+	return 0
+}
+
+type Comment struct {
+	IdUser               uint64   `protobuf:"varint,1,opt,name=id_user,json=idUser,proto3" json:"id_user,omitempty"`
+	Name                 string   `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Photo                string   `protobuf:"bytes,3,opt,name=photo,proto3" json:"photo,omitempty"`
+	IdFilm               uint64   `protobuf:"varint,4,opt,name=id_film,json=idFilm,proto3" json:"id_film,omitempty"`
+	Rating               uint32   `protobuf:"varint,5,opt,name=rating,proto3" json:"rating,omitempty"`
+	Text                 string   `protobuf:"bytes,6,opt,name=text,proto3" json:"text,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Comment) Reset()         { *m = Comment{} }
+func (m *Comment) String() string { return proto.CompactTextString(m) }
+func (*Comment) ProtoMessage()    {}
+func (*Comment) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c79ba7e4af40529a, []int{1}
+}
+
+func (m *Comment) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Comment.Unmarshal(m, b)
+}
+func (m *Comment) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Comment.Marshal(b, m, deterministic)
+}
+func (m *Comment) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Comment.Merge(m, src)
+}
+func (m *Comment) XXX_Size() int {
+	return xxx_messageInfo_Comment.Size(m)
+}
+func (m *Comment) XXX_DiscardUnknown() {
+	xxx_messageInfo_Comment.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Comment proto.InternalMessageInfo
+
+func (m *Comment) GetIdUser() uint64 {
+	if m != nil {
+		return m.IdUser
+	}
+	return 0
+}
+
+func (m *Comment) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *Comment) GetPhoto() string {
+	if m != nil {
+		return m.Photo
+	}
+	return ""
+}
+
+func (m *Comment) GetIdFilm() uint64 {
+	if m != nil {
+		return m.IdFilm
+	}
+	return 0
+}
+
+func (m *Comment) GetRating() uint32 {
+	if m != nil {
+		return m.Rating
+	}
+	return 0
+}
+
+func (m *Comment) GetText() string {
+	if m != nil {
+		return m.Text
+	}
+	return ""
+}
+
+type FilmCommentsResponse struct {
+	Comments             []*Comment `protobuf:"bytes,1,rep,name=comments,proto3" json:"comments,omitempty"`
+	Own                  *Comment   `protobuf:"bytes,2,opt,name=own,proto3" json:"own,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
+	XXX_unrecognized     []byte     `json:"-"`
+	XXX_sizecache        int32      `json:"-"`
+}
+
+func (m *FilmCommentsResponse) Reset()         { *m = FilmCommentsResponse{} }
+func (m *FilmCommentsResponse) String() string { return proto.CompactTextString(m) }
+func (*FilmCommentsResponse) ProtoMessage()    {}
+func (*FilmCommentsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c79ba7e4af40529a, []int{2}
+}
+
+func (m *FilmCommentsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_FilmCommentsResponse.Unmarshal(m, b)
+}
+func (m *FilmCommentsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_FilmCommentsResponse.Marshal(b, m, deterministic)
+}
+func (m *FilmCommentsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FilmCommentsResponse.Merge(m, src)
+}
+func (m *FilmCommentsResponse) XXX_Size() int {
+	return xxx_messageInfo_FilmCommentsResponse.Size(m)
+}
+func (m *FilmCommentsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_FilmCommentsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FilmCommentsResponse proto.InternalMessageInfo
+
+func (m *FilmCommentsResponse) GetComments() []*Comment {
+	if m != nil {
+		return m.Comments
+	}
+	return nil
+}
+
+func (m *FilmCommentsResponse) GetOwn() *Comment {
+	if m != nil {
+		return m.Own
+	}
+	return nil
+}
+
+type FilmsStatsRequest struct {
+	FilmIds              []uint64 `protobuf:"varint,1,rep,packed,name=film_ids,json=filmIds,proto3" json:"film_ids,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *FilmsStatsRequest) Reset()         { *m = FilmsStatsRequest{} }
+func (m *FilmsStatsRequest) String() string { return proto.CompactTextString(m) }
+func (*FilmsStatsRequest) ProtoMessage()    {}
+func (*FilmsStatsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c79ba7e4af40529a, []int{3}
+}
+
+func (m *FilmsStatsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_FilmsStatsRequest.Unmarshal(m, b)
+}
+func (m *FilmsStatsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_FilmsStatsRequest.Marshal(b, m, deterministic)
+}
+func (m *FilmsStatsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FilmsStatsRequest.Merge(m, src)
+}
+func (m *FilmsStatsRequest) XXX_Size() int {
+	return xxx_messageInfo_FilmsStatsRequest.Size(m)
+}
+func (m *FilmsStatsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_FilmsStatsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FilmsStatsRequest proto.InternalMessageInfo
+
+func (m *FilmsStatsRequest) GetFilmIds() []uint64 {
+	if m != nil {
+		return m.FilmIds
+	}
+	return nil
+}
+
+type FilmStats struct {
+	FilmId               uint64   `protobuf:"varint,1,opt,name=film_id,json=filmId,proto3" json:"film_id,omitempty"`
+	Count                uint64   `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+	Average              float64  `protobuf:"fixed64,3,opt,name=average,proto3" json:"average,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *FilmStats) Reset()         { *m = FilmStats{} }
+func (m *FilmStats) String() string { return proto.CompactTextString(m) }
+func (*FilmStats) ProtoMessage()    {}
+func (*FilmStats) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c79ba7e4af40529a, []int{4}
+}
+
+func (m *FilmStats) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_FilmStats.Unmarshal(m, b)
+}
+func (m *FilmStats) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_FilmStats.Marshal(b, m, deterministic)
+}
+func (m *FilmStats) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FilmStats.Merge(m, src)
+}
+func (m *FilmStats) XXX_Size() int {
+	return xxx_messageInfo_FilmStats.Size(m)
+}
+func (m *FilmStats) XXX_DiscardUnknown() {
+	xxx_messageInfo_FilmStats.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FilmStats proto.InternalMessageInfo
+
+func (m *FilmStats) GetFilmId() uint64 {
+	if m != nil {
+		return m.FilmId
+	}
+	return 0
+}
+
+func (m *FilmStats) GetCount() uint64 {
+	if m != nil {
+		return m.Count
+	}
+	return 0
+}
+
+func (m *FilmStats) GetAverage() float64 {
+	if m != nil {
+		return m.Average
+	}
+	return 0
+}
+
+type FilmsStatsResponse struct {
+	Stats                []*FilmStats `protobuf:"bytes,1,rep,name=stats,proto3" json:"stats,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
+	XXX_unrecognized     []byte       `json:"-"`
+	XXX_sizecache        int32        `json:"-"`
+}
+
+func (m *FilmsStatsResponse) Reset()         { *m = FilmsStatsResponse{} }
+func (m *FilmsStatsResponse) String() string { return proto.CompactTextString(m) }
+func (*FilmsStatsResponse) ProtoMessage()    {}
+func (*FilmsStatsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c79ba7e4af40529a, []int{5}
+}
+
+func (m *FilmsStatsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_FilmsStatsResponse.Unmarshal(m, b)
+}
+func (m *FilmsStatsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_FilmsStatsResponse.Marshal(b, m, deterministic)
+}
+func (m *FilmsStatsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FilmsStatsResponse.Merge(m, src)
+}
+func (m *FilmsStatsResponse) XXX_Size() int {
+	return xxx_messageInfo_FilmsStatsResponse.Size(m)
+}
+func (m *FilmsStatsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_FilmsStatsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FilmsStatsResponse proto.InternalMessageInfo
+
+func (m *FilmsStatsResponse) GetStats() []*FilmStats {
+	if m != nil {
+		return m.Stats
+	}
+	return nil
+}
+
+type UserCommentsRequest struct {
+	UserId               uint64   `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Page                 uint64   `protobuf:"varint,2,opt,name=page,proto3" json:"page,omitempty"`
+	PerPage              uint64   `protobuf:"varint,3,opt,name=per_page,json=perPage,proto3" json:"per_page,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *UserCommentsRequest) Reset()         { *m = UserCommentsRequest{} }
+func (m *UserCommentsRequest) String() string { return proto.CompactTextString(m) }
+func (*UserCommentsRequest) ProtoMessage()    {}
+func (*UserCommentsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c79ba7e4af40529a, []int{6}
+}
+
+func (m *UserCommentsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UserCommentsRequest.Unmarshal(m, b)
+}
+func (m *UserCommentsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UserCommentsRequest.Marshal(b, m, deterministic)
+}
+func (m *UserCommentsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UserCommentsRequest.Merge(m, src)
+}
+func (m *UserCommentsRequest) XXX_Size() int {
+	return xxx_messageInfo_UserCommentsRequest.Size(m)
+}
+func (m *UserCommentsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_UserCommentsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UserCommentsRequest proto.InternalMessageInfo
+
+func (m *UserCommentsRequest) GetUserId() uint64 {
+	if m != nil {
+		return m.UserId
+	}
+	return 0
+}
+
+func (m *UserCommentsRequest) GetPage() uint64 {
+	if m != nil {
+		return m.Page
+	}
+	return 0
+}
+
+func (m *UserCommentsRequest) GetPerPage() uint64 {
+	if m != nil {
+		return m.PerPage
+	}
+	return 0
+}
+
+type LatestCommentsRequest struct {
+	Limit                uint64   `protobuf:"varint,1,opt,name=limit,proto3" json:"limit,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *LatestCommentsRequest) Reset()         { *m = LatestCommentsRequest{} }
+func (m *LatestCommentsRequest) String() string { return proto.CompactTextString(m) }
+func (*LatestCommentsRequest) ProtoMessage()    {}
+func (*LatestCommentsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c79ba7e4af40529a, []int{7}
+}
+
+func (m *LatestCommentsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_LatestCommentsRequest.Unmarshal(m, b)
+}
+func (m *LatestCommentsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_LatestCommentsRequest.Marshal(b, m, deterministic)
+}
+func (m *LatestCommentsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LatestCommentsRequest.Merge(m, src)
+}
+func (m *LatestCommentsRequest) XXX_Size() int {
+	return xxx_messageInfo_LatestCommentsRequest.Size(m)
+}
+func (m *LatestCommentsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_LatestCommentsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LatestCommentsRequest proto.InternalMessageInfo
+
+func (m *LatestCommentsRequest) GetLimit() uint64 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+type CommentsResponse struct {
+	Comments             []*Comment `protobuf:"bytes,1,rep,name=comments,proto3" json:"comments,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
+	XXX_unrecognized     []byte     `json:"-"`
+	XXX_sizecache        int32      `json:"-"`
+}
+
+func (m *CommentsResponse) Reset()         { *m = CommentsResponse{} }
+func (m *CommentsResponse) String() string { return proto.CompactTextString(m) }
+func (*CommentsResponse) ProtoMessage()    {}
+func (*CommentsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c79ba7e4af40529a, []int{8}
+}
+
+func (m *CommentsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CommentsResponse.Unmarshal(m, b)
+}
+func (m *CommentsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CommentsResponse.Marshal(b, m, deterministic)
+}
+func (m *CommentsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CommentsResponse.Merge(m, src)
+}
+func (m *CommentsResponse) XXX_Size() int {
+	return xxx_messageInfo_CommentsResponse.Size(m)
+}
+func (m *CommentsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_CommentsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CommentsResponse proto.InternalMessageInfo
+
+func (m *CommentsResponse) GetComments() []*Comment {
+	if m != nil {
+		return m.Comments
+	}
+	return nil
+}
+
+type DeleteUserCommentsRequest struct {
+	UserId               uint64   `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DeleteUserCommentsRequest) Reset()         { *m = DeleteUserCommentsRequest{} }
+func (m *DeleteUserCommentsRequest) String() string { return proto.CompactTextString(m) }
+func (*DeleteUserCommentsRequest) ProtoMessage()    {}
+func (*DeleteUserCommentsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c79ba7e4af40529a, []int{9}
+}
+
+func (m *DeleteUserCommentsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DeleteUserCommentsRequest.Unmarshal(m, b)
+}
+func (m *DeleteUserCommentsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DeleteUserCommentsRequest.Marshal(b, m, deterministic)
+}
+func (m *DeleteUserCommentsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeleteUserCommentsRequest.Merge(m, src)
+}
+func (m *DeleteUserCommentsRequest) XXX_Size() int {
+	return xxx_messageInfo_DeleteUserCommentsRequest.Size(m)
+}
+func (m *DeleteUserCommentsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeleteUserCommentsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeleteUserCommentsRequest proto.InternalMessageInfo
+
+func (m *DeleteUserCommentsRequest) GetUserId() uint64 {
+	if m != nil {
+		return m.UserId
+	}
+	return 0
+}
+
+type DeleteUserCommentsResponse struct {
+	Deleted              uint64   `protobuf:"varint,1,opt,name=deleted,proto3" json:"deleted,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DeleteUserCommentsResponse) Reset()         { *m = DeleteUserCommentsResponse{} }
+func (m *DeleteUserCommentsResponse) String() string { return proto.CompactTextString(m) }
+func (*DeleteUserCommentsResponse) ProtoMessage()    {}
+func (*DeleteUserCommentsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c79ba7e4af40529a, []int{10}
+}
+
+func (m *DeleteUserCommentsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DeleteUserCommentsResponse.Unmarshal(m, b)
+}
+func (m *DeleteUserCommentsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DeleteUserCommentsResponse.Marshal(b, m, deterministic)
+}
+func (m *DeleteUserCommentsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeleteUserCommentsResponse.Merge(m, src)
+}
+func (m *DeleteUserCommentsResponse) XXX_Size() int {
+	return xxx_messageInfo_DeleteUserCommentsResponse.Size(m)
+}
+func (m *DeleteUserCommentsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeleteUserCommentsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeleteUserCommentsResponse proto.InternalMessageInfo
+
+func (m *DeleteUserCommentsResponse) GetDeleted() uint64 {
+	if m != nil {
+		return m.Deleted
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*FilmCommentsRequest)(nil), "comments.FilmCommentsRequest")
+	proto.RegisterType((*Comment)(nil), "comments.Comment")
+	proto.RegisterType((*FilmCommentsResponse)(nil), "comments.FilmCommentsResponse")
+	proto.RegisterType((*FilmsStatsRequest)(nil), "comments.FilmsStatsRequest")
+	proto.RegisterType((*FilmStats)(nil), "comments.FilmStats")
+	proto.RegisterType((*FilmsStatsResponse)(nil), "comments.FilmsStatsResponse")
+	proto.RegisterType((*UserCommentsRequest)(nil), "comments.UserCommentsRequest")
+	proto.RegisterType((*LatestCommentsRequest)(nil), "comments.LatestCommentsRequest")
+	proto.RegisterType((*CommentsResponse)(nil), "comments.CommentsResponse")
+	proto.RegisterType((*DeleteUserCommentsRequest)(nil), "comments.DeleteUserCommentsRequest")
+	proto.RegisterType((*DeleteUserCommentsResponse)(nil), "comments.DeleteUserCommentsResponse")
+}
+
+func init() {
+	proto.RegisterFile("comments.proto", fileDescriptor_c79ba7e4af40529a)
+}
+
+var fileDescriptor_c79ba7e4af40529a = []byte{
+	// 531 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x54, 0xdb, 0x6e, 0xd3, 0x40,
+	0x10, 0xc5, 0x4d, 0x62, 0x3b, 0x83, 0x4a, 0xc9, 0x26, 0x80, 0xe3, 0x72, 0x89, 0xb6, 0x3c, 0x84,
+	0x87, 0xa6, 0x52, 0x41, 0xbc, 0x22, 0x2e, 0xa2, 0xaa, 0x54, 0x21, 0xb4, 0xc0, 0x0b, 0x12, 0x8a,
+	0x4c, 0x3d, 0x84, 0x45, 0xf1, 0x05, 0xef, 0xa6, 0x45, 0xfc, 0x05, 0xdf, 0xc2, 0x0f, 0xa2, 0xbd,
+	0xd8, 0xb1, 0x5b, 0x07, 0x84, 0xfa, 0xe4, 0x9d, 0xeb, 0x99, 0x39, 0x67, 0x64, 0xb8, 0x71, 0x9a,
+	0x25, 0x09, 0xa6, 0x52, 0xcc, 0xf2, 0x22, 0x93, 0x19, 0xf1, 0x4b, 0x9b, 0xfe, 0x84, 0xe1, 0x6b,
+	0xbe, 0x4c, 0x5e, 0x5a, 0x9b, 0xe1, 0xf7, 0x15, 0x0a, 0x49, 0xee, 0x80, 0xf7, 0x85, 0x2f, 0x93,
+	0x39, 0x8f, 0x03, 0x67, 0xe2, 0x4c, 0xbb, 0xcc, 0x55, 0xe6, 0x71, 0x4c, 0x76, 0xa1, 0x7f, 0xc6,
+	0xf1, 0x1c, 0x0b, 0x15, 0xda, 0xd2, 0x21, 0xdf, 0x38, 0x8e, 0x63, 0x42, 0xa0, 0x9b, 0x47, 0x0b,
+	0x0c, 0x3a, 0xda, 0xaf, 0xdf, 0x64, 0x0c, 0x7e, 0x8e, 0xc5, 0x5c, 0xfb, 0xbb, 0xda, 0xef, 0xe5,
+	0x58, 0xbc, 0x8d, 0x16, 0x48, 0x7f, 0x39, 0xe0, 0x59, 0x60, 0x05, 0xc8, 0xe3, 0xf9, 0x4a, 0x60,
+	0x51, 0x02, 0xf2, 0xf8, 0x83, 0xc0, 0x42, 0xf5, 0x4c, 0xa3, 0x04, 0x35, 0x56, 0x9f, 0xe9, 0x37,
+	0x19, 0x41, 0x2f, 0xff, 0x9a, 0xc9, 0x4c, 0x03, 0xf5, 0x99, 0x31, 0x6c, 0x0b, 0x35, 0xa7, 0x05,
+	0x72, 0x79, 0xac, 0x76, 0x23, 0xb7, 0xc1, 0x2d, 0x22, 0xc9, 0xd3, 0x45, 0xd0, 0x9b, 0x38, 0xd3,
+	0x6d, 0x66, 0x2d, 0xd5, 0x5a, 0xe2, 0x0f, 0x19, 0xb8, 0xa6, 0xb5, 0x7a, 0xd3, 0x6f, 0x30, 0x6a,
+	0xf2, 0x21, 0xf2, 0x2c, 0x15, 0x48, 0xf6, 0xa1, 0xe2, 0x2c, 0x70, 0x26, 0x9d, 0xe9, 0xf5, 0xc3,
+	0xc1, 0xac, 0x22, 0xd5, 0x66, 0xb3, 0x2a, 0x85, 0xec, 0x41, 0x27, 0x3b, 0x4f, 0xf5, 0xd0, 0xad,
+	0x99, 0x2a, 0x4a, 0x67, 0x30, 0x50, 0x58, 0xe2, 0x9d, 0x8c, 0xd6, 0xcc, 0x8f, 0xc1, 0xb7, 0xcc,
+	0x1b, 0xa0, 0x2e, 0xf3, 0x0c, 0xf5, 0x82, 0xbe, 0x87, 0xbe, 0xca, 0xd7, 0xe9, 0x9b, 0x15, 0x1a,
+	0x41, 0xef, 0x34, 0x5b, 0xa5, 0xd2, 0xaa, 0x63, 0x0c, 0x12, 0x80, 0x17, 0x9d, 0x61, 0x51, 0xaa,
+	0xe3, 0xb0, 0xd2, 0xa4, 0xcf, 0x80, 0xd4, 0xa7, 0xb0, 0xfb, 0x3e, 0x82, 0x9e, 0x50, 0x0e, 0xbb,
+	0xec, 0x70, 0xbd, 0x42, 0x35, 0x02, 0x33, 0x19, 0xf4, 0x13, 0x0c, 0x95, 0x52, 0x2d, 0x27, 0xa4,
+	0xe4, 0xac, 0x0d, 0xb8, 0x12, 0x8d, 0x2b, 0xd9, 0xda, 0x70, 0x25, 0x9d, 0xe6, 0x95, 0xec, 0xc3,
+	0xad, 0x93, 0x48, 0xa2, 0x90, 0x17, 0x01, 0x46, 0xd0, 0x5b, 0xf2, 0x84, 0x4b, 0xdb, 0xde, 0x18,
+	0xf4, 0x39, 0xdc, 0xbc, 0xa2, 0x78, 0xf4, 0x09, 0x8c, 0x5f, 0xe1, 0x12, 0x25, 0xfe, 0xcf, 0x5a,
+	0xf4, 0x29, 0x84, 0x6d, 0x55, 0x76, 0x84, 0x00, 0xbc, 0x58, 0x47, 0xcb, 0xb2, 0xd2, 0x3c, 0xfc,
+	0xdd, 0x01, 0xbf, 0x4c, 0x27, 0x0c, 0x76, 0x8e, 0x50, 0xd6, 0x2f, 0x90, 0xdc, 0x6b, 0x52, 0x7f,
+	0x61, 0x9e, 0xf0, 0xfe, 0xa6, 0xb0, 0x01, 0xa6, 0xd7, 0xc8, 0x09, 0x6c, 0xdb, 0x9e, 0x46, 0x63,
+	0xb2, 0xdb, 0x2c, 0x69, 0xdc, 0x5f, 0x78, 0xb7, 0x3d, 0x58, 0x75, 0x7b, 0xa3, 0x27, 0xac, 0xef,
+	0x58, 0x9f, 0xb0, 0x85, 0xb1, 0x30, 0xbc, 0xc4, 0x75, 0xbd, 0x1f, 0x83, 0xc1, 0x11, 0xca, 0xa6,
+	0xc2, 0xe4, 0xc1, 0xba, 0xa4, 0x55, 0xfb, 0x7f, 0xf4, 0x8c, 0x80, 0x5c, 0x96, 0x82, 0xec, 0xad,
+	0x6b, 0x36, 0xca, 0x1b, 0x3e, 0xfc, 0x7b, 0x52, 0x09, 0xf1, 0x62, 0xf0, 0x71, 0xe7, 0xa0, 0xcc,
+	0x3c, 0xd0, 0x3f, 0xd5, 0xcf, 0xae, 0xfe, 0x3c, 0xfe, 0x33, 0x00, 0x61, 0xb6, 0x8f, 0x75, 0x6d,
+	0x05, 0x00, 0x00,
+}
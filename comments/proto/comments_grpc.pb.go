@@ -0,0 +1,257 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.3.0
+// - protoc             v3.12.4
+// source: comments.proto
+
+package proto
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+const (
+	Comments_GetFilmComments_FullMethodName    = "/comments.Comments/GetFilmComments"
+	Comments_GetFilmsStats_FullMethodName      = "/comments.Comments/GetFilmsStats"
+	Comments_GetUserComments_FullMethodName    = "/comments.Comments/GetUserComments"
+	Comments_GetLatestComments_FullMethodName  = "/comments.Comments/GetLatestComments"
+	Comments_DeleteUserComments_FullMethodName = "/comments.Comments/DeleteUserComments"
+)
+
+// CommentsClient is the client API for Comments service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type CommentsClient interface {
+	GetFilmComments(ctx context.Context, in *FilmCommentsRequest, opts ...grpc.CallOption) (*FilmCommentsResponse, error)
+	GetFilmsStats(ctx context.Context, in *FilmsStatsRequest, opts ...grpc.CallOption) (*FilmsStatsResponse, error)
+	GetUserComments(ctx context.Context, in *UserCommentsRequest, opts ...grpc.CallOption) (*CommentsResponse, error)
+	GetLatestComments(ctx context.Context, in *LatestCommentsRequest, opts ...grpc.CallOption) (*CommentsResponse, error)
+	DeleteUserComments(ctx context.Context, in *DeleteUserCommentsRequest, opts ...grpc.CallOption) (*DeleteUserCommentsResponse, error)
+}
+
+type commentsClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewCommentsClient(cc grpc.ClientConnInterface) CommentsClient {
+	return &commentsClient{cc}
+}
+
+func (c *commentsClient) GetFilmComments(ctx context.Context, in *FilmCommentsRequest, opts ...grpc.CallOption) (*FilmCommentsResponse, error) {
+	out := new(FilmCommentsResponse)
+	err := c.cc.Invoke(ctx, Comments_GetFilmComments_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *commentsClient) GetFilmsStats(ctx context.Context, in *FilmsStatsRequest, opts ...grpc.CallOption) (*FilmsStatsResponse, error) {
+	out := new(FilmsStatsResponse)
+	err := c.cc.Invoke(ctx, Comments_GetFilmsStats_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *commentsClient) GetUserComments(ctx context.Context, in *UserCommentsRequest, opts ...grpc.CallOption) (*CommentsResponse, error) {
+	out := new(CommentsResponse)
+	err := c.cc.Invoke(ctx, Comments_GetUserComments_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *commentsClient) GetLatestComments(ctx context.Context, in *LatestCommentsRequest, opts ...grpc.CallOption) (*CommentsResponse, error) {
+	out := new(CommentsResponse)
+	err := c.cc.Invoke(ctx, Comments_GetLatestComments_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *commentsClient) DeleteUserComments(ctx context.Context, in *DeleteUserCommentsRequest, opts ...grpc.CallOption) (*DeleteUserCommentsResponse, error) {
+	out := new(DeleteUserCommentsResponse)
+	err := c.cc.Invoke(ctx, Comments_DeleteUserComments_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// CommentsServer is the server API for Comments service.
+// All implementations must embed UnimplementedCommentsServer
+// for forward compatibility
+type CommentsServer interface {
+	GetFilmComments(context.Context, *FilmCommentsRequest) (*FilmCommentsResponse, error)
+	GetFilmsStats(context.Context, *FilmsStatsRequest) (*FilmsStatsResponse, error)
+	GetUserComments(context.Context, *UserCommentsRequest) (*CommentsResponse, error)
+	GetLatestComments(context.Context, *LatestCommentsRequest) (*CommentsResponse, error)
+	DeleteUserComments(context.Context, *DeleteUserCommentsRequest) (*DeleteUserCommentsResponse, error)
+	mustEmbedUnimplementedCommentsServer()
+}
+
+// UnimplementedCommentsServer must be embedded to have forward compatible implementations.
+type UnimplementedCommentsServer struct {
+}
+
+func (UnimplementedCommentsServer) GetFilmComments(context.Context, *FilmCommentsRequest) (*FilmCommentsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetFilmComments not implemented")
+}
+func (UnimplementedCommentsServer) GetFilmsStats(context.Context, *FilmsStatsRequest) (*FilmsStatsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetFilmsStats not implemented")
+}
+func (UnimplementedCommentsServer) GetUserComments(context.Context, *UserCommentsRequest) (*CommentsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetUserComments not implemented")
+}
+func (UnimplementedCommentsServer) GetLatestComments(context.Context, *LatestCommentsRequest) (*CommentsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetLatestComments not implemented")
+}
+func (UnimplementedCommentsServer) DeleteUserComments(context.Context, *DeleteUserCommentsRequest) (*DeleteUserCommentsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteUserComments not implemented")
+}
+func (UnimplementedCommentsServer) mustEmbedUnimplementedCommentsServer() {}
+
+// UnsafeCommentsServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to CommentsServer will
+// result in compilation errors.
+type UnsafeCommentsServer interface {
+	mustEmbedUnimplementedCommentsServer()
+}
+
+func RegisterCommentsServer(s grpc.ServiceRegistrar, srv CommentsServer) {
+	s.RegisterService(&Comments_ServiceDesc, srv)
+}
+
+func _Comments_GetFilmComments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FilmCommentsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CommentsServer).GetFilmComments(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Comments_GetFilmComments_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CommentsServer).GetFilmComments(ctx, req.(*FilmCommentsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Comments_GetFilmsStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FilmsStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CommentsServer).GetFilmsStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Comments_GetFilmsStats_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CommentsServer).GetFilmsStats(ctx, req.(*FilmsStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Comments_GetUserComments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UserCommentsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CommentsServer).GetUserComments(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Comments_GetUserComments_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CommentsServer).GetUserComments(ctx, req.(*UserCommentsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Comments_GetLatestComments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LatestCommentsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CommentsServer).GetLatestComments(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Comments_GetLatestComments_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CommentsServer).GetLatestComments(ctx, req.(*LatestCommentsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Comments_DeleteUserComments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteUserCommentsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CommentsServer).DeleteUserComments(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Comments_DeleteUserComments_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CommentsServer).DeleteUserComments(ctx, req.(*DeleteUserCommentsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// Comments_ServiceDesc is the grpc.ServiceDesc for Comments service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var Comments_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "comments.Comments",
+	HandlerType: (*CommentsServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetFilmComments",
+			Handler:    _Comments_GetFilmComments_Handler,
+		},
+		{
+			MethodName: "GetFilmsStats",
+			Handler:    _Comments_GetFilmsStats_Handler,
+		},
+		{
+			MethodName: "GetUserComments",
+			Handler:    _Comments_GetUserComments_Handler,
+		},
+		{
+			MethodName: "GetLatestComments",
+			Handler:    _Comments_GetLatestComments_Handler,
+		},
+		{
+			MethodName: "DeleteUserComments",
+			Handler:    _Comments_DeleteUserComments_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "comments.proto",
+}
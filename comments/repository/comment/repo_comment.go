@@ -1,16 +1,17 @@
 package comment
 
 import (
+	"context"
 	"database/sql"
 	"errors"
 	"fmt"
 	"log/slog"
-	"time"
 
 	"github.com/go-park-mail-ru/2023_2_Vkladyshi/configs"
 	"github.com/go-park-mail-ru/2023_2_Vkladyshi/pkg/models"
 
 	_ "github.com/jackc/pgx/stdlib"
+	"github.com/lib/pq"
 )
 
 //go:generate mockgen -source=repo_comment.go -destination=../../mocks/repo_mock.go -package=mocks
@@ -19,7 +20,12 @@ type ICommentRepo interface {
 	GetFilmComments(filmId uint64, first uint64, limit uint64) ([]models.CommentItem, error)
 	AddComment(filmId uint64, userId uint64, rating uint16, text string) error
 	HasUsersComment(userId uint64, filmId uint64) (bool, error)
+	GetUserComment(userId uint64, filmId uint64) (*models.CommentItem, error)
 	DeleteComment(idUser uint64, idFilm uint64) error
+	GetFilmsStats(filmIds []uint64) ([]models.FilmStats, error)
+	GetUserComments(userId uint64, first uint64, limit uint64) ([]models.CommentItem, error)
+	GetLatestComments(limit uint64) ([]models.CommentItem, error)
+	DeleteUserComments(userId uint64) (uint64, error)
 }
 
 type RepoPostgre struct {
@@ -43,19 +49,17 @@ func GetCommentRepo(config *configs.CommentCfg, lg *slog.Logger) (*RepoPostgre,
 
 	postgreDb := RepoPostgre{db: db}
 
-	go postgreDb.pingDb(config.Timer, lg)
 	return &postgreDb, nil
 }
 
-func (repo *RepoPostgre) pingDb(timer uint32, lg *slog.Logger) {
-	for {
-		err := repo.db.Ping()
-		if err != nil {
-			lg.Error("repo comment db ping error", "err", err.Error())
-		}
+// Ping lets the health checker tell whether the database is reachable.
+func (repo *RepoPostgre) Ping(ctx context.Context) error {
+	return repo.db.PingContext(ctx)
+}
 
-		time.Sleep(time.Duration(timer) * time.Second)
-	}
+// Close closes the connection pool once the service has stopped.
+func (repo *RepoPostgre) Close() error {
+	return repo.db.Close()
 }
 
 func (repo *RepoPostgre) GetFilmComments(filmId uint64, first uint64, limit uint64) ([]models.CommentItem, error) {
@@ -109,6 +113,23 @@ func (repo *RepoPostgre) HasUsersComment(userId uint64, filmId uint64) (bool, er
 	return true, nil
 }
 
+// GetUserComment returns nil when the user has neither rated nor commented the film.
+func (repo *RepoPostgre) GetUserComment(userId uint64, filmId uint64) (*models.CommentItem, error) {
+	comment := models.CommentItem{IdUser: userId, IdFilm: filmId}
+	err := repo.db.QueryRow(
+		"SELECT rating, COALESCE(comment, '') FROM users_comment "+
+			"WHERE id_user = $1 AND id_film = $2", userId, filmId).Scan(&comment.Rating, &comment.Comment)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+
+		return nil, fmt.Errorf("get user comment err: %w", err)
+	}
+
+	return &comment, nil
+}
+
 func (repo *RepoPostgre) DeleteComment(idUser uint64, idFilm uint64) error {
 	_, err := repo.db.Exec("DELETE FROM users_comment WHERE id_user = $1 AND id_film = $2", idUser, idFilm)
 	if err != nil {
@@ -116,3 +137,92 @@ func (repo *RepoPostgre) DeleteComment(idUser uint64, idFilm uint64) error {
 	}
 	return nil
 }
+
+// GetFilmsStats skips films nobody has rated.
+func (repo *RepoPostgre) GetFilmsStats(filmIds []uint64) ([]models.FilmStats, error) {
+	stats := []models.FilmStats{}
+
+	rows, err := repo.db.Query(
+		"SELECT id_film, COUNT(rating), AVG(rating) FROM users_comment "+
+			"WHERE id_film = ANY($1::bigint[]) "+
+			"GROUP BY id_film ORDER BY id_film", pq.Array(filmIds))
+	if err != nil {
+		return nil, fmt.Errorf("get films stats err: %w", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		post := models.FilmStats{}
+		err := rows.Scan(&post.IdFilm, &post.Count, &post.Average)
+		if err != nil {
+			return nil, fmt.Errorf("get films stats scan err: %w", err)
+		}
+		stats = append(stats, post)
+	}
+
+	return stats, nil
+}
+
+func (repo *RepoPostgre) GetUserComments(userId uint64, first uint64, limit uint64) ([]models.CommentItem, error) {
+	comments := []models.CommentItem{}
+
+	rows, err := repo.db.Query(
+		"SELECT id_film, rating, comment FROM users_comment "+
+			"WHERE id_user = $1 "+
+			"ORDER BY date DESC "+
+			"OFFSET $2 LIMIT $3", userId, first, limit)
+	if err != nil {
+		return nil, fmt.Errorf("get user comments err: %w", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		post := models.CommentItem{IdUser: userId}
+		err := rows.Scan(&post.IdFilm, &post.Rating, &post.Comment)
+		if err != nil {
+			return nil, fmt.Errorf("get user comments scan err: %w", err)
+		}
+		comments = append(comments, post)
+	}
+
+	return comments, nil
+}
+
+// GetLatestComments returns the newest comments with text, bare ratings are skipped.
+func (repo *RepoPostgre) GetLatestComments(limit uint64) ([]models.CommentItem, error) {
+	comments := []models.CommentItem{}
+
+	rows, err := repo.db.Query(
+		"SELECT id_user, id_film, rating, comment FROM users_comment "+
+			"WHERE comment <> '' "+
+			"ORDER BY date DESC "+
+			"LIMIT $1", limit)
+	if err != nil {
+		return nil, fmt.Errorf("get latest comments err: %w", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		post := models.CommentItem{}
+		err := rows.Scan(&post.IdUser, &post.IdFilm, &post.Rating, &post.Comment)
+		if err != nil {
+			return nil, fmt.Errorf("get latest comments scan err: %w", err)
+		}
+		comments = append(comments, post)
+	}
+
+	return comments, nil
+}
+
+func (repo *RepoPostgre) DeleteUserComments(userId uint64) (uint64, error) {
+	result, err := repo.db.Exec("DELETE FROM users_comment WHERE id_user = $1", userId)
+	if err != nil {
+		return 0, fmt.Errorf("delete user comments err: %w", err)
+	}
+	deleted, err := result.RowsAffected()
+	if err != nil {
+		return 0, fmt.Errorf("delete user comments err: %w", err)
+	}
+
+	return uint64(deleted), nil
+}
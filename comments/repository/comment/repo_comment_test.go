@@ -195,6 +195,54 @@ func TestHasUsersComment(t *testing.T) {
 	}
 }
 
+func TestGetUserComment(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("cant create mock: %s", err)
+	}
+	defer db.Close()
+
+	sqlQuery := "SELECT rating, COALESCE(comment, '') FROM users_comment WHERE id_user = $1 AND id_film = $2"
+	mock.ExpectQuery(regexp.QuoteMeta(sqlQuery)).
+		WithArgs(1, 2).
+		WillReturnRows(sqlmock.NewRows([]string{"Rating", "Comment"}).AddRow(4, "c1"))
+
+	repo := &RepoPostgre{
+		db: db,
+	}
+
+	comment, err := repo.GetUserComment(1, 2)
+	if err != nil {
+		t.Errorf("GetUserComment error: %s", err)
+	}
+	expect := &models.CommentItem{IdUser: 1, IdFilm: 2, Rating: 4, Comment: "c1"}
+	if !reflect.DeepEqual(comment, expect) {
+		t.Errorf("results not match, want %v, have %v", expect, comment)
+	}
+
+	mock.ExpectQuery(regexp.QuoteMeta(sqlQuery)).
+		WithArgs(1, 2).
+		WillReturnError(sql.ErrNoRows)
+
+	comment, err = repo.GetUserComment(1, 2)
+	if err != nil || comment != nil {
+		t.Errorf("expected no comment, have %v, %v", comment, err)
+	}
+
+	mock.ExpectQuery(regexp.QuoteMeta(sqlQuery)).
+		WithArgs(1, 2).
+		WillReturnError(fmt.Errorf("db_error"))
+
+	_, err = repo.GetUserComment(1, 2)
+	if err == nil {
+		t.Errorf("expected error, got nil")
+	}
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("there were unfulfilled expectations: %s", err)
+	}
+}
+
 func TestDeleteComment(t *testing.T) {
 	db, mock, err := sqlmock.New()
 	if err != nil {
@@ -236,3 +284,153 @@ func TestDeleteComment(t *testing.T) {
 		return
 	}
 }
+
+func TestGetFilmsStats(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("cant create mock: %s", err)
+	}
+	defer db.Close()
+
+	sqlQuery := "SELECT id_film, COUNT(rating), AVG(rating) FROM users_comment WHERE id_film = ANY($1::bigint[]) GROUP BY id_film ORDER BY id_film"
+	mock.ExpectQuery(regexp.QuoteMeta(sqlQuery)).
+		WithArgs(sqlmock.AnyArg()).
+		WillReturnRows(sqlmock.NewRows([]string{"IdFilm", "Count", "Average"}).AddRow(1, 2, 7.5))
+
+	repo := &RepoPostgre{
+		db: db,
+	}
+
+	stats, err := repo.GetFilmsStats([]uint64{1, 2})
+	if err != nil {
+		t.Errorf("GetFilmsStats error: %s", err)
+	}
+	expect := []models.FilmStats{{IdFilm: 1, Count: 2, Average: 7.5}}
+	if !reflect.DeepEqual(stats, expect) {
+		t.Errorf("results not match, want %v, have %v", expect, stats)
+	}
+
+	mock.ExpectQuery(regexp.QuoteMeta(sqlQuery)).
+		WithArgs(sqlmock.AnyArg()).
+		WillReturnError(fmt.Errorf("db_error"))
+
+	_, err = repo.GetFilmsStats([]uint64{1, 2})
+	if err == nil {
+		t.Errorf("expected error, got nil")
+	}
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("there were unfulfilled expectations: %s", err)
+	}
+}
+
+func TestGetUserComments(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("cant create mock: %s", err)
+	}
+	defer db.Close()
+
+	sqlQuery := "SELECT id_film, rating, comment FROM users_comment WHERE id_user = $1 ORDER BY date DESC OFFSET $2 LIMIT $3"
+	mock.ExpectQuery(regexp.QuoteMeta(sqlQuery)).
+		WithArgs(1, 0, 5).
+		WillReturnRows(sqlmock.NewRows([]string{"IdFilm", "Rating", "Comment"}).AddRow(3, 4, "c1"))
+
+	repo := &RepoPostgre{
+		db: db,
+	}
+
+	comments, err := repo.GetUserComments(1, 0, 5)
+	if err != nil {
+		t.Errorf("GetUserComments error: %s", err)
+	}
+	expect := []models.CommentItem{{IdUser: 1, IdFilm: 3, Rating: 4, Comment: "c1"}}
+	if !reflect.DeepEqual(comments, expect) {
+		t.Errorf("results not match, want %v, have %v", expect, comments)
+	}
+
+	mock.ExpectQuery(regexp.QuoteMeta(sqlQuery)).
+		WithArgs(1, 0, 5).
+		WillReturnError(fmt.Errorf("db_error"))
+
+	_, err = repo.GetUserComments(1, 0, 5)
+	if err == nil {
+		t.Errorf("expected error, got nil")
+	}
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("there were unfulfilled expectations: %s", err)
+	}
+}
+
+func TestGetLatestComments(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("cant create mock: %s", err)
+	}
+	defer db.Close()
+
+	sqlQuery := "SELECT id_user, id_film, rating, comment FROM users_comment WHERE comment <> '' ORDER BY date DESC LIMIT $1"
+	mock.ExpectQuery(regexp.QuoteMeta(sqlQuery)).
+		WithArgs(5).
+		WillReturnRows(sqlmock.NewRows([]string{"IdUser", "IdFilm", "Rating", "Comment"}).AddRow(1, 3, 4, "c1"))
+
+	repo := &RepoPostgre{
+		db: db,
+	}
+
+	comments, err := repo.GetLatestComments(5)
+	if err != nil {
+		t.Errorf("GetLatestComments error: %s", err)
+	}
+	expect := []models.CommentItem{{IdUser: 1, IdFilm: 3, Rating: 4, Comment: "c1"}}
+	if !reflect.DeepEqual(comments, expect) {
+		t.Errorf("results not match, want %v, have %v", expect, comments)
+	}
+
+	mock.ExpectQuery(regexp.QuoteMeta(sqlQuery)).
+		WithArgs(5).
+		WillReturnError(fmt.Errorf("db_error"))
+
+	_, err = repo.GetLatestComments(5)
+	if err == nil {
+		t.Errorf("expected error, got nil")
+	}
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("there were unfulfilled expectations: %s", err)
+	}
+}
+
+func TestDeleteUserComments(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("cant create mock: %s", err)
+	}
+	defer db.Close()
+
+	sqlQuery := "DELETE FROM users_comment WHERE id_user = $1"
+	mock.ExpectExec(regexp.QuoteMeta(sqlQuery)).
+		WithArgs(1).WillReturnResult(sqlmock.NewResult(0, 3))
+
+	repo := &RepoPostgre{
+		db: db,
+	}
+
+	deleted, err := repo.DeleteUserComments(1)
+	if err != nil || deleted != 3 {
+		t.Errorf("unexpected result %d, %v", deleted, err)
+	}
+
+	mock.ExpectExec(regexp.QuoteMeta(sqlQuery)).
+		WithArgs(1).WillReturnError(fmt.Errorf("repo err"))
+
+	_, err = repo.DeleteUserComments(1)
+	if err == nil {
+		t.Errorf("expected error, got nil")
+	}
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("there were unfulfilled expectations: %s", err)
+	}
+}
@@ -0,0 +1,172 @@
+package comment
+
+import (
+	"cmp"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/go-park-mail-ru/2023_2_Vkladyshi/configs"
+	"github.com/go-park-mail-ru/2023_2_Vkladyshi/pkg/memory"
+	"github.com/go-park-mail-ru/2023_2_Vkladyshi/pkg/models"
+)
+
+type RepoMemory struct {
+	store *memory.Store
+}
+
+func GetCommentMemoryRepo(config *configs.CommentCfg) (*RepoMemory, error) {
+	store, err := memory.Open(config.Seed)
+	if err != nil {
+		return nil, fmt.Errorf("get comment repo: %w", err)
+	}
+
+	return &RepoMemory{store: store}, nil
+}
+
+func (repo *RepoMemory) GetFilmComments(filmId uint64, first uint64, limit uint64) ([]models.CommentItem, error) {
+	repo.store.RLock()
+	defer repo.store.RUnlock()
+
+	comments := []models.CommentItem{}
+	for _, comment := range repo.store.Comments {
+		if comment.IdFilm == filmId {
+			comments = append(comments, models.CommentItem{IdUser: comment.IdUser, Rating: comment.Rating, Comment: comment.Comment})
+		}
+	}
+
+	return memory.Paginate(comments, first, limit), nil
+}
+
+func (repo *RepoMemory) AddComment(filmId uint64, userId uint64, rating uint16, text string) error {
+	repo.store.Lock()
+	defer repo.store.Unlock()
+
+	if slices.ContainsFunc(repo.store.Comments, func(comment memory.Comment) bool {
+		return comment.IdUser == userId && comment.IdFilm == filmId
+	}) {
+		return fmt.Errorf("AddComment: user %d has already commented film %d", userId, filmId)
+	}
+	repo.store.Comments = append(repo.store.Comments, memory.Comment{
+		IdUser:  userId,
+		IdFilm:  filmId,
+		Rating:  rating,
+		Comment: text,
+		Date:    time.Now(),
+	})
+
+	return nil
+}
+
+func (repo *RepoMemory) HasUsersComment(userId uint64, filmId uint64) (bool, error) {
+	repo.store.RLock()
+	defer repo.store.RUnlock()
+
+	return slices.ContainsFunc(repo.store.Comments, func(comment memory.Comment) bool {
+		return comment.IdUser == userId && comment.IdFilm == filmId
+	}), nil
+}
+
+func (repo *RepoMemory) GetUserComment(userId uint64, filmId uint64) (*models.CommentItem, error) {
+	repo.store.RLock()
+	defer repo.store.RUnlock()
+
+	for _, comment := range repo.store.Comments {
+		if comment.IdUser == userId && comment.IdFilm == filmId {
+			return &models.CommentItem{IdUser: userId, IdFilm: filmId, Rating: comment.Rating, Comment: comment.Comment}, nil
+		}
+	}
+
+	return nil, nil
+}
+
+func (repo *RepoMemory) DeleteComment(idUser uint64, idFilm uint64) error {
+	repo.store.Lock()
+	defer repo.store.Unlock()
+
+	repo.store.Comments = slices.DeleteFunc(repo.store.Comments, func(comment memory.Comment) bool {
+		return comment.IdUser == idUser && comment.IdFilm == idFilm
+	})
+
+	return nil
+}
+
+func (repo *RepoMemory) GetFilmsStats(filmIds []uint64) ([]models.FilmStats, error) {
+	repo.store.RLock()
+	defer repo.store.RUnlock()
+
+	stats := []models.FilmStats{}
+	for _, filmId := range filmIds {
+		if slices.ContainsFunc(stats, func(item models.FilmStats) bool { return item.IdFilm == filmId }) {
+			continue
+		}
+		average, count := repo.store.Rating(filmId)
+		if count > 0 {
+			stats = append(stats, models.FilmStats{IdFilm: filmId, Count: count, Average: average})
+		}
+	}
+	slices.SortFunc(stats, func(a, b models.FilmStats) int {
+		return cmp.Compare(a.IdFilm, b.IdFilm)
+	})
+
+	return stats, nil
+}
+
+// newest returns the matching comments, the latest first.
+func (repo *RepoMemory) newest(match func(memory.Comment) bool) []models.CommentItem {
+	found := []memory.Comment{}
+	for _, comment := range repo.store.Comments {
+		if match(comment) {
+			found = append(found, comment)
+		}
+	}
+	slices.SortStableFunc(found, func(a, b memory.Comment) int {
+		return b.Date.Compare(a.Date)
+	})
+
+	comments := make([]models.CommentItem, 0, len(found))
+	for _, comment := range found {
+		comments = append(comments, models.CommentItem{
+			IdUser:  comment.IdUser,
+			IdFilm:  comment.IdFilm,
+			Rating:  comment.Rating,
+			Comment: comment.Comment,
+		})
+	}
+
+	return comments
+}
+
+func (repo *RepoMemory) GetUserComments(userId uint64, first uint64, limit uint64) ([]models.CommentItem, error) {
+	repo.store.RLock()
+	defer repo.store.RUnlock()
+
+	comments := repo.newest(func(comment memory.Comment) bool {
+		return comment.IdUser == userId
+	})
+
+	return memory.Paginate(comments, first, limit), nil
+}
+
+func (repo *RepoMemory) GetLatestComments(limit uint64) ([]models.CommentItem, error) {
+	repo.store.RLock()
+	defer repo.store.RUnlock()
+
+	comments := repo.newest(func(comment memory.Comment) bool {
+		return comment.Comment != ""
+	})
+
+	return memory.Paginate(comments, 0, limit), nil
+}
+
+func (repo *RepoMemory) DeleteUserComments(userId uint64) (uint64, error) {
+	repo.store.Lock()
+	defer repo.store.Unlock()
+
+	before := len(repo.store.Comments)
+	repo.store.Comments = slices.DeleteFunc(repo.store.Comments, func(comment memory.Comment) bool {
+		return comment.IdUser == userId
+	})
+
+	return uint64(before - len(repo.store.Comments)), nil
+}
@@ -0,0 +1,81 @@
+package comment
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/go-park-mail-ru/2023_2_Vkladyshi/pkg/memory"
+	"github.com/go-park-mail-ru/2023_2_Vkladyshi/pkg/models"
+)
+
+func TestMemoryComments(t *testing.T) {
+	repo := &RepoMemory{store: &memory.Store{}}
+
+	for _, userId := range []uint64{1, 2, 3} {
+		err := repo.AddComment(1, userId, uint16(userId+5), "c")
+		if err != nil {
+			t.Errorf("AddComment error: %s", err)
+		}
+	}
+	if err := repo.AddComment(1, 2, 5, "again"); err == nil {
+		t.Errorf("expected error for second comment")
+	}
+
+	comments, err := repo.GetFilmComments(1, 1, 5)
+	if err != nil {
+		t.Errorf("GetFilmComments error: %s", err)
+	}
+	expect := []models.CommentItem{
+		{IdUser: 2, Rating: 7, Comment: "c"},
+		{IdUser: 3, Rating: 8, Comment: "c"},
+	}
+	if !reflect.DeepEqual(comments, expect) {
+		t.Errorf("results not match, want %v, have %v", expect, comments)
+	}
+
+	if has, _ := repo.HasUsersComment(2, 1); !has {
+		t.Errorf("expected comment")
+	}
+	own, _ := repo.GetUserComment(2, 1)
+	if own == nil || own.Rating != 7 || own.IdFilm != 1 {
+		t.Errorf("unexpected own comment %v", own)
+	}
+	_ = repo.DeleteComment(2, 1)
+	if has, _ := repo.HasUsersComment(2, 1); has {
+		t.Errorf("expected comment to be deleted")
+	}
+	if own, _ := repo.GetUserComment(2, 1); own != nil {
+		t.Errorf("expected no own comment, have %v", own)
+	}
+}
+
+func TestMemoryUserComments(t *testing.T) {
+	start := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	repo := &RepoMemory{store: &memory.Store{Seed: memory.Seed{Comments: []memory.Comment{
+		{IdUser: 1, IdFilm: 1, Rating: 6, Comment: "old", Date: start},
+		{IdUser: 2, IdFilm: 1, Rating: 9, Date: start.Add(time.Hour)},
+		{IdUser: 1, IdFilm: 2, Rating: 8, Comment: "new", Date: start.Add(2 * time.Hour)},
+	}}}}
+
+	stats, _ := repo.GetFilmsStats([]uint64{2, 1, 3, 1})
+	expectStats := []models.FilmStats{{IdFilm: 1, Count: 2, Average: 7.5}, {IdFilm: 2, Count: 1, Average: 8}}
+	if !reflect.DeepEqual(stats, expectStats) {
+		t.Errorf("stats not match, want %v, have %v", expectStats, stats)
+	}
+
+	comments, _ := repo.GetUserComments(1, 0, 5)
+	if len(comments) != 2 || comments[0].Comment != "new" || comments[1].Comment != "old" {
+		t.Errorf("unexpected user comments %v", comments)
+	}
+
+	comments, _ = repo.GetLatestComments(5)
+	if len(comments) != 2 || comments[0].Comment != "new" {
+		t.Errorf("unexpected latest comments %v", comments)
+	}
+
+	deleted, _ := repo.DeleteUserComments(1)
+	if deleted != 2 || len(repo.store.Comments) != 1 {
+		t.Errorf("expected 2 deleted comments, have %d", deleted)
+	}
+}
@@ -2,62 +2,99 @@ package usecase
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"log/slog"
+	"time"
 
 	auth "github.com/go-park-mail-ru/2023_2_Vkladyshi/authorization/proto"
 	"github.com/go-park-mail-ru/2023_2_Vkladyshi/comments/repository/comment"
-	"github.com/go-park-mail-ru/2023_2_Vkladyshi/configs"
+	films "github.com/go-park-mail-ru/2023_2_Vkladyshi/films/proto"
+	"github.com/go-park-mail-ru/2023_2_Vkladyshi/pkg/grpcclient"
+	"github.com/go-park-mail-ru/2023_2_Vkladyshi/pkg/healthcheck"
+	"github.com/go-park-mail-ru/2023_2_Vkladyshi/pkg/lifecycle"
+	"github.com/go-park-mail-ru/2023_2_Vkladyshi/pkg/middleware"
 	"github.com/go-park-mail-ru/2023_2_Vkladyshi/pkg/models"
 	"google.golang.org/grpc"
-	"google.golang.org/grpc/credentials/insecure"
 )
 
+var ErrFilmNotFound = errors.New("film not found")
+
 //go:generate mockgen -source=core.go -destination=../mocks/core_mock.go -package=mocks
 
 type ICore interface {
 	GetFilmComments(filmId uint64, first uint64, limit uint64) ([]models.CommentItem, error)
 	AddComment(filmId uint64, userId uint64, rating uint16, text string) (bool, error)
-	GetUserId(ctx context.Context, sid string) (uint64, error)
+	ValidateSession(ctx context.Context, sid string) (*middleware.Principal, error)
 	DeleteComment(idUser uint64, idFilm uint64) error
+	GetUserComment(userId uint64, filmId uint64) (*models.CommentItem, error)
+	GetFilmsStats(filmIds []uint64) ([]models.FilmStats, error)
+	GetUserComments(userId uint64, first uint64, limit uint64) ([]models.CommentItem, error)
+	GetLatestComments(limit uint64) ([]models.CommentItem, error)
+	DeleteUserComments(userId uint64) (uint64, error)
 }
 
 type Core struct {
 	lg       *slog.Logger
 	comments comment.ICommentRepo
 	client   auth.AuthorizationClient
+	films    films.FilmsClient
 }
 
-func GetClient(port string) (auth.AuthorizationClient, error) {
-	conn, err := grpc.Dial(port, grpc.WithTransportCredentials(insecure.NewCredentials()))
+// GetClient connects to the authorization server and adds it to the
+// dependencies checker watches.
+func GetClient(port string, checker *healthcheck.Checker, opts ...grpc.DialOption) (auth.AuthorizationClient, error) {
+	conn, err := grpcclient.Dial(port, grpcclient.DefaultConfig("auth", auth.Authorization_ServiceDesc.ServiceName), opts...)
 	if err != nil {
 		return nil, fmt.Errorf("grpc connect err: %w", err)
 	}
+	checker.Add("grpc auth", grpcclient.HealthCheck(conn, auth.Authorization_ServiceDesc.ServiceName))
 	client := auth.NewAuthorizationClient(conn)
 
 	return client, nil
 }
 
-func GetCore(cfg_sql *configs.CommentCfg, lg *slog.Logger, comments comment.ICommentRepo) *Core {
-	client, err := GetClient(cfg_sql.GrpcPort)
+func GetFilmsClient(adress string, opts ...grpc.DialOption) (films.FilmsClient, error) {
+	conn, err := grpcclient.Dial(adress, grpcclient.DefaultConfig("films", films.Films_ServiceDesc.ServiceName), opts...)
 	if err != nil {
-		lg.Error("get client error", "err", err.Error())
-		return nil
+		return nil, fmt.Errorf("grpc connect err: %w", err)
 	}
+
+	return films.NewFilmsClient(conn), nil
+}
+
+func GetCore(client auth.AuthorizationClient, filmsClient films.FilmsClient, lg *slog.Logger, comments comment.ICommentRepo) *Core {
 	core := Core{
 		lg:       lg.With("module", "core"),
 		comments: comments,
 		client:   client,
+		films:    filmsClient,
 	}
 	return &core
 }
 
+// Close closes the database client of the repository once the servers have
+// stopped.
+func (core *Core) Close() error {
+	return lifecycle.Close(core.comments)
+}
+
 func (core *Core) GetFilmComments(filmId uint64, first uint64, limit uint64) ([]models.CommentItem, error) {
 	comments, err := core.comments.GetFilmComments(filmId, first, limit)
 	if err != nil {
 		core.lg.Error("Get Film Comments error", "err", err.Error())
 		return nil, fmt.Errorf("GetFilmComments err: %w", err)
 	}
+
+	err = core.setAuthors(comments)
+	if err != nil {
+		return nil, fmt.Errorf("get film comments grpc err: %w", err)
+	}
+	return comments, nil
+}
+
+// setAuthors fills in the names and photos of the comment authors.
+func (core *Core) setAuthors(comments []models.CommentItem) error {
 	ids := make([]int32, len(comments))
 	for i := 0; i < len(ids); i++ {
 		ids[i] = int32(comments[i].IdUser)
@@ -65,17 +102,31 @@ func (core *Core) GetFilmComments(filmId uint64, first uint64, limit uint64) ([]
 
 	namesAndPhotos, err := core.client.GetIdsAndPaths(context.Background(), &auth.NamesAndPathsListRequest{Ids: ids})
 	if err != nil {
-		core.lg.Error("get film comments grpc error", "err", err.Error())
-		return nil, fmt.Errorf("get film comments grpc err: %w", err)
+		core.lg.Error("get comment authors grpc error", "err", err.Error())
+		return err
 	}
 	for i := 0; i < len(namesAndPhotos.Names); i++ {
 		comments[i].Username = namesAndPhotos.Names[i]
 		comments[i].Photo = namesAndPhotos.Paths[i]
 	}
-	return comments, nil
+
+	return nil
 }
 
+// AddComment returns true when the user has already rated the film. Without
+// a films client the film is not checked to exist.
 func (core *Core) AddComment(filmId uint64, userId uint64, rating uint16, text string) (bool, error) {
+	if core.films != nil {
+		film, err := core.films.FilmExists(context.Background(), &films.FilmExistsRequest{FilmId: filmId})
+		if err != nil {
+			core.lg.Error("film exists grpc error", "err", err.Error())
+			return false, fmt.Errorf("check film err: %w", err)
+		}
+		if !film.Exists {
+			return false, ErrFilmNotFound
+		}
+	}
+
 	found, err := core.comments.HasUsersComment(userId, filmId)
 	if err != nil {
 		core.lg.Error("find users comment error", "err", err.Error())
@@ -94,15 +145,24 @@ func (core *Core) AddComment(filmId uint64, userId uint64, rating uint16, text s
 	return false, nil
 }
 
-func (core *Core) GetUserId(ctx context.Context, sid string) (uint64, error) {
-	request := auth.FindIdRequest{Sid: sid}
-
-	response, err := core.client.GetId(ctx, &request)
+func (core *Core) ValidateSession(ctx context.Context, sid string) (*middleware.Principal, error) {
+	response, err := core.client.ValidateSession(ctx, &auth.ValidateSessionRequest{Sid: sid})
 	if err != nil {
-		core.lg.Error("get user id error", "err", err.Error())
-		return 0, fmt.Errorf("get user id err: %w", err)
+		core.lg.Error("validate session error", "err", err.Error())
+		return nil, fmt.Errorf("validate session err: %w", err)
 	}
-	return uint64(response.Value), nil
+
+	principal := &middleware.Principal{
+		Id:    uint64(response.Id),
+		Login: response.Login,
+		Name:  response.Name,
+		Role:  response.Role,
+	}
+	if response.ExpiresAt != 0 {
+		principal.ExpiresAt = time.Unix(response.ExpiresAt, 0)
+	}
+
+	return principal, nil
 }
 
 func (core *Core) DeleteComment(idUser uint64, idFilm uint64) error {
@@ -114,3 +174,57 @@ func (core *Core) DeleteComment(idUser uint64, idFilm uint64) error {
 
 	return nil
 }
+
+func (core *Core) GetUserComment(userId uint64, filmId uint64) (*models.CommentItem, error) {
+	comment, err := core.comments.GetUserComment(userId, filmId)
+	if err != nil {
+		core.lg.Error("get user comment error", "err", err.Error())
+		return nil, fmt.Errorf("get user comment err: %w", err)
+	}
+
+	return comment, nil
+}
+
+func (core *Core) GetFilmsStats(filmIds []uint64) ([]models.FilmStats, error) {
+	stats, err := core.comments.GetFilmsStats(filmIds)
+	if err != nil {
+		core.lg.Error("get films stats error", "err", err.Error())
+		return nil, fmt.Errorf("get films stats err: %w", err)
+	}
+
+	return stats, nil
+}
+
+func (core *Core) GetUserComments(userId uint64, first uint64, limit uint64) ([]models.CommentItem, error) {
+	comments, err := core.comments.GetUserComments(userId, first, limit)
+	if err != nil {
+		core.lg.Error("get user comments error", "err", err.Error())
+		return nil, fmt.Errorf("get user comments err: %w", err)
+	}
+
+	return comments, nil
+}
+
+func (core *Core) GetLatestComments(limit uint64) ([]models.CommentItem, error) {
+	comments, err := core.comments.GetLatestComments(limit)
+	if err != nil {
+		core.lg.Error("get latest comments error", "err", err.Error())
+		return nil, fmt.Errorf("get latest comments err: %w", err)
+	}
+
+	err = core.setAuthors(comments)
+	if err != nil {
+		return nil, fmt.Errorf("get latest comments grpc err: %w", err)
+	}
+	return comments, nil
+}
+
+func (core *Core) DeleteUserComments(userId uint64) (uint64, error) {
+	deleted, err := core.comments.DeleteUserComments(userId)
+	if err != nil {
+		core.lg.Error("delete user comments error", "err", err.Error())
+		return 0, fmt.Errorf("delete user comments err: %w", err)
+	}
+
+	return deleted, nil
+}
@@ -2,13 +2,16 @@ package usecase
 
 import (
 	"bytes"
+	"context"
 	"errors"
 	"fmt"
 	"log/slog"
 	"testing"
 
 	"github.com/go-park-mail-ru/2023_2_Vkladyshi/comments/mocks"
+	films "github.com/go-park-mail-ru/2023_2_Vkladyshi/films/proto"
 	"github.com/golang/mock/gomock"
+	"google.golang.org/grpc"
 )
 
 func TestAddComment(t *testing.T) {
@@ -68,6 +71,50 @@ func TestAddComment(t *testing.T) {
 	}
 }
 
+type filmsClient struct {
+	films.FilmsClient
+	known map[uint64]bool
+	err   error
+}
+
+func (c *filmsClient) FilmExists(ctx context.Context, in *films.FilmExistsRequest, opts ...grpc.CallOption) (*films.FilmExistsResponse, error) {
+	if c.err != nil {
+		return nil, c.err
+	}
+
+	return &films.FilmExistsResponse{Exists: c.known[in.FilmId]}, nil
+}
+
+func TestAddCommentChecksFilm(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	mockObj := mocks.NewMockICommentRepo(mockCtrl)
+	mockObj.EXPECT().HasUsersComment(uint64(1), uint64(1)).Return(false, nil)
+	mockObj.EXPECT().AddComment(uint64(1), uint64(1), uint16(1), "t").Return(nil)
+
+	var buff bytes.Buffer
+	logger := slog.New(slog.NewJSONHandler(&buff, nil))
+	client := &filmsClient{known: map[uint64]bool{1: true}}
+	core := Core{comments: mockObj, films: client, lg: logger}
+
+	_, err := core.AddComment(1, 1, 1, "t")
+	if err != nil {
+		t.Errorf("waited no errors, got %s", err)
+	}
+
+	_, err = core.AddComment(2, 1, 1, "t")
+	if !errors.Is(err, ErrFilmNotFound) {
+		t.Errorf("waited film not found, got %v", err)
+	}
+
+	client.err = fmt.Errorf("grpc err")
+	_, err = core.AddComment(1, 1, 1, "t")
+	if err == nil || errors.Is(err, ErrFilmNotFound) {
+		t.Errorf("waited grpc error, got %v", err)
+	}
+}
+
 func TestDeleteComment(t *testing.T) {
 	testCases := map[string]struct {
 		err error
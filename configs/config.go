@@ -8,35 +8,80 @@ import (
 )
 
 type DbDsnCfg struct {
-	User         string `yaml:"user"`
-	DbName       string `yaml:"dbname"`
-	Password     string `yaml:"password"`
-	Host         string `yaml:"host"`
-	Port         int    `yaml:"port"`
-	Sslmode      string `yaml:"sslmode"`
-	MaxOpenConns int    `yaml:"max_open_conns"`
-	Timer        uint32 `yaml:"timer"`
-	FilmsDb      string `yaml:"films_db"`
-	GenresDb     string `yaml:"genres_db"`
-	CrewDb       string `yaml:"crew_db"`
-	ProfessionDb string `yaml:"profession_db"`
-	CalendarDb   string `yaml:"calendar_db"`
-	ServerAdress string `yaml:"server_adress"`
-	GrpcPort     string `yaml:"grpc_port"`
+	User          string          `yaml:"user"`
+	DbName        string          `yaml:"dbname"`
+	Password      string          `yaml:"password"`
+	Host          string          `yaml:"host"`
+	Port          int             `yaml:"port"`
+	Sslmode       string          `yaml:"sslmode"`
+	MaxOpenConns  int             `yaml:"max_open_conns"`
+	Timer         uint32          `yaml:"timer"`
+	FilmsDb       string          `yaml:"films_db"`
+	GenresDb      string          `yaml:"genres_db"`
+	CrewDb        string          `yaml:"crew_db"`
+	ProfessionDb  string          `yaml:"profession_db"`
+	CalendarDb    string          `yaml:"calendar_db"`
+	TranslateDb   string          `yaml:"translation_db"`
+	PlaceholderDb string          `yaml:"placeholder_db"`
+	UsersDb       string          `yaml:"users_db"`
+	Seed          string          `yaml:"seed"`
+	ServerAdress  string          `yaml:"server_adress"`
+	GrpcPort      string          `yaml:"grpc_port"`
+	CommentsGrpc  string          `yaml:"comments_grpc"`
+	GrpcAdress    string          `yaml:"grpc_adress"`
+	SessionCache  SessionCacheCfg `yaml:"session_cache"`
+	Tls           TlsCfg          `yaml:"tls"`
+	PasswordHash  PasswordHashCfg `yaml:"password_hash"`
+	GatewaySecret string          `yaml:"gateway_secret"`
 }
 
 type CommentCfg struct {
-	User         string `yaml:"user"`
-	DbName       string `yaml:"dbname"`
-	Password     string `yaml:"password"`
-	Host         string `yaml:"host"`
-	Port         int    `yaml:"port"`
-	Sslmode      string `yaml:"sslmode"`
-	MaxOpenConns int    `yaml:"max_open_conns"`
-	Timer        uint32 `yaml:"timer"`
-	CommentsDb   string `yaml:"comment_db"`
-	ServerAdress string `yaml:"server_adress"`
-	GrpcPort     string `yaml:"grpc_port"`
+	User          string          `yaml:"user"`
+	DbName        string          `yaml:"dbname"`
+	Password      string          `yaml:"password"`
+	Host          string          `yaml:"host"`
+	Port          int             `yaml:"port"`
+	Sslmode       string          `yaml:"sslmode"`
+	MaxOpenConns  int             `yaml:"max_open_conns"`
+	Timer         uint32          `yaml:"timer"`
+	CommentsDb    string          `yaml:"comment_db"`
+	Seed          string          `yaml:"seed"`
+	ServerAdress  string          `yaml:"server_adress"`
+	GrpcPort      string          `yaml:"grpc_port"`
+	GrpcAdress    string          `yaml:"grpc_adress"`
+	FilmsGrpc     string          `yaml:"films_grpc"`
+	SessionCache  SessionCacheCfg `yaml:"session_cache"`
+	Tls           TlsCfg          `yaml:"tls"`
+	GatewaySecret string          `yaml:"gateway_secret"`
+}
+
+// SessionCacheCfg sets up the cache of session checks, size 0 turns it off.
+type SessionCacheCfg struct {
+	Size int `yaml:"size"`
+	Ttl  int `yaml:"ttl"`
+}
+
+// PasswordHashCfg sets the Argon2id cost of new password hashes, memory is
+// in KiB. Zero values take the defaults, hashes of other costs are replaced
+// on the next login.
+type PasswordHashCfg struct {
+	Memory      uint32 `yaml:"memory"`
+	Iterations  uint32 `yaml:"iterations"`
+	Parallelism uint8  `yaml:"parallelism"`
+}
+
+// TlsCfg turns on mutual TLS between the services when Cert is set. The
+// certificate common name is the identity of the service, and the
+// certificates of servers also carry it as a DNS name. The files are read
+// again when they change.
+type TlsCfg struct {
+	Cert string `yaml:"cert"`
+	Key  string `yaml:"key"`
+	Ca   string `yaml:"ca"`
+	// Allow maps a full method name like "auth.Authorization/GetId" or a
+	// service name to the identities that may call it, "*" allows every
+	// service. Calls missing from Allow are denied.
+	Allow map[string][]string `yaml:"allow"`
 }
 
 type DbRedisCfg struct {
@@ -44,11 +89,82 @@ type DbRedisCfg struct {
 	Password string `yaml:"password"`
 	DbNumber int    `yaml:"db"`
 	Timer    int    `yaml:"timer"`
+	Backend  string `yaml:"backend"`
+}
+
+type StorageCfg struct {
+	Backend string        `yaml:"backend"`
+	Local   LocalStoreCfg `yaml:"local"`
+	S3      S3StoreCfg    `yaml:"s3"`
+}
+
+type LocalStoreCfg struct {
+	Root    string   `yaml:"root"`
+	BaseURL string   `yaml:"base_url"`
+	Secret  string   `yaml:"secret"`
+	Private []string `yaml:"private"`
+}
+
+type S3StoreCfg struct {
+	Endpoint  string `yaml:"endpoint"`
+	Region    string `yaml:"region"`
+	Bucket    string `yaml:"bucket"`
+	AccessKey string `yaml:"access_key"`
+	SecretKey string `yaml:"secret_key"`
+	UseSSL    bool   `yaml:"use_ssl"`
+	PublicURL string `yaml:"public_url"`
+}
+
+type GatewayCfg struct {
+	ServerAdress string        `yaml:"server_adress"`
+	GrpcPort     string        `yaml:"grpc_port"`
+	Cors         CorsCfg       `yaml:"cors"`
+	Routes       []RouteCfg    `yaml:"routes"`
+	Metrics      []UpstreamCfg `yaml:"metrics"`
+	Tls          TlsCfg        `yaml:"tls"`
+	Secret       string        `yaml:"secret"`
+}
+
+type CorsCfg struct {
+	AllowedOrigins   []string `yaml:"allowed_origins"`
+	AllowedMethods   []string `yaml:"allowed_methods"`
+	AllowedHeaders   []string `yaml:"allowed_headers"`
+	AllowCredentials bool     `yaml:"allow_credentials"`
+	MaxAge           int      `yaml:"max_age"`
+}
+
+// RouteCfg sends every request whose path starts with Prefix to Upstream.
+type RouteCfg struct {
+	Prefix   string `yaml:"prefix"`
+	Upstream string `yaml:"upstream"`
+}
+
+type UpstreamCfg struct {
+	Service string `yaml:"service"`
+	Url     string `yaml:"url"`
+}
+
+// UsesPostgres reports whether any repository is configured to use Postgres,
+// so the schema has to be checked on startup.
+func (config *DbDsnCfg) UsesPostgres() bool {
+	for _, db := range []string{config.FilmsDb, config.GenresDb, config.CrewDb, config.ProfessionDb,
+		config.CalendarDb, config.TranslateDb, config.PlaceholderDb, config.UsersDb} {
+		if db == "postgres" {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (config *CommentCfg) UsesPostgres() bool {
+	return config.CommentsDb == "postgres"
 }
 
 type GrpcConfig struct {
 	Port           string `yaml:"port"`
 	ConnectionType string `yaml:"connection_type"`
+	Tls            TlsCfg `yaml:"tls"`
 }
 
 func ReadGrpcConfig() (*GrpcConfig, error) {
@@ -170,4 +286,34 @@ func ReadNearFilmRedisConfig() (*DbRedisCfg, error) {
 	}
 
 	return &nearConfig, nil
-}
\ No newline at end of file
+}
+
+func ReadStorageConfig() (*StorageCfg, error) {
+	storageConfig := StorageCfg{}
+	storageFile, err := os.ReadFile("../../configs/storage.yaml")
+	if err != nil {
+		return nil, err
+	}
+
+	err = yaml.Unmarshal(storageFile, &storageConfig)
+	if err != nil {
+		return nil, err
+	}
+
+	return &storageConfig, nil
+}
+
+func ReadGatewayConfig() (*GatewayCfg, error) {
+	gatewayConfig := GatewayCfg{}
+	gatewayFile, err := os.ReadFile("../../configs/gateway.yaml")
+	if err != nil {
+		return nil, err
+	}
+
+	err = yaml.Unmarshal(gatewayFile, &gatewayConfig)
+	if err != nil {
+		return nil, err
+	}
+
+	return &gatewayConfig, nil
+}
@@ -0,0 +1,126 @@
+package app
+
+import (
+	"fmt"
+	"log/slog"
+
+	auth "github.com/go-park-mail-ru/2023_2_Vkladyshi/authorization/proto"
+	comments "github.com/go-park-mail-ru/2023_2_Vkladyshi/comments/proto"
+	"github.com/go-park-mail-ru/2023_2_Vkladyshi/configs"
+	"github.com/go-park-mail-ru/2023_2_Vkladyshi/films/repository/calendar"
+	"github.com/go-park-mail-ru/2023_2_Vkladyshi/films/repository/crew"
+	"github.com/go-park-mail-ru/2023_2_Vkladyshi/films/repository/film"
+	"github.com/go-park-mail-ru/2023_2_Vkladyshi/films/repository/genre"
+	"github.com/go-park-mail-ru/2023_2_Vkladyshi/films/repository/placeholder"
+	"github.com/go-park-mail-ru/2023_2_Vkladyshi/films/repository/profession"
+	"github.com/go-park-mail-ru/2023_2_Vkladyshi/films/repository/translation"
+	"github.com/go-park-mail-ru/2023_2_Vkladyshi/films/usecase"
+	"github.com/go-park-mail-ru/2023_2_Vkladyshi/pkg/healthcheck"
+	"github.com/go-park-mail-ru/2023_2_Vkladyshi/pkg/storage"
+)
+
+// GetCore creates the repositories chosen in the configs and the core of the
+// films service on top of them. The databases among them are added to checker.
+func GetCore(config *configs.DbDsnCfg, redisConfig *configs.DbRedisCfg, client auth.AuthorizationClient,
+	commentsClient comments.CommentsClient, store storage.Storage, lg *slog.Logger, checker *healthcheck.Checker) (*usecase.Core, error) {
+	var (
+		err         error
+		films       film.IFilmsRepo
+		genres      genre.IGenreRepo
+		actors      crew.ICrewRepo
+		professions profession.IProfessionRepo
+		news        calendar.ICalendarRepo
+		translated  translation.ITranslationRepo
+		previews    placeholder.IPlaceholderRepo
+	)
+	switch config.FilmsDb {
+	case "postgres":
+		films, err = film.GetFilmRepo(config, lg)
+	case "memory":
+		films, err = film.GetFilmMemoryRepo(config)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("create films repo err: %w", err)
+	}
+
+	switch config.GenresDb {
+	case "postgres":
+		genres, err = genre.GetGenreRepo(config, lg)
+	case "memory":
+		genres, err = genre.GetGenreMemoryRepo(config)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("create genre repo err: %w", err)
+	}
+
+	switch config.CrewDb {
+	case "postgres":
+		actors, err = crew.GetCrewRepo(config, lg)
+	case "memory":
+		actors, err = crew.GetCrewMemoryRepo(config)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("create crew repo err: %w", err)
+	}
+
+	switch config.ProfessionDb {
+	case "postgres":
+		professions, err = profession.GetProfessionRepo(config, lg)
+	case "memory":
+		professions, err = profession.GetProfessionMemoryRepo(config)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("create profession repo err: %w", err)
+	}
+
+	switch config.CalendarDb {
+	case "postgres":
+		news, err = calendar.GetCalendarRepo(config, lg)
+	case "memory":
+		news, err = calendar.GetCalendarMemoryRepo(config)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("create calendar repo err: %w", err)
+	}
+
+	switch config.TranslateDb {
+	case "postgres":
+		translated, err = translation.GetTranslationRepo(config, lg)
+	case "memory":
+		translated, err = translation.GetTranslationMemoryRepo(config)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("create translation repo err: %w", err)
+	}
+
+	switch config.PlaceholderDb {
+	case "postgres":
+		previews, err = placeholder.GetPlaceholderRepo(config, lg)
+	case "memory":
+		previews, err = placeholder.GetPlaceholderMemoryRepo(config)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("create placeholder repo err: %w", err)
+	}
+	var nearFilms film.INearFilmsRepo
+	switch redisConfig.Backend {
+	case "redis":
+		nearFilms, err = film.GetFilmRedisRepo(*redisConfig, lg)
+	case "memory":
+		nearFilms = film.GetNearFilmsMemoryRepo(*redisConfig)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("create near films repo err: %w", err)
+	}
+
+	checker.AddPinger("postgres films", films)
+	checker.AddPinger("postgres genres", genres)
+	checker.AddPinger("postgres crew", actors)
+	checker.AddPinger("postgres professions", professions)
+	checker.AddPinger("postgres calendar", news)
+	checker.AddPinger("postgres translations", translated)
+	checker.AddPinger("postgres placeholders", previews)
+	checker.AddPinger("redis near films", nearFilms)
+
+	return usecase.GetCore(client, commentsClient, lg, films, genres, actors, professions, news, translated, previews, nearFilms, store), nil
+}
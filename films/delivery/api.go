@@ -2,68 +2,101 @@ package delivery
 
 import (
 	"errors"
-	"fmt"
 	"io"
 	"log/slog"
+	"math"
 	"net/http"
-	"os"
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/go-park-mail-ru/2023_2_Vkladyshi/configs"
 	"github.com/go-park-mail-ru/2023_2_Vkladyshi/films/usecase"
+	"github.com/go-park-mail-ru/2023_2_Vkladyshi/pkg/healthcheck"
+	"github.com/go-park-mail-ru/2023_2_Vkladyshi/pkg/images"
+	"github.com/go-park-mail-ru/2023_2_Vkladyshi/pkg/locale"
 	"github.com/go-park-mail-ru/2023_2_Vkladyshi/pkg/middleware"
 	"github.com/go-park-mail-ru/2023_2_Vkladyshi/pkg/models"
 	"github.com/go-park-mail-ru/2023_2_Vkladyshi/pkg/requests"
+	"github.com/go-park-mail-ru/2023_2_Vkladyshi/pkg/storage"
 	"github.com/mailru/easyjson"
 	"github.com/prometheus/client_golang/prometheus/promhttp"
 )
 
+// maxFilmographyPage bounds per_page of the filmography of an actor.
+const maxFilmographyPage = 100
+
+// maxCollaborators bounds limit of the collaborators of an actor.
+const maxCollaborators = 100
+
+// adminRoles may change the catalogue.
+var adminRoles = map[string]bool{"admin": true, "super": true}
+
 type API struct {
 	core   usecase.ICore
+	auth   middleware.Core
 	lg     *slog.Logger
 	mx     *http.ServeMux
 	ct     *requests.Collector
 	adress string
+	// gateway is the secret of requests forwarded by the gateway.
+	gateway string
 }
 
-func GetApi(c *usecase.Core, l *slog.Logger, cfg *configs.DbDsnCfg) *API {
+func GetApi(c *usecase.Core, l *slog.Logger, cfg *configs.DbDsnCfg, store storage.Storage, sessions *middleware.SessionCache,
+	checker *healthcheck.Checker) *API {
 	api := &API{
-		core:   c,
-		lg:     l.With("module", "api"),
-		mx:     http.NewServeMux(),
-		ct:     requests.GetCollector(),
-		adress: cfg.ServerAdress,
+		core:    c,
+		auth:    middleware.CacheSessions(c, sessions),
+		lg:      l.With("module", "api"),
+		mx:      http.NewServeMux(),
+		ct:      requests.GetCollector(),
+		adress:  cfg.ServerAdress,
+		gateway: cfg.GatewaySecret,
 	}
 
 	api.mx.Handle("/metrics", promhttp.Handler())
-	api.mx.HandleFunc("/api/v1/films", api.Films)
-	api.mx.Handle("/api/v1/film", middleware.AuthCheck(http.HandlerFunc(api.Film), c, l))
-	api.mx.HandleFunc("/api/v1/actor", api.Actor)
-	api.mx.Handle("/api/v1/favorite/films", middleware.AuthCheck(http.HandlerFunc(api.FavoriteFilms), c, l))
-	api.mx.Handle("/api/v1/favorite/film/add", middleware.AuthCheck(http.HandlerFunc(api.FavoriteFilmsAdd), c, l))
-	api.mx.Handle("/api/v1/favorite/film/remove", middleware.AuthCheck(http.HandlerFunc(api.FavoriteFilmsRemove), c, l))
-	api.mx.Handle("/api/v1/favorite/actors", middleware.AuthCheck(http.HandlerFunc(api.FavoriteActors), c, l))
-	api.mx.Handle("/api/v1/favorite/actor/add", middleware.AuthCheck(http.HandlerFunc(api.FavoriteActorsAdd), c, l))
-	api.mx.Handle("/api/v1/favorite/actor/remove", middleware.AuthCheck(http.HandlerFunc(api.FavoriteActorsRemove), c, l))
-	api.mx.HandleFunc("/api/v1/find", api.FindFilm)
-	api.mx.HandleFunc("/api/v1/search/actor", api.FindActor)
-	api.mx.HandleFunc("/api/v1/calendar", api.Calendar)
-	api.mx.Handle("/api/v1/rating/add", middleware.AuthCheck(http.HandlerFunc(api.AddRating), c, l))
-	api.mx.HandleFunc("/api/v1/add/film", api.AddFilm)
-	api.mx.Handle("/api/v1/rating/delete", middleware.AuthCheck(http.HandlerFunc(api.DeleteRating), c, l))
-	api.mx.Handle("/api/v1/statistics", middleware.AuthCheck(http.HandlerFunc(api.UsersStatistics), c, l))
-	api.mx.HandleFunc("/api/v1/trends", api.Trends)
-	api.mx.Handle("/api/v1/lasts", middleware.AuthCheck(http.HandlerFunc(api.LastSeen), c, l))
+	checker.Register(api.mx)
+	api.Register(api.mx)
+
+	if local, ok := store.(*storage.Local); ok && local.BaseURL() != "" {
+		api.mx.Handle(local.BaseURL()+"/", local)
+	}
 
 	return api
 }
 
-func (a *API) ListenAndServe() {
-	err := http.ListenAndServe(a.adress, a.mx)
-	if err != nil {
-		a.lg.Error("listen and serve error", "err", err.Error())
-	}
+// Register adds the film routes to mx, so the services can share one listener.
+func (a *API) Register(mx *http.ServeMux) {
+	mx.HandleFunc("/api/v1/films", a.Films)
+	mx.Handle("/api/v1/film", middleware.AuthCheck(http.HandlerFunc(a.Film), a.auth, a.lg))
+	mx.Handle("/api/v1/film/page", middleware.AuthCheck(http.HandlerFunc(a.FilmPage), a.auth, a.lg))
+	mx.HandleFunc("/api/v1/actor", a.Actor)
+	mx.HandleFunc("/api/v1/actors/path", a.ActorsPath)
+	mx.HandleFunc("/api/v1/actor/collaborators", a.Collaborators)
+	mx.Handle("/api/v1/favorite/films", middleware.AuthCheck(http.HandlerFunc(a.FavoriteFilms), a.auth, a.lg))
+	mx.Handle("/api/v1/favorite/film/add", middleware.AuthCheck(http.HandlerFunc(a.FavoriteFilmsAdd), a.auth, a.lg))
+	mx.Handle("/api/v1/favorite/film/remove", middleware.AuthCheck(http.HandlerFunc(a.FavoriteFilmsRemove), a.auth, a.lg))
+	mx.Handle("/api/v1/favorite/actors", middleware.AuthCheck(http.HandlerFunc(a.FavoriteActors), a.auth, a.lg))
+	mx.Handle("/api/v1/favorite/actor/add", middleware.AuthCheck(http.HandlerFunc(a.FavoriteActorsAdd), a.auth, a.lg))
+	mx.Handle("/api/v1/favorite/actor/remove", middleware.AuthCheck(http.HandlerFunc(a.FavoriteActorsRemove), a.auth, a.lg))
+	mx.HandleFunc("/api/v1/find", a.FindFilm)
+	mx.HandleFunc("/api/v1/search/actor", a.FindActor)
+	mx.HandleFunc("/api/v1/calendar", a.Calendar)
+	mx.Handle("/api/v1/rating/add", middleware.AuthCheck(http.HandlerFunc(a.AddRating), a.auth, a.lg))
+	mx.Handle("/api/v1/add/film", middleware.AuthCheck(http.HandlerFunc(a.AddFilm), a.auth, a.lg))
+	mx.Handle("/api/v1/film/edit", middleware.AuthCheck(http.HandlerFunc(a.EditFilm), a.auth, a.lg))
+	mx.Handle("/api/v1/film/poster", middleware.AuthCheck(http.HandlerFunc(a.ReplacePoster), a.auth, a.lg))
+	mx.Handle("/api/v1/translation/add", middleware.AuthCheck(http.HandlerFunc(a.AddTranslation), a.auth, a.lg))
+	mx.Handle("/api/v1/rating/delete", middleware.AuthCheck(http.HandlerFunc(a.DeleteRating), a.auth, a.lg))
+	mx.Handle("/api/v1/statistics", middleware.AuthCheck(http.HandlerFunc(a.UsersStatistics), a.auth, a.lg))
+	mx.HandleFunc("/api/v1/trends", a.Trends)
+	mx.Handle("/api/v1/lasts", middleware.AuthCheck(http.HandlerFunc(a.LastSeen), a.auth, a.lg))
+}
+
+// Server serves the routes of the API, the caller starts and stops it.
+func (a *API) Server() *http.Server {
+	return &http.Server{Addr: a.adress, Handler: middleware.TrustGateway(a.mx, a.gateway)}
 }
 
 func (a *API) Films(w http.ResponseWriter, r *http.Request) {
@@ -92,7 +125,7 @@ func (a *API) Films(w http.ResponseWriter, r *http.Request) {
 
 	var films []models.FilmItem
 
-	films, genre, err := a.core.GetFilmsAndGenreTitle(genreId, uint64((page-1)*pageSize), pageSize)
+	films, genre, err := a.core.GetFilmsAndGenreTitle(locale.FromRequest(r), genreId, uint64((page-1)*pageSize), pageSize)
 	if err != nil {
 		a.lg.Error("get films error", "err", err.Error())
 		response.Status = http.StatusInternalServerError
@@ -128,7 +161,7 @@ func (a *API) Film(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	film, err := a.core.GetFilmInfo(filmId)
+	film, err := a.core.GetFilmInfo(locale.FromRequest(r), filmId)
 	if err != nil {
 		if errors.Is(err, usecase.ErrNotFound) {
 			response.Status = http.StatusNotFound
@@ -145,8 +178,12 @@ func (a *API) Film(w http.ResponseWriter, r *http.Request) {
 
 	a.ct.SendResponse(w, r, response, a.lg, start)
 
-	userId, isAuth := r.Context().Value(middleware.UserIDKey).(uint64)
+	a.addNearFilm(r, filmId)
+}
 
+// addNearFilm remembers that a signed in user has opened the film.
+func (a *API) addNearFilm(r *http.Request, filmId uint64) {
+	user, isAuth := middleware.PrincipalFrom(r.Context())
 	if !isAuth {
 		a.lg.Error("User StatusUnauthorized", "err", !isAuth)
 		return
@@ -154,7 +191,7 @@ func (a *API) Film(w http.ResponseWriter, r *http.Request) {
 
 	nearFilm := models.NearFilm{
 		IdFilm: filmId,
-		IdUser: userId,
+		IdUser: user.Id,
 	}
 
 	addedNearFilm, err := a.core.AddNearFilm(r.Context(), nearFilm, a.lg)
@@ -164,11 +201,55 @@ func (a *API) Film(w http.ResponseWriter, r *http.Request) {
 	}
 
 	if !addedNearFilm {
-		a.lg.Error("Failed to add near film", "error", err.Error())
+		a.lg.Error("Failed to add near film")
 		return
 	}
 }
 
+// FilmPage returns everything the film page shows in one response. Parts
+// other than the film itself that could not be loaded are listed in
+// "unavailable" instead of failing the request.
+func (a *API) FilmPage(w http.ResponseWriter, r *http.Request) {
+	response := requests.Response{Status: http.StatusOK, Body: nil}
+	start := time.Now()
+	if r.Method != http.MethodGet {
+		response.Status = http.StatusMethodNotAllowed
+		a.ct.SendResponse(w, r, response, a.lg, start)
+		return
+	}
+
+	filmId, err := strconv.ParseUint(r.URL.Query().Get("film_id"), 10, 64)
+	if err != nil {
+		response.Status = http.StatusBadRequest
+		a.ct.SendResponse(w, r, response, a.lg, start)
+		return
+	}
+
+	var userId uint64
+	if user, isAuth := middleware.PrincipalFrom(r.Context()); isAuth {
+		userId = user.Id
+	}
+
+	page, err := a.core.GetFilmPage(r.Context(), locale.FromRequest(r), filmId, userId)
+	if err != nil {
+		if errors.Is(err, usecase.ErrNotFound) {
+			response.Status = http.StatusNotFound
+			a.ct.SendResponse(w, r, response, a.lg, start)
+			return
+		}
+		a.lg.Error("film page error", "err", err.Error())
+		response.Status = http.StatusInternalServerError
+		a.ct.SendResponse(w, r, response, a.lg, start)
+		return
+	}
+
+	response.Body = page
+
+	a.ct.SendResponse(w, r, response, a.lg, start)
+
+	a.addNearFilm(r, filmId)
+}
+
 func (a *API) Actor(w http.ResponseWriter, r *http.Request) {
 	response := requests.Response{Status: http.StatusOK, Body: nil}
 	start := time.Now()
@@ -186,13 +267,35 @@ func (a *API) Actor(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	actor, err := a.core.GetActorInfo(actorId)
+	page, err := strconv.ParseUint(r.URL.Query().Get("page"), 10, 64)
+	if err != nil || page == 0 {
+		page = 1
+	}
+	pageSize, err := strconv.ParseUint(r.URL.Query().Get("per_page"), 10, 64)
+	if err != nil || pageSize == 0 {
+		pageSize = 20
+	}
+	if pageSize > maxFilmographyPage {
+		pageSize = maxFilmographyPage
+	}
+	if page-1 > math.MaxInt64/pageSize {
+		response.Status = http.StatusBadRequest
+		a.ct.SendResponse(w, r, response, a.lg, start)
+		return
+	}
+
+	actor, err := a.core.GetActorInfo(locale.FromRequest(r), actorId, r.URL.Query().Get("sort"), (page-1)*pageSize, pageSize)
 	if err != nil {
 		if errors.Is(err, usecase.ErrNotFound) {
 			response.Status = http.StatusNotFound
 			a.ct.SendResponse(w, r, response, a.lg, start)
 			return
 		}
+		if errors.Is(err, usecase.ErrBadSort) {
+			response.Status = http.StatusBadRequest
+			a.ct.SendResponse(w, r, response, a.lg, start)
+			return
+		}
 		a.lg.Error("actor error", "err", err.Error())
 		response.Status = http.StatusInternalServerError
 
@@ -204,6 +307,87 @@ func (a *API) Actor(w http.ResponseWriter, r *http.Request) {
 	a.ct.SendResponse(w, r, response, a.lg, start)
 }
 
+func (a *API) ActorsPath(w http.ResponseWriter, r *http.Request) {
+	response := requests.Response{Status: http.StatusOK, Body: nil}
+	start := time.Now()
+	if r.Method != http.MethodGet {
+		response.Status = http.StatusMethodNotAllowed
+		a.ct.SendResponse(w, r, response, a.lg, start)
+		return
+	}
+
+	from, err := strconv.ParseUint(r.URL.Query().Get("from"), 10, 64)
+	if err != nil {
+		response.Status = http.StatusBadRequest
+		a.ct.SendResponse(w, r, response, a.lg, start)
+		return
+	}
+	to, err := strconv.ParseUint(r.URL.Query().Get("to"), 10, 64)
+	if err != nil {
+		response.Status = http.StatusBadRequest
+		a.ct.SendResponse(w, r, response, a.lg, start)
+		return
+	}
+
+	path, err := a.core.ActorsPath(from, to)
+	if err != nil {
+		if errors.Is(err, usecase.ErrNotFound) {
+			response.Status = http.StatusNotFound
+			a.ct.SendResponse(w, r, response, a.lg, start)
+			return
+		}
+		a.lg.Error("actors path error", "err", err.Error())
+		response.Status = http.StatusInternalServerError
+		a.ct.SendResponse(w, r, response, a.lg, start)
+		return
+	}
+	response.Body = path
+
+	a.ct.SendResponse(w, r, response, a.lg, start)
+}
+
+func (a *API) Collaborators(w http.ResponseWriter, r *http.Request) {
+	response := requests.Response{Status: http.StatusOK, Body: nil}
+	start := time.Now()
+	if r.Method != http.MethodGet {
+		response.Status = http.StatusMethodNotAllowed
+		a.ct.SendResponse(w, r, response, a.lg, start)
+		return
+	}
+
+	actorId, err := strconv.ParseUint(r.URL.Query().Get("actor_id"), 10, 64)
+	if err != nil {
+		response.Status = http.StatusBadRequest
+		a.ct.SendResponse(w, r, response, a.lg, start)
+		return
+	}
+	limit, err := strconv.ParseUint(r.URL.Query().Get("limit"), 10, 64)
+	if err != nil {
+		limit = 10
+	}
+	if limit > maxCollaborators {
+		response.Status = http.StatusBadRequest
+		a.ct.SendResponse(w, r, response, a.lg, start)
+		return
+	}
+
+	collaborators, err := a.core.Collaborators(actorId, limit)
+	if err != nil {
+		if errors.Is(err, usecase.ErrNotFound) {
+			response.Status = http.StatusNotFound
+			a.ct.SendResponse(w, r, response, a.lg, start)
+			return
+		}
+		a.lg.Error("collaborators error", "err", err.Error())
+		response.Status = http.StatusInternalServerError
+		a.ct.SendResponse(w, r, response, a.lg, start)
+		return
+	}
+	response.Body = requests.CollaboratorsResponse{Collaborators: collaborators}
+
+	a.ct.SendResponse(w, r, response, a.lg, start)
+}
+
 func (a *API) FindFilm(w http.ResponseWriter, r *http.Request) {
 	response := requests.Response{Status: http.StatusOK, Body: nil}
 	start := time.Now()
@@ -229,8 +413,9 @@ func (a *API) FindFilm(w http.ResponseWriter, r *http.Request) {
 		a.ct.SendResponse(w, r, response, a.lg, start)
 		return
 	}
-	films, err := a.core.FindFilm(request.Title, request.DateFrom, request.DateTo, request.RatingFrom, request.RatingTo,
-		request.Mpaa, request.Genres, request.Actors, (request.Page-1)*request.PerPage, request.PerPage)
+	films, err := a.core.FindFilm(locale.FromRequest(r), request.Title, request.DateFrom, request.DateTo, request.RatingFrom, request.RatingTo,
+		request.Mpaa, request.Genres, request.Actors, request.RuntimeFrom, request.RuntimeTo, request.Language,
+		(request.Page-1)*request.PerPage, request.PerPage)
 	if err != nil {
 		if errors.Is(err, usecase.ErrNotFound) {
 			response.Status = http.StatusNotFound
@@ -262,7 +447,13 @@ func (a *API) FavoriteFilmsAdd(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	userId := r.Context().Value(middleware.UserIDKey).(uint64)
+	user, isAuth := middleware.PrincipalFrom(r.Context())
+	if !isAuth {
+		response.Status = http.StatusUnauthorized
+		a.ct.SendResponse(w, r, response, a.lg, start)
+		return
+	}
+	userId := user.Id
 
 	filmId, err := strconv.ParseUint(r.URL.Query().Get("film_id"), 10, 64)
 	if err != nil {
@@ -297,7 +488,13 @@ func (a *API) FavoriteFilmsRemove(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	userId := r.Context().Value(middleware.UserIDKey).(uint64)
+	user, isAuth := middleware.PrincipalFrom(r.Context())
+	if !isAuth {
+		response.Status = http.StatusUnauthorized
+		a.ct.SendResponse(w, r, response, a.lg, start)
+		return
+	}
+	userId := user.Id
 
 	filmId, err := strconv.ParseUint(r.URL.Query().Get("film_id"), 10, 64)
 	if err != nil {
@@ -326,7 +523,13 @@ func (a *API) FavoriteFilms(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	userId := r.Context().Value(middleware.UserIDKey).(uint64)
+	user, isAuth := middleware.PrincipalFrom(r.Context())
+	if !isAuth {
+		response.Status = http.StatusUnauthorized
+		a.ct.SendResponse(w, r, response, a.lg, start)
+		return
+	}
+	userId := user.Id
 
 	page, err := strconv.ParseUint(r.URL.Query().Get("page"), 10, 64)
 	if err != nil {
@@ -338,7 +541,7 @@ func (a *API) FavoriteFilms(w http.ResponseWriter, r *http.Request) {
 		pageSize = 8
 	}
 
-	films, err := a.core.FavoriteFilms(userId, uint64((page-1)*pageSize), pageSize)
+	films, err := a.core.FavoriteFilms(locale.FromRequest(r), userId, uint64((page-1)*pageSize), pageSize)
 	if err != nil {
 		a.lg.Error("favorite films error", "err", err.Error())
 		response.Status = http.StatusInternalServerError
@@ -359,7 +562,7 @@ func (a *API) Calendar(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	calendar, err := a.core.GetCalendar()
+	calendar, err := a.core.GetCalendar(locale.FromRequest(r))
 	if err != nil {
 		a.lg.Error("calendar error", "err", err.Error())
 		response.Status = http.StatusInternalServerError
@@ -429,7 +632,13 @@ func (a *API) AddRating(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	userId := r.Context().Value(middleware.UserIDKey).(uint64)
+	user, isAuth := middleware.PrincipalFrom(r.Context())
+	if !isAuth {
+		response.Status = http.StatusUnauthorized
+		a.ct.SendResponse(w, r, response, a.lg, start)
+		return
+	}
+	userId := user.Id
 
 	var commentRequest requests.CommentRequest
 
@@ -468,11 +677,15 @@ func (a *API) AddFilm(w http.ResponseWriter, r *http.Request) {
 		a.ct.SendResponse(w, r, response, a.lg, start)
 		return
 	}
+	if !a.allowAdmin(w, r, start) {
+		return
+	}
 
-	err := r.ParseMultipartForm(10 << 20)
+	r.Body = http.MaxBytesReader(w, r.Body, images.MaxUploadSize)
+	err := r.ParseMultipartForm(images.MaxUploadSize)
 	if err != nil {
 		a.lg.Error("add film error", "err", err.Error())
-		response.Status = http.StatusBadRequest
+		response.Status = images.FormStatus(err)
 		a.ct.SendResponse(w, r, response, a.lg, start)
 		return
 	}
@@ -481,6 +694,42 @@ func (a *API) AddFilm(w http.ResponseWriter, r *http.Request) {
 	info := r.FormValue("info")
 	date := r.FormValue("date")
 	country := r.FormValue("country")
+	originalTitle := r.FormValue("original_title")
+	imdbId := r.FormValue("imdb_id")
+	kinopoiskId := r.FormValue("kinopoisk_id")
+	languages := splitList(r.FormValue("languages"))
+	trailers := splitList(r.FormValue("trailers"))
+
+	var runtime uint64
+	if value := r.FormValue("runtime"); value != "" {
+		runtime, err = strconv.ParseUint(value, 10, 32)
+		if err != nil {
+			a.lg.Error("add film error", "err", err.Error())
+			response.Status = http.StatusBadRequest
+			a.ct.SendResponse(w, r, response, a.lg, start)
+			return
+		}
+	}
+	var budget uint64
+	if value := r.FormValue("budget"); value != "" {
+		budget, err = strconv.ParseUint(value, 10, 64)
+		if err != nil {
+			a.lg.Error("add film error", "err", err.Error())
+			response.Status = http.StatusBadRequest
+			a.ct.SendResponse(w, r, response, a.lg, start)
+			return
+		}
+	}
+	var boxOffice uint64
+	if value := r.FormValue("box_office"); value != "" {
+		boxOffice, err = strconv.ParseUint(value, 10, 64)
+		if err != nil {
+			a.lg.Error("add film error", "err", err.Error())
+			response.Status = http.StatusBadRequest
+			a.ct.SendResponse(w, r, response, a.lg, start)
+			return
+		}
+	}
 
 	genresString := r.FormValue("genre")
 	var genres []uint64
@@ -532,51 +781,245 @@ func (a *API) AddFilm(w http.ResponseWriter, r *http.Request) {
 	}
 	actors = append(actors, actorUint)
 
-	fmt.Println(actors, genres)
-	poster, handler, err := r.FormFile("photo")
+	var filename string
+	var placeholder *models.Placeholder
+	poster, _, err := r.FormFile("photo")
 	if err != nil && !errors.Is(err, http.ErrMissingFile) {
 		a.lg.Error("add film error", "err", err.Error())
 		response.Status = http.StatusInternalServerError
 		a.ct.SendResponse(w, r, response, a.lg, start)
 		return
 	}
+	if poster != nil {
+		defer poster.Close()
+
+		img, err := images.Process(poster)
+		if err != nil {
+			a.lg.Error("add film error", "err", err.Error())
+			response.Status = images.StatusCode(err)
+			a.ct.SendResponse(w, r, response, a.lg, start)
+			return
+		}
+		filename, err = a.core.SavePoster(r.Context(), img)
+		if err != nil {
+			a.lg.Error("add film error", "err", err.Error())
+			response.Status = http.StatusInternalServerError
+			a.ct.SendResponse(w, r, response, a.lg, start)
+			return
+		}
+		placeholder = &img.Placeholder
+	}
+
+	film := models.FilmItem{
+		Title:       title,
+		Info:        info,
+		Poster:      filename,
+		ReleaseDate: date,
+		Country:     country,
+
+		OriginalTitle: originalTitle,
+		Runtime:       uint32(runtime),
+		Budget:        budget,
+		BoxOffice:     boxOffice,
+		Languages:     languages,
+		Trailers:      trailers,
+		ImdbId:        imdbId,
+		KinopoiskId:   kinopoiskId,
+		Placeholder:   placeholder,
+	}
+
+	err = a.core.AddFilm(film, genres, actors)
+	if err != nil {
+		a.lg.Error("add film error", "err", err.Error())
+		response.Status = http.StatusInternalServerError
+		a.ct.SendResponse(w, r, response, a.lg, start)
+		return
+	}
+
+	a.ct.SendResponse(w, r, response, a.lg, start)
+}
 
-	filename := "/icons/" + handler.Filename
-	if err != nil && handler != nil && poster != nil {
-		a.lg.Error("Post profile error", "err", err.Error())
+func (a *API) EditFilm(w http.ResponseWriter, r *http.Request) {
+	response := requests.Response{Status: http.StatusOK, Body: nil}
+	start := time.Now()
+	if r.Method != http.MethodPost {
+		response.Status = http.StatusMethodNotAllowed
+		a.ct.SendResponse(w, r, response, a.lg, start)
+		return
+	}
+	if !a.allowAdmin(w, r, start) {
+		return
+	}
+
+	var request requests.EditFilmRequest
+
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		a.lg.Error("edit film error", "err", err.Error())
+		response.Status = http.StatusBadRequest
+		a.ct.SendResponse(w, r, response, a.lg, start)
+		return
+	}
+
+	if err = easyjson.Unmarshal(body, &request); err != nil {
+		a.lg.Error("edit film error", "err", err.Error())
+		response.Status = http.StatusBadRequest
+		a.ct.SendResponse(w, r, response, a.lg, start)
+		return
+	}
+	if request.FilmId == 0 || request.Title != nil && *request.Title == "" {
 		response.Status = http.StatusBadRequest
 		a.ct.SendResponse(w, r, response, a.lg, start)
 		return
 	}
 
-	filePhoto, err := os.OpenFile("/home/ubuntu/frontend-project"+filename, os.O_WRONLY|os.O_CREATE, 0666)
+	edit := models.FilmEdit{
+		Id:            request.FilmId,
+		Title:         request.Title,
+		Info:          request.Info,
+		ReleaseDate:   request.ReleaseDate,
+		Country:       request.Country,
+		Mpaa:          request.Mpaa,
+		OriginalTitle: request.OriginalTitle,
+		Runtime:       request.Runtime,
+		Budget:        request.Budget,
+		BoxOffice:     request.BoxOffice,
+		Languages:     request.Languages,
+		Trailers:      request.Trailers,
+		ImdbId:        request.ImdbId,
+		KinopoiskId:   request.KinopoiskId,
+	}
+
+	err = a.core.EditFilm(edit)
 	if err != nil {
-		a.lg.Error("add film error", "err", err.Error())
+		if errors.Is(err, usecase.ErrNotFound) {
+			response.Status = http.StatusNotFound
+			a.ct.SendResponse(w, r, response, a.lg, start)
+			return
+		}
+
+		a.lg.Error("edit film error", "err", err.Error())
 		response.Status = http.StatusInternalServerError
 		a.ct.SendResponse(w, r, response, a.lg, start)
 		return
 	}
-	defer filePhoto.Close()
 
-	_, err = io.Copy(filePhoto, poster)
+	a.ct.SendResponse(w, r, response, a.lg, start)
+}
+
+func (a *API) ReplacePoster(w http.ResponseWriter, r *http.Request) {
+	response := requests.Response{Status: http.StatusOK, Body: nil}
+	start := time.Now()
+	if r.Method != http.MethodPost {
+		response.Status = http.StatusMethodNotAllowed
+		a.ct.SendResponse(w, r, response, a.lg, start)
+		return
+	}
+
+	if !a.allowAdmin(w, r, start) {
+		return
+	}
+
+	r.Body = http.MaxBytesReader(w, r.Body, images.MaxUploadSize)
+	err := r.ParseMultipartForm(images.MaxUploadSize)
 	if err != nil {
-		a.lg.Error("add film error", "err", err.Error())
+		a.lg.Error("replace poster error", "err", err.Error())
+		response.Status = images.FormStatus(err)
+		a.ct.SendResponse(w, r, response, a.lg, start)
+		return
+	}
+
+	filmId, err := strconv.ParseUint(r.FormValue("film_id"), 10, 64)
+	if err != nil || filmId == 0 {
+		response.Status = http.StatusBadRequest
+		a.ct.SendResponse(w, r, response, a.lg, start)
+		return
+	}
+
+	poster, _, err := r.FormFile("photo")
+	if err != nil {
+		a.lg.Error("replace poster error", "err", err.Error())
+		response.Status = http.StatusBadRequest
+		a.ct.SendResponse(w, r, response, a.lg, start)
+		return
+	}
+	defer poster.Close()
+
+	img, err := images.Process(poster)
+	if err != nil {
+		a.lg.Error("replace poster error", "err", err.Error())
+		response.Status = images.StatusCode(err)
+		a.ct.SendResponse(w, r, response, a.lg, start)
+		return
+	}
+
+	filename, err := a.core.ReplacePoster(r.Context(), filmId, img)
+	if err != nil {
+		if errors.Is(err, usecase.ErrNotFound) {
+			response.Status = http.StatusNotFound
+			a.ct.SendResponse(w, r, response, a.lg, start)
+			return
+		}
+
+		a.lg.Error("replace poster error", "err", err.Error())
 		response.Status = http.StatusInternalServerError
 		a.ct.SendResponse(w, r, response, a.lg, start)
 		return
 	}
 
-	film := models.FilmItem{
-		Title:       title,
-		Info:        info,
-		Poster:      filename,
-		ReleaseDate: date,
-		Country:     country,
+	response.Body = requests.PosterResponse{
+		Poster:         filename,
+		PosterVariants: images.Variants(filename),
+		Placeholder:    &img.Placeholder,
 	}
+	a.ct.SendResponse(w, r, response, a.lg, start)
+}
 
-	err = a.core.AddFilm(film, genres, actors)
+func (a *API) AddTranslation(w http.ResponseWriter, r *http.Request) {
+	response := requests.Response{Status: http.StatusOK, Body: nil}
+	start := time.Now()
+	if r.Method != http.MethodPost {
+		response.Status = http.StatusMethodNotAllowed
+		a.ct.SendResponse(w, r, response, a.lg, start)
+		return
+	}
+	if !a.allowAdmin(w, r, start) {
+		return
+	}
+
+	var request requests.AddTranslationRequest
+
+	body, err := io.ReadAll(r.Body)
 	if err != nil {
-		a.lg.Error("add film error", "err", err.Error())
+		a.lg.Error("add translation error", "err", err.Error())
+		response.Status = http.StatusBadRequest
+		a.ct.SendResponse(w, r, response, a.lg, start)
+		return
+	}
+
+	if err = easyjson.Unmarshal(body, &request); err != nil {
+		a.lg.Error("add translation error", "err", err.Error())
+		response.Status = http.StatusBadRequest
+		a.ct.SendResponse(w, r, response, a.lg, start)
+		return
+	}
+
+	item := models.Translation{
+		Id:    request.Id,
+		Lang:  request.Lang,
+		Title: request.Title,
+		Info:  request.Info,
+	}
+
+	err = a.core.AddTranslation(request.Entity, item)
+	if err != nil {
+		if errors.Is(err, usecase.ErrBadTranslation) {
+			response.Status = http.StatusBadRequest
+			a.ct.SendResponse(w, r, response, a.lg, start)
+			return
+		}
+
+		a.lg.Error("add translation error", "err", err.Error())
 		response.Status = http.StatusInternalServerError
 		a.ct.SendResponse(w, r, response, a.lg, start)
 		return
@@ -585,6 +1028,18 @@ func (a *API) AddFilm(w http.ResponseWriter, r *http.Request) {
 	a.ct.SendResponse(w, r, response, a.lg, start)
 }
 
+func splitList(value string) []string {
+	var result []string
+	for _, item := range strings.Split(value, ",") {
+		item = strings.TrimSpace(item)
+		if item != "" {
+			result = append(result, item)
+		}
+	}
+
+	return result
+}
+
 func (a *API) FavoriteActorsAdd(w http.ResponseWriter, r *http.Request) {
 	response := requests.Response{Status: http.StatusOK, Body: nil}
 	start := time.Now()
@@ -594,7 +1049,13 @@ func (a *API) FavoriteActorsAdd(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	userId := r.Context().Value(middleware.UserIDKey).(uint64)
+	user, isAuth := middleware.PrincipalFrom(r.Context())
+	if !isAuth {
+		response.Status = http.StatusUnauthorized
+		a.ct.SendResponse(w, r, response, a.lg, start)
+		return
+	}
+	userId := user.Id
 
 	actorId, err := strconv.ParseUint(r.URL.Query().Get("actor_id"), 10, 64)
 	if err != nil {
@@ -628,7 +1089,13 @@ func (a *API) FavoriteActorsRemove(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	userId := r.Context().Value(middleware.UserIDKey).(uint64)
+	user, isAuth := middleware.PrincipalFrom(r.Context())
+	if !isAuth {
+		response.Status = http.StatusUnauthorized
+		a.ct.SendResponse(w, r, response, a.lg, start)
+		return
+	}
+	userId := user.Id
 
 	actorId, err := strconv.ParseUint(r.URL.Query().Get("actor_id"), 10, 64)
 	if err != nil {
@@ -657,7 +1124,13 @@ func (a *API) FavoriteActors(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	userId := r.Context().Value(middleware.UserIDKey).(uint64)
+	user, isAuth := middleware.PrincipalFrom(r.Context())
+	if !isAuth {
+		response.Status = http.StatusUnauthorized
+		a.ct.SendResponse(w, r, response, a.lg, start)
+		return
+	}
+	userId := user.Id
 
 	page, err := strconv.ParseUint(r.URL.Query().Get("page"), 10, 64)
 	if err != nil {
@@ -729,7 +1202,13 @@ func (a *API) UsersStatistics(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	userId := r.Context().Value(middleware.UserIDKey).(uint64)
+	user, isAuth := middleware.PrincipalFrom(r.Context())
+	if !isAuth {
+		response.Status = http.StatusUnauthorized
+		a.ct.SendResponse(w, r, response, a.lg, start)
+		return
+	}
+	userId := user.Id
 
 	stats, err := a.core.UsersStatistics(userId)
 	if err != nil {
@@ -753,7 +1232,7 @@ func (a *API) Trends(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	trends, err := a.core.Trends()
+	trends, err := a.core.Trends(locale.FromRequest(r))
 	if err != nil {
 		a.lg.Error("trends error", "err", err.Error())
 		response.Status = http.StatusInternalServerError
@@ -779,7 +1258,13 @@ func (a *API) LastSeen(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	userId := r.Context().Value(middleware.UserIDKey).(uint64)
+	user, isAuth := middleware.PrincipalFrom(r.Context())
+	if !isAuth {
+		response.Status = http.StatusUnauthorized
+		a.ct.SendResponse(w, r, response, a.lg, start)
+		return
+	}
+	userId := user.Id
 
 	filmsIds, err := a.core.GetNearFilms(r.Context(), userId, a.lg)
 	if err != nil {
@@ -789,7 +1274,7 @@ func (a *API) LastSeen(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	films, err := a.core.GetLastSeen(filmsIds)
+	films, err := a.core.GetLastSeen(locale.FromRequest(r), filmsIds)
 	if err != nil {
 		if errors.Is(err, usecase.ErrNotFound) {
 			response.Status = http.StatusNotFound
@@ -810,3 +1295,19 @@ func (a *API) LastSeen(w http.ResponseWriter, r *http.Request) {
 	response.Body = filmsResponse
 	a.ct.SendResponse(w, r, response, a.lg, start)
 }
+
+// allowAdmin tells whether the request comes from an admin, otherwise it
+// answers 401 to anonymous callers and 403 to the rest.
+func (a *API) allowAdmin(w http.ResponseWriter, r *http.Request, start time.Time) bool {
+	user, isAuth := middleware.PrincipalFrom(r.Context())
+	if !isAuth {
+		a.ct.SendResponse(w, r, requests.Response{Status: http.StatusUnauthorized}, a.lg, start)
+		return false
+	}
+	if !adminRoles[user.Role] {
+		a.ct.SendResponse(w, r, requests.Response{Status: http.StatusForbidden}, a.lg, start)
+		return false
+	}
+
+	return true
+}
@@ -2,17 +2,22 @@ package delivery
 
 import (
 	"bytes"
-	"context"
 	"fmt"
+	"image"
+	imagepng "image/png"
 	"io"
 	"log/slog"
+	"mime/multipart"
 	"net/http"
 	"net/http/httptest"
 	"reflect"
+	"strings"
 	"testing"
 
 	"github.com/go-park-mail-ru/2023_2_Vkladyshi/films/mocks"
 	"github.com/go-park-mail-ru/2023_2_Vkladyshi/films/usecase"
+	"github.com/go-park-mail-ru/2023_2_Vkladyshi/pkg/images"
+	"github.com/go-park-mail-ru/2023_2_Vkladyshi/pkg/locale"
 	"github.com/go-park-mail-ru/2023_2_Vkladyshi/pkg/middleware"
 	"github.com/go-park-mail-ru/2023_2_Vkladyshi/pkg/models"
 	"github.com/go-park-mail-ru/2023_2_Vkladyshi/pkg/requests"
@@ -42,6 +47,8 @@ func getResponse(w *httptest.ResponseRecorder) (*requests.Response, error) {
 	return &response, nil
 }
 
+var defaultLangs = []string{locale.Default}
+
 func createBody(req requests.FindFilmRequest) io.Reader {
 	jsonReq, _ := easyjson.Marshal(req)
 
@@ -109,8 +116,8 @@ func TestFilms(t *testing.T) {
 
 	mockCore := mocks.NewMockICore(mockCtrl)
 
-	mockCore.EXPECT().GetFilmsAndGenreTitle(uint64(0), uint64(0), uint64(8)).Return(nil, "", fmt.Errorf("core_err")).Times(1)
-	mockCore.EXPECT().GetFilmsAndGenreTitle(uint64(1), uint64(0), uint64(8)).Return(expectedFilms, expectedGenre, nil).Times(1)
+	mockCore.EXPECT().GetFilmsAndGenreTitle(defaultLangs, uint64(0), uint64(0), uint64(8)).Return(nil, "", fmt.Errorf("core_err")).Times(1)
+	mockCore.EXPECT().GetFilmsAndGenreTitle(defaultLangs, uint64(1), uint64(0), uint64(8)).Return(expectedFilms, expectedGenre, nil).Times(1)
 	var buff bytes.Buffer
 	logger := slog.New(slog.NewJSONHandler(&buff, nil))
 
@@ -194,9 +201,9 @@ func TestFilm(t *testing.T) {
 	logger := slog.New(slog.NewJSONHandler(&buff, nil))
 
 	mockCore := mocks.NewMockICore(mockCtrl)
-	mockCore.EXPECT().GetFilmInfo(uint64(1)).Return(nil, fmt.Errorf("core_err")).Times(1)
-	mockCore.EXPECT().GetFilmInfo(uint64(2)).Return(nil, usecase.ErrNotFound).Times(1)
-	mockCore.EXPECT().GetFilmInfo(uint64(3)).Return(expectedResponse, nil).Times(1)
+	mockCore.EXPECT().GetFilmInfo(defaultLangs, uint64(1)).Return(nil, fmt.Errorf("core_err")).Times(1)
+	mockCore.EXPECT().GetFilmInfo(defaultLangs, uint64(2)).Return(nil, usecase.ErrNotFound).Times(1)
+	mockCore.EXPECT().GetFilmInfo(defaultLangs, uint64(3)).Return(expectedResponse, nil).Times(1)
 
 	api := API{core: mockCore, lg: logger, ct: collector}
 
@@ -226,6 +233,87 @@ func TestFilm(t *testing.T) {
 	}
 }
 
+func TestFilmPage(t *testing.T) {
+	expectedResponse := &requests.FilmPageResponse{
+		FilmResponse: requests.FilmResponse{Film: models.FilmItem{Title: "t1"}, Rating: 9.5, Number: 10},
+		Comments:     []models.CommentItem{{IdUser: 2, Username: "u", Rating: 8, Comment: "c"}},
+		OwnComment:   &models.CommentItem{IdUser: 1, Rating: 7},
+		IsFavorite:   true,
+		Unavailable:  []string{usecase.PartComments},
+	}
+
+	testCases := map[string]struct {
+		method string
+		params map[string]string
+		result *requests.Response
+	}{
+		"Bad method": {
+			method: http.MethodPost,
+			result: &requests.Response{Status: http.StatusMethodNotAllowed, Body: nil},
+		},
+		"bad request error": {
+			method: http.MethodGet,
+			params: map[string]string{},
+			result: &requests.Response{Status: http.StatusBadRequest, Body: nil},
+		},
+		"Core error": {
+			method: http.MethodGet,
+			params: map[string]string{"film_id": "1"},
+			result: &requests.Response{Status: http.StatusInternalServerError, Body: nil},
+		},
+		"not found error": {
+			method: http.MethodGet,
+			params: map[string]string{"film_id": "2"},
+			result: getExpectedResult(&requests.Response{Status: http.StatusNotFound, Body: nil}),
+		},
+		"Ok": {
+			method: http.MethodGet,
+			params: map[string]string{"film_id": "3"},
+			result: getExpectedResult(&requests.Response{Status: http.StatusOK, Body: expectedResponse}),
+		},
+	}
+
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	var buff bytes.Buffer
+	logger := slog.New(slog.NewJSONHandler(&buff, nil))
+
+	mockCore := mocks.NewMockICore(mockCtrl)
+	mockCore.EXPECT().GetFilmPage(gomock.Any(), defaultLangs, uint64(1), uint64(1)).Return(nil, fmt.Errorf("core_err")).Times(1)
+	mockCore.EXPECT().GetFilmPage(gomock.Any(), defaultLangs, uint64(2), uint64(1)).Return(nil, usecase.ErrNotFound).Times(1)
+	mockCore.EXPECT().GetFilmPage(gomock.Any(), defaultLangs, uint64(3), uint64(1)).Return(expectedResponse, nil).Times(1)
+	mockCore.EXPECT().AddNearFilm(gomock.Any(), models.NearFilm{IdFilm: 3, IdUser: 1}, gomock.Any()).Return(true, nil).Times(1)
+
+	api := API{core: mockCore, lg: logger, ct: collector}
+
+	for _, curr := range testCases {
+		r := httptest.NewRequest(curr.method, "/api/v1/film/page", nil)
+		q := r.URL.Query()
+		for key, value := range curr.params {
+			q.Add(key, value)
+		}
+		r.URL.RawQuery = q.Encode()
+		r = r.WithContext(middleware.WithPrincipal(r.Context(), &middleware.Principal{Id: 1}))
+		w := httptest.NewRecorder()
+
+		api.FilmPage(w, r)
+		response, err := getResponse(w)
+		if err != nil {
+			t.Errorf("unexpected error: %s", err)
+			return
+		}
+		if response.Status != curr.result.Status {
+			t.Errorf("unexpected status: %d, want %d", response.Status, curr.result.Status)
+			return
+		}
+		if !reflect.DeepEqual(response.Body, curr.result.Body) {
+			t.Errorf("wanted %v, got %v", curr.result.Body, response.Body)
+			return
+		}
+	}
+}
+
 func TestActor(t *testing.T) {
 	careerItem := models.ProfessionItem{Title: "g1"}
 	expectedCareer := []models.ProfessionItem{careerItem}
@@ -263,15 +351,38 @@ func TestActor(t *testing.T) {
 			params: map[string]string{"actor_id": "3"},
 			result: getExpectedResult(&requests.Response{Status: http.StatusOK, Body: expectedResponse}),
 		},
+		"Ok with page": {
+			method: http.MethodGet,
+			params: map[string]string{"actor_id": "4", "sort": "rating", "page": "2", "per_page": "5"},
+			result: getExpectedResult(&requests.Response{Status: http.StatusOK, Body: expectedResponse}),
+		},
+		"bad sort error": {
+			method: http.MethodGet,
+			params: map[string]string{"actor_id": "5", "sort": "title"},
+			result: &requests.Response{Status: http.StatusBadRequest, Body: nil},
+		},
+		"per page capped": {
+			method: http.MethodGet,
+			params: map[string]string{"actor_id": "6", "page": "2", "per_page": "1000"},
+			result: getExpectedResult(&requests.Response{Status: http.StatusOK, Body: expectedResponse}),
+		},
+		"page overflow": {
+			method: http.MethodGet,
+			params: map[string]string{"actor_id": "7", "page": "18446744073709551615", "per_page": "100"},
+			result: &requests.Response{Status: http.StatusBadRequest, Body: nil},
+		},
 	}
 
 	mockCtrl := gomock.NewController(t)
 	defer mockCtrl.Finish()
 
 	mockCore := mocks.NewMockICore(mockCtrl)
-	mockCore.EXPECT().GetActorInfo(uint64(1)).Return(nil, fmt.Errorf("core_err")).Times(1)
-	mockCore.EXPECT().GetActorInfo(uint64(2)).Return(nil, usecase.ErrNotFound).Times(1)
-	mockCore.EXPECT().GetActorInfo(uint64(3)).Return(expectedResponse, nil).Times(1)
+	mockCore.EXPECT().GetActorInfo(defaultLangs, uint64(1), "", uint64(0), uint64(20)).Return(nil, fmt.Errorf("core_err")).Times(1)
+	mockCore.EXPECT().GetActorInfo(defaultLangs, uint64(2), "", uint64(0), uint64(20)).Return(nil, usecase.ErrNotFound).Times(1)
+	mockCore.EXPECT().GetActorInfo(defaultLangs, uint64(3), "", uint64(0), uint64(20)).Return(expectedResponse, nil).Times(1)
+	mockCore.EXPECT().GetActorInfo(defaultLangs, uint64(4), "rating", uint64(5), uint64(5)).Return(expectedResponse, nil).Times(1)
+	mockCore.EXPECT().GetActorInfo(defaultLangs, uint64(5), "title", uint64(0), uint64(20)).Return(nil, usecase.ErrBadSort).Times(1)
+	mockCore.EXPECT().GetActorInfo(defaultLangs, uint64(6), "", uint64(100), uint64(100)).Return(expectedResponse, nil).Times(1)
 	var buff bytes.Buffer
 	logger := slog.New(slog.NewJSONHandler(&buff, nil))
 
@@ -346,9 +457,9 @@ func TestFindFilm(t *testing.T) {
 	defer mockCtrl.Finish()
 
 	mockCore := mocks.NewMockICore(mockCtrl)
-	mockCore.EXPECT().FindFilm(string("t1"), string(""), string(""), float32(0), float32(0), string(""), nil, nil, uint64(0), uint64(0)).Return(nil, fmt.Errorf("core_err")).Times(1)
-	mockCore.EXPECT().FindFilm(string("t2"), string(""), string(""), float32(0), float32(0), string(""), nil, nil, uint64(0), uint64(0)).Return(nil, usecase.ErrNotFound).Times(1)
-	mockCore.EXPECT().FindFilm(string("t3"), string(""), string(""), float32(0), float32(0), string(""), nil, nil, uint64(0), uint64(0)).Return(films, nil).Times(1)
+	mockCore.EXPECT().FindFilm(defaultLangs, string("t1"), string(""), string(""), float32(0), float32(0), string(""), nil, nil, uint32(0), uint32(0), string(""), uint64(0), uint64(0)).Return(nil, fmt.Errorf("core_err")).Times(1)
+	mockCore.EXPECT().FindFilm(defaultLangs, string("t2"), string(""), string(""), float32(0), float32(0), string(""), nil, nil, uint32(0), uint32(0), string(""), uint64(0), uint64(0)).Return(nil, usecase.ErrNotFound).Times(1)
+	mockCore.EXPECT().FindFilm(defaultLangs, string("t3"), string(""), string(""), float32(0), float32(0), string(""), nil, nil, uint32(0), uint32(0), string(""), uint64(0), uint64(0)).Return(films, nil).Times(1)
 	var buff bytes.Buffer
 	logger := slog.New(slog.NewJSONHandler(&buff, nil))
 
@@ -479,8 +590,8 @@ func TestCalendar(t *testing.T) {
 	defer mockCtrl.Finish()
 
 	mockCore := mocks.NewMockICore(mockCtrl)
-	mockCore.EXPECT().GetCalendar().Return(nil, fmt.Errorf("core_err")).Times(1)
-	mockCore.EXPECT().GetCalendar().Return(expectedResponse, nil).Times(1)
+	mockCore.EXPECT().GetCalendar(defaultLangs).Return(nil, fmt.Errorf("core_err")).Times(1)
+	mockCore.EXPECT().GetCalendar(defaultLangs).Return(expectedResponse, nil).Times(1)
 	var buff bytes.Buffer
 	logger := slog.New(slog.NewJSONHandler(&buff, nil))
 
@@ -507,6 +618,35 @@ func TestCalendar(t *testing.T) {
 	}
 }
 
+func TestCalendarLanguage(t *testing.T) {
+	expectedResponse := &requests.CalendarResponse{MonthName: "January", MonthText: "New this month"}
+
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	mockCore := mocks.NewMockICore(mockCtrl)
+	mockCore.EXPECT().GetCalendar([]string{"en", "fr", "de", "ru"}).Return(expectedResponse, nil).Times(1)
+	var buff bytes.Buffer
+	logger := slog.New(slog.NewJSONHandler(&buff, nil))
+
+	api := API{core: mockCore, lg: logger, ct: collector}
+
+	r := httptest.NewRequest(http.MethodGet, "/api/v1/calendar?lang=en", nil)
+	r.Header.Set("Accept-Language", "de-DE;q=0.5, fr-CH, en;q=0.9, *;q=0.1, it;q=0")
+	w := httptest.NewRecorder()
+
+	api.Calendar(w, r)
+	response, err := getResponse(w)
+	if err != nil {
+		t.Errorf("unexpected error: %s", err)
+		return
+	}
+	if response.Status != http.StatusOK {
+		t.Errorf("unexpected status: %d, want %d", response.Status, http.StatusOK)
+		return
+	}
+}
+
 func TestFavoriteFilmsAdd(t *testing.T) {
 	testCases := map[string]struct {
 		method string
@@ -553,7 +693,7 @@ func TestFavoriteFilmsAdd(t *testing.T) {
 
 	for _, curr := range testCases {
 		r := httptest.NewRequest(curr.method, "/api/v1/favorite/film/add", nil)
-		newReq := r.WithContext(context.WithValue(r.Context(), middleware.UserIDKey, uint64(1)))
+		newReq := r.WithContext(middleware.WithPrincipal(r.Context(), &middleware.Principal{Id: 1}))
 		q := r.URL.Query()
 		for key, value := range curr.params {
 			q.Add(key, value)
@@ -618,7 +758,7 @@ func TestFavoriteFilmsRemove(t *testing.T) {
 
 	for _, curr := range testCases {
 		r := httptest.NewRequest(curr.method, "/api/v1/favorite/film/remove", nil)
-		newReq := r.WithContext(context.WithValue(r.Context(), middleware.UserIDKey, uint64(1)))
+		newReq := r.WithContext(middleware.WithPrincipal(r.Context(), &middleware.Principal{Id: 1}))
 		q := r.URL.Query()
 		for key, value := range curr.params {
 			q.Add(key, value)
@@ -672,8 +812,8 @@ func TestFavoriteFilms(t *testing.T) {
 	defer mockCtrl.Finish()
 
 	mockCore := mocks.NewMockICore(mockCtrl)
-	mockCore.EXPECT().FavoriteFilms(uint64(1), uint64(8), uint64(8)).Return(nil, fmt.Errorf("core_err")).Times(1)
-	mockCore.EXPECT().FavoriteFilms(uint64(1), uint64(0), uint64(8)).Return(films, nil).Times(1)
+	mockCore.EXPECT().FavoriteFilms(defaultLangs, uint64(1), uint64(8), uint64(8)).Return(nil, fmt.Errorf("core_err")).Times(1)
+	mockCore.EXPECT().FavoriteFilms(defaultLangs, uint64(1), uint64(0), uint64(8)).Return(films, nil).Times(1)
 	var buff bytes.Buffer
 	logger := slog.New(slog.NewJSONHandler(&buff, nil))
 
@@ -681,7 +821,7 @@ func TestFavoriteFilms(t *testing.T) {
 
 	for _, curr := range testCases {
 		r := httptest.NewRequest(curr.method, "/api/v1/favorite/films", nil)
-		newReq := r.WithContext(context.WithValue(r.Context(), middleware.UserIDKey, uint64(1)))
+		newReq := r.WithContext(middleware.WithPrincipal(r.Context(), &middleware.Principal{Id: 1}))
 		q := r.URL.Query()
 		for key, value := range curr.params {
 			q.Add(key, value)
@@ -752,7 +892,7 @@ func TestFavoriteActorsAdd(t *testing.T) {
 
 	for _, curr := range testCases {
 		r := httptest.NewRequest(curr.method, "/api/v1/favorite/actor/add", nil)
-		newReq := r.WithContext(context.WithValue(r.Context(), middleware.UserIDKey, uint64(1)))
+		newReq := r.WithContext(middleware.WithPrincipal(r.Context(), &middleware.Principal{Id: 1}))
 		q := r.URL.Query()
 		for key, value := range curr.params {
 			q.Add(key, value)
@@ -817,7 +957,7 @@ func TestFavoriteActorsRemove(t *testing.T) {
 
 	for _, curr := range testCases {
 		r := httptest.NewRequest(curr.method, "/api/v1/favorite/actor/remove", nil)
-		newReq := r.WithContext(context.WithValue(r.Context(), middleware.UserIDKey, uint64(1)))
+		newReq := r.WithContext(middleware.WithPrincipal(r.Context(), &middleware.Principal{Id: 1}))
 		q := r.URL.Query()
 		for key, value := range curr.params {
 			q.Add(key, value)
@@ -881,7 +1021,7 @@ func TestFavoriteActors(t *testing.T) {
 
 	for _, curr := range testCases {
 		r := httptest.NewRequest(curr.method, "/api/v1/favorite/actors", nil)
-		newReq := r.WithContext(context.WithValue(r.Context(), middleware.UserIDKey, uint64(1)))
+		newReq := r.WithContext(middleware.WithPrincipal(r.Context(), &middleware.Principal{Id: 1}))
 		q := r.URL.Query()
 		for key, value := range curr.params {
 			q.Add(key, value)
@@ -952,7 +1092,7 @@ func TestAddRating(t *testing.T) {
 
 	for _, curr := range testCases {
 		r := httptest.NewRequest(curr.method, "/api/v1/rating/add", curr.body)
-		newReq := r.WithContext(context.WithValue(r.Context(), middleware.UserIDKey, uint64(1)))
+		newReq := r.WithContext(middleware.WithPrincipal(r.Context(), &middleware.Principal{Id: 1}))
 
 		w := httptest.NewRecorder()
 
@@ -1013,7 +1153,7 @@ func TestDeleteRating(t *testing.T) {
 
 	for _, curr := range testCases {
 		r := httptest.NewRequest(curr.method, "/api/v1/rating/delete", curr.body)
-		newReq := r.WithContext(context.WithValue(r.Context(), middleware.UserIDKey, uint64(1)))
+		newReq := r.WithContext(middleware.WithPrincipal(r.Context(), &middleware.Principal{Id: 1}))
 
 		w := httptest.NewRecorder()
 
@@ -1072,7 +1212,7 @@ func TestUsersStatistics(t *testing.T) {
 
 	for _, curr := range testCases {
 		r := httptest.NewRequest(curr.method, "/api/v1/statistics", nil)
-		newReq := r.WithContext(context.WithValue(r.Context(), middleware.UserIDKey, curr.userId))
+		newReq := r.WithContext(middleware.WithPrincipal(r.Context(), &middleware.Principal{Id: curr.userId}))
 
 		w := httptest.NewRecorder()
 
@@ -1123,8 +1263,8 @@ func TestTrends(t *testing.T) {
 	defer mockCtrl.Finish()
 
 	mockCore := mocks.NewMockICore(mockCtrl)
-	mockCore.EXPECT().Trends().Return(nil, fmt.Errorf("core_err")).Times(1)
-	mockCore.EXPECT().Trends().Return(expect, nil).Times(1)
+	mockCore.EXPECT().Trends(defaultLangs).Return(nil, fmt.Errorf("core_err")).Times(1)
+	mockCore.EXPECT().Trends(defaultLangs).Return(expect, nil).Times(1)
 
 	var buff bytes.Buffer
 	logger := slog.New(slog.NewJSONHandler(&buff, nil))
@@ -1222,10 +1362,10 @@ func TestLastSeen(t *testing.T) {
 
 	for _, curr := range testCases {
 		r := httptest.NewRequest(curr.method, "/api/v1/lasts", nil)
-		newReq := r.WithContext(context.WithValue(r.Context(), middleware.UserIDKey, curr.userId))
+		newReq := r.WithContext(middleware.WithPrincipal(r.Context(), &middleware.Principal{Id: curr.userId}))
 
 		mockCore.EXPECT().GetNearFilms(newReq.Context(), curr.userId, logger).Return(curr.nearFilmResult, curr.nearFilmErr).MaxTimes(1)
-		mockCore.EXPECT().GetLastSeen(curr.nearFilmResult).Return(curr.lastSeenResult, curr.lastSeenError).MaxTimes(1)
+		mockCore.EXPECT().GetLastSeen(defaultLangs, curr.nearFilmResult).Return(curr.lastSeenResult, curr.lastSeenError).MaxTimes(1)
 
 		w := httptest.NewRecorder()
 
@@ -1245,3 +1385,559 @@ func TestLastSeen(t *testing.T) {
 		}
 	}
 }
+
+func TestActorsPath(t *testing.T) {
+	expectedResponse := &requests.ActorsPathResponse{
+		Degrees: 1,
+		Actors:  []models.Character{{IdActor: 1}, {IdActor: 2}},
+		Films:   []models.FilmItem{{Id: 1}},
+	}
+
+	testCases := map[string]struct {
+		method string
+		params map[string]string
+		result *requests.Response
+	}{
+		"Bad method": {
+			method: http.MethodPost,
+			result: &requests.Response{Status: http.StatusMethodNotAllowed, Body: nil},
+		},
+		"bad request error": {
+			method: http.MethodGet,
+			params: map[string]string{"from": "1"},
+			result: &requests.Response{Status: http.StatusBadRequest, Body: nil},
+		},
+		"Core error": {
+			method: http.MethodGet,
+			params: map[string]string{"from": "1", "to": "3"},
+			result: &requests.Response{Status: http.StatusInternalServerError, Body: nil},
+		},
+		"not found error": {
+			method: http.MethodGet,
+			params: map[string]string{"from": "1", "to": "4"},
+			result: &requests.Response{Status: http.StatusNotFound, Body: nil},
+		},
+		"Ok": {
+			method: http.MethodGet,
+			params: map[string]string{"from": "1", "to": "2"},
+			result: getExpectedResult(&requests.Response{Status: http.StatusOK, Body: expectedResponse}),
+		},
+	}
+
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	mockCore := mocks.NewMockICore(mockCtrl)
+	mockCore.EXPECT().ActorsPath(uint64(1), uint64(3)).Return(nil, fmt.Errorf("core_err")).Times(1)
+	mockCore.EXPECT().ActorsPath(uint64(1), uint64(4)).Return(nil, usecase.ErrNotFound).Times(1)
+	mockCore.EXPECT().ActorsPath(uint64(1), uint64(2)).Return(expectedResponse, nil).Times(1)
+	var buff bytes.Buffer
+	logger := slog.New(slog.NewJSONHandler(&buff, nil))
+
+	api := API{core: mockCore, lg: logger, ct: collector}
+
+	for _, curr := range testCases {
+		r := httptest.NewRequest(curr.method, "/api/v1/actors/path", nil)
+		q := r.URL.Query()
+		for key, value := range curr.params {
+			q.Add(key, value)
+		}
+		r.URL.RawQuery = q.Encode()
+		w := httptest.NewRecorder()
+
+		api.ActorsPath(w, r)
+		response, err := getResponse(w)
+		if err != nil {
+			t.Errorf("unexpected error: %s", err)
+			return
+		}
+		if response.Status != curr.result.Status {
+			t.Errorf("unexpected status: %d, want %d", response.Status, curr.result.Status)
+			return
+		}
+		if !reflect.DeepEqual(response.Body, curr.result.Body) {
+			t.Errorf("wanted %v, got %v", curr.result.Body, response.Body)
+			return
+		}
+	}
+}
+
+func TestCollaborators(t *testing.T) {
+	expected := []models.Collaborator{{IdActor: 2, Count: 3}}
+	expectedResponse := requests.CollaboratorsResponse{Collaborators: expected}
+
+	testCases := map[string]struct {
+		method string
+		params map[string]string
+		result *requests.Response
+	}{
+		"Bad method": {
+			method: http.MethodPost,
+			result: &requests.Response{Status: http.StatusMethodNotAllowed, Body: nil},
+		},
+		"bad request error": {
+			method: http.MethodGet,
+			params: map[string]string{},
+			result: &requests.Response{Status: http.StatusBadRequest, Body: nil},
+		},
+		"Core error": {
+			method: http.MethodGet,
+			params: map[string]string{"actor_id": "1"},
+			result: &requests.Response{Status: http.StatusInternalServerError, Body: nil},
+		},
+		"not found error": {
+			method: http.MethodGet,
+			params: map[string]string{"actor_id": "2"},
+			result: &requests.Response{Status: http.StatusNotFound, Body: nil},
+		},
+		"too large limit": {
+			method: http.MethodGet,
+			params: map[string]string{"actor_id": "3", "limit": "101"},
+			result: &requests.Response{Status: http.StatusBadRequest, Body: nil},
+		},
+		"Ok": {
+			method: http.MethodGet,
+			params: map[string]string{"actor_id": "3", "limit": "5"},
+			result: getExpectedResult(&requests.Response{Status: http.StatusOK, Body: expectedResponse}),
+		},
+	}
+
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	mockCore := mocks.NewMockICore(mockCtrl)
+	mockCore.EXPECT().Collaborators(uint64(1), uint64(10)).Return(nil, fmt.Errorf("core_err")).Times(1)
+	mockCore.EXPECT().Collaborators(uint64(2), uint64(10)).Return(nil, usecase.ErrNotFound).Times(1)
+	mockCore.EXPECT().Collaborators(uint64(3), uint64(5)).Return(expected, nil).Times(1)
+	var buff bytes.Buffer
+	logger := slog.New(slog.NewJSONHandler(&buff, nil))
+
+	api := API{core: mockCore, lg: logger, ct: collector}
+
+	for _, curr := range testCases {
+		r := httptest.NewRequest(curr.method, "/api/v1/actor/collaborators", nil)
+		q := r.URL.Query()
+		for key, value := range curr.params {
+			q.Add(key, value)
+		}
+		r.URL.RawQuery = q.Encode()
+		w := httptest.NewRecorder()
+
+		api.Collaborators(w, r)
+		response, err := getResponse(w)
+		if err != nil {
+			t.Errorf("unexpected error: %s", err)
+			return
+		}
+		if response.Status != curr.result.Status {
+			t.Errorf("unexpected status: %d, want %d", response.Status, curr.result.Status)
+			return
+		}
+		if !reflect.DeepEqual(response.Body, curr.result.Body) {
+			t.Errorf("wanted %v, got %v", curr.result.Body, response.Body)
+			return
+		}
+	}
+}
+
+func TestEditFilm(t *testing.T) {
+	admin := &middleware.Principal{Id: 1, Role: "admin"}
+	testCases := map[string]struct {
+		method string
+		user   *middleware.Principal
+		body   string
+		result *requests.Response
+	}{
+		"Bad method": {
+			method: http.MethodGet,
+			user:   admin,
+			body:   `{"film_id": 1}`,
+			result: &requests.Response{Status: http.StatusMethodNotAllowed, Body: nil},
+		},
+		"not signed in": {
+			method: http.MethodPost,
+			body:   `{"film_id": 1, "runtime": 90}`,
+			result: &requests.Response{Status: http.StatusUnauthorized, Body: nil},
+		},
+		"not admin": {
+			method: http.MethodPost,
+			user:   &middleware.Principal{Id: 2, Role: "user"},
+			body:   `{"film_id": 1, "runtime": 90}`,
+			result: &requests.Response{Status: http.StatusForbidden, Body: nil},
+		},
+		"bad request error": {
+			method: http.MethodPost,
+			user:   admin,
+			body:   `{"title": "t"}`,
+			result: &requests.Response{Status: http.StatusBadRequest, Body: nil},
+		},
+		"empty title": {
+			method: http.MethodPost,
+			user:   admin,
+			body:   `{"film_id": 1, "title": ""}`,
+			result: &requests.Response{Status: http.StatusBadRequest, Body: nil},
+		},
+		"Core error": {
+			method: http.MethodPost,
+			user:   admin,
+			body:   `{"film_id": 1, "runtime": 90}`,
+			result: &requests.Response{Status: http.StatusInternalServerError, Body: nil},
+		},
+		"not found error": {
+			method: http.MethodPost,
+			user:   admin,
+			body:   `{"film_id": 2, "runtime": 90}`,
+			result: &requests.Response{Status: http.StatusNotFound, Body: nil},
+		},
+		"Ok": {
+			method: http.MethodPost,
+			user:   &middleware.Principal{Id: 3, Role: "super"},
+			body:   `{"film_id": 3, "runtime": 90, "languages": ["en"]}`,
+			result: &requests.Response{Status: http.StatusOK, Body: nil},
+		},
+		"clear fields": {
+			method: http.MethodPost,
+			user:   admin,
+			body:   `{"film_id": 4, "budget": 0, "trailers": [], "imdb_id": ""}`,
+			result: &requests.Response{Status: http.StatusOK, Body: nil},
+		},
+	}
+
+	runtime := uint32(90)
+	budget := uint64(0)
+	trailers := []string{}
+	languages := []string{"en"}
+	imdbId := ""
+
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	mockCore := mocks.NewMockICore(mockCtrl)
+	mockCore.EXPECT().EditFilm(models.FilmEdit{Id: 1, Runtime: &runtime}).Return(fmt.Errorf("core_err")).Times(1)
+	mockCore.EXPECT().EditFilm(models.FilmEdit{Id: 2, Runtime: &runtime}).Return(usecase.ErrNotFound).Times(1)
+	mockCore.EXPECT().EditFilm(models.FilmEdit{Id: 3, Runtime: &runtime, Languages: &languages}).Return(nil).Times(1)
+	mockCore.EXPECT().EditFilm(models.FilmEdit{Id: 4, Budget: &budget, Trailers: &trailers, ImdbId: &imdbId}).Return(nil).Times(1)
+	var buff bytes.Buffer
+	logger := slog.New(slog.NewJSONHandler(&buff, nil))
+
+	api := API{core: mockCore, lg: logger, ct: collector}
+
+	for name, curr := range testCases {
+		r := httptest.NewRequest(curr.method, "/api/v1/film/edit", strings.NewReader(curr.body))
+		if curr.user != nil {
+			r = r.WithContext(middleware.WithPrincipal(r.Context(), curr.user))
+		}
+		w := httptest.NewRecorder()
+
+		api.EditFilm(w, r)
+		response, err := getResponse(w)
+		if err != nil {
+			t.Errorf("unexpected error: %s", err)
+			return
+		}
+		if response.Status != curr.result.Status {
+			t.Errorf("%s: unexpected status: %d, want %d", name, response.Status, curr.result.Status)
+			return
+		}
+	}
+}
+
+func createTranslationBody(req requests.AddTranslationRequest) io.Reader {
+	jsonReq, _ := easyjson.Marshal(req)
+
+	body := bytes.NewBuffer(jsonReq)
+	return body
+}
+
+func TestAddTranslation(t *testing.T) {
+	admin := &middleware.Principal{Id: 1, Role: "admin"}
+	testCases := map[string]struct {
+		method string
+		user   *middleware.Principal
+		body   io.Reader
+		result *requests.Response
+	}{
+		"Bad method": {
+			method: http.MethodGet,
+			user:   admin,
+			body:   createTranslationBody(requests.AddTranslationRequest{}),
+			result: &requests.Response{Status: http.StatusMethodNotAllowed, Body: nil},
+		},
+		"not signed in": {
+			method: http.MethodPost,
+			body:   createTranslationBody(requests.AddTranslationRequest{Entity: "film", Id: 3, Lang: "en", Title: "t"}),
+			result: &requests.Response{Status: http.StatusUnauthorized, Body: nil},
+		},
+		"not admin": {
+			method: http.MethodPost,
+			user:   &middleware.Principal{Id: 2, Role: "user"},
+			body:   createTranslationBody(requests.AddTranslationRequest{Entity: "film", Id: 3, Lang: "en", Title: "t"}),
+			result: &requests.Response{Status: http.StatusForbidden, Body: nil},
+		},
+		"bad request error": {
+			method: http.MethodPost,
+			user:   admin,
+			body:   createTranslationBody(requests.AddTranslationRequest{Entity: "collection", Id: 1, Lang: "en", Title: "t"}),
+			result: &requests.Response{Status: http.StatusBadRequest, Body: nil},
+		},
+		"Core error": {
+			method: http.MethodPost,
+			user:   admin,
+			body:   createTranslationBody(requests.AddTranslationRequest{Entity: "film", Id: 2, Lang: "en", Title: "t"}),
+			result: &requests.Response{Status: http.StatusInternalServerError, Body: nil},
+		},
+		"Ok": {
+			method: http.MethodPost,
+			user:   admin,
+			body:   createTranslationBody(requests.AddTranslationRequest{Entity: "film", Id: 3, Lang: "en", Title: "t", Info: "i"}),
+			result: &requests.Response{Status: http.StatusOK, Body: nil},
+		},
+	}
+
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	mockCore := mocks.NewMockICore(mockCtrl)
+	mockCore.EXPECT().AddTranslation("collection", models.Translation{Id: 1, Lang: "en", Title: "t"}).Return(usecase.ErrBadTranslation).Times(1)
+	mockCore.EXPECT().AddTranslation("film", models.Translation{Id: 2, Lang: "en", Title: "t"}).Return(fmt.Errorf("core_err")).Times(1)
+	mockCore.EXPECT().AddTranslation("film", models.Translation{Id: 3, Lang: "en", Title: "t", Info: "i"}).Return(nil).Times(1)
+	var buff bytes.Buffer
+	logger := slog.New(slog.NewJSONHandler(&buff, nil))
+
+	api := API{core: mockCore, lg: logger, ct: collector}
+
+	for name, curr := range testCases {
+		r := httptest.NewRequest(curr.method, "/api/v1/translation/add", curr.body)
+		if curr.user != nil {
+			r = r.WithContext(middleware.WithPrincipal(r.Context(), curr.user))
+		}
+		w := httptest.NewRecorder()
+
+		api.AddTranslation(w, r)
+		response, err := getResponse(w)
+		if err != nil {
+			t.Errorf("unexpected error: %s", err)
+			return
+		}
+		if response.Status != curr.result.Status {
+			t.Errorf("%s: unexpected status: %d, want %d", name, response.Status, curr.result.Status)
+			return
+		}
+	}
+}
+
+func createPosterBody(filmId string, photo []byte) (io.Reader, string) {
+	body := &bytes.Buffer{}
+	writer := multipart.NewWriter(body)
+	_ = writer.WriteField("film_id", filmId)
+	if photo != nil {
+		part, _ := writer.CreateFormFile("photo", "poster.png")
+		_, _ = part.Write(photo)
+	}
+	writer.Close()
+
+	return body, writer.FormDataContentType()
+}
+
+func TestReplacePoster(t *testing.T) {
+	var png bytes.Buffer
+	_ = imagepng.Encode(&png, image.NewRGBA(image.Rect(0, 0, 4, 4)))
+
+	admin := &middleware.Principal{Id: 1, Role: "admin"}
+	testCases := map[string]struct {
+		method string
+		user   *middleware.Principal
+		filmId string
+		photo  []byte
+		status int
+	}{
+		"Bad method": {
+			method: http.MethodGet,
+			user:   admin,
+			filmId: "1",
+			photo:  png.Bytes(),
+			status: http.StatusMethodNotAllowed,
+		},
+		"not signed in": {
+			method: http.MethodPost,
+			filmId: "1",
+			photo:  png.Bytes(),
+			status: http.StatusUnauthorized,
+		},
+		"not admin": {
+			method: http.MethodPost,
+			user:   &middleware.Principal{Id: 2, Role: "user"},
+			filmId: "1",
+			photo:  png.Bytes(),
+			status: http.StatusForbidden,
+		},
+		"bad film id": {
+			method: http.MethodPost,
+			user:   admin,
+			filmId: "x",
+			photo:  png.Bytes(),
+			status: http.StatusBadRequest,
+		},
+		"no photo": {
+			method: http.MethodPost,
+			user:   admin,
+			filmId: "1",
+			status: http.StatusBadRequest,
+		},
+		"unsupported photo": {
+			method: http.MethodPost,
+			user:   admin,
+			filmId: "1",
+			photo:  []byte("not an image"),
+			status: http.StatusUnsupportedMediaType,
+		},
+		"too large body": {
+			method: http.MethodPost,
+			user:   admin,
+			filmId: "1",
+			photo:  make([]byte, images.MaxUploadSize),
+			status: http.StatusRequestEntityTooLarge,
+		},
+		"not found error": {
+			method: http.MethodPost,
+			user:   admin,
+			filmId: "2",
+			photo:  png.Bytes(),
+			status: http.StatusNotFound,
+		},
+		"Ok": {
+			method: http.MethodPost,
+			user:   admin,
+			filmId: "3",
+			photo:  png.Bytes(),
+			status: http.StatusOK,
+		},
+	}
+
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	mockCore := mocks.NewMockICore(mockCtrl)
+	mockCore.EXPECT().ReplacePoster(gomock.Any(), uint64(2), gomock.Any()).Return("", usecase.ErrNotFound).Times(1)
+	mockCore.EXPECT().ReplacePoster(gomock.Any(), uint64(3), gomock.Any()).Return("/icons/p.jpg", nil).Times(1)
+	var buff bytes.Buffer
+	logger := slog.New(slog.NewJSONHandler(&buff, nil))
+
+	api := API{core: mockCore, lg: logger, ct: collector}
+
+	for name, curr := range testCases {
+		body, contentType := createPosterBody(curr.filmId, curr.photo)
+		r := httptest.NewRequest(curr.method, "/api/v1/film/poster", body)
+		r.Header.Set("Content-Type", contentType)
+		if curr.user != nil {
+			r = r.WithContext(middleware.WithPrincipal(r.Context(), curr.user))
+		}
+		w := httptest.NewRecorder()
+
+		api.ReplacePoster(w, r)
+		response, err := getResponse(w)
+		if err != nil {
+			t.Errorf("unexpected error: %s", err)
+			return
+		}
+		if response.Status != curr.status {
+			t.Errorf("%s: unexpected status: %d, want %d", name, response.Status, curr.status)
+			return
+		}
+	}
+}
+
+func createFilmBody(fields map[string]string, photo []byte) (io.Reader, string) {
+	body := &bytes.Buffer{}
+	writer := multipart.NewWriter(body)
+	for key, value := range fields {
+		_ = writer.WriteField(key, value)
+	}
+	if photo != nil {
+		part, _ := writer.CreateFormFile("photo", "poster.png")
+		_, _ = part.Write(photo)
+	}
+	writer.Close()
+
+	return body, writer.FormDataContentType()
+}
+
+func TestAddFilm(t *testing.T) {
+	admin := &middleware.Principal{Id: 1, Role: "admin"}
+	film := map[string]string{"title": "t", "genre": "1,2", "actors": "3"}
+	testCases := map[string]struct {
+		method string
+		user   *middleware.Principal
+		fields map[string]string
+		photo  []byte
+		status int
+	}{
+		"Bad method": {
+			method: http.MethodGet,
+			user:   admin,
+			fields: film,
+			status: http.StatusMethodNotAllowed,
+		},
+		"not signed in": {
+			method: http.MethodPost,
+			fields: film,
+			status: http.StatusUnauthorized,
+		},
+		"not admin": {
+			method: http.MethodPost,
+			user:   &middleware.Principal{Id: 2, Role: "user"},
+			fields: film,
+			status: http.StatusForbidden,
+		},
+		"bad genre": {
+			method: http.MethodPost,
+			user:   admin,
+			fields: map[string]string{"title": "t", "genre": "x", "actors": "3"},
+			status: http.StatusBadRequest,
+		},
+		"too large body": {
+			method: http.MethodPost,
+			user:   admin,
+			fields: film,
+			photo:  make([]byte, images.MaxUploadSize),
+			status: http.StatusRequestEntityTooLarge,
+		},
+		"Ok": {
+			method: http.MethodPost,
+			user:   admin,
+			fields: film,
+			status: http.StatusOK,
+		},
+	}
+
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	mockCore := mocks.NewMockICore(mockCtrl)
+	mockCore.EXPECT().AddFilm(gomock.Any(), []uint64{1, 2}, []uint64{3}).Return(nil).Times(1)
+	var buff bytes.Buffer
+	logger := slog.New(slog.NewJSONHandler(&buff, nil))
+
+	api := API{core: mockCore, lg: logger, ct: collector}
+
+	for name, curr := range testCases {
+		body, contentType := createFilmBody(curr.fields, curr.photo)
+		r := httptest.NewRequest(curr.method, "/api/v1/add/film", body)
+		r.Header.Set("Content-Type", contentType)
+		if curr.user != nil {
+			r = r.WithContext(middleware.WithPrincipal(r.Context(), curr.user))
+		}
+		w := httptest.NewRecorder()
+
+		api.AddFilm(w, r)
+		response, err := getResponse(w)
+		if err != nil {
+			t.Errorf("unexpected error: %s", err)
+			return
+		}
+		if response.Status != curr.status {
+			t.Errorf("%s: unexpected status: %d, want %d", name, response.Status, curr.status)
+			return
+		}
+	}
+}
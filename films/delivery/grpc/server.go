@@ -0,0 +1,158 @@
+package delivery_films_grpc
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"net"
+
+	pb "github.com/go-park-mail-ru/2023_2_Vkladyshi/films/proto"
+	"github.com/go-park-mail-ru/2023_2_Vkladyshi/films/usecase"
+	"github.com/go-park-mail-ru/2023_2_Vkladyshi/pkg/healthcheck"
+	"github.com/go-park-mail-ru/2023_2_Vkladyshi/pkg/interceptors"
+	"github.com/go-park-mail-ru/2023_2_Vkladyshi/pkg/lifecycle"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/reflection"
+	"google.golang.org/grpc/status"
+)
+
+const maxBatchSize = 100
+
+type filmsGrpc struct {
+	grpcServ *grpc.Server
+	health   *health.Server
+	lg       *slog.Logger
+}
+
+type server struct {
+	pb.UnimplementedFilmsServer
+	core usecase.ICore
+	lg   *slog.Logger
+}
+
+func NewServer(core usecase.ICore, l *slog.Logger, checker *healthcheck.Checker, opts ...grpc.ServerOption) *filmsGrpc {
+	s := grpc.NewServer(append(interceptors.ServerOptions("films", l, interceptors.Codes{
+		usecase.ErrNotFound:       codes.NotFound,
+		usecase.ErrBadSort:        codes.InvalidArgument,
+		usecase.ErrBadTranslation: codes.InvalidArgument,
+	}), opts...)...)
+	pb.RegisterFilmsServer(s, &server{
+		core: core,
+		lg:   l,
+	})
+
+	// The health service follows the dependencies of checker, reflection
+	// lets grpcurl list the services.
+	healthServer := health.NewServer()
+	healthpb.RegisterHealthServer(s, healthServer)
+	checker.ServeGrpc(healthServer, pb.Films_ServiceDesc.ServiceName)
+	reflection.Register(s)
+
+	return &filmsGrpc{grpcServ: s, health: healthServer, lg: l}
+}
+
+// GetFilmCards returns the id, title and poster of each known film in the
+// order of the request, so that other services need not read the films database.
+func (s *server) GetFilmCards(ctx context.Context, req *pb.FilmCardsRequest) (*pb.FilmCardsResponse, error) {
+	if len(req.Ids) > maxBatchSize {
+		return nil, status.Errorf(codes.InvalidArgument, "at most %d films per request", maxBatchSize)
+	}
+
+	films, err := s.core.GetFilmCards(req.Langs, req.Ids)
+	if err != nil {
+		s.lg.Error("failed to get film cards", "err", err.Error())
+		return nil, err
+	}
+
+	response := &pb.FilmCardsResponse{Films: make([]*pb.FilmCard, 0, len(films))}
+	for _, film := range films {
+		response.Films = append(response.Films, &pb.FilmCard{
+			Id:     film.Id,
+			Title:  film.Title,
+			Poster: film.Poster,
+		})
+	}
+
+	return response, nil
+}
+
+func (s *server) FilmExists(ctx context.Context, req *pb.FilmExistsRequest) (*pb.FilmExistsResponse, error) {
+	exists, err := s.core.FilmExists(req.FilmId)
+	if err != nil {
+		s.lg.Error("failed to check film", "err", err.Error())
+		return nil, err
+	}
+
+	return &pb.FilmExistsResponse{Exists: exists}, nil
+}
+
+// CheckFavorites returns those of the requested films the user has in favorites.
+func (s *server) CheckFavorites(ctx context.Context, req *pb.FavoritesRequest) (*pb.FavoritesResponse, error) {
+	if len(req.FilmIds) > maxBatchSize {
+		return nil, status.Errorf(codes.InvalidArgument, "at most %d films per request", maxBatchSize)
+	}
+
+	favorites, err := s.core.FavoriteFilmIds(req.UserId, req.FilmIds)
+	if err != nil {
+		s.lg.Error("failed to check favorites", "err", err.Error())
+		return nil, err
+	}
+
+	return &pb.FavoritesResponse{FilmIds: favorites}, nil
+}
+
+// GetCalendar returns the releases of the current month.
+func (s *server) GetCalendar(ctx context.Context, req *pb.CalendarRequest) (*pb.CalendarResponse, error) {
+	calendar, err := s.core.GetCalendar(req.Langs)
+	if err != nil {
+		s.lg.Error("failed to get calendar", "err", err.Error())
+		return nil, err
+	}
+
+	response := &pb.CalendarResponse{
+		MonthName:  calendar.MonthName,
+		MonthText:  calendar.MonthText,
+		CurrentDay: uint32(calendar.CurrentDay),
+		Days:       make([]*pb.CalendarDay, 0, len(calendar.Days)),
+	}
+	for _, day := range calendar.Days {
+		response.Days = append(response.Days, &pb.CalendarDay{
+			Day:    uint32(day.DayNumber),
+			News:   day.DayNews,
+			FilmId: day.IdFilm,
+			Poster: day.Poster,
+		})
+	}
+
+	return response, nil
+}
+
+func (s *filmsGrpc) ListenAndServeGrpc(adress string) error {
+	lis, err := net.Listen("tcp", adress)
+	if err != nil {
+		s.lg.Error("failed to listen", "err", err.Error())
+		return fmt.Errorf("listen and serve grpc error: %w", err)
+	}
+
+	return s.Serve(lis)
+}
+
+func (s *filmsGrpc) Serve(lis net.Listener) error {
+	if err := s.grpcServ.Serve(lis); err != nil {
+		s.lg.Error("failed to serve", "err", err.Error())
+		return fmt.Errorf("listen and serve grpc error: %w", err)
+	}
+
+	return nil
+}
+
+// Shutdown reports the server as not serving, so that clients move away,
+// and stops it once the calls in flight are done or ctx is.
+func (s *filmsGrpc) Shutdown(ctx context.Context) error {
+	s.health.Shutdown()
+
+	return lifecycle.StopGrpc(ctx, s.grpcServ)
+}
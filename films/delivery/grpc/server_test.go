@@ -0,0 +1,109 @@
+package delivery_films_grpc
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"log/slog"
+	"testing"
+
+	"github.com/go-park-mail-ru/2023_2_Vkladyshi/films/mocks"
+	pb "github.com/go-park-mail-ru/2023_2_Vkladyshi/films/proto"
+	"github.com/go-park-mail-ru/2023_2_Vkladyshi/pkg/models"
+	"github.com/go-park-mail-ru/2023_2_Vkladyshi/pkg/requests"
+	"github.com/golang/mock/gomock"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestGetFilmCards(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	mockCore := mocks.NewMockICore(mockCtrl)
+	s := &server{core: mockCore, lg: slog.New(slog.NewTextHandler(io.Discard, nil))}
+
+	films := []models.FilmItem{{Id: 2, Title: "t2", Poster: "p2"}, {Id: 1, Title: "t1", Poster: "p1"}}
+	mockCore.EXPECT().GetFilmCards([]string{"en"}, []uint64{2, 1}).Return(films, nil)
+	mockCore.EXPECT().GetFilmCards(nil, []uint64{3}).Return(nil, fmt.Errorf("repo err"))
+
+	response, err := s.GetFilmCards(context.Background(), &pb.FilmCardsRequest{Ids: []uint64{2, 1}, Langs: []string{"en"}})
+	if err != nil {
+		t.Errorf("GetFilmCards error: %s", err)
+	}
+	if len(response.Films) != 2 || response.Films[0].Id != 2 || response.Films[1].Poster != "p1" {
+		t.Errorf("unexpected films %v", response.Films)
+	}
+
+	_, err = s.GetFilmCards(context.Background(), &pb.FilmCardsRequest{Ids: []uint64{3}})
+	if err == nil {
+		t.Errorf("expected error, got nil")
+	}
+
+	_, err = s.GetFilmCards(context.Background(), &pb.FilmCardsRequest{Ids: make([]uint64, maxBatchSize+1)})
+	if status.Code(err) != codes.InvalidArgument {
+		t.Errorf("expected invalid argument, got %v", err)
+	}
+}
+
+func TestFilmExists(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	mockCore := mocks.NewMockICore(mockCtrl)
+	s := &server{core: mockCore, lg: slog.New(slog.NewTextHandler(io.Discard, nil))}
+
+	mockCore.EXPECT().FilmExists(uint64(1)).Return(true, nil)
+	mockCore.EXPECT().FilmExists(uint64(2)).Return(false, nil)
+
+	response, err := s.FilmExists(context.Background(), &pb.FilmExistsRequest{FilmId: 1})
+	if err != nil || !response.Exists {
+		t.Errorf("FilmExists(1) = %v, %v", response, err)
+	}
+
+	response, err = s.FilmExists(context.Background(), &pb.FilmExistsRequest{FilmId: 2})
+	if err != nil || response.Exists {
+		t.Errorf("FilmExists(2) = %v, %v", response, err)
+	}
+}
+
+func TestCheckFavorites(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	mockCore := mocks.NewMockICore(mockCtrl)
+	s := &server{core: mockCore, lg: slog.New(slog.NewTextHandler(io.Discard, nil))}
+
+	mockCore.EXPECT().FavoriteFilmIds(uint64(7), []uint64{1, 2, 3}).Return([]uint64{2}, nil)
+
+	response, err := s.CheckFavorites(context.Background(), &pb.FavoritesRequest{UserId: 7, FilmIds: []uint64{1, 2, 3}})
+	if err != nil {
+		t.Errorf("CheckFavorites error: %s", err)
+	}
+	if len(response.FilmIds) != 1 || response.FilmIds[0] != 2 {
+		t.Errorf("unexpected favorites %v", response.FilmIds)
+	}
+}
+
+func TestGetCalendar(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	mockCore := mocks.NewMockICore(mockCtrl)
+	s := &server{core: mockCore, lg: slog.New(slog.NewTextHandler(io.Discard, nil))}
+
+	calendar := &requests.CalendarResponse{
+		MonthName:  "May",
+		CurrentDay: 3,
+		Days:       []models.DayItem{{DayNumber: 12, DayNews: "t", IdFilm: 4, Poster: "p"}},
+	}
+	mockCore.EXPECT().GetCalendar([]string{"en"}).Return(calendar, nil)
+
+	response, err := s.GetCalendar(context.Background(), &pb.CalendarRequest{Langs: []string{"en"}})
+	if err != nil {
+		t.Errorf("GetCalendar error: %s", err)
+	}
+	if response.MonthName != "May" || response.CurrentDay != 3 || len(response.Days) != 1 || response.Days[0].FilmId != 4 {
+		t.Errorf("unexpected calendar %v", response)
+	}
+}
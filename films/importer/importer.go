@@ -0,0 +1,538 @@
+package importer
+
+import (
+	"fmt"
+	"io"
+	"reflect"
+	"slices"
+	"strings"
+
+	"github.com/go-park-mail-ru/2023_2_Vkladyshi/films/repository/catalog"
+)
+
+const (
+	KindGenre    = "genre"
+	KindPerson   = "person"
+	KindFilm     = "film"
+	KindCredit   = "credit"
+	KindCalendar = "calendar"
+)
+
+type Dump struct {
+	Genres   []catalog.Genre
+	People   []catalog.Person
+	Films    []catalog.Film
+	Credits  []catalog.Credit
+	Calendar []catalog.CalendarDate
+}
+
+type Conflict struct {
+	Kind   string
+	Key    string
+	Reason string
+}
+
+func (c Conflict) String() string {
+	return fmt.Sprintf("%s %s: %s", c.Kind, c.Key, c.Reason)
+}
+
+type Report struct {
+	Created   uint64
+	Updated   uint64
+	Unchanged uint64
+	Conflicts []Conflict
+}
+
+// Importer upserts a dump by external id. Every change is written to out as
+// a diff line: "+" for a new record, "~" for an updated one and "!" for a
+// conflict, which is skipped. A dry run only prints the diff.
+//
+// Records are written one by one without a wrapping transaction: the import
+// is idempotent, so an interrupted run is finished by running it again.
+type Importer struct {
+	repo   catalog.ICatalogRepo
+	out    io.Writer
+	dryRun bool
+
+	// ids of the records seen in this run by external id, 0 for the ones
+	// a dry run would create.
+	genres      map[string]uint64
+	people      map[string]uint64
+	films       map[string]uint64
+	professions map[string]uint64
+
+	report Report
+}
+
+func New(repo catalog.ICatalogRepo, out io.Writer, dryRun bool) *Importer {
+	return &Importer{
+		repo:        repo,
+		out:         out,
+		dryRun:      dryRun,
+		genres:      map[string]uint64{},
+		people:      map[string]uint64{},
+		films:       map[string]uint64{},
+		professions: map[string]uint64{},
+	}
+}
+
+// Run imports genres and people first, so films and credits in the same
+// dump can refer to them. Conflicts are reported, database errors abort.
+func (imp *Importer) Run(dump Dump) (*Report, error) {
+	for _, genre := range dump.Genres {
+		if err := imp.importGenre(genre); err != nil {
+			return &imp.report, err
+		}
+	}
+	for _, person := range dump.People {
+		if err := imp.importPerson(person); err != nil {
+			return &imp.report, err
+		}
+	}
+	for _, film := range dump.Films {
+		if err := imp.importFilm(film); err != nil {
+			return &imp.report, err
+		}
+	}
+	for _, credit := range dump.Credits {
+		if err := imp.importCredit(credit); err != nil {
+			return &imp.report, err
+		}
+	}
+	for _, date := range dump.Calendar {
+		if err := imp.importCalendarDate(date); err != nil {
+			return &imp.report, err
+		}
+	}
+
+	return &imp.report, nil
+}
+
+func (imp *Importer) conflict(kind string, key string, reason string, args ...any) {
+	conflict := Conflict{Kind: kind, Key: key, Reason: fmt.Sprintf(reason, args...)}
+	imp.report.Conflicts = append(imp.report.Conflicts, conflict)
+	fmt.Fprintf(imp.out, "! %s\n", conflict)
+}
+
+func (imp *Importer) created(kind string, key string, summary string) {
+	imp.report.Created++
+	fmt.Fprintf(imp.out, "+ %s %s %s\n", kind, key, summary)
+}
+
+func (imp *Importer) updated(kind string, key string, changes []string) bool {
+	if len(changes) == 0 {
+		imp.report.Unchanged++
+		return false
+	}
+
+	imp.report.Updated++
+	fmt.Fprintf(imp.out, "~ %s %s %s\n", kind, key, strings.Join(changes, ", "))
+	return true
+}
+
+// claim finds the row a record should be written to: the one with its
+// external id or, for rows created before imports existed, an unclaimed row
+// with the same natural key.
+func claim[T any](byId func() (*T, error), byKey func() (*T, error), externalId func(*T) string) (*T, string, error) {
+	current, err := byId()
+	if err != nil || current != nil {
+		return current, "", err
+	}
+
+	current, err = byKey()
+	if err != nil || current == nil {
+		return nil, "", err
+	}
+	if other := externalId(current); other != "" {
+		return nil, other, nil
+	}
+
+	return current, "", nil
+}
+
+func (imp *Importer) importGenre(genre catalog.Genre) error {
+	if genre.ExternalId == "" || genre.Title == "" {
+		imp.conflict(KindGenre, genre.ExternalId, "external_id and title are required")
+		return nil
+	}
+	if _, ok := imp.genres[genre.ExternalId]; ok {
+		imp.conflict(KindGenre, genre.ExternalId, "duplicate external id in dump")
+		return nil
+	}
+
+	current, other, err := claim(
+		func() (*catalog.Genre, error) { return imp.repo.FindGenre(genre.ExternalId) },
+		func() (*catalog.Genre, error) { return imp.repo.FindGenreByTitle(genre.Title) },
+		func(g *catalog.Genre) string { return g.ExternalId })
+	if err != nil {
+		return err
+	}
+	if other != "" {
+		imp.conflict(KindGenre, genre.ExternalId, "title %q already belongs to genre %s", genre.Title, other)
+		return nil
+	}
+
+	if current == nil {
+		imp.created(KindGenre, genre.ExternalId, fmt.Sprintf("%q", genre.Title))
+		if imp.dryRun {
+			imp.genres[genre.ExternalId] = 0
+			return nil
+		}
+		id, err := imp.repo.CreateGenre(genre)
+		imp.genres[genre.ExternalId] = id
+		return err
+	}
+
+	imp.genres[genre.ExternalId] = current.Id
+	merged := merge(*current, genre)
+	if imp.updated(KindGenre, genre.ExternalId, changes(*current, merged)) && !imp.dryRun {
+		return imp.repo.UpdateGenre(merged)
+	}
+
+	return nil
+}
+
+func (imp *Importer) importPerson(person catalog.Person) error {
+	if person.ExternalId == "" || person.Name == "" {
+		imp.conflict(KindPerson, person.ExternalId, "external_id and name are required")
+		return nil
+	}
+	if _, ok := imp.people[person.ExternalId]; ok {
+		imp.conflict(KindPerson, person.ExternalId, "duplicate external id in dump")
+		return nil
+	}
+
+	current, other, err := claim(
+		func() (*catalog.Person, error) { return imp.repo.FindPerson(person.ExternalId) },
+		func() (*catalog.Person, error) { return imp.repo.FindPersonByName(person.Name, person.BirthDate) },
+		func(p *catalog.Person) string { return p.ExternalId })
+	if err != nil {
+		return err
+	}
+	if other != "" {
+		imp.conflict(KindPerson, person.ExternalId, "%q born %q already belongs to person %s",
+			person.Name, person.BirthDate, other)
+		return nil
+	}
+
+	if current == nil {
+		imp.created(KindPerson, person.ExternalId, fmt.Sprintf("%q", person.Name))
+		if imp.dryRun {
+			imp.people[person.ExternalId] = 0
+			return nil
+		}
+		id, err := imp.repo.CreatePerson(person)
+		imp.people[person.ExternalId] = id
+		return err
+	}
+
+	imp.people[person.ExternalId] = current.Id
+	merged := merge(*current, person)
+	if imp.updated(KindPerson, person.ExternalId, changes(*current, merged)) && !imp.dryRun {
+		return imp.repo.UpdatePerson(merged)
+	}
+
+	return nil
+}
+
+func (imp *Importer) importFilm(film catalog.Film) error {
+	if film.ExternalId == "" || film.Title == "" {
+		imp.conflict(KindFilm, film.ExternalId, "external_id and title are required")
+		return nil
+	}
+	if _, ok := imp.films[film.ExternalId]; ok {
+		imp.conflict(KindFilm, film.ExternalId, "duplicate external id in dump")
+		return nil
+	}
+
+	genres := []uint64{}
+	for _, externalId := range film.Genres {
+		id, found, err := imp.resolveGenre(externalId)
+		if err != nil {
+			return err
+		}
+		if !found {
+			imp.conflict(KindFilm, film.ExternalId, "unknown genre %s", externalId)
+			return nil
+		}
+		genres = append(genres, id)
+	}
+
+	current, other, err := claim(
+		func() (*catalog.Film, error) { return imp.repo.FindFilm(film.ExternalId) },
+		func() (*catalog.Film, error) { return imp.repo.FindFilmByTitle(film.Title) },
+		func(f *catalog.Film) string { return f.ExternalId })
+	if err != nil {
+		return err
+	}
+	if other != "" {
+		imp.conflict(KindFilm, film.ExternalId, "title %q already belongs to film %s", film.Title, other)
+		return nil
+	}
+
+	if current == nil {
+		imp.created(KindFilm, film.ExternalId, fmt.Sprintf("%q genres %s", film.Title, strings.Join(film.Genres, " ")))
+		if imp.dryRun {
+			imp.films[film.ExternalId] = 0
+			return nil
+		}
+		id, err := imp.repo.CreateFilm(film)
+		if err != nil {
+			return err
+		}
+		imp.films[film.ExternalId] = id
+		if len(genres) == 0 {
+			return nil
+		}
+		return imp.repo.AddFilmGenres(id, genres)
+	}
+
+	imp.films[film.ExternalId] = current.Id
+	merged := merge(*current, film)
+	diff := changes(*current, merged)
+
+	var add, remove []uint64
+	if len(film.Genres) != 0 {
+		existing, err := imp.repo.GetFilmGenres(current.Id)
+		if err != nil {
+			return err
+		}
+		var names []string
+		add, remove, names = syncGenres(existing, genres, film.Genres)
+		if len(names) != 0 {
+			diff = append(diff, "genres "+strings.Join(names, " "))
+		}
+	}
+
+	if !imp.updated(KindFilm, film.ExternalId, diff) || imp.dryRun {
+		return nil
+	}
+	if !reflect.DeepEqual(*current, merged) {
+		err = imp.repo.UpdateFilm(merged)
+		if err != nil {
+			return err
+		}
+	}
+	if len(add) != 0 {
+		err = imp.repo.AddFilmGenres(current.Id, add)
+		if err != nil {
+			return err
+		}
+	}
+	if len(remove) != 0 {
+		return imp.repo.RemoveFilmGenres(current.Id, remove)
+	}
+
+	return nil
+}
+
+func (imp *Importer) importCredit(credit catalog.Credit) error {
+	key := credit.Film + "/" + credit.Person + "/" + credit.Profession
+	if credit.Film == "" || credit.Person == "" || credit.Profession == "" {
+		imp.conflict(KindCredit, key, "film, person and profession are required")
+		return nil
+	}
+
+	filmId, found, err := imp.resolveFilm(credit.Film)
+	if err != nil {
+		return err
+	}
+	if !found {
+		imp.conflict(KindCredit, key, "unknown film %s", credit.Film)
+		return nil
+	}
+	personId, found, err := imp.resolvePerson(credit.Person)
+	if err != nil {
+		return err
+	}
+	if !found {
+		imp.conflict(KindCredit, key, "unknown person %s", credit.Person)
+		return nil
+	}
+	professionId, err := imp.resolveProfession(credit.Profession)
+	if err != nil {
+		return err
+	}
+	if professionId == 0 {
+		imp.conflict(KindCredit, key, "unknown profession %q", credit.Profession)
+		return nil
+	}
+
+	var current *string
+	if filmId != 0 && personId != 0 {
+		current, err = imp.repo.FindCredit(filmId, personId, professionId)
+		if err != nil {
+			return err
+		}
+	}
+
+	if current == nil {
+		imp.created(KindCredit, key, fmt.Sprintf("%q", credit.Character))
+		if imp.dryRun {
+			return nil
+		}
+		return imp.repo.CreateCredit(filmId, personId, professionId, credit.Character)
+	}
+
+	var diff []string
+	if credit.Character != "" && credit.Character != *current {
+		diff = append(diff, fmt.Sprintf("character %q -> %q", *current, credit.Character))
+	}
+	if imp.updated(KindCredit, key, diff) && !imp.dryRun {
+		return imp.repo.UpdateCredit(filmId, personId, professionId, credit.Character)
+	}
+
+	return nil
+}
+
+func (imp *Importer) importCalendarDate(date catalog.CalendarDate) error {
+	if date.Month < 1 || date.Month > 12 || date.Day < 1 || date.Day > 31 {
+		imp.conflict(KindCalendar, date.Film, "bad date %d.%d", date.Day, date.Month)
+		return nil
+	}
+
+	filmId, found, err := imp.resolveFilm(date.Film)
+	if err != nil {
+		return err
+	}
+	if !found {
+		imp.conflict(KindCalendar, date.Film, "unknown film %s", date.Film)
+		return nil
+	}
+
+	var current *catalog.CalendarDate
+	if filmId != 0 {
+		current, err = imp.repo.FindCalendarDate(filmId)
+		if err != nil {
+			return err
+		}
+	}
+
+	if current == nil {
+		imp.created(KindCalendar, date.Film, fmt.Sprintf("%02d.%02d", date.Day, date.Month))
+		if imp.dryRun {
+			return nil
+		}
+		return imp.repo.CreateCalendarDate(filmId, date)
+	}
+
+	var diff []string
+	if current.Month != date.Month || current.Day != date.Day {
+		diff = append(diff, fmt.Sprintf("date %02d.%02d -> %02d.%02d", current.Day, current.Month, date.Day, date.Month))
+	}
+	if imp.updated(KindCalendar, date.Film, diff) && !imp.dryRun {
+		return imp.repo.UpdateCalendarDate(filmId, date)
+	}
+
+	return nil
+}
+
+// resolve returns the id of a record imported in this run or already in the
+// database. A dry run reports 0 for the records it would create.
+func resolve[T any](seen map[string]uint64, externalId string, find func(string) (*T, error), id func(*T) uint64) (uint64, bool, error) {
+	if id, ok := seen[externalId]; ok {
+		return id, true, nil
+	}
+
+	current, err := find(externalId)
+	if err != nil || current == nil {
+		return 0, false, err
+	}
+	seen[externalId] = id(current)
+
+	return id(current), true, nil
+}
+
+func (imp *Importer) resolveGenre(externalId string) (uint64, bool, error) {
+	return resolve(imp.genres, externalId, imp.repo.FindGenre, func(g *catalog.Genre) uint64 { return g.Id })
+}
+
+func (imp *Importer) resolvePerson(externalId string) (uint64, bool, error) {
+	return resolve(imp.people, externalId, imp.repo.FindPerson, func(p *catalog.Person) uint64 { return p.Id })
+}
+
+func (imp *Importer) resolveFilm(externalId string) (uint64, bool, error) {
+	return resolve(imp.films, externalId, imp.repo.FindFilm, func(f *catalog.Film) uint64 { return f.Id })
+}
+
+func (imp *Importer) resolveProfession(title string) (uint64, error) {
+	if id, ok := imp.professions[title]; ok {
+		return id, nil
+	}
+
+	id, err := imp.repo.GetProfessionId(title)
+	if err != nil {
+		return 0, err
+	}
+	imp.professions[title] = id
+
+	return id, nil
+}
+
+// merge takes the fields set in the dump over the stored ones. Empty fields
+// in the dump keep the stored value, like a partial edit does. Genres are
+// synced separately.
+func merge[T any](current T, incoming T) T {
+	result := current
+	target := reflect.ValueOf(&result).Elem()
+	source := reflect.ValueOf(incoming)
+
+	for i := 0; i < source.NumField(); i++ {
+		name := target.Type().Field(i).Name
+		value := source.Field(i)
+		if name == "Id" || name == "Genres" || value.IsZero() || value.Kind() == reflect.Slice && value.Len() == 0 {
+			continue
+		}
+		target.Field(i).Set(value)
+	}
+
+	return result
+}
+
+func changes[T any](current T, merged T) []string {
+	var result []string
+	before := reflect.ValueOf(current)
+	after := reflect.ValueOf(merged)
+
+	for i := 0; i < before.NumField(); i++ {
+		field := before.Type().Field(i)
+		if field.Name == "Genres" || reflect.DeepEqual(before.Field(i).Interface(), after.Field(i).Interface()) {
+			continue
+		}
+		result = append(result, fmt.Sprintf("%s %s -> %s", fieldName(field),
+			show(before.Field(i).Interface()), show(after.Field(i).Interface())))
+	}
+
+	return result
+}
+
+func show(value any) string {
+	if s, ok := value.(string); ok {
+		return fmt.Sprintf("%q", s)
+	}
+
+	return fmt.Sprintf("%v", value)
+}
+
+// syncGenres lists the genre links to add and remove. Genres are named by
+// external id when added and by id when removed, since only the ids of the
+// stored links are known.
+func syncGenres(existing []uint64, wanted []uint64, externalIds []string) ([]uint64, []uint64, []string) {
+	var add, remove []uint64
+	var names []string
+	for i, id := range wanted {
+		if id != 0 && (slices.Contains(existing, id) || slices.Contains(add, id)) {
+			continue
+		}
+		add = append(add, id)
+		names = append(names, "+"+externalIds[i])
+	}
+	for _, id := range existing {
+		if !slices.Contains(wanted, id) {
+			remove = append(remove, id)
+			names = append(names, fmt.Sprintf("-#%d", id))
+		}
+	}
+
+	return add, remove, names
+}
@@ -0,0 +1,194 @@
+package importer
+
+import (
+	"bytes"
+	"fmt"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/go-park-mail-ru/2023_2_Vkladyshi/films/mocks"
+	"github.com/go-park-mail-ru/2023_2_Vkladyshi/films/repository/catalog"
+	"github.com/golang/mock/gomock"
+)
+
+func TestRunCreate(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	genre := catalog.Genre{ExternalId: "drama", Title: "Драма"}
+	person := catalog.Person{ExternalId: "nm1", Name: "Марлон Брандо", BirthDate: "1924-04-03"}
+	film := catalog.Film{ExternalId: "tt1", Title: "Крёстный отец", Genres: []string{"drama"}}
+	credit := catalog.Credit{Film: "tt1", Person: "nm1", Profession: "актёр", Character: "Вито Корлеоне"}
+	date := catalog.CalendarDate{Film: "tt1", Month: 3, Day: 24}
+
+	repo := mocks.NewMockICatalogRepo(mockCtrl)
+	repo.EXPECT().FindGenre("drama").Return(nil, nil)
+	repo.EXPECT().FindGenreByTitle("Драма").Return(nil, nil)
+	repo.EXPECT().CreateGenre(genre).Return(uint64(1), nil)
+	repo.EXPECT().FindPerson("nm1").Return(nil, nil)
+	repo.EXPECT().FindPersonByName("Марлон Брандо", "1924-04-03").Return(nil, nil)
+	repo.EXPECT().CreatePerson(person).Return(uint64(2), nil)
+	repo.EXPECT().FindFilm("tt1").Return(nil, nil)
+	repo.EXPECT().FindFilmByTitle("Крёстный отец").Return(nil, nil)
+	repo.EXPECT().CreateFilm(film).Return(uint64(3), nil)
+	repo.EXPECT().AddFilmGenres(uint64(3), []uint64{1}).Return(nil)
+	repo.EXPECT().GetProfessionId("актёр").Return(uint64(4), nil)
+	repo.EXPECT().FindCredit(uint64(3), uint64(2), uint64(4)).Return(nil, nil)
+	repo.EXPECT().CreateCredit(uint64(3), uint64(2), uint64(4), "Вито Корлеоне").Return(nil)
+	repo.EXPECT().FindCalendarDate(uint64(3)).Return(nil, nil)
+	repo.EXPECT().CreateCalendarDate(uint64(3), date).Return(nil)
+
+	var out bytes.Buffer
+	report, err := New(repo, &out, false).Run(Dump{
+		Genres:   []catalog.Genre{genre},
+		People:   []catalog.Person{person},
+		Films:    []catalog.Film{film},
+		Credits:  []catalog.Credit{credit},
+		Calendar: []catalog.CalendarDate{date},
+	})
+	if err != nil {
+		t.Errorf("Run error: %s", err)
+		return
+	}
+
+	expect := Report{Created: 5}
+	if !reflect.DeepEqual(*report, expect) {
+		t.Errorf("report not match, want %v, have %v", expect, *report)
+	}
+	if strings.Count(out.String(), "\n+ ") != 4 || !strings.HasPrefix(out.String(), "+ genre drama") {
+		t.Errorf("unexpected diff:\n%s", out.String())
+	}
+}
+
+func TestRunUpdate(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	stored := catalog.Film{Id: 3, ExternalId: "tt1", Title: "Крёстный отец", Country: "США", Runtime: 170}
+	incoming := catalog.Film{ExternalId: "tt1", Title: "Крёстный отец", Runtime: 175, Genres: []string{"drama", "crime"}}
+	merged := stored
+	merged.Runtime = 175
+
+	repo := mocks.NewMockICatalogRepo(mockCtrl)
+	repo.EXPECT().FindGenre("drama").Return(&catalog.Genre{Id: 1, ExternalId: "drama", Title: "Драма"}, nil)
+	repo.EXPECT().FindGenre("crime").Return(&catalog.Genre{Id: 2, ExternalId: "crime", Title: "Криминал"}, nil)
+	repo.EXPECT().FindFilm("tt1").Return(&stored, nil)
+	repo.EXPECT().GetFilmGenres(uint64(3)).Return([]uint64{1, 5}, nil)
+	repo.EXPECT().UpdateFilm(merged).Return(nil)
+	repo.EXPECT().AddFilmGenres(uint64(3), []uint64{2}).Return(nil)
+	repo.EXPECT().RemoveFilmGenres(uint64(3), []uint64{5}).Return(nil)
+	repo.EXPECT().GetProfessionId("актёр").Return(uint64(4), nil)
+	repo.EXPECT().FindPerson("nm1").Return(&catalog.Person{Id: 2, ExternalId: "nm1"}, nil)
+	character := "Вито Корлеоне"
+	repo.EXPECT().FindCredit(uint64(3), uint64(2), uint64(4)).Return(&character, nil)
+
+	var out bytes.Buffer
+	report, err := New(repo, &out, false).Run(Dump{
+		Films:   []catalog.Film{incoming},
+		Credits: []catalog.Credit{{Film: "tt1", Person: "nm1", Profession: "актёр", Character: character}},
+	})
+	if err != nil {
+		t.Errorf("Run error: %s", err)
+		return
+	}
+
+	expect := Report{Updated: 1, Unchanged: 1}
+	if !reflect.DeepEqual(*report, expect) {
+		t.Errorf("report not match, want %v, have %v", expect, *report)
+	}
+	expectDiff := "~ film tt1 runtime 170 -> 175, genres +crime -#5\n"
+	if out.String() != expectDiff {
+		t.Errorf("diff not match, want %q, have %q", expectDiff, out.String())
+	}
+}
+
+func TestRunConflicts(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	repo := mocks.NewMockICatalogRepo(mockCtrl)
+	repo.EXPECT().FindGenre("drama").Return(nil, nil)
+	repo.EXPECT().FindGenreByTitle("Драма").Return(&catalog.Genre{Id: 1, ExternalId: "tmdb-18", Title: "Драма"}, nil)
+	repo.EXPECT().FindFilm("tt1").Return(nil, nil)
+	repo.EXPECT().FindGenre("western").Return(nil, nil)
+
+	var out bytes.Buffer
+	report, err := New(repo, &out, false).Run(Dump{
+		Genres: []catalog.Genre{{ExternalId: "drama", Title: "Драма"}, {ExternalId: "noir"}},
+		Films:  []catalog.Film{{ExternalId: "tt2", Title: "Дилижанс", Genres: []string{"western"}}},
+		Credits: []catalog.Credit{
+			{Film: "tt1", Person: "nm1", Profession: "актёр"},
+		},
+		Calendar: []catalog.CalendarDate{{Film: "tt1", Month: 13, Day: 1}},
+	})
+	if err != nil {
+		t.Errorf("Run error: %s", err)
+		return
+	}
+
+	expect := []Conflict{
+		{Kind: KindGenre, Key: "drama", Reason: `title "Драма" already belongs to genre tmdb-18`},
+		{Kind: KindGenre, Key: "noir", Reason: "external_id and title are required"},
+		{Kind: KindFilm, Key: "tt2", Reason: "unknown genre western"},
+		{Kind: KindCredit, Key: "tt1/nm1/актёр", Reason: "unknown film tt1"},
+		{Kind: KindCalendar, Key: "tt1", Reason: "bad date 1.13"},
+	}
+	if !reflect.DeepEqual(report.Conflicts, expect) {
+		t.Errorf("conflicts not match, want %v, have %v", expect, report.Conflicts)
+	}
+	if report.Created != 0 || report.Updated != 0 {
+		t.Errorf("nothing must be written, have %v", *report)
+	}
+}
+
+func TestRunDryRun(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	repo := mocks.NewMockICatalogRepo(mockCtrl)
+	repo.EXPECT().FindGenre("drama").Return(nil, nil)
+	repo.EXPECT().FindGenreByTitle("Драма").Return(nil, nil)
+	repo.EXPECT().FindFilm("tt1").Return(nil, nil)
+	repo.EXPECT().FindFilmByTitle("Крёстный отец").Return(nil, nil)
+	repo.EXPECT().FindPerson("nm1").Return(&catalog.Person{Id: 2, ExternalId: "nm1"}, nil)
+	repo.EXPECT().GetProfessionId("режиссёр").Return(uint64(5), nil)
+
+	var out bytes.Buffer
+	report, err := New(repo, &out, true).Run(Dump{
+		Genres:  []catalog.Genre{{ExternalId: "drama", Title: "Драма"}},
+		Films:   []catalog.Film{{ExternalId: "tt1", Title: "Крёстный отец", Genres: []string{"drama"}}},
+		Credits: []catalog.Credit{{Film: "tt1", Person: "nm1", Profession: "режиссёр"}},
+	})
+	if err != nil {
+		t.Errorf("Run error: %s", err)
+		return
+	}
+
+	if report.Created != 3 {
+		t.Errorf("expected 3 created, have %d", report.Created)
+	}
+	expectDiff := "+ genre drama \"Драма\"\n" +
+		"+ film tt1 \"Крёстный отец\" genres drama\n" +
+		"+ credit tt1/nm1/режиссёр \"\"\n"
+	if out.String() != expectDiff {
+		t.Errorf("diff not match, want %q, have %q", expectDiff, out.String())
+	}
+}
+
+func TestRunRepoError(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	repo := mocks.NewMockICatalogRepo(mockCtrl)
+	repo.EXPECT().FindPerson("nm1").Return(nil, fmt.Errorf("db_error"))
+
+	var out bytes.Buffer
+	_, err := New(repo, &out, false).Run(Dump{
+		People: []catalog.Person{{ExternalId: "nm1", Name: "Марлон Брандо"}},
+		Films:  []catalog.Film{{ExternalId: "tt1", Title: "Крёстный отец"}},
+	})
+	if err == nil {
+		t.Errorf("expected error, got nil")
+	}
+}
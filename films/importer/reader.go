@@ -0,0 +1,171 @@
+package importer
+
+import (
+	"bufio"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"path/filepath"
+	"reflect"
+	"strconv"
+	"strings"
+
+	"github.com/mailru/easyjson"
+)
+
+// listSeparator splits list cells such as genres or languages in CSV dumps,
+// where commas already separate the columns.
+const listSeparator = ";"
+
+var ErrFormat = errors.New("unknown dump format")
+
+type Format int
+
+const (
+	JSONLines Format = iota
+	CSV
+)
+
+func FormatOf(path string) (Format, error) {
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".jsonl", ".ndjson":
+		return JSONLines, nil
+	case ".csv":
+		return CSV, nil
+	default:
+		return 0, fmt.Errorf("%w: %s", ErrFormat, path)
+	}
+}
+
+type record[T any] interface {
+	*T
+	easyjson.Unmarshaler
+}
+
+// Read decodes a dump of records. JSON Lines dumps hold one object per line,
+// CSV dumps have a header row named after the same JSON fields.
+func Read[T any, P record[T]](r io.Reader, format Format) ([]T, error) {
+	switch format {
+	case JSONLines:
+		return readJSONLines[T, P](r)
+	case CSV:
+		return readCSV[T](r)
+	default:
+		return nil, ErrFormat
+	}
+}
+
+func readJSONLines[T any, P record[T]](r io.Reader) ([]T, error) {
+	result := []T{}
+
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 64*1024), 16*1024*1024)
+	line := 0
+	for scanner.Scan() {
+		line++
+		text := strings.TrimSpace(scanner.Text())
+		if text == "" {
+			continue
+		}
+
+		var item T
+		err := easyjson.Unmarshal([]byte(text), P(&item))
+		if err != nil {
+			return nil, fmt.Errorf("line %d: %w", line, err)
+		}
+		result = append(result, item)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("read dump err: %w", err)
+	}
+
+	return result, nil
+}
+
+func readCSV[T any](r io.Reader) ([]T, error) {
+	reader := csv.NewReader(r)
+	reader.TrimLeadingSpace = true
+
+	header, err := reader.Read()
+	if err != nil {
+		if errors.Is(err, io.EOF) {
+			return []T{}, nil
+		}
+		return nil, fmt.Errorf("read dump header err: %w", err)
+	}
+
+	fields := map[string]int{}
+	itemType := reflect.TypeOf((*T)(nil)).Elem()
+	for i := 0; i < itemType.NumField(); i++ {
+		fields[fieldName(itemType.Field(i))] = i
+	}
+
+	columns := make([]int, len(header))
+	for i, name := range header {
+		field, ok := fields[strings.TrimSpace(name)]
+		if !ok || name == "-" {
+			return nil, fmt.Errorf("unknown column %q", name)
+		}
+		columns[i] = field
+	}
+
+	result := []T{}
+	for line := 2; ; line++ {
+		row, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			return result, nil
+		}
+		if err != nil {
+			return nil, fmt.Errorf("line %d: %w", line, err)
+		}
+
+		var item T
+		value := reflect.ValueOf(&item).Elem()
+		for i, cell := range row {
+			err := setField(value.Field(columns[i]), strings.TrimSpace(cell))
+			if err != nil {
+				return nil, fmt.Errorf("line %d, column %s: %w", line, header[i], err)
+			}
+		}
+		result = append(result, item)
+	}
+}
+
+func fieldName(field reflect.StructField) string {
+	name, _, _ := strings.Cut(field.Tag.Get("json"), ",")
+	if name == "" {
+		return field.Name
+	}
+
+	return name
+}
+
+func setField(field reflect.Value, cell string) error {
+	if cell == "" {
+		return nil
+	}
+
+	switch field.Kind() {
+	case reflect.String:
+		field.SetString(cell)
+	case reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		number, err := strconv.ParseUint(cell, 10, field.Type().Bits())
+		if err != nil {
+			return err
+		}
+		field.SetUint(number)
+	case reflect.Slice:
+		list := []string{}
+		for _, item := range strings.Split(cell, listSeparator) {
+			if item = strings.TrimSpace(item); item != "" {
+				list = append(list, item)
+			}
+		}
+		field.Set(reflect.ValueOf(list))
+	default:
+		return fmt.Errorf("unsupported field type %s", field.Type())
+	}
+
+	return nil
+}
@@ -0,0 +1,97 @@
+package importer
+
+import (
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/go-park-mail-ru/2023_2_Vkladyshi/films/repository/catalog"
+)
+
+func TestFormatOf(t *testing.T) {
+	testCases := map[string]struct {
+		path   string
+		format Format
+		err    error
+	}{
+		"jsonl":   {path: "films.jsonl", format: JSONLines},
+		"ndjson":  {path: "dump/films.NDJSON", format: JSONLines},
+		"csv":     {path: "films.csv", format: CSV},
+		"unknown": {path: "films.xml", err: ErrFormat},
+	}
+
+	for name, curr := range testCases {
+		format, err := FormatOf(curr.path)
+		if !errors.Is(err, curr.err) {
+			t.Errorf("%s: unexpected error %v, want %v", name, err, curr.err)
+			continue
+		}
+		if err == nil && format != curr.format {
+			t.Errorf("%s: format not match, want %v, have %v", name, curr.format, format)
+		}
+	}
+}
+
+func TestReadFilms(t *testing.T) {
+	expect := []catalog.Film{
+		{
+			ExternalId: "tt0133093",
+			Title:      "Матрица",
+			Runtime:    136,
+			Languages:  []string{"en", "ru"},
+			Genres:     []string{"action", "sci-fi"},
+		},
+		{
+			ExternalId: "tt0068646",
+			Title:      "Крёстный отец, часть 1",
+			Budget:     6000000,
+		},
+	}
+
+	jsonl := `{"external_id":"tt0133093","title":"Матрица","runtime":136,"languages":["en","ru"],"genres":["action","sci-fi"]}
+
+{"external_id":"tt0068646","title":"Крёстный отец, часть 1","budget":6000000}
+`
+	csv := `external_id,title,runtime,budget,languages,genres
+tt0133093,Матрица,136,,en;ru,action; sci-fi
+tt0068646,"Крёстный отец, часть 1",,6000000,,
+`
+
+	testCases := map[string]struct {
+		dump   string
+		format Format
+	}{
+		"jsonl": {dump: jsonl, format: JSONLines},
+		"csv":   {dump: csv, format: CSV},
+	}
+
+	for name, curr := range testCases {
+		films, err := Read[catalog.Film](strings.NewReader(curr.dump), curr.format)
+		if err != nil {
+			t.Errorf("%s: Read error: %s", name, err)
+			continue
+		}
+		if !reflect.DeepEqual(films, expect) {
+			t.Errorf("%s: results not match, want %v, have %v", name, expect, films)
+		}
+	}
+}
+
+func TestReadErrors(t *testing.T) {
+	testCases := map[string]struct {
+		dump   string
+		format Format
+	}{
+		"bad json":       {dump: "{\"title\":\n", format: JSONLines},
+		"unknown column": {dump: "external_id,rating\ng1,5\n", format: CSV},
+		"bad number":     {dump: "film,month,day\nf1,may,1\n", format: CSV},
+	}
+
+	for name, curr := range testCases {
+		_, err := Read[catalog.CalendarDate](strings.NewReader(curr.dump), curr.format)
+		if err == nil {
+			t.Errorf("%s: expected error, got nil", name)
+		}
+	}
+}
@@ -0,0 +1,95 @@
+-- Baseline of the films database as it was built by hand before migrations.
+-- Every statement is IF NOT EXISTS, so running it against such a database
+-- only records the version.
+
+CREATE TABLE IF NOT EXISTS genre (
+    id    SERIAL PRIMARY KEY,
+    title TEXT NOT NULL
+);
+
+CREATE TABLE IF NOT EXISTS film (
+    id           SERIAL PRIMARY KEY,
+    title        TEXT NOT NULL,
+    info         TEXT NOT NULL DEFAULT '',
+    poster       TEXT NOT NULL DEFAULT '',
+    release_date DATE,
+    country      TEXT NOT NULL DEFAULT '',
+    mpaa         TEXT NOT NULL DEFAULT '',
+    fts          TSVECTOR GENERATED ALWAYS AS (to_tsvector('russian', title)) STORED
+);
+
+CREATE INDEX IF NOT EXISTS film_fts_idx ON film USING GIN (fts);
+CREATE INDEX IF NOT EXISTS film_title_idx ON film (title);
+CREATE INDEX IF NOT EXISTS film_release_date_idx ON film (release_date);
+
+CREATE TABLE IF NOT EXISTS films_genre (
+    id_film  INTEGER NOT NULL REFERENCES film (id) ON DELETE CASCADE,
+    id_genre INTEGER NOT NULL REFERENCES genre (id) ON DELETE CASCADE,
+    PRIMARY KEY (id_film, id_genre)
+);
+
+CREATE INDEX IF NOT EXISTS films_genre_genre_idx ON films_genre (id_genre);
+
+CREATE TABLE IF NOT EXISTS crew (
+    id         SERIAL PRIMARY KEY,
+    name       TEXT NOT NULL,
+    birth_date DATE,
+    photo      TEXT NOT NULL DEFAULT '',
+    info       TEXT NOT NULL DEFAULT ''
+);
+
+CREATE INDEX IF NOT EXISTS crew_name_idx ON crew (name);
+
+CREATE TABLE IF NOT EXISTS profession (
+    id    SERIAL PRIMARY KEY,
+    title TEXT NOT NULL UNIQUE
+);
+
+-- The crew queries look these up by title.
+INSERT INTO profession (title) VALUES ('актёр'), ('режиссёр'), ('сценарист')
+ON CONFLICT (title) DO NOTHING;
+
+CREATE TABLE IF NOT EXISTS person_in_film (
+    id_film        INTEGER NOT NULL REFERENCES film (id) ON DELETE CASCADE,
+    id_person      INTEGER NOT NULL REFERENCES crew (id) ON DELETE CASCADE,
+    id_profession  INTEGER NOT NULL REFERENCES profession (id),
+    character_name TEXT NOT NULL DEFAULT '',
+    PRIMARY KEY (id_film, id_person, id_profession)
+);
+
+CREATE INDEX IF NOT EXISTS person_in_film_person_idx ON person_in_film (id_person);
+
+CREATE TABLE IF NOT EXISTS calendar (
+    id            INTEGER PRIMARY KEY REFERENCES film (id) ON DELETE CASCADE,
+    release_month SMALLINT NOT NULL CHECK (release_month BETWEEN 1 AND 12),
+    release_day   SMALLINT NOT NULL CHECK (release_day BETWEEN 1 AND 31)
+);
+
+CREATE INDEX IF NOT EXISTS calendar_month_idx ON calendar (release_month, release_day);
+
+-- Ratings are read from the comments table, which the films service shares
+-- with the comments service. id_user refers to the auth database, so there
+-- is no foreign key for it.
+CREATE TABLE IF NOT EXISTS users_comment (
+    id_user INTEGER NOT NULL,
+    id_film INTEGER NOT NULL,
+    rating  SMALLINT NOT NULL,
+    comment TEXT NOT NULL DEFAULT '',
+    date    TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (id_user, id_film)
+);
+
+CREATE INDEX IF NOT EXISTS users_comment_film_idx ON users_comment (id_film);
+CREATE INDEX IF NOT EXISTS users_comment_date_idx ON users_comment (date);
+
+CREATE TABLE IF NOT EXISTS users_favorite_film (
+    id_user INTEGER NOT NULL,
+    id_film INTEGER NOT NULL REFERENCES film (id) ON DELETE CASCADE,
+    PRIMARY KEY (id_user, id_film)
+);
+
+CREATE TABLE IF NOT EXISTS users_favorite_actor (
+    id_user  INTEGER NOT NULL,
+    id_actor INTEGER NOT NULL REFERENCES crew (id) ON DELETE CASCADE,
+    PRIMARY KEY (id_user, id_actor)
+);
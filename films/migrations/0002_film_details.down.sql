@@ -0,0 +1,9 @@
+ALTER TABLE film
+    DROP COLUMN IF EXISTS kinopoisk_id,
+    DROP COLUMN IF EXISTS imdb_id,
+    DROP COLUMN IF EXISTS trailers,
+    DROP COLUMN IF EXISTS languages,
+    DROP COLUMN IF EXISTS box_office,
+    DROP COLUMN IF EXISTS budget,
+    DROP COLUMN IF EXISTS runtime,
+    DROP COLUMN IF EXISTS original_title;
@@ -0,0 +1,9 @@
+ALTER TABLE film
+    ADD COLUMN IF NOT EXISTS original_title TEXT,
+    ADD COLUMN IF NOT EXISTS runtime        INTEGER CHECK (runtime >= 0),
+    ADD COLUMN IF NOT EXISTS budget         BIGINT CHECK (budget >= 0),
+    ADD COLUMN IF NOT EXISTS box_office     BIGINT CHECK (box_office >= 0),
+    ADD COLUMN IF NOT EXISTS languages      TEXT[] NOT NULL DEFAULT '{}',
+    ADD COLUMN IF NOT EXISTS trailers       TEXT[] NOT NULL DEFAULT '{}',
+    ADD COLUMN IF NOT EXISTS imdb_id        TEXT,
+    ADD COLUMN IF NOT EXISTS kinopoisk_id   TEXT;
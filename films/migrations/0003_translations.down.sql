@@ -0,0 +1,3 @@
+DROP TABLE IF EXISTS genre_translation;
+DROP TABLE IF EXISTS person_translation;
+DROP TABLE IF EXISTS film_translation;
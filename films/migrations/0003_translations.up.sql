@@ -0,0 +1,22 @@
+CREATE TABLE IF NOT EXISTS film_translation (
+    id_film INTEGER NOT NULL REFERENCES film (id) ON DELETE CASCADE,
+    lang    TEXT NOT NULL,
+    title   TEXT NOT NULL,
+    info    TEXT,
+    PRIMARY KEY (id_film, lang)
+);
+
+CREATE TABLE IF NOT EXISTS person_translation (
+    id_person INTEGER NOT NULL REFERENCES crew (id) ON DELETE CASCADE,
+    lang      TEXT NOT NULL,
+    name      TEXT NOT NULL,
+    info      TEXT,
+    PRIMARY KEY (id_person, lang)
+);
+
+CREATE TABLE IF NOT EXISTS genre_translation (
+    id_genre INTEGER NOT NULL REFERENCES genre (id) ON DELETE CASCADE,
+    lang     TEXT NOT NULL,
+    title    TEXT NOT NULL,
+    PRIMARY KEY (id_genre, lang)
+);
@@ -0,0 +1 @@
+DROP INDEX IF EXISTS film_poster_idx;
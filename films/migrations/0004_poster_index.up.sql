@@ -0,0 +1,2 @@
+-- Storage cleanup counts the films still using a poster before deleting it.
+CREATE INDEX IF NOT EXISTS film_poster_idx ON film (poster);
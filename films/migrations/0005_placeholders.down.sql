@@ -0,0 +1,9 @@
+ALTER TABLE crew
+    DROP COLUMN IF EXISTS photo_accent,
+    DROP COLUMN IF EXISTS photo_color,
+    DROP COLUMN IF EXISTS photo_blurhash;
+
+ALTER TABLE film
+    DROP COLUMN IF EXISTS poster_accent,
+    DROP COLUMN IF EXISTS poster_color,
+    DROP COLUMN IF EXISTS poster_blurhash;
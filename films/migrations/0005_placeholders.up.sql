@@ -0,0 +1,9 @@
+ALTER TABLE film
+    ADD COLUMN IF NOT EXISTS poster_blurhash TEXT,
+    ADD COLUMN IF NOT EXISTS poster_color    TEXT,
+    ADD COLUMN IF NOT EXISTS poster_accent   TEXT;
+
+ALTER TABLE crew
+    ADD COLUMN IF NOT EXISTS photo_blurhash TEXT,
+    ADD COLUMN IF NOT EXISTS photo_color    TEXT,
+    ADD COLUMN IF NOT EXISTS photo_accent   TEXT;
@@ -0,0 +1,5 @@
+ALTER TABLE crew
+    DROP COLUMN IF EXISTS country,
+    DROP COLUMN IF EXISTS external_id;
+ALTER TABLE film DROP COLUMN IF EXISTS external_id;
+ALTER TABLE genre DROP COLUMN IF EXISTS external_id;
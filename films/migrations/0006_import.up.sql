@@ -0,0 +1,6 @@
+-- The importer upserts records by the id they have in the dump.
+ALTER TABLE genre ADD COLUMN IF NOT EXISTS external_id TEXT UNIQUE;
+ALTER TABLE film ADD COLUMN IF NOT EXISTS external_id TEXT UNIQUE;
+ALTER TABLE crew
+    ADD COLUMN IF NOT EXISTS external_id TEXT UNIQUE,
+    ADD COLUMN IF NOT EXISTS country     TEXT;
@@ -35,16 +35,16 @@ func (m *MockICalendarRepo) EXPECT() *MockICalendarRepoMockRecorder {
 }
 
 // GetCalendar mocks base method.
-func (m *MockICalendarRepo) GetCalendar() ([]models.DayItem, error) {
+func (m *MockICalendarRepo) GetCalendar(langs []string) ([]models.DayItem, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetCalendar")
+	ret := m.ctrl.Call(m, "GetCalendar", langs)
 	ret0, _ := ret[0].([]models.DayItem)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetCalendar indicates an expected call of GetCalendar.
-func (mr *MockICalendarRepoMockRecorder) GetCalendar() *gomock.Call {
+func (mr *MockICalendarRepoMockRecorder) GetCalendar(langs interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCalendar", reflect.TypeOf((*MockICalendarRepo)(nil).GetCalendar))
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCalendar", reflect.TypeOf((*MockICalendarRepo)(nil).GetCalendar), langs)
 }
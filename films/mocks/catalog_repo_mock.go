@@ -0,0 +1,356 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: repo_catalog.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	reflect "reflect"
+
+	catalog "github.com/go-park-mail-ru/2023_2_Vkladyshi/films/repository/catalog"
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockICatalogRepo is a mock of ICatalogRepo interface.
+type MockICatalogRepo struct {
+	ctrl     *gomock.Controller
+	recorder *MockICatalogRepoMockRecorder
+}
+
+// MockICatalogRepoMockRecorder is the mock recorder for MockICatalogRepo.
+type MockICatalogRepoMockRecorder struct {
+	mock *MockICatalogRepo
+}
+
+// NewMockICatalogRepo creates a new mock instance.
+func NewMockICatalogRepo(ctrl *gomock.Controller) *MockICatalogRepo {
+	mock := &MockICatalogRepo{ctrl: ctrl}
+	mock.recorder = &MockICatalogRepoMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockICatalogRepo) EXPECT() *MockICatalogRepoMockRecorder {
+	return m.recorder
+}
+
+// AddFilmGenres mocks base method.
+func (m *MockICatalogRepo) AddFilmGenres(filmId uint64, genres []uint64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddFilmGenres", filmId, genres)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddFilmGenres indicates an expected call of AddFilmGenres.
+func (mr *MockICatalogRepoMockRecorder) AddFilmGenres(filmId, genres interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddFilmGenres", reflect.TypeOf((*MockICatalogRepo)(nil).AddFilmGenres), filmId, genres)
+}
+
+// CreateCalendarDate mocks base method.
+func (m *MockICatalogRepo) CreateCalendarDate(filmId uint64, date catalog.CalendarDate) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateCalendarDate", filmId, date)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateCalendarDate indicates an expected call of CreateCalendarDate.
+func (mr *MockICatalogRepoMockRecorder) CreateCalendarDate(filmId, date interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateCalendarDate", reflect.TypeOf((*MockICatalogRepo)(nil).CreateCalendarDate), filmId, date)
+}
+
+// CreateCredit mocks base method.
+func (m *MockICatalogRepo) CreateCredit(filmId, personId, professionId uint64, character string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateCredit", filmId, personId, professionId, character)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateCredit indicates an expected call of CreateCredit.
+func (mr *MockICatalogRepoMockRecorder) CreateCredit(filmId, personId, professionId, character interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateCredit", reflect.TypeOf((*MockICatalogRepo)(nil).CreateCredit), filmId, personId, professionId, character)
+}
+
+// CreateFilm mocks base method.
+func (m *MockICatalogRepo) CreateFilm(film catalog.Film) (uint64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateFilm", film)
+	ret0, _ := ret[0].(uint64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateFilm indicates an expected call of CreateFilm.
+func (mr *MockICatalogRepoMockRecorder) CreateFilm(film interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateFilm", reflect.TypeOf((*MockICatalogRepo)(nil).CreateFilm), film)
+}
+
+// CreateGenre mocks base method.
+func (m *MockICatalogRepo) CreateGenre(genre catalog.Genre) (uint64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateGenre", genre)
+	ret0, _ := ret[0].(uint64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateGenre indicates an expected call of CreateGenre.
+func (mr *MockICatalogRepoMockRecorder) CreateGenre(genre interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateGenre", reflect.TypeOf((*MockICatalogRepo)(nil).CreateGenre), genre)
+}
+
+// CreatePerson mocks base method.
+func (m *MockICatalogRepo) CreatePerson(person catalog.Person) (uint64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreatePerson", person)
+	ret0, _ := ret[0].(uint64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreatePerson indicates an expected call of CreatePerson.
+func (mr *MockICatalogRepoMockRecorder) CreatePerson(person interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreatePerson", reflect.TypeOf((*MockICatalogRepo)(nil).CreatePerson), person)
+}
+
+// FindCalendarDate mocks base method.
+func (m *MockICatalogRepo) FindCalendarDate(filmId uint64) (*catalog.CalendarDate, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindCalendarDate", filmId)
+	ret0, _ := ret[0].(*catalog.CalendarDate)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindCalendarDate indicates an expected call of FindCalendarDate.
+func (mr *MockICatalogRepoMockRecorder) FindCalendarDate(filmId interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindCalendarDate", reflect.TypeOf((*MockICatalogRepo)(nil).FindCalendarDate), filmId)
+}
+
+// FindCredit mocks base method.
+func (m *MockICatalogRepo) FindCredit(filmId, personId, professionId uint64) (*string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindCredit", filmId, personId, professionId)
+	ret0, _ := ret[0].(*string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindCredit indicates an expected call of FindCredit.
+func (mr *MockICatalogRepoMockRecorder) FindCredit(filmId, personId, professionId interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindCredit", reflect.TypeOf((*MockICatalogRepo)(nil).FindCredit), filmId, personId, professionId)
+}
+
+// FindFilm mocks base method.
+func (m *MockICatalogRepo) FindFilm(externalId string) (*catalog.Film, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindFilm", externalId)
+	ret0, _ := ret[0].(*catalog.Film)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindFilm indicates an expected call of FindFilm.
+func (mr *MockICatalogRepoMockRecorder) FindFilm(externalId interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindFilm", reflect.TypeOf((*MockICatalogRepo)(nil).FindFilm), externalId)
+}
+
+// FindFilmByTitle mocks base method.
+func (m *MockICatalogRepo) FindFilmByTitle(title string) (*catalog.Film, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindFilmByTitle", title)
+	ret0, _ := ret[0].(*catalog.Film)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindFilmByTitle indicates an expected call of FindFilmByTitle.
+func (mr *MockICatalogRepoMockRecorder) FindFilmByTitle(title interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindFilmByTitle", reflect.TypeOf((*MockICatalogRepo)(nil).FindFilmByTitle), title)
+}
+
+// FindGenre mocks base method.
+func (m *MockICatalogRepo) FindGenre(externalId string) (*catalog.Genre, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindGenre", externalId)
+	ret0, _ := ret[0].(*catalog.Genre)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindGenre indicates an expected call of FindGenre.
+func (mr *MockICatalogRepoMockRecorder) FindGenre(externalId interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindGenre", reflect.TypeOf((*MockICatalogRepo)(nil).FindGenre), externalId)
+}
+
+// FindGenreByTitle mocks base method.
+func (m *MockICatalogRepo) FindGenreByTitle(title string) (*catalog.Genre, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindGenreByTitle", title)
+	ret0, _ := ret[0].(*catalog.Genre)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindGenreByTitle indicates an expected call of FindGenreByTitle.
+func (mr *MockICatalogRepoMockRecorder) FindGenreByTitle(title interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindGenreByTitle", reflect.TypeOf((*MockICatalogRepo)(nil).FindGenreByTitle), title)
+}
+
+// FindPerson mocks base method.
+func (m *MockICatalogRepo) FindPerson(externalId string) (*catalog.Person, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindPerson", externalId)
+	ret0, _ := ret[0].(*catalog.Person)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindPerson indicates an expected call of FindPerson.
+func (mr *MockICatalogRepoMockRecorder) FindPerson(externalId interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindPerson", reflect.TypeOf((*MockICatalogRepo)(nil).FindPerson), externalId)
+}
+
+// FindPersonByName mocks base method.
+func (m *MockICatalogRepo) FindPersonByName(name, birthDate string) (*catalog.Person, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindPersonByName", name, birthDate)
+	ret0, _ := ret[0].(*catalog.Person)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindPersonByName indicates an expected call of FindPersonByName.
+func (mr *MockICatalogRepoMockRecorder) FindPersonByName(name, birthDate interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindPersonByName", reflect.TypeOf((*MockICatalogRepo)(nil).FindPersonByName), name, birthDate)
+}
+
+// GetFilmGenres mocks base method.
+func (m *MockICatalogRepo) GetFilmGenres(filmId uint64) ([]uint64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetFilmGenres", filmId)
+	ret0, _ := ret[0].([]uint64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetFilmGenres indicates an expected call of GetFilmGenres.
+func (mr *MockICatalogRepoMockRecorder) GetFilmGenres(filmId interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFilmGenres", reflect.TypeOf((*MockICatalogRepo)(nil).GetFilmGenres), filmId)
+}
+
+// GetProfessionId mocks base method.
+func (m *MockICatalogRepo) GetProfessionId(title string) (uint64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetProfessionId", title)
+	ret0, _ := ret[0].(uint64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetProfessionId indicates an expected call of GetProfessionId.
+func (mr *MockICatalogRepoMockRecorder) GetProfessionId(title interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetProfessionId", reflect.TypeOf((*MockICatalogRepo)(nil).GetProfessionId), title)
+}
+
+// RemoveFilmGenres mocks base method.
+func (m *MockICatalogRepo) RemoveFilmGenres(filmId uint64, genres []uint64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveFilmGenres", filmId, genres)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RemoveFilmGenres indicates an expected call of RemoveFilmGenres.
+func (mr *MockICatalogRepoMockRecorder) RemoveFilmGenres(filmId, genres interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveFilmGenres", reflect.TypeOf((*MockICatalogRepo)(nil).RemoveFilmGenres), filmId, genres)
+}
+
+// UpdateCalendarDate mocks base method.
+func (m *MockICatalogRepo) UpdateCalendarDate(filmId uint64, date catalog.CalendarDate) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateCalendarDate", filmId, date)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateCalendarDate indicates an expected call of UpdateCalendarDate.
+func (mr *MockICatalogRepoMockRecorder) UpdateCalendarDate(filmId, date interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateCalendarDate", reflect.TypeOf((*MockICatalogRepo)(nil).UpdateCalendarDate), filmId, date)
+}
+
+// UpdateCredit mocks base method.
+func (m *MockICatalogRepo) UpdateCredit(filmId, personId, professionId uint64, character string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateCredit", filmId, personId, professionId, character)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateCredit indicates an expected call of UpdateCredit.
+func (mr *MockICatalogRepoMockRecorder) UpdateCredit(filmId, personId, professionId, character interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateCredit", reflect.TypeOf((*MockICatalogRepo)(nil).UpdateCredit), filmId, personId, professionId, character)
+}
+
+// UpdateFilm mocks base method.
+func (m *MockICatalogRepo) UpdateFilm(film catalog.Film) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateFilm", film)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateFilm indicates an expected call of UpdateFilm.
+func (mr *MockICatalogRepoMockRecorder) UpdateFilm(film interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateFilm", reflect.TypeOf((*MockICatalogRepo)(nil).UpdateFilm), film)
+}
+
+// UpdateGenre mocks base method.
+func (m *MockICatalogRepo) UpdateGenre(genre catalog.Genre) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateGenre", genre)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateGenre indicates an expected call of UpdateGenre.
+func (mr *MockICatalogRepoMockRecorder) UpdateGenre(genre interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateGenre", reflect.TypeOf((*MockICatalogRepo)(nil).UpdateGenre), genre)
+}
+
+// UpdatePerson mocks base method.
+func (m *MockICatalogRepo) UpdatePerson(person catalog.Person) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdatePerson", person)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdatePerson indicates an expected call of UpdatePerson.
+func (mr *MockICatalogRepoMockRecorder) UpdatePerson(person interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdatePerson", reflect.TypeOf((*MockICatalogRepo)(nil).UpdatePerson), person)
+}
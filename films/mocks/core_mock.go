@@ -9,6 +9,8 @@ import (
 	slog "log/slog"
 	reflect "reflect"
 
+	images "github.com/go-park-mail-ru/2023_2_Vkladyshi/pkg/images"
+	middleware "github.com/go-park-mail-ru/2023_2_Vkladyshi/pkg/middleware"
 	models "github.com/go-park-mail-ru/2023_2_Vkladyshi/pkg/models"
 	requests "github.com/go-park-mail-ru/2023_2_Vkladyshi/pkg/requests"
 	gomock "github.com/golang/mock/gomock"
@@ -37,6 +39,21 @@ func (m *MockICore) EXPECT() *MockICoreMockRecorder {
 	return m.recorder
 }
 
+// ActorsPath mocks base method.
+func (m *MockICore) ActorsPath(from, to uint64) (*requests.ActorsPathResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ActorsPath", from, to)
+	ret0, _ := ret[0].(*requests.ActorsPathResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ActorsPath indicates an expected call of ActorsPath.
+func (mr *MockICoreMockRecorder) ActorsPath(from, to interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ActorsPath", reflect.TypeOf((*MockICore)(nil).ActorsPath), from, to)
+}
+
 // AddFilm mocks base method.
 func (m *MockICore) AddFilm(film models.FilmItem, genres, actors []uint64) error {
 	m.ctrl.T.Helper()
@@ -81,6 +98,35 @@ func (mr *MockICoreMockRecorder) AddRating(filmId, userId, rating interface{}) *
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddRating", reflect.TypeOf((*MockICore)(nil).AddRating), filmId, userId, rating)
 }
 
+// AddTranslation mocks base method.
+func (m *MockICore) AddTranslation(entity string, translation models.Translation) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddTranslation", entity, translation)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddTranslation indicates an expected call of AddTranslation.
+func (mr *MockICoreMockRecorder) AddTranslation(entity, translation interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddTranslation", reflect.TypeOf((*MockICore)(nil).AddTranslation), entity, translation)
+}
+
+// Collaborators mocks base method.
+func (m *MockICore) Collaborators(actorId, limit uint64) ([]models.Collaborator, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Collaborators", actorId, limit)
+	ret0, _ := ret[0].([]models.Collaborator)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Collaborators indicates an expected call of Collaborators.
+func (mr *MockICoreMockRecorder) Collaborators(actorId, limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Collaborators", reflect.TypeOf((*MockICore)(nil).Collaborators), actorId, limit)
+}
+
 // DeleteRating mocks base method.
 func (m *MockICore) DeleteRating(idUser, idFilm uint64) error {
 	m.ctrl.T.Helper()
@@ -95,6 +141,20 @@ func (mr *MockICoreMockRecorder) DeleteRating(idUser, idFilm interface{}) *gomoc
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteRating", reflect.TypeOf((*MockICore)(nil).DeleteRating), idUser, idFilm)
 }
 
+// EditFilm mocks base method.
+func (m *MockICore) EditFilm(edit models.FilmEdit) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EditFilm", edit)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// EditFilm indicates an expected call of EditFilm.
+func (mr *MockICoreMockRecorder) EditFilm(edit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EditFilm", reflect.TypeOf((*MockICore)(nil).EditFilm), edit)
+}
+
 // FavoriteActors mocks base method.
 func (m *MockICore) FavoriteActors(userId, start, end uint64) ([]models.Character, error) {
 	m.ctrl.T.Helper()
@@ -138,19 +198,34 @@ func (mr *MockICoreMockRecorder) FavoriteActorsRemove(userId, filmId interface{}
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FavoriteActorsRemove", reflect.TypeOf((*MockICore)(nil).FavoriteActorsRemove), userId, filmId)
 }
 
+// FavoriteFilmIds mocks base method.
+func (m *MockICore) FavoriteFilmIds(userId uint64, filmIds []uint64) ([]uint64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FavoriteFilmIds", userId, filmIds)
+	ret0, _ := ret[0].([]uint64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FavoriteFilmIds indicates an expected call of FavoriteFilmIds.
+func (mr *MockICoreMockRecorder) FavoriteFilmIds(userId, filmIds interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FavoriteFilmIds", reflect.TypeOf((*MockICore)(nil).FavoriteFilmIds), userId, filmIds)
+}
+
 // FavoriteFilms mocks base method.
-func (m *MockICore) FavoriteFilms(userId, start, end uint64) ([]models.FilmItem, error) {
+func (m *MockICore) FavoriteFilms(langs []string, userId, start, end uint64) ([]models.FilmItem, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "FavoriteFilms", userId, start, end)
+	ret := m.ctrl.Call(m, "FavoriteFilms", langs, userId, start, end)
 	ret0, _ := ret[0].([]models.FilmItem)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // FavoriteFilms indicates an expected call of FavoriteFilms.
-func (mr *MockICoreMockRecorder) FavoriteFilms(userId, start, end interface{}) *gomock.Call {
+func (mr *MockICoreMockRecorder) FavoriteFilms(langs, userId, start, end interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FavoriteFilms", reflect.TypeOf((*MockICore)(nil).FavoriteFilms), userId, start, end)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FavoriteFilms", reflect.TypeOf((*MockICore)(nil).FavoriteFilms), langs, userId, start, end)
 }
 
 // FavoriteFilmsAdd mocks base method.
@@ -181,6 +256,21 @@ func (mr *MockICoreMockRecorder) FavoriteFilmsRemove(userId, filmId interface{})
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FavoriteFilmsRemove", reflect.TypeOf((*MockICore)(nil).FavoriteFilmsRemove), userId, filmId)
 }
 
+// FilmExists mocks base method.
+func (m *MockICore) FilmExists(filmId uint64) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FilmExists", filmId)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FilmExists indicates an expected call of FilmExists.
+func (mr *MockICoreMockRecorder) FilmExists(filmId interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FilmExists", reflect.TypeOf((*MockICore)(nil).FilmExists), filmId)
+}
+
 // FindActor mocks base method.
 func (m *MockICore) FindActor(name, birthDate string, films, career []string, country string, first, limit uint64) ([]models.Character, error) {
 	m.ctrl.T.Helper()
@@ -197,33 +287,33 @@ func (mr *MockICoreMockRecorder) FindActor(name, birthDate, films, career, count
 }
 
 // FindFilm mocks base method.
-func (m *MockICore) FindFilm(title, dateFrom, dateTo string, ratingFrom, ratingTo float32, mpaa string, genres []uint32, actors []string, first, limit uint64) ([]models.FilmItem, error) {
+func (m *MockICore) FindFilm(langs []string, title, dateFrom, dateTo string, ratingFrom, ratingTo float32, mpaa string, genres []uint32, actors []string, runtimeFrom, runtimeTo uint32, language string, first, limit uint64) ([]models.FilmItem, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "FindFilm", title, dateFrom, dateTo, ratingFrom, ratingTo, mpaa, genres, actors, first, limit)
+	ret := m.ctrl.Call(m, "FindFilm", langs, title, dateFrom, dateTo, ratingFrom, ratingTo, mpaa, genres, actors, runtimeFrom, runtimeTo, language, first, limit)
 	ret0, _ := ret[0].([]models.FilmItem)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // FindFilm indicates an expected call of FindFilm.
-func (mr *MockICoreMockRecorder) FindFilm(title, dateFrom, dateTo, ratingFrom, ratingTo, mpaa, genres, actors, first, limit interface{}) *gomock.Call {
+func (mr *MockICoreMockRecorder) FindFilm(langs, title, dateFrom, dateTo, ratingFrom, ratingTo, mpaa, genres, actors, runtimeFrom, runtimeTo, language, first, limit interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindFilm", reflect.TypeOf((*MockICore)(nil).FindFilm), title, dateFrom, dateTo, ratingFrom, ratingTo, mpaa, genres, actors, first, limit)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindFilm", reflect.TypeOf((*MockICore)(nil).FindFilm), langs, title, dateFrom, dateTo, ratingFrom, ratingTo, mpaa, genres, actors, runtimeFrom, runtimeTo, language, first, limit)
 }
 
 // GetActorInfo mocks base method.
-func (m *MockICore) GetActorInfo(actorId uint64) (*requests.ActorResponse, error) {
+func (m *MockICore) GetActorInfo(langs []string, actorId uint64, sortBy string, first, limit uint64) (*requests.ActorResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetActorInfo", actorId)
+	ret := m.ctrl.Call(m, "GetActorInfo", langs, actorId, sortBy, first, limit)
 	ret0, _ := ret[0].(*requests.ActorResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetActorInfo indicates an expected call of GetActorInfo.
-func (mr *MockICoreMockRecorder) GetActorInfo(actorId interface{}) *gomock.Call {
+func (mr *MockICoreMockRecorder) GetActorInfo(langs, actorId, sortBy, first, limit interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetActorInfo", reflect.TypeOf((*MockICore)(nil).GetActorInfo), actorId)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetActorInfo", reflect.TypeOf((*MockICore)(nil).GetActorInfo), langs, actorId, sortBy, first, limit)
 }
 
 // GetActorsCareer mocks base method.
@@ -242,39 +332,69 @@ func (mr *MockICoreMockRecorder) GetActorsCareer(actorId interface{}) *gomock.Ca
 }
 
 // GetCalendar mocks base method.
-func (m *MockICore) GetCalendar() (*requests.CalendarResponse, error) {
+func (m *MockICore) GetCalendar(langs []string) (*requests.CalendarResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetCalendar")
+	ret := m.ctrl.Call(m, "GetCalendar", langs)
 	ret0, _ := ret[0].(*requests.CalendarResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetCalendar indicates an expected call of GetCalendar.
-func (mr *MockICoreMockRecorder) GetCalendar() *gomock.Call {
+func (mr *MockICoreMockRecorder) GetCalendar(langs interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCalendar", reflect.TypeOf((*MockICore)(nil).GetCalendar))
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCalendar", reflect.TypeOf((*MockICore)(nil).GetCalendar), langs)
+}
+
+// GetFilmCards mocks base method.
+func (m *MockICore) GetFilmCards(langs []string, ids []uint64) ([]models.FilmItem, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetFilmCards", langs, ids)
+	ret0, _ := ret[0].([]models.FilmItem)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetFilmCards indicates an expected call of GetFilmCards.
+func (mr *MockICoreMockRecorder) GetFilmCards(langs, ids interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFilmCards", reflect.TypeOf((*MockICore)(nil).GetFilmCards), langs, ids)
 }
 
 // GetFilmInfo mocks base method.
-func (m *MockICore) GetFilmInfo(filmId uint64) (*requests.FilmResponse, error) {
+func (m *MockICore) GetFilmInfo(langs []string, filmId uint64) (*requests.FilmResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetFilmInfo", filmId)
+	ret := m.ctrl.Call(m, "GetFilmInfo", langs, filmId)
 	ret0, _ := ret[0].(*requests.FilmResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetFilmInfo indicates an expected call of GetFilmInfo.
-func (mr *MockICoreMockRecorder) GetFilmInfo(filmId interface{}) *gomock.Call {
+func (mr *MockICoreMockRecorder) GetFilmInfo(langs, filmId interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFilmInfo", reflect.TypeOf((*MockICore)(nil).GetFilmInfo), langs, filmId)
+}
+
+// GetFilmPage mocks base method.
+func (m *MockICore) GetFilmPage(ctx context.Context, langs []string, filmId, userId uint64) (*requests.FilmPageResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetFilmPage", ctx, langs, filmId, userId)
+	ret0, _ := ret[0].(*requests.FilmPageResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetFilmPage indicates an expected call of GetFilmPage.
+func (mr *MockICoreMockRecorder) GetFilmPage(ctx, langs, filmId, userId interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFilmInfo", reflect.TypeOf((*MockICore)(nil).GetFilmInfo), filmId)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFilmPage", reflect.TypeOf((*MockICore)(nil).GetFilmPage), ctx, langs, filmId, userId)
 }
 
 // GetFilmsAndGenreTitle mocks base method.
-func (m *MockICore) GetFilmsAndGenreTitle(genreId, start, end uint64) ([]models.FilmItem, string, error) {
+func (m *MockICore) GetFilmsAndGenreTitle(langs []string, genreId, start, end uint64) ([]models.FilmItem, string, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetFilmsAndGenreTitle", genreId, start, end)
+	ret := m.ctrl.Call(m, "GetFilmsAndGenreTitle", langs, genreId, start, end)
 	ret0, _ := ret[0].([]models.FilmItem)
 	ret1, _ := ret[1].(string)
 	ret2, _ := ret[2].(error)
@@ -282,9 +402,9 @@ func (m *MockICore) GetFilmsAndGenreTitle(genreId, start, end uint64) ([]models.
 }
 
 // GetFilmsAndGenreTitle indicates an expected call of GetFilmsAndGenreTitle.
-func (mr *MockICoreMockRecorder) GetFilmsAndGenreTitle(genreId, start, end interface{}) *gomock.Call {
+func (mr *MockICoreMockRecorder) GetFilmsAndGenreTitle(langs, genreId, start, end interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFilmsAndGenreTitle", reflect.TypeOf((*MockICore)(nil).GetFilmsAndGenreTitle), genreId, start, end)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFilmsAndGenreTitle", reflect.TypeOf((*MockICore)(nil).GetFilmsAndGenreTitle), langs, genreId, start, end)
 }
 
 // GetGenre mocks base method.
@@ -303,18 +423,18 @@ func (mr *MockICoreMockRecorder) GetGenre(genreId interface{}) *gomock.Call {
 }
 
 // GetLastSeen mocks base method.
-func (m *MockICore) GetLastSeen(arg0 []models.NearFilm) ([]models.FilmItem, error) {
+func (m *MockICore) GetLastSeen(langs []string, films []models.NearFilm) ([]models.FilmItem, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetLastSeen", arg0)
+	ret := m.ctrl.Call(m, "GetLastSeen", langs, films)
 	ret0, _ := ret[0].([]models.FilmItem)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetLastSeen indicates an expected call of GetLastSeen.
-func (mr *MockICoreMockRecorder) GetLastSeen(arg0 interface{}) *gomock.Call {
+func (mr *MockICoreMockRecorder) GetLastSeen(langs, films interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLastSeen", reflect.TypeOf((*MockICore)(nil).GetLastSeen), arg0)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLastSeen", reflect.TypeOf((*MockICore)(nil).GetLastSeen), langs, films)
 }
 
 // GetNearFilms mocks base method.
@@ -332,34 +452,49 @@ func (mr *MockICoreMockRecorder) GetNearFilms(ctx, userId, lg interface{}) *gomo
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetNearFilms", reflect.TypeOf((*MockICore)(nil).GetNearFilms), ctx, userId, lg)
 }
 
-// GetUserId mocks base method.
-func (m *MockICore) GetUserId(ctx context.Context, sid string) (uint64, error) {
+// ReplacePoster mocks base method.
+func (m *MockICore) ReplacePoster(ctx context.Context, filmId uint64, img *images.Image) (string, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetUserId", ctx, sid)
-	ret0, _ := ret[0].(uint64)
+	ret := m.ctrl.Call(m, "ReplacePoster", ctx, filmId, img)
+	ret0, _ := ret[0].(string)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetUserId indicates an expected call of GetUserId.
-func (mr *MockICoreMockRecorder) GetUserId(ctx, sid interface{}) *gomock.Call {
+// ReplacePoster indicates an expected call of ReplacePoster.
+func (mr *MockICoreMockRecorder) ReplacePoster(ctx, filmId, img interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserId", reflect.TypeOf((*MockICore)(nil).GetUserId), ctx, sid)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReplacePoster", reflect.TypeOf((*MockICore)(nil).ReplacePoster), ctx, filmId, img)
+}
+
+// SavePoster mocks base method.
+func (m *MockICore) SavePoster(ctx context.Context, img *images.Image) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SavePoster", ctx, img)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SavePoster indicates an expected call of SavePoster.
+func (mr *MockICoreMockRecorder) SavePoster(ctx, img interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SavePoster", reflect.TypeOf((*MockICore)(nil).SavePoster), ctx, img)
 }
 
 // Trends mocks base method.
-func (m *MockICore) Trends() ([]models.FilmItem, error) {
+func (m *MockICore) Trends(langs []string) ([]models.FilmItem, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Trends")
+	ret := m.ctrl.Call(m, "Trends", langs)
 	ret0, _ := ret[0].([]models.FilmItem)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // Trends indicates an expected call of Trends.
-func (mr *MockICoreMockRecorder) Trends() *gomock.Call {
+func (mr *MockICoreMockRecorder) Trends(langs interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Trends", reflect.TypeOf((*MockICore)(nil).Trends))
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Trends", reflect.TypeOf((*MockICore)(nil).Trends), langs)
 }
 
 // UsersStatistics mocks base method.
@@ -376,3 +511,18 @@ func (mr *MockICoreMockRecorder) UsersStatistics(idUser interface{}) *gomock.Cal
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UsersStatistics", reflect.TypeOf((*MockICore)(nil).UsersStatistics), idUser)
 }
+
+// ValidateSession mocks base method.
+func (m *MockICore) ValidateSession(ctx context.Context, sid string) (*middleware.Principal, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ValidateSession", ctx, sid)
+	ret0, _ := ret[0].(*middleware.Principal)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ValidateSession indicates an expected call of ValidateSession.
+func (mr *MockICoreMockRecorder) ValidateSession(ctx, sid interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ValidateSession", reflect.TypeOf((*MockICore)(nil).ValidateSession), ctx, sid)
+}
@@ -107,6 +107,66 @@ func (mr *MockICrewRepoMockRecorder) GetActor(actorId interface{}) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetActor", reflect.TypeOf((*MockICrewRepo)(nil).GetActor), actorId)
 }
 
+// GetActorFilms mocks base method.
+func (m *MockICrewRepo) GetActorFilms(actorId uint64, sortBy string, first, limit uint64) ([]models.FilmographyItem, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetActorFilms", actorId, sortBy, first, limit)
+	ret0, _ := ret[0].([]models.FilmographyItem)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetActorFilms indicates an expected call of GetActorFilms.
+func (mr *MockICrewRepoMockRecorder) GetActorFilms(actorId, sortBy, first, limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetActorFilms", reflect.TypeOf((*MockICrewRepo)(nil).GetActorFilms), actorId, sortBy, first, limit)
+}
+
+// GetActorFilmsCount mocks base method.
+func (m *MockICrewRepo) GetActorFilmsCount(actorId uint64) (map[string]uint64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetActorFilmsCount", actorId)
+	ret0, _ := ret[0].(map[string]uint64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetActorFilmsCount indicates an expected call of GetActorFilmsCount.
+func (mr *MockICrewRepoMockRecorder) GetActorFilmsCount(actorId interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetActorFilmsCount", reflect.TypeOf((*MockICrewRepo)(nil).GetActorFilmsCount), actorId)
+}
+
+// GetActorKnownFor mocks base method.
+func (m *MockICrewRepo) GetActorKnownFor(actorId, limit uint64) ([]models.FilmItem, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetActorKnownFor", actorId, limit)
+	ret0, _ := ret[0].([]models.FilmItem)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetActorKnownFor indicates an expected call of GetActorKnownFor.
+func (mr *MockICrewRepoMockRecorder) GetActorKnownFor(actorId, limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetActorKnownFor", reflect.TypeOf((*MockICrewRepo)(nil).GetActorKnownFor), actorId, limit)
+}
+
+// GetCrewLinks mocks base method.
+func (m *MockICrewRepo) GetCrewLinks() ([]models.CrewLink, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCrewLinks")
+	ret0, _ := ret[0].([]models.CrewLink)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCrewLinks indicates an expected call of GetCrewLinks.
+func (mr *MockICrewRepoMockRecorder) GetCrewLinks() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCrewLinks", reflect.TypeOf((*MockICrewRepo)(nil).GetCrewLinks))
+}
+
 // GetFavoriteActors mocks base method.
 func (m *MockICrewRepo) GetFavoriteActors(userId, start, end uint64) ([]models.Character, error) {
 	m.ctrl.T.Helper()
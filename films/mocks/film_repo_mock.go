@@ -91,6 +91,21 @@ func (mr *MockIFilmsRepoMockRecorder) CheckFilm(userId, filmId interface{}) *gom
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CheckFilm", reflect.TypeOf((*MockIFilmsRepo)(nil).CheckFilm), userId, filmId)
 }
 
+// CountPosterUsage mocks base method.
+func (m *MockIFilmsRepo) CountPosterUsage(poster string) (uint64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountPosterUsage", poster)
+	ret0, _ := ret[0].(uint64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountPosterUsage indicates an expected call of CountPosterUsage.
+func (mr *MockIFilmsRepoMockRecorder) CountPosterUsage(poster interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountPosterUsage", reflect.TypeOf((*MockIFilmsRepo)(nil).CountPosterUsage), poster)
+}
+
 // DeleteRating mocks base method.
 func (m *MockIFilmsRepo) DeleteRating(idUser, idFilm uint64) error {
 	m.ctrl.T.Helper()
@@ -105,19 +120,33 @@ func (mr *MockIFilmsRepoMockRecorder) DeleteRating(idUser, idFilm interface{}) *
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteRating", reflect.TypeOf((*MockIFilmsRepo)(nil).DeleteRating), idUser, idFilm)
 }
 
+// EditFilm mocks base method.
+func (m *MockIFilmsRepo) EditFilm(edit models.FilmEdit) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EditFilm", edit)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// EditFilm indicates an expected call of EditFilm.
+func (mr *MockIFilmsRepoMockRecorder) EditFilm(edit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EditFilm", reflect.TypeOf((*MockIFilmsRepo)(nil).EditFilm), edit)
+}
+
 // FindFilm mocks base method.
-func (m *MockIFilmsRepo) FindFilm(title, dateFrom, dateTo string, ratingFrom, ratingTo float32, mpaa string, genres []uint32, actors []string, first, limit uint64) ([]models.FilmItem, error) {
+func (m *MockIFilmsRepo) FindFilm(title, dateFrom, dateTo string, ratingFrom, ratingTo float32, mpaa string, genres []uint32, actors []string, runtimeFrom, runtimeTo uint32, language string, first, limit uint64) ([]models.FilmItem, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "FindFilm", title, dateFrom, dateTo, ratingFrom, ratingTo, mpaa, genres, actors, first, limit)
+	ret := m.ctrl.Call(m, "FindFilm", title, dateFrom, dateTo, ratingFrom, ratingTo, mpaa, genres, actors, runtimeFrom, runtimeTo, language, first, limit)
 	ret0, _ := ret[0].([]models.FilmItem)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // FindFilm indicates an expected call of FindFilm.
-func (mr *MockIFilmsRepoMockRecorder) FindFilm(title, dateFrom, dateTo, ratingFrom, ratingTo, mpaa, genres, actors, first, limit interface{}) *gomock.Call {
+func (mr *MockIFilmsRepoMockRecorder) FindFilm(title, dateFrom, dateTo, ratingFrom, ratingTo, mpaa, genres, actors, runtimeFrom, runtimeTo, language, first, limit interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindFilm", reflect.TypeOf((*MockIFilmsRepo)(nil).FindFilm), title, dateFrom, dateTo, ratingFrom, ratingTo, mpaa, genres, actors, first, limit)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindFilm", reflect.TypeOf((*MockIFilmsRepo)(nil).FindFilm), title, dateFrom, dateTo, ratingFrom, ratingTo, mpaa, genres, actors, runtimeFrom, runtimeTo, language, first, limit)
 }
 
 // GetFavoriteFilms mocks base method.
@@ -211,6 +240,21 @@ func (mr *MockIFilmsRepoMockRecorder) GetFilmsByGenre(genre, start, end interfac
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFilmsByGenre", reflect.TypeOf((*MockIFilmsRepo)(nil).GetFilmsByGenre), genre, start, end)
 }
 
+// GetFilmsByIds mocks base method.
+func (m *MockIFilmsRepo) GetFilmsByIds(ids []uint64) ([]models.FilmItem, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetFilmsByIds", ids)
+	ret0, _ := ret[0].([]models.FilmItem)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetFilmsByIds indicates an expected call of GetFilmsByIds.
+func (mr *MockIFilmsRepoMockRecorder) GetFilmsByIds(ids interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFilmsByIds", reflect.TypeOf((*MockIFilmsRepo)(nil).GetFilmsByIds), ids)
+}
+
 // GetLasts mocks base method.
 func (m *MockIFilmsRepo) GetLasts(ids []uint64) ([]models.FilmItem, error) {
 	m.ctrl.T.Helper()
@@ -0,0 +1,124 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: repo_placeholder.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	reflect "reflect"
+
+	placeholder "github.com/go-park-mail-ru/2023_2_Vkladyshi/films/repository/placeholder"
+	models "github.com/go-park-mail-ru/2023_2_Vkladyshi/pkg/models"
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockIPlaceholderRepo is a mock of IPlaceholderRepo interface.
+type MockIPlaceholderRepo struct {
+	ctrl     *gomock.Controller
+	recorder *MockIPlaceholderRepoMockRecorder
+}
+
+// MockIPlaceholderRepoMockRecorder is the mock recorder for MockIPlaceholderRepo.
+type MockIPlaceholderRepoMockRecorder struct {
+	mock *MockIPlaceholderRepo
+}
+
+// NewMockIPlaceholderRepo creates a new mock instance.
+func NewMockIPlaceholderRepo(ctrl *gomock.Controller) *MockIPlaceholderRepo {
+	mock := &MockIPlaceholderRepo{ctrl: ctrl}
+	mock.recorder = &MockIPlaceholderRepoMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIPlaceholderRepo) EXPECT() *MockIPlaceholderRepoMockRecorder {
+	return m.recorder
+}
+
+// GetFilmImages mocks base method.
+func (m *MockIPlaceholderRepo) GetFilmImages(after, limit uint64, missingOnly bool) ([]placeholder.Image, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetFilmImages", after, limit, missingOnly)
+	ret0, _ := ret[0].([]placeholder.Image)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetFilmImages indicates an expected call of GetFilmImages.
+func (mr *MockIPlaceholderRepoMockRecorder) GetFilmImages(after, limit, missingOnly interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFilmImages", reflect.TypeOf((*MockIPlaceholderRepo)(nil).GetFilmImages), after, limit, missingOnly)
+}
+
+// GetFilmPlaceholders mocks base method.
+func (m *MockIPlaceholderRepo) GetFilmPlaceholders(ids []uint64) (map[uint64]models.Placeholder, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetFilmPlaceholders", ids)
+	ret0, _ := ret[0].(map[uint64]models.Placeholder)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetFilmPlaceholders indicates an expected call of GetFilmPlaceholders.
+func (mr *MockIPlaceholderRepoMockRecorder) GetFilmPlaceholders(ids interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFilmPlaceholders", reflect.TypeOf((*MockIPlaceholderRepo)(nil).GetFilmPlaceholders), ids)
+}
+
+// GetPersonImages mocks base method.
+func (m *MockIPlaceholderRepo) GetPersonImages(after, limit uint64, missingOnly bool) ([]placeholder.Image, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPersonImages", after, limit, missingOnly)
+	ret0, _ := ret[0].([]placeholder.Image)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPersonImages indicates an expected call of GetPersonImages.
+func (mr *MockIPlaceholderRepoMockRecorder) GetPersonImages(after, limit, missingOnly interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPersonImages", reflect.TypeOf((*MockIPlaceholderRepo)(nil).GetPersonImages), after, limit, missingOnly)
+}
+
+// GetPersonPlaceholders mocks base method.
+func (m *MockIPlaceholderRepo) GetPersonPlaceholders(ids []uint64) (map[uint64]models.Placeholder, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPersonPlaceholders", ids)
+	ret0, _ := ret[0].(map[uint64]models.Placeholder)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPersonPlaceholders indicates an expected call of GetPersonPlaceholders.
+func (mr *MockIPlaceholderRepoMockRecorder) GetPersonPlaceholders(ids interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPersonPlaceholders", reflect.TypeOf((*MockIPlaceholderRepo)(nil).GetPersonPlaceholders), ids)
+}
+
+// SetFilmPlaceholder mocks base method.
+func (m *MockIPlaceholderRepo) SetFilmPlaceholder(id uint64, placeholder models.Placeholder) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetFilmPlaceholder", id, placeholder)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetFilmPlaceholder indicates an expected call of SetFilmPlaceholder.
+func (mr *MockIPlaceholderRepoMockRecorder) SetFilmPlaceholder(id, placeholder interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetFilmPlaceholder", reflect.TypeOf((*MockIPlaceholderRepo)(nil).SetFilmPlaceholder), id, placeholder)
+}
+
+// SetPersonPlaceholder mocks base method.
+func (m *MockIPlaceholderRepo) SetPersonPlaceholder(id uint64, placeholder models.Placeholder) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetPersonPlaceholder", id, placeholder)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetPersonPlaceholder indicates an expected call of SetPersonPlaceholder.
+func (mr *MockIPlaceholderRepoMockRecorder) SetPersonPlaceholder(id, placeholder interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetPersonPlaceholder", reflect.TypeOf((*MockIPlaceholderRepo)(nil).SetPersonPlaceholder), id, placeholder)
+}
@@ -0,0 +1,94 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: repo_translation.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	reflect "reflect"
+
+	models "github.com/go-park-mail-ru/2023_2_Vkladyshi/pkg/models"
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockITranslationRepo is a mock of ITranslationRepo interface.
+type MockITranslationRepo struct {
+	ctrl     *gomock.Controller
+	recorder *MockITranslationRepoMockRecorder
+}
+
+// MockITranslationRepoMockRecorder is the mock recorder for MockITranslationRepo.
+type MockITranslationRepoMockRecorder struct {
+	mock *MockITranslationRepo
+}
+
+// NewMockITranslationRepo creates a new mock instance.
+func NewMockITranslationRepo(ctrl *gomock.Controller) *MockITranslationRepo {
+	mock := &MockITranslationRepo{ctrl: ctrl}
+	mock.recorder = &MockITranslationRepoMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockITranslationRepo) EXPECT() *MockITranslationRepoMockRecorder {
+	return m.recorder
+}
+
+// AddTranslation mocks base method.
+func (m *MockITranslationRepo) AddTranslation(entity string, translation models.Translation) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddTranslation", entity, translation)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddTranslation indicates an expected call of AddTranslation.
+func (mr *MockITranslationRepoMockRecorder) AddTranslation(entity, translation interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddTranslation", reflect.TypeOf((*MockITranslationRepo)(nil).AddTranslation), entity, translation)
+}
+
+// GetFilmTranslations mocks base method.
+func (m *MockITranslationRepo) GetFilmTranslations(ids []uint64, langs []string) ([]models.Translation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetFilmTranslations", ids, langs)
+	ret0, _ := ret[0].([]models.Translation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetFilmTranslations indicates an expected call of GetFilmTranslations.
+func (mr *MockITranslationRepoMockRecorder) GetFilmTranslations(ids, langs interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFilmTranslations", reflect.TypeOf((*MockITranslationRepo)(nil).GetFilmTranslations), ids, langs)
+}
+
+// GetGenreTranslations mocks base method.
+func (m *MockITranslationRepo) GetGenreTranslations(ids []uint64, langs []string) ([]models.Translation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetGenreTranslations", ids, langs)
+	ret0, _ := ret[0].([]models.Translation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetGenreTranslations indicates an expected call of GetGenreTranslations.
+func (mr *MockITranslationRepoMockRecorder) GetGenreTranslations(ids, langs interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetGenreTranslations", reflect.TypeOf((*MockITranslationRepo)(nil).GetGenreTranslations), ids, langs)
+}
+
+// GetPersonTranslations mocks base method.
+func (m *MockITranslationRepo) GetPersonTranslations(ids []uint64, langs []string) ([]models.Translation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPersonTranslations", ids, langs)
+	ret0, _ := ret[0].([]models.Translation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPersonTranslations indicates an expected call of GetPersonTranslations.
+func (mr *MockITranslationRepoMockRecorder) GetPersonTranslations(ids, langs interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPersonTranslations", reflect.TypeOf((*MockITranslationRepo)(nil).GetPersonTranslations), ids, langs)
+}
@@ -0,0 +1,543 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// source: films.proto
+
+package proto
+
+import (
+	fmt "fmt"
+	proto "github.com/golang/protobuf/proto"
+	math "math"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.ProtoPackageIsVersion3 // please upgrade the proto package
+
+type FilmCardsRequest struct {
+	Ids                  []uint64 `protobuf:"varint,1,rep,packed,name=ids,proto3" json:"ids,omitempty"`
+	Langs                []string `protobuf:"bytes,2,rep,name=langs,proto3" json:"langs,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *FilmCardsRequest) Reset()         { *m = FilmCardsRequest{} }
+func (m *FilmCardsRequest) String() string { return proto.CompactTextString(m) }
+func (*FilmCardsRequest) ProtoMessage()    {}
+func (*FilmCardsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2b51fa83d42468b7, []int{0}
+}
+
+func (m *FilmCardsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_FilmCardsRequest.Unmarshal(m, b)
+}
+func (m *FilmCardsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_FilmCardsRequest.Marshal(b, m, deterministic)
+}
+func (m *FilmCardsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FilmCardsRequest.Merge(m, src)
+}
+func (m *FilmCardsRequest) XXX_Size() int {
+	return xxx_messageInfo_FilmCardsRequest.Size(m)
+}
+func (m *FilmCardsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_FilmCardsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FilmCardsRequest proto.InternalMessageInfo
+
+func (m *FilmCardsRequest) GetIds() []uint64 {
+	if m != nil {
+		return m.Ids
+	}
+	return nil
+}
+
+func (m *FilmCardsRequest) GetLangs() []string {
+	if m != nil {
+		return m.Langs
+	}
+	return nil
+}
+
+type FilmCard struct {
+	Id                   uint64   `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Title                string   `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	Poster               string   `protobuf:"bytes,3,opt,name=poster,proto3" json:"poster,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *FilmCard) Reset()         { *m = FilmCard{} }
+func (m *FilmCard) String() string { return proto.CompactTextString(m) }
+func (*FilmCard) ProtoMessage()    {}
+func (*FilmCard) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2b51fa83d42468b7, []int{1}
+}
+
+func (m *FilmCard) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_FilmCard.Unmarshal(m, b)
+}
+func (m *FilmCard) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_FilmCard.Marshal(b, m, deterministic)
+}
+func (m *FilmCard) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FilmCard.Merge(m, src)
+}
+func (m *FilmCard) XXX_Size() int {
+	return xxx_messageInfo_FilmCard.Size(m)
+}
+func (m *FilmCard) XXX_DiscardUnknown() {
+	xxx_messageInfo_FilmCard.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FilmCard proto.InternalMessageInfo
+
+func (m *FilmCard) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *FilmCard) GetTitle() string {
+	if m != nil {
+		return m.Title
+	}
+	return ""
+}
+
+func (m *FilmCard) GetPoster() string {
+	if m != nil {
+		return m.Poster
+	}
+	return ""
+}
+
+type FilmCardsResponse struct {
+	Films                []*FilmCard `protobuf:"bytes,1,rep,name=films,proto3" json:"films,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
+	XXX_unrecognized     []byte      `json:"-"`
+	XXX_sizecache        int32       `json:"-"`
+}
+
+func (m *FilmCardsResponse) Reset()         { *m = FilmCardsResponse{} }
+func (m *FilmCardsResponse) String() string { return proto.CompactTextString(m) }
+func (*FilmCardsResponse) ProtoMessage()    {}
+func (*FilmCardsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2b51fa83d42468b7, []int{2}
+}
+
+func (m *FilmCardsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_FilmCardsResponse.Unmarshal(m, b)
+}
+func (m *FilmCardsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_FilmCardsResponse.Marshal(b, m, deterministic)
+}
+func (m *FilmCardsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FilmCardsResponse.Merge(m, src)
+}
+func (m *FilmCardsResponse) XXX_Size() int {
+	return xxx_messageInfo_FilmCardsResponse.Size(m)
+}
+func (m *FilmCardsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_FilmCardsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FilmCardsResponse proto.InternalMessageInfo
+
+func (m *FilmCardsResponse) GetFilms() []*FilmCard {
+	if m != nil {
+		return m.Films
+	}
+	return nil
+}
+
+type FilmExistsRequest struct {
+	FilmId               uint64   `protobuf:"varint,1,opt,name=film_id,json=filmId,proto3" json:"film_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *FilmExistsRequest) Reset()         { *m = FilmExistsRequest{} }
+func (m *FilmExistsRequest) String() string { return proto.CompactTextString(m) }
+func (*FilmExistsRequest) ProtoMessage()    {}
+func (*FilmExistsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2b51fa83d42468b7, []int{3}
+}
+
+func (m *FilmExistsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_FilmExistsRequest.Unmarshal(m, b)
+}
+func (m *FilmExistsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_FilmExistsRequest.Marshal(b, m, deterministic)
+}
+func (m *FilmExistsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FilmExistsRequest.Merge(m, src)
+}
+func (m *FilmExistsRequest) XXX_Size() int {
+	return xxx_messageInfo_FilmExistsRequest.Size(m)
+}
+func (m *FilmExistsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_FilmExistsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FilmExistsRequest proto.InternalMessageInfo
+
+func (m *FilmExistsRequest) GetFilmId() uint64 {
+	if m != nil {
+		return m.FilmId
+	}
+	return 0
+}
+
+type FilmExistsResponse struct {
+	Exists               bool     `protobuf:"varint,1,opt,name=exists,proto3" json:"exists,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *FilmExistsResponse) Reset()         { *m = FilmExistsResponse{} }
+func (m *FilmExistsResponse) String() string { return proto.CompactTextString(m) }
+func (*FilmExistsResponse) ProtoMessage()    {}
+func (*FilmExistsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2b51fa83d42468b7, []int{4}
+}
+
+func (m *FilmExistsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_FilmExistsResponse.Unmarshal(m, b)
+}
+func (m *FilmExistsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_FilmExistsResponse.Marshal(b, m, deterministic)
+}
+func (m *FilmExistsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FilmExistsResponse.Merge(m, src)
+}
+func (m *FilmExistsResponse) XXX_Size() int {
+	return xxx_messageInfo_FilmExistsResponse.Size(m)
+}
+func (m *FilmExistsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_FilmExistsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FilmExistsResponse proto.InternalMessageInfo
+
+func (m *FilmExistsResponse) GetExists() bool {
+	if m != nil {
+		return m.Exists
+	}
+	return false
+}
+
+type FavoritesRequest struct {
+	UserId               uint64   `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	FilmIds              []uint64 `protobuf:"varint,2,rep,packed,name=film_ids,json=filmIds,proto3" json:"film_ids,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *FavoritesRequest) Reset()         { *m = FavoritesRequest{} }
+func (m *FavoritesRequest) String() string { return proto.CompactTextString(m) }
+func (*FavoritesRequest) ProtoMessage()    {}
+func (*FavoritesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2b51fa83d42468b7, []int{5}
+}
+
+func (m *FavoritesRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_FavoritesRequest.Unmarshal(m, b)
+}
+func (m *FavoritesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_FavoritesRequest.Marshal(b, m, deterministic)
+}
+func (m *FavoritesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FavoritesRequest.Merge(m, src)
+}
+func (m *FavoritesRequest) XXX_Size() int {
+	return xxx_messageInfo_FavoritesRequest.Size(m)
+}
+func (m *FavoritesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_FavoritesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FavoritesRequest proto.InternalMessageInfo
+
+func (m *FavoritesRequest) GetUserId() uint64 {
+	if m != nil {
+		return m.UserId
+	}
+	return 0
+}
+
+func (m *FavoritesRequest) GetFilmIds() []uint64 {
+	if m != nil {
+		return m.FilmIds
+	}
+	return nil
+}
+
+type FavoritesResponse struct {
+	FilmIds              []uint64 `protobuf:"varint,1,rep,packed,name=film_ids,json=filmIds,proto3" json:"film_ids,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *FavoritesResponse) Reset()         { *m = FavoritesResponse{} }
+func (m *FavoritesResponse) String() string { return proto.CompactTextString(m) }
+func (*FavoritesResponse) ProtoMessage()    {}
+func (*FavoritesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2b51fa83d42468b7, []int{6}
+}
+
+func (m *FavoritesResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_FavoritesResponse.Unmarshal(m, b)
+}
+func (m *FavoritesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_FavoritesResponse.Marshal(b, m, deterministic)
+}
+func (m *FavoritesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FavoritesResponse.Merge(m, src)
+}
+func (m *FavoritesResponse) XXX_Size() int {
+	return xxx_messageInfo_FavoritesResponse.Size(m)
+}
+func (m *FavoritesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_FavoritesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FavoritesResponse proto.InternalMessageInfo
+
+func (m *FavoritesResponse) GetFilmIds() []uint64 {
+	if m != nil {
+		return m.FilmIds
+	}
+	return nil
+}
+
+type CalendarRequest struct {
+	Langs                []string `protobuf:"bytes,1,rep,name=langs,proto3" json:"langs,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CalendarRequest) Reset()         { *m = CalendarRequest{} }
+func (m *CalendarRequest) String() string { return proto.CompactTextString(m) }
+func (*CalendarRequest) ProtoMessage()    {}
+func (*CalendarRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2b51fa83d42468b7, []int{7}
+}
+
+func (m *CalendarRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CalendarRequest.Unmarshal(m, b)
+}
+func (m *CalendarRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CalendarRequest.Marshal(b, m, deterministic)
+}
+func (m *CalendarRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CalendarRequest.Merge(m, src)
+}
+func (m *CalendarRequest) XXX_Size() int {
+	return xxx_messageInfo_CalendarRequest.Size(m)
+}
+func (m *CalendarRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CalendarRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CalendarRequest proto.InternalMessageInfo
+
+func (m *CalendarRequest) GetLangs() []string {
+	if m != nil {
+		return m.Langs
+	}
+	return nil
+}
+
+type CalendarDay struct {
+	Day                  uint32   `protobuf:"varint,1,opt,name=day,proto3" json:"day,omitempty"`
+	News                 string   `protobuf:"bytes,2,opt,name=news,proto3" json:"news,omitempty"`
+	FilmId               uint64   `protobuf:"varint,3,opt,name=film_id,json=filmId,proto3" json:"film_id,omitempty"`
+	Poster               string   `protobuf:"bytes,4,opt,name=poster,proto3" json:"poster,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CalendarDay) Reset()         { *m = CalendarDay{} }
+func (m *CalendarDay) String() string { return proto.CompactTextString(m) }
+func (*CalendarDay) ProtoMessage()    {}
+func (*CalendarDay) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2b51fa83d42468b7, []int{8}
+}
+
+func (m *CalendarDay) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CalendarDay.Unmarshal(m, b)
+}
+func (m *CalendarDay) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CalendarDay.Marshal(b, m, deterministic)
+}
+func (m *CalendarDay) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CalendarDay.Merge(m, src)
+}
+func (m *CalendarDay) XXX_Size() int {
+	return xxx_messageInfo_CalendarDay.Size(m)
+}
+func (m *CalendarDay) XXX_DiscardUnknown() {
+	xxx_messageInfo_CalendarDay.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CalendarDay proto.InternalMessageInfo
+
+func (m *CalendarDay) GetDay() uint32 {
+	if m != nil {
+		return m.Day
+	}
+	return 0
+}
+
+func (m *CalendarDay) GetNews() string {
+	if m != nil {
+		return m.News
+	}
+	return ""
+}
+
+func (m *CalendarDay) GetFilmId() uint64 {
+	if m != nil {
+		return m.FilmId
+	}
+	return 0
+}
+
+func (m *CalendarDay) GetPoster() string {
+	if m != nil {
+		return m.Poster
+	}
+	return ""
+}
+
+type CalendarResponse struct {
+	MonthName            string         `protobuf:"bytes,1,opt,name=month_name,json=monthName,proto3" json:"month_name,omitempty"`
+	MonthText            string         `protobuf:"bytes,2,opt,name=month_text,json=monthText,proto3" json:"month_text,omitempty"`
+	CurrentDay           uint32         `protobuf:"varint,3,opt,name=current_day,json=currentDay,proto3" json:"current_day,omitempty"`
+	Days                 []*CalendarDay `protobuf:"bytes,4,rep,name=days,proto3" json:"days,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *CalendarResponse) Reset()         { *m = CalendarResponse{} }
+func (m *CalendarResponse) String() string { return proto.CompactTextString(m) }
+func (*CalendarResponse) ProtoMessage()    {}
+func (*CalendarResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2b51fa83d42468b7, []int{9}
+}
+
+func (m *CalendarResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CalendarResponse.Unmarshal(m, b)
+}
+func (m *CalendarResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CalendarResponse.Marshal(b, m, deterministic)
+}
+func (m *CalendarResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CalendarResponse.Merge(m, src)
+}
+func (m *CalendarResponse) XXX_Size() int {
+	return xxx_messageInfo_CalendarResponse.Size(m)
+}
+func (m *CalendarResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_CalendarResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CalendarResponse proto.InternalMessageInfo
+
+func (m *CalendarResponse) GetMonthName() string {
+	if m != nil {
+		return m.MonthName
+	}
+	return ""
+}
+
+func (m *CalendarResponse) GetMonthText() string {
+	if m != nil {
+		return m.MonthText
+	}
+	return ""
+}
+
+func (m *CalendarResponse) GetCurrentDay() uint32 {
+	if m != nil {
+		return m.CurrentDay
+	}
+	return 0
+}
+
+func (m *CalendarResponse) GetDays() []*CalendarDay {
+	if m != nil {
+		return m.Days
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*FilmCardsRequest)(nil), "films.FilmCardsRequest")
+	proto.RegisterType((*FilmCard)(nil), "films.FilmCard")
+	proto.RegisterType((*FilmCardsResponse)(nil), "films.FilmCardsResponse")
+	proto.RegisterType((*FilmExistsRequest)(nil), "films.FilmExistsRequest")
+	proto.RegisterType((*FilmExistsResponse)(nil), "films.FilmExistsResponse")
+	proto.RegisterType((*FavoritesRequest)(nil), "films.FavoritesRequest")
+	proto.RegisterType((*FavoritesResponse)(nil), "films.FavoritesResponse")
+	proto.RegisterType((*CalendarRequest)(nil), "films.CalendarRequest")
+	proto.RegisterType((*CalendarDay)(nil), "films.CalendarDay")
+	proto.RegisterType((*CalendarResponse)(nil), "films.CalendarResponse")
+}
+
+func init() {
+	proto.RegisterFile("films.proto", fileDescriptor_2b51fa83d42468b7)
+}
+
+var fileDescriptor_2b51fa83d42468b7 = []byte{
+	// 484 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x53, 0x4b, 0x6f, 0xd3, 0x40,
+	0x10, 0xc6, 0x8f, 0xa4, 0xc9, 0xb8, 0xa4, 0xe9, 0x0a, 0x25, 0xdb, 0x48, 0x88, 0xc8, 0x12, 0x90,
+	0x43, 0x95, 0x4a, 0xe5, 0xd6, 0x13, 0xc2, 0x34, 0xa5, 0x17, 0x0e, 0x2b, 0x4e, 0x5c, 0xa2, 0xa5,
+	0x3b, 0x50, 0x0b, 0x3f, 0x82, 0x77, 0x03, 0xc9, 0x4f, 0x81, 0x5f, 0x8b, 0x76, 0xbd, 0x76, 0x36,
+	0x49, 0x4f, 0xde, 0x99, 0x6f, 0x1e, 0xdf, 0xcc, 0x37, 0x86, 0xe8, 0x7b, 0x9a, 0xe5, 0x72, 0xbe,
+	0xaa, 0x4a, 0x55, 0x92, 0x8e, 0x31, 0xe2, 0x1b, 0x18, 0x2e, 0xd2, 0x2c, 0x4f, 0x78, 0x25, 0x24,
+	0xc3, 0x5f, 0x6b, 0x94, 0x8a, 0x0c, 0x21, 0x48, 0x85, 0xa4, 0xde, 0x34, 0x98, 0x85, 0x4c, 0x3f,
+	0xc9, 0x0b, 0xe8, 0x64, 0xbc, 0xf8, 0x21, 0xa9, 0x3f, 0x0d, 0x66, 0x7d, 0x56, 0x1b, 0xf1, 0x27,
+	0xe8, 0x35, 0xb9, 0x64, 0x00, 0x7e, 0x2a, 0xa8, 0x37, 0xf5, 0x66, 0x21, 0xf3, 0x53, 0xa1, 0x33,
+	0x54, 0xaa, 0x32, 0xa4, 0xfe, 0xd4, 0xd3, 0x19, 0xc6, 0x20, 0x23, 0xe8, 0xae, 0x4a, 0xa9, 0xb0,
+	0xa2, 0x81, 0x71, 0x5b, 0x2b, 0xbe, 0x81, 0x73, 0x87, 0x85, 0x5c, 0x95, 0x85, 0x44, 0xf2, 0x1a,
+	0x6a, 0x8e, 0x86, 0x48, 0x74, 0x7d, 0x36, 0xaf, 0xe9, 0x37, 0x81, 0xcc, 0x4e, 0x70, 0x59, 0xe7,
+	0xde, 0x6e, 0x52, 0xa9, 0xda, 0x11, 0xc6, 0x70, 0xa2, 0xd1, 0x65, 0xcb, 0xa9, 0xab, 0xcd, 0x7b,
+	0x11, 0x5f, 0x02, 0x71, 0xa3, 0x6d, 0xab, 0x11, 0x74, 0xd1, 0x78, 0x4c, 0x74, 0x8f, 0x59, 0x2b,
+	0x5e, 0xc0, 0x70, 0xc1, 0x7f, 0x97, 0x55, 0xaa, 0xd0, 0x2d, 0xbd, 0x96, 0x58, 0x39, 0xa5, 0xb5,
+	0x79, 0x2f, 0xc8, 0x05, 0xf4, 0x6c, 0xcf, 0x7a, 0x4f, 0x21, 0x3b, 0xa9, 0x9b, 0xca, 0x78, 0x0e,
+	0xe7, 0x4e, 0x1d, 0xdb, 0xd4, 0x8d, 0xf7, 0xf6, 0xe3, 0xdf, 0xc2, 0x59, 0xc2, 0x33, 0x2c, 0x04,
+	0xaf, 0x9a, 0xb6, 0xad, 0x04, 0x9e, 0x2b, 0x81, 0x80, 0xa8, 0x09, 0xfc, 0xc8, 0xb7, 0x5a, 0x39,
+	0xc1, 0xb7, 0x86, 0xd7, 0x73, 0xa6, 0x9f, 0x84, 0x40, 0x58, 0xe0, 0x1f, 0x69, 0x65, 0x30, 0x6f,
+	0x77, 0x39, 0x81, 0xbb, 0x1c, 0x47, 0x9e, 0x70, 0x4f, 0x9e, 0xbf, 0x1e, 0x0c, 0x77, 0x7c, 0x2c,
+	0xfd, 0x97, 0x00, 0x79, 0x59, 0xa8, 0xc7, 0x65, 0xc1, 0x73, 0x34, 0x2d, 0xfb, 0xac, 0x6f, 0x3c,
+	0x9f, 0x79, 0xee, 0xc0, 0x0a, 0x37, 0x8a, 0xfa, 0x0e, 0xfc, 0x05, 0x37, 0x8a, 0xbc, 0x82, 0xe8,
+	0x61, 0x5d, 0x55, 0x58, 0xa8, 0xa5, 0x66, 0x1c, 0x18, 0xc6, 0x60, 0x5d, 0x7a, 0x94, 0x37, 0x10,
+	0x0a, 0xbe, 0x95, 0x34, 0x34, 0xe2, 0x13, 0x2b, 0xbe, 0x33, 0x2c, 0x33, 0xf8, 0xf5, 0x3f, 0x1f,
+	0x3a, 0x5a, 0x51, 0x49, 0x12, 0x38, 0xbd, 0x43, 0xd5, 0xde, 0x11, 0x19, 0x1f, 0x1c, 0x4c, 0xa3,
+	0xe0, 0x84, 0x1e, 0x03, 0xf5, 0x4c, 0xf1, 0x33, 0x92, 0x00, 0xec, 0xee, 0x83, 0xb8, 0x91, 0x7b,
+	0x07, 0x36, 0xb9, 0x78, 0x02, 0x69, 0x8b, 0xdc, 0xc2, 0x20, 0x79, 0xc4, 0x87, 0x9f, 0xad, 0xe6,
+	0x3b, 0x2e, 0x07, 0xd7, 0x34, 0xa1, 0xc7, 0x40, 0x5b, 0xe6, 0x3d, 0x44, 0x77, 0xa8, 0x9a, 0x91,
+	0xc9, 0xe8, 0x60, 0x07, 0x4d, 0x89, 0xf1, 0x91, 0xbf, 0xa9, 0xf0, 0x61, 0xf0, 0xf5, 0xf4, 0xca,
+	0x80, 0x57, 0xe6, 0xa7, 0xff, 0xd6, 0x35, 0x9f, 0x77, 0xff, 0x07, 0x00, 0xe9, 0xeb, 0x8f, 0x71,
+	0x0a, 0x04, 0x00, 0x00,
+}
@@ -0,0 +1,220 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.3.0
+// - protoc             v3.12.4
+// source: films.proto
+
+package proto
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+const (
+	Films_GetFilmCards_FullMethodName   = "/films.Films/GetFilmCards"
+	Films_FilmExists_FullMethodName     = "/films.Films/FilmExists"
+	Films_CheckFavorites_FullMethodName = "/films.Films/CheckFavorites"
+	Films_GetCalendar_FullMethodName    = "/films.Films/GetCalendar"
+)
+
+// FilmsClient is the client API for Films service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type FilmsClient interface {
+	GetFilmCards(ctx context.Context, in *FilmCardsRequest, opts ...grpc.CallOption) (*FilmCardsResponse, error)
+	FilmExists(ctx context.Context, in *FilmExistsRequest, opts ...grpc.CallOption) (*FilmExistsResponse, error)
+	CheckFavorites(ctx context.Context, in *FavoritesRequest, opts ...grpc.CallOption) (*FavoritesResponse, error)
+	GetCalendar(ctx context.Context, in *CalendarRequest, opts ...grpc.CallOption) (*CalendarResponse, error)
+}
+
+type filmsClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewFilmsClient(cc grpc.ClientConnInterface) FilmsClient {
+	return &filmsClient{cc}
+}
+
+func (c *filmsClient) GetFilmCards(ctx context.Context, in *FilmCardsRequest, opts ...grpc.CallOption) (*FilmCardsResponse, error) {
+	out := new(FilmCardsResponse)
+	err := c.cc.Invoke(ctx, Films_GetFilmCards_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *filmsClient) FilmExists(ctx context.Context, in *FilmExistsRequest, opts ...grpc.CallOption) (*FilmExistsResponse, error) {
+	out := new(FilmExistsResponse)
+	err := c.cc.Invoke(ctx, Films_FilmExists_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *filmsClient) CheckFavorites(ctx context.Context, in *FavoritesRequest, opts ...grpc.CallOption) (*FavoritesResponse, error) {
+	out := new(FavoritesResponse)
+	err := c.cc.Invoke(ctx, Films_CheckFavorites_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *filmsClient) GetCalendar(ctx context.Context, in *CalendarRequest, opts ...grpc.CallOption) (*CalendarResponse, error) {
+	out := new(CalendarResponse)
+	err := c.cc.Invoke(ctx, Films_GetCalendar_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// FilmsServer is the server API for Films service.
+// All implementations must embed UnimplementedFilmsServer
+// for forward compatibility
+type FilmsServer interface {
+	GetFilmCards(context.Context, *FilmCardsRequest) (*FilmCardsResponse, error)
+	FilmExists(context.Context, *FilmExistsRequest) (*FilmExistsResponse, error)
+	CheckFavorites(context.Context, *FavoritesRequest) (*FavoritesResponse, error)
+	GetCalendar(context.Context, *CalendarRequest) (*CalendarResponse, error)
+	mustEmbedUnimplementedFilmsServer()
+}
+
+// UnimplementedFilmsServer must be embedded to have forward compatible implementations.
+type UnimplementedFilmsServer struct {
+}
+
+func (UnimplementedFilmsServer) GetFilmCards(context.Context, *FilmCardsRequest) (*FilmCardsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetFilmCards not implemented")
+}
+func (UnimplementedFilmsServer) FilmExists(context.Context, *FilmExistsRequest) (*FilmExistsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FilmExists not implemented")
+}
+func (UnimplementedFilmsServer) CheckFavorites(context.Context, *FavoritesRequest) (*FavoritesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CheckFavorites not implemented")
+}
+func (UnimplementedFilmsServer) GetCalendar(context.Context, *CalendarRequest) (*CalendarResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCalendar not implemented")
+}
+func (UnimplementedFilmsServer) mustEmbedUnimplementedFilmsServer() {}
+
+// UnsafeFilmsServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to FilmsServer will
+// result in compilation errors.
+type UnsafeFilmsServer interface {
+	mustEmbedUnimplementedFilmsServer()
+}
+
+func RegisterFilmsServer(s grpc.ServiceRegistrar, srv FilmsServer) {
+	s.RegisterService(&Films_ServiceDesc, srv)
+}
+
+func _Films_GetFilmCards_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FilmCardsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FilmsServer).GetFilmCards(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Films_GetFilmCards_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FilmsServer).GetFilmCards(ctx, req.(*FilmCardsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Films_FilmExists_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FilmExistsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FilmsServer).FilmExists(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Films_FilmExists_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FilmsServer).FilmExists(ctx, req.(*FilmExistsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Films_CheckFavorites_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FavoritesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FilmsServer).CheckFavorites(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Films_CheckFavorites_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FilmsServer).CheckFavorites(ctx, req.(*FavoritesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Films_GetCalendar_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CalendarRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FilmsServer).GetCalendar(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Films_GetCalendar_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FilmsServer).GetCalendar(ctx, req.(*CalendarRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// Films_ServiceDesc is the grpc.ServiceDesc for Films service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var Films_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "films.Films",
+	HandlerType: (*FilmsServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetFilmCards",
+			Handler:    _Films_GetFilmCards_Handler,
+		},
+		{
+			MethodName: "FilmExists",
+			Handler:    _Films_FilmExists_Handler,
+		},
+		{
+			MethodName: "CheckFavorites",
+			Handler:    _Films_CheckFavorites_Handler,
+		},
+		{
+			MethodName: "GetCalendar",
+			Handler:    _Films_GetCalendar_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "films.proto",
+}
@@ -1,14 +1,15 @@
 package calendar
 
 import (
+	"context"
 	"database/sql"
 	"errors"
 	"fmt"
 	"log/slog"
-	"time"
 
 	"github.com/go-park-mail-ru/2023_2_Vkladyshi/configs"
 	"github.com/go-park-mail-ru/2023_2_Vkladyshi/pkg/models"
+	"github.com/lib/pq"
 
 	_ "github.com/jackc/pgx/stdlib"
 )
@@ -16,7 +17,7 @@ import (
 //go:generate mockgen -source=calendar.go -destination=../../mocks/calendar_repo_mock.go -package=mocks
 
 type ICalendarRepo interface {
-	GetCalendar() ([]models.DayItem, error)
+	GetCalendar(langs []string) ([]models.DayItem, error)
 }
 
 type RepoPostgre struct {
@@ -40,43 +41,54 @@ func GetCalendarRepo(config *configs.DbDsnCfg, lg *slog.Logger) (*RepoPostgre, e
 
 	postgreDb := RepoPostgre{db: db}
 
-	go postgreDb.pingDb(config.Timer, lg)
 	return &postgreDb, nil
 }
 
-func (repo *RepoPostgre) pingDb(timer uint32, lg *slog.Logger) {
-	for {
-		err := repo.db.Ping()
-		if err != nil {
-			lg.Error("Repo Crew db ping error", "err", err.Error())
-		}
-
-		time.Sleep(time.Duration(timer) * time.Second)
-	}
+// Ping lets the health checker tell whether the database is reachable.
+func (repo *RepoPostgre) Ping(ctx context.Context) error {
+	return repo.db.PingContext(ctx)
 }
 
-func (repo *RepoPostgre) GetCalendar() ([]models.DayItem, error) {
-	calendar := []models.DayItem{}
-	lastAppendDay := uint8(0)
-	news := ""
+// Close closes the connection pool once the service has stopped.
+func (repo *RepoPostgre) Close() error {
+	return repo.db.Close()
+}
 
-	rows, err := repo.db.Query("SELECT film.title, release_day, film.poster, film.id FROM calendar " +
-		"JOIN film ON film.id = calendar.id " +
-		"WHERE release_month = DATE_PART('MONTH', CURRENT_DATE) " +
-		"ORDER BY release_day")
+func (repo *RepoPostgre) GetCalendar(langs []string) ([]models.DayItem, error) {
+	rows, err := repo.db.Query("SELECT COALESCE((SELECT film_translation.title FROM film_translation "+
+		"WHERE film_translation.id_film = film.id AND film_translation.lang = ANY($1) "+
+		"ORDER BY array_position($1, film_translation.lang) LIMIT 1), film.title), "+
+		"release_day, film.poster, film.id FROM calendar "+
+		"JOIN film ON film.id = calendar.id "+
+		"WHERE release_month = DATE_PART('MONTH', CURRENT_DATE) "+
+		"ORDER BY release_day", pq.Array(langs))
 	if err != nil && !errors.Is(err, sql.ErrNoRows) {
 		return nil, err
 	}
 	defer rows.Close()
 
-	post1 := models.DayItem{}
+	releases := []models.DayItem{}
 	for rows.Next() {
-		post2 := models.DayItem{}
-		err := rows.Scan(&post2.DayNews, &post2.DayNumber, &post2.Poster, &post2.IdFilm)
+		post := models.DayItem{}
+		err := rows.Scan(&post.DayNews, &post.DayNumber, &post.Poster, &post.IdFilm)
 		if err != nil {
 			return nil, fmt.Errorf("get calendar scan err: %w", err)
 		}
+		releases = append(releases, post)
+	}
+
+	return groupByDay(releases), nil
+}
+
+// groupByDay merges releases ordered by day into one item per day. The titles
+// of a day are joined with spaces and the item keeps the last film of the day.
+func groupByDay(releases []models.DayItem) []models.DayItem {
+	calendar := []models.DayItem{}
+	lastAppendDay := uint8(0)
+	news := ""
 
+	post1 := models.DayItem{}
+	for _, post2 := range releases {
 		if post1.DayNumber == 0 {
 			post1 = post2
 			continue
@@ -97,5 +109,5 @@ func (repo *RepoPostgre) GetCalendar() ([]models.DayItem, error) {
 		calendar = append(calendar, models.DayItem{DayNumber: post1.DayNumber, DayNews: news + post1.DayNews, IdFilm: post1.IdFilm, Poster: post1.Poster})
 	}
 
-	return calendar, nil
+	return calendar
 }
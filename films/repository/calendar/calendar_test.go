@@ -27,18 +27,22 @@ func TestGetCalendar(t *testing.T) {
 		rows = rows.AddRow(item.DayNews, item.DayNumber, item.Poster, item.IdFilm)
 	}
 
-	selectRow := "SELECT film.title, release_day, film.poster, film.id FROM calendar JOIN film ON film.id = calendar.id WHERE release_month = DATE_PART('MONTH', CURRENT_DATE) ORDER BY release_day"
+	selectRow := "SELECT COALESCE((SELECT film_translation.title FROM film_translation " +
+		"WHERE film_translation.id_film = film.id AND film_translation.lang = ANY($1) " +
+		"ORDER BY array_position($1, film_translation.lang) LIMIT 1), film.title), " +
+		"release_day, film.poster, film.id FROM calendar JOIN film ON film.id = calendar.id " +
+		"WHERE release_month = DATE_PART('MONTH', CURRENT_DATE) ORDER BY release_day"
 
 	mock.ExpectQuery(
 		regexp.QuoteMeta(selectRow)).
-		WithArgs().
+		WithArgs("{\"en\"}").
 		WillReturnRows(rows)
 
 	repo := &RepoPostgre{
 		db: db,
 	}
 
-	days, err := repo.GetCalendar()
+	days, err := repo.GetCalendar([]string{"en"})
 	if err != nil {
 		t.Errorf("get calendar error: %s", err)
 	}
@@ -55,10 +59,10 @@ func TestGetCalendar(t *testing.T) {
 
 	mock.ExpectQuery(
 		regexp.QuoteMeta(selectRow)).
-		WithArgs().
+		WithArgs("{\"en\"}").
 		WillReturnError(fmt.Errorf("db_error"))
 
-	days, err = repo.GetCalendar()
+	days, err = repo.GetCalendar([]string{"en"})
 	if err := mock.ExpectationsWereMet(); err != nil {
 		t.Errorf("there were unfulfilled expectations: %s", err)
 		return
@@ -0,0 +1,64 @@
+package calendar
+
+import (
+	"cmp"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/go-park-mail-ru/2023_2_Vkladyshi/configs"
+	"github.com/go-park-mail-ru/2023_2_Vkladyshi/pkg/memory"
+	"github.com/go-park-mail-ru/2023_2_Vkladyshi/pkg/models"
+)
+
+type RepoMemory struct {
+	store *memory.Store
+	now   func() time.Time
+}
+
+func GetCalendarMemoryRepo(config *configs.DbDsnCfg) (*RepoMemory, error) {
+	store, err := memory.Open(config.Seed)
+	if err != nil {
+		return nil, fmt.Errorf("get calendar repo: %w", err)
+	}
+
+	return &RepoMemory{store: store, now: time.Now}, nil
+}
+
+func (repo *RepoMemory) GetCalendar(langs []string) ([]models.DayItem, error) {
+	repo.store.RLock()
+	defer repo.store.RUnlock()
+
+	month := uint8(repo.now().Month())
+	releases := []models.DayItem{}
+	for _, date := range repo.store.Calendar {
+		film := repo.store.Film(date.IdFilm)
+		if date.Month != month || film == nil {
+			continue
+		}
+		releases = append(releases, models.DayItem{
+			DayNumber: date.Day,
+			DayNews:   repo.title(film, langs),
+			IdFilm:    film.Id,
+			Poster:    film.Poster,
+		})
+	}
+	slices.SortStableFunc(releases, func(a, b models.DayItem) int {
+		return cmp.Compare(a.DayNumber, b.DayNumber)
+	})
+
+	return groupByDay(releases), nil
+}
+
+// title returns the translation in the first language that has one.
+func (repo *RepoMemory) title(film *memory.Film, langs []string) string {
+	for _, lang := range langs {
+		for _, translation := range repo.store.FilmTranslations {
+			if translation.Id == film.Id && translation.Lang == lang {
+				return translation.Title
+			}
+		}
+	}
+
+	return film.Title
+}
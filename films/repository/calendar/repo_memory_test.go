@@ -0,0 +1,47 @@
+package calendar
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/go-park-mail-ru/2023_2_Vkladyshi/pkg/memory"
+	"github.com/go-park-mail-ru/2023_2_Vkladyshi/pkg/models"
+)
+
+func TestMemoryGetCalendar(t *testing.T) {
+	repo := &RepoMemory{
+		store: &memory.Store{Seed: memory.Seed{
+			Films: []memory.Film{
+				{Id: 1, Title: "Солярис", Poster: "p1"},
+				{Id: 2, Title: "Сталкер", Poster: "p2"},
+				{Id: 3, Title: "Зеркало", Poster: "p3"},
+				{Id: 4, Title: "Ностальгия", Poster: "p4"},
+			},
+			Calendar: []memory.CalendarDate{
+				{IdFilm: 2, Month: 3, Day: 20},
+				{IdFilm: 1, Month: 3, Day: 5},
+				{IdFilm: 3, Month: 3, Day: 20},
+				{IdFilm: 4, Month: 4, Day: 1},
+			},
+			FilmTranslations: []memory.Translation{
+				{Id: 1, Lang: "de", Title: "Solaris (de)"},
+				{Id: 1, Lang: "en", Title: "Solaris"},
+			},
+		}},
+		now: func() time.Time { return time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC) },
+	}
+
+	calendar, err := repo.GetCalendar([]string{"en", "de"})
+	if err != nil {
+		t.Errorf("GetCalendar error: %s", err)
+	}
+
+	expect := []models.DayItem{
+		{DayNumber: 5, DayNews: "Solaris", IdFilm: 1, Poster: "p1"},
+		{DayNumber: 20, DayNews: "Сталкер Зеркало", IdFilm: 3, Poster: "p3"},
+	}
+	if !reflect.DeepEqual(calendar, expect) {
+		t.Errorf("results not match, want %v, have %v", expect, calendar)
+	}
+}
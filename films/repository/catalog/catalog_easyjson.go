@@ -0,0 +1,642 @@
+// Code generated by easyjson for marshaling/unmarshaling. DO NOT EDIT.
+
+package catalog
+
+import (
+	json "encoding/json"
+	easyjson "github.com/mailru/easyjson"
+	jlexer "github.com/mailru/easyjson/jlexer"
+	jwriter "github.com/mailru/easyjson/jwriter"
+)
+
+// suppress unused package warning
+var (
+	_ *json.RawMessage
+	_ *jlexer.Lexer
+	_ *jwriter.Writer
+	_ easyjson.Marshaler
+)
+
+func easyjson40cc99a3DecodeGithubComGoParkMailRu20232VkladyshiFilmsRepositoryCatalog(in *jlexer.Lexer, out *Person) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "external_id":
+			out.ExternalId = string(in.String())
+		case "name":
+			out.Name = string(in.String())
+		case "birth_date":
+			out.BirthDate = string(in.String())
+		case "photo":
+			out.Photo = string(in.String())
+		case "country":
+			out.Country = string(in.String())
+		case "info":
+			out.Info = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson40cc99a3EncodeGithubComGoParkMailRu20232VkladyshiFilmsRepositoryCatalog(out *jwriter.Writer, in Person) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"external_id\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.String(string(in.ExternalId))
+	}
+	{
+		const prefix string = ",\"name\":"
+		out.RawString(prefix)
+		out.String(string(in.Name))
+	}
+	{
+		const prefix string = ",\"birth_date\":"
+		out.RawString(prefix)
+		out.String(string(in.BirthDate))
+	}
+	{
+		const prefix string = ",\"photo\":"
+		out.RawString(prefix)
+		out.String(string(in.Photo))
+	}
+	{
+		const prefix string = ",\"country\":"
+		out.RawString(prefix)
+		out.String(string(in.Country))
+	}
+	{
+		const prefix string = ",\"info\":"
+		out.RawString(prefix)
+		out.String(string(in.Info))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v Person) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson40cc99a3EncodeGithubComGoParkMailRu20232VkladyshiFilmsRepositoryCatalog(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v Person) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson40cc99a3EncodeGithubComGoParkMailRu20232VkladyshiFilmsRepositoryCatalog(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *Person) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson40cc99a3DecodeGithubComGoParkMailRu20232VkladyshiFilmsRepositoryCatalog(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *Person) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson40cc99a3DecodeGithubComGoParkMailRu20232VkladyshiFilmsRepositoryCatalog(l, v)
+}
+func easyjson40cc99a3DecodeGithubComGoParkMailRu20232VkladyshiFilmsRepositoryCatalog1(in *jlexer.Lexer, out *Genre) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "external_id":
+			out.ExternalId = string(in.String())
+		case "title":
+			out.Title = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson40cc99a3EncodeGithubComGoParkMailRu20232VkladyshiFilmsRepositoryCatalog1(out *jwriter.Writer, in Genre) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"external_id\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.String(string(in.ExternalId))
+	}
+	{
+		const prefix string = ",\"title\":"
+		out.RawString(prefix)
+		out.String(string(in.Title))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v Genre) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson40cc99a3EncodeGithubComGoParkMailRu20232VkladyshiFilmsRepositoryCatalog1(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v Genre) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson40cc99a3EncodeGithubComGoParkMailRu20232VkladyshiFilmsRepositoryCatalog1(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *Genre) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson40cc99a3DecodeGithubComGoParkMailRu20232VkladyshiFilmsRepositoryCatalog1(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *Genre) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson40cc99a3DecodeGithubComGoParkMailRu20232VkladyshiFilmsRepositoryCatalog1(l, v)
+}
+func easyjson40cc99a3DecodeGithubComGoParkMailRu20232VkladyshiFilmsRepositoryCatalog2(in *jlexer.Lexer, out *Film) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "external_id":
+			out.ExternalId = string(in.String())
+		case "title":
+			out.Title = string(in.String())
+		case "info":
+			out.Info = string(in.String())
+		case "poster":
+			out.Poster = string(in.String())
+		case "release_date":
+			out.ReleaseDate = string(in.String())
+		case "country":
+			out.Country = string(in.String())
+		case "mpaa":
+			out.Mpaa = string(in.String())
+		case "original_title":
+			out.OriginalTitle = string(in.String())
+		case "runtime":
+			out.Runtime = uint32(in.Uint32())
+		case "budget":
+			out.Budget = uint64(in.Uint64())
+		case "box_office":
+			out.BoxOffice = uint64(in.Uint64())
+		case "languages":
+			if in.IsNull() {
+				in.Skip()
+				out.Languages = nil
+			} else {
+				in.Delim('[')
+				if out.Languages == nil {
+					if !in.IsDelim(']') {
+						out.Languages = make([]string, 0, 4)
+					} else {
+						out.Languages = []string{}
+					}
+				} else {
+					out.Languages = (out.Languages)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v1 string
+					v1 = string(in.String())
+					out.Languages = append(out.Languages, v1)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "trailers":
+			if in.IsNull() {
+				in.Skip()
+				out.Trailers = nil
+			} else {
+				in.Delim('[')
+				if out.Trailers == nil {
+					if !in.IsDelim(']') {
+						out.Trailers = make([]string, 0, 4)
+					} else {
+						out.Trailers = []string{}
+					}
+				} else {
+					out.Trailers = (out.Trailers)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v2 string
+					v2 = string(in.String())
+					out.Trailers = append(out.Trailers, v2)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "imdb_id":
+			out.ImdbId = string(in.String())
+		case "kinopoisk_id":
+			out.KinopoiskId = string(in.String())
+		case "genres":
+			if in.IsNull() {
+				in.Skip()
+				out.Genres = nil
+			} else {
+				in.Delim('[')
+				if out.Genres == nil {
+					if !in.IsDelim(']') {
+						out.Genres = make([]string, 0, 4)
+					} else {
+						out.Genres = []string{}
+					}
+				} else {
+					out.Genres = (out.Genres)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v3 string
+					v3 = string(in.String())
+					out.Genres = append(out.Genres, v3)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson40cc99a3EncodeGithubComGoParkMailRu20232VkladyshiFilmsRepositoryCatalog2(out *jwriter.Writer, in Film) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"external_id\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.String(string(in.ExternalId))
+	}
+	{
+		const prefix string = ",\"title\":"
+		out.RawString(prefix)
+		out.String(string(in.Title))
+	}
+	{
+		const prefix string = ",\"info\":"
+		out.RawString(prefix)
+		out.String(string(in.Info))
+	}
+	{
+		const prefix string = ",\"poster\":"
+		out.RawString(prefix)
+		out.String(string(in.Poster))
+	}
+	{
+		const prefix string = ",\"release_date\":"
+		out.RawString(prefix)
+		out.String(string(in.ReleaseDate))
+	}
+	{
+		const prefix string = ",\"country\":"
+		out.RawString(prefix)
+		out.String(string(in.Country))
+	}
+	{
+		const prefix string = ",\"mpaa\":"
+		out.RawString(prefix)
+		out.String(string(in.Mpaa))
+	}
+	{
+		const prefix string = ",\"original_title\":"
+		out.RawString(prefix)
+		out.String(string(in.OriginalTitle))
+	}
+	{
+		const prefix string = ",\"runtime\":"
+		out.RawString(prefix)
+		out.Uint32(uint32(in.Runtime))
+	}
+	{
+		const prefix string = ",\"budget\":"
+		out.RawString(prefix)
+		out.Uint64(uint64(in.Budget))
+	}
+	{
+		const prefix string = ",\"box_office\":"
+		out.RawString(prefix)
+		out.Uint64(uint64(in.BoxOffice))
+	}
+	{
+		const prefix string = ",\"languages\":"
+		out.RawString(prefix)
+		if in.Languages == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v4, v5 := range in.Languages {
+				if v4 > 0 {
+					out.RawByte(',')
+				}
+				out.String(string(v5))
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"trailers\":"
+		out.RawString(prefix)
+		if in.Trailers == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v6, v7 := range in.Trailers {
+				if v6 > 0 {
+					out.RawByte(',')
+				}
+				out.String(string(v7))
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"imdb_id\":"
+		out.RawString(prefix)
+		out.String(string(in.ImdbId))
+	}
+	{
+		const prefix string = ",\"kinopoisk_id\":"
+		out.RawString(prefix)
+		out.String(string(in.KinopoiskId))
+	}
+	{
+		const prefix string = ",\"genres\":"
+		out.RawString(prefix)
+		if in.Genres == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v8, v9 := range in.Genres {
+				if v8 > 0 {
+					out.RawByte(',')
+				}
+				out.String(string(v9))
+			}
+			out.RawByte(']')
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v Film) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson40cc99a3EncodeGithubComGoParkMailRu20232VkladyshiFilmsRepositoryCatalog2(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v Film) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson40cc99a3EncodeGithubComGoParkMailRu20232VkladyshiFilmsRepositoryCatalog2(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *Film) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson40cc99a3DecodeGithubComGoParkMailRu20232VkladyshiFilmsRepositoryCatalog2(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *Film) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson40cc99a3DecodeGithubComGoParkMailRu20232VkladyshiFilmsRepositoryCatalog2(l, v)
+}
+func easyjson40cc99a3DecodeGithubComGoParkMailRu20232VkladyshiFilmsRepositoryCatalog3(in *jlexer.Lexer, out *Credit) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "film":
+			out.Film = string(in.String())
+		case "person":
+			out.Person = string(in.String())
+		case "profession":
+			out.Profession = string(in.String())
+		case "character":
+			out.Character = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson40cc99a3EncodeGithubComGoParkMailRu20232VkladyshiFilmsRepositoryCatalog3(out *jwriter.Writer, in Credit) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"film\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Film))
+	}
+	{
+		const prefix string = ",\"person\":"
+		out.RawString(prefix)
+		out.String(string(in.Person))
+	}
+	{
+		const prefix string = ",\"profession\":"
+		out.RawString(prefix)
+		out.String(string(in.Profession))
+	}
+	{
+		const prefix string = ",\"character\":"
+		out.RawString(prefix)
+		out.String(string(in.Character))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v Credit) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson40cc99a3EncodeGithubComGoParkMailRu20232VkladyshiFilmsRepositoryCatalog3(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v Credit) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson40cc99a3EncodeGithubComGoParkMailRu20232VkladyshiFilmsRepositoryCatalog3(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *Credit) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson40cc99a3DecodeGithubComGoParkMailRu20232VkladyshiFilmsRepositoryCatalog3(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *Credit) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson40cc99a3DecodeGithubComGoParkMailRu20232VkladyshiFilmsRepositoryCatalog3(l, v)
+}
+func easyjson40cc99a3DecodeGithubComGoParkMailRu20232VkladyshiFilmsRepositoryCatalog4(in *jlexer.Lexer, out *CalendarDate) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "film":
+			out.Film = string(in.String())
+		case "month":
+			out.Month = uint8(in.Uint8())
+		case "day":
+			out.Day = uint8(in.Uint8())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson40cc99a3EncodeGithubComGoParkMailRu20232VkladyshiFilmsRepositoryCatalog4(out *jwriter.Writer, in CalendarDate) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"film\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Film))
+	}
+	{
+		const prefix string = ",\"month\":"
+		out.RawString(prefix)
+		out.Uint8(uint8(in.Month))
+	}
+	{
+		const prefix string = ",\"day\":"
+		out.RawString(prefix)
+		out.Uint8(uint8(in.Day))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v CalendarDate) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson40cc99a3EncodeGithubComGoParkMailRu20232VkladyshiFilmsRepositoryCatalog4(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v CalendarDate) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson40cc99a3EncodeGithubComGoParkMailRu20232VkladyshiFilmsRepositoryCatalog4(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *CalendarDate) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson40cc99a3DecodeGithubComGoParkMailRu20232VkladyshiFilmsRepositoryCatalog4(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *CalendarDate) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson40cc99a3DecodeGithubComGoParkMailRu20232VkladyshiFilmsRepositoryCatalog4(l, v)
+}
@@ -0,0 +1,56 @@
+package catalog
+
+// Records are keyed by the external id of the source they were imported
+// from. Films, credits and calendar dates refer to other records by it.
+//
+//easyjson:json
+type (
+	Genre struct {
+		Id         uint64 `json:"-"`
+		ExternalId string `json:"external_id"`
+		Title      string `json:"title"`
+	}
+
+	Person struct {
+		Id         uint64 `json:"-"`
+		ExternalId string `json:"external_id"`
+		Name       string `json:"name"`
+		BirthDate  string `json:"birth_date"`
+		Photo      string `json:"photo"`
+		Country    string `json:"country"`
+		Info       string `json:"info"`
+	}
+
+	Film struct {
+		Id            uint64   `json:"-"`
+		ExternalId    string   `json:"external_id"`
+		Title         string   `json:"title"`
+		Info          string   `json:"info"`
+		Poster        string   `json:"poster"`
+		ReleaseDate   string   `json:"release_date"`
+		Country       string   `json:"country"`
+		Mpaa          string   `json:"mpaa"`
+		OriginalTitle string   `json:"original_title"`
+		Runtime       uint32   `json:"runtime"`
+		Budget        uint64   `json:"budget"`
+		BoxOffice     uint64   `json:"box_office"`
+		Languages     []string `json:"languages"`
+		Trailers      []string `json:"trailers"`
+		ImdbId        string   `json:"imdb_id"`
+		KinopoiskId   string   `json:"kinopoisk_id"`
+		Genres        []string `json:"genres"`
+	}
+
+	Credit struct {
+		Film       string `json:"film"`
+		Person     string `json:"person"`
+		Profession string `json:"profession"`
+		Character  string `json:"character"`
+	}
+
+	CalendarDate struct {
+		Film  string `json:"film"`
+		Month uint8  `json:"month"`
+		Day   uint8  `json:"day"`
+	}
+)
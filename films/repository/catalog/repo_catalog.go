@@ -0,0 +1,357 @@
+package catalog
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"log/slog"
+
+	"github.com/go-park-mail-ru/2023_2_Vkladyshi/configs"
+	"github.com/lib/pq"
+
+	_ "github.com/jackc/pgx/stdlib"
+)
+
+//go:generate mockgen -source=repo_catalog.go -destination=../../mocks/catalog_repo_mock.go -package=mocks
+
+// ICatalogRepo looks catalogue entries up by the external id they were
+// imported with and writes them back. Find methods return nil when nothing
+// matches.
+type ICatalogRepo interface {
+	FindGenre(externalId string) (*Genre, error)
+	FindGenreByTitle(title string) (*Genre, error)
+	CreateGenre(genre Genre) (uint64, error)
+	UpdateGenre(genre Genre) error
+
+	FindPerson(externalId string) (*Person, error)
+	FindPersonByName(name string, birthDate string) (*Person, error)
+	CreatePerson(person Person) (uint64, error)
+	UpdatePerson(person Person) error
+
+	FindFilm(externalId string) (*Film, error)
+	FindFilmByTitle(title string) (*Film, error)
+	CreateFilm(film Film) (uint64, error)
+	UpdateFilm(film Film) error
+	GetFilmGenres(filmId uint64) ([]uint64, error)
+	AddFilmGenres(filmId uint64, genres []uint64) error
+	RemoveFilmGenres(filmId uint64, genres []uint64) error
+
+	GetProfessionId(title string) (uint64, error)
+	FindCredit(filmId uint64, personId uint64, professionId uint64) (*string, error)
+	CreateCredit(filmId uint64, personId uint64, professionId uint64, character string) error
+	UpdateCredit(filmId uint64, personId uint64, professionId uint64, character string) error
+
+	FindCalendarDate(filmId uint64) (*CalendarDate, error)
+	CreateCalendarDate(filmId uint64, date CalendarDate) error
+	UpdateCalendarDate(filmId uint64, date CalendarDate) error
+}
+
+type RepoPostgre struct {
+	db *sql.DB
+}
+
+func GetCatalogRepo(config *configs.DbDsnCfg, lg *slog.Logger) (*RepoPostgre, error) {
+	dsn := fmt.Sprintf("user=%s dbname=%s password= %s host=%s port=%d sslmode=%s",
+		config.User, config.DbName, config.Password, config.Host, config.Port, config.Sslmode)
+	db, err := sql.Open("pgx", dsn)
+	if err != nil {
+		lg.Error("sql open error", "err", err.Error())
+		return nil, fmt.Errorf("get catalog repo: %w", err)
+	}
+	err = db.Ping()
+	if err != nil {
+		lg.Error("sql ping error", "err", err.Error())
+		return nil, fmt.Errorf("get catalog repo: %w", err)
+	}
+	db.SetMaxOpenConns(config.MaxOpenConns)
+
+	postgreDb := RepoPostgre{db: db}
+
+	return &postgreDb, nil
+}
+
+// Ping lets the health checker tell whether the database is reachable.
+func (repo *RepoPostgre) Ping(ctx context.Context) error {
+	return repo.db.PingContext(ctx)
+}
+
+// Close closes the connection pool once the service has stopped.
+func (repo *RepoPostgre) Close() error {
+	return repo.db.Close()
+}
+
+const genreColumns = "SELECT id, COALESCE(external_id, ''), title FROM genre "
+
+func (repo *RepoPostgre) FindGenre(externalId string) (*Genre, error) {
+	return repo.findGenre(genreColumns+"WHERE external_id = $1", externalId)
+}
+
+func (repo *RepoPostgre) FindGenreByTitle(title string) (*Genre, error) {
+	return repo.findGenre(genreColumns+"WHERE title = $1 LIMIT 1", title)
+}
+
+func (repo *RepoPostgre) findGenre(query string, args ...any) (*Genre, error) {
+	genre := &Genre{}
+	err := repo.db.QueryRow(query, args...).Scan(&genre.Id, &genre.ExternalId, &genre.Title)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("find genre err: %w", err)
+	}
+
+	return genre, nil
+}
+
+func (repo *RepoPostgre) CreateGenre(genre Genre) (uint64, error) {
+	var id uint64
+	err := repo.db.QueryRow("INSERT INTO genre(external_id, title) VALUES($1, $2) RETURNING id",
+		genre.ExternalId, genre.Title).Scan(&id)
+	if err != nil {
+		return 0, fmt.Errorf("create genre err: %w", err)
+	}
+
+	return id, nil
+}
+
+func (repo *RepoPostgre) UpdateGenre(genre Genre) error {
+	_, err := repo.db.Exec("UPDATE genre SET external_id = $1, title = $2 WHERE id = $3",
+		genre.ExternalId, genre.Title, genre.Id)
+	if err != nil {
+		return fmt.Errorf("update genre err: %w", err)
+	}
+
+	return nil
+}
+
+const personColumns = "SELECT id, COALESCE(external_id, ''), name, COALESCE(to_char(birth_date, 'YYYY-MM-DD'), ''), " +
+	"COALESCE(photo, ''), COALESCE(country, ''), COALESCE(info, '') FROM crew "
+
+func (repo *RepoPostgre) FindPerson(externalId string) (*Person, error) {
+	return repo.findPerson(personColumns+"WHERE external_id = $1", externalId)
+}
+
+func (repo *RepoPostgre) FindPersonByName(name string, birthDate string) (*Person, error) {
+	return repo.findPerson(personColumns+"WHERE name = $1 AND COALESCE(to_char(birth_date, 'YYYY-MM-DD'), '') = $2 LIMIT 1",
+		name, birthDate)
+}
+
+func (repo *RepoPostgre) findPerson(query string, args ...any) (*Person, error) {
+	person := &Person{}
+	err := repo.db.QueryRow(query, args...).Scan(&person.Id, &person.ExternalId, &person.Name, &person.BirthDate,
+		&person.Photo, &person.Country, &person.Info)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("find person err: %w", err)
+	}
+
+	return person, nil
+}
+
+func (repo *RepoPostgre) CreatePerson(person Person) (uint64, error) {
+	var id uint64
+	err := repo.db.QueryRow("INSERT INTO crew(external_id, name, birth_date, photo, country, info) "+
+		"VALUES($1, $2, NULLIF($3, '')::date, $4, $5, $6) RETURNING id",
+		person.ExternalId, person.Name, person.BirthDate, person.Photo, person.Country, person.Info).Scan(&id)
+	if err != nil {
+		return 0, fmt.Errorf("create person err: %w", err)
+	}
+
+	return id, nil
+}
+
+func (repo *RepoPostgre) UpdatePerson(person Person) error {
+	_, err := repo.db.Exec("UPDATE crew SET external_id = $1, name = $2, birth_date = NULLIF($3, '')::date, "+
+		"photo = $4, country = $5, info = $6 WHERE id = $7",
+		person.ExternalId, person.Name, person.BirthDate, person.Photo, person.Country, person.Info, person.Id)
+	if err != nil {
+		return fmt.Errorf("update person err: %w", err)
+	}
+
+	return nil
+}
+
+const filmColumns = "SELECT id, COALESCE(external_id, ''), title, COALESCE(info, ''), COALESCE(poster, ''), " +
+	"COALESCE(to_char(release_date, 'YYYY-MM-DD'), ''), COALESCE(country, ''), COALESCE(mpaa, ''), " +
+	"COALESCE(original_title, ''), COALESCE(runtime, 0), COALESCE(budget, 0), COALESCE(box_office, 0), " +
+	"languages, trailers, COALESCE(imdb_id, ''), COALESCE(kinopoisk_id, '') FROM film "
+
+func (repo *RepoPostgre) FindFilm(externalId string) (*Film, error) {
+	return repo.findFilm(filmColumns+"WHERE external_id = $1", externalId)
+}
+
+func (repo *RepoPostgre) FindFilmByTitle(title string) (*Film, error) {
+	return repo.findFilm(filmColumns+"WHERE title = $1 LIMIT 1", title)
+}
+
+func (repo *RepoPostgre) findFilm(query string, args ...any) (*Film, error) {
+	film := &Film{}
+	err := repo.db.QueryRow(query, args...).Scan(&film.Id, &film.ExternalId, &film.Title, &film.Info, &film.Poster,
+		&film.ReleaseDate, &film.Country, &film.Mpaa, &film.OriginalTitle, &film.Runtime, &film.Budget,
+		&film.BoxOffice, pq.Array(&film.Languages), pq.Array(&film.Trailers), &film.ImdbId, &film.KinopoiskId)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("find film err: %w", err)
+	}
+
+	return film, nil
+}
+
+func (repo *RepoPostgre) CreateFilm(film Film) (uint64, error) {
+	var id uint64
+	err := repo.db.QueryRow("INSERT INTO film(external_id, title, info, poster, release_date, country, mpaa, "+
+		"original_title, runtime, budget, box_office, languages, trailers, imdb_id, kinopoisk_id) "+
+		"VALUES($1, $2, $3, $4, NULLIF($5, '')::date, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15) RETURNING id",
+		film.ExternalId, film.Title, film.Info, film.Poster, film.ReleaseDate, film.Country, film.Mpaa,
+		film.OriginalTitle, film.Runtime, film.Budget, film.BoxOffice,
+		pq.Array(film.Languages), pq.Array(film.Trailers), film.ImdbId, film.KinopoiskId).Scan(&id)
+	if err != nil {
+		return 0, fmt.Errorf("create film err: %w", err)
+	}
+
+	return id, nil
+}
+
+func (repo *RepoPostgre) UpdateFilm(film Film) error {
+	_, err := repo.db.Exec("UPDATE film SET external_id = $1, title = $2, info = $3, poster = $4, "+
+		"release_date = NULLIF($5, '')::date, country = $6, mpaa = $7, original_title = $8, runtime = $9, "+
+		"budget = $10, box_office = $11, languages = $12, trailers = $13, imdb_id = $14, kinopoisk_id = $15 "+
+		"WHERE id = $16",
+		film.ExternalId, film.Title, film.Info, film.Poster, film.ReleaseDate, film.Country, film.Mpaa,
+		film.OriginalTitle, film.Runtime, film.Budget, film.BoxOffice,
+		pq.Array(film.Languages), pq.Array(film.Trailers), film.ImdbId, film.KinopoiskId, film.Id)
+	if err != nil {
+		return fmt.Errorf("update film err: %w", err)
+	}
+
+	return nil
+}
+
+func (repo *RepoPostgre) GetFilmGenres(filmId uint64) ([]uint64, error) {
+	genres := []uint64{}
+
+	rows, err := repo.db.Query("SELECT id_genre FROM films_genre WHERE id_film = $1 ORDER BY id_genre", filmId)
+	if err != nil {
+		return nil, fmt.Errorf("get film genres err: %w", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var id uint64
+		err := rows.Scan(&id)
+		if err != nil {
+			return nil, fmt.Errorf("get film genres scan err: %w", err)
+		}
+		genres = append(genres, id)
+	}
+
+	return genres, nil
+}
+
+func (repo *RepoPostgre) AddFilmGenres(filmId uint64, genres []uint64) error {
+	_, err := repo.db.Exec("INSERT INTO films_genre(id_film, id_genre) SELECT $1, unnest($2::bigint[])",
+		filmId, pq.Array(genres))
+	if err != nil {
+		return fmt.Errorf("add film genres err: %w", err)
+	}
+
+	return nil
+}
+
+func (repo *RepoPostgre) RemoveFilmGenres(filmId uint64, genres []uint64) error {
+	_, err := repo.db.Exec("DELETE FROM films_genre WHERE id_film = $1 AND id_genre = ANY($2::bigint[])",
+		filmId, pq.Array(genres))
+	if err != nil {
+		return fmt.Errorf("remove film genres err: %w", err)
+	}
+
+	return nil
+}
+
+// GetProfessionId returns 0 for an unknown profession.
+func (repo *RepoPostgre) GetProfessionId(title string) (uint64, error) {
+	var id uint64
+	err := repo.db.QueryRow("SELECT id FROM profession WHERE title = $1", title).Scan(&id)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return 0, nil
+		}
+		return 0, fmt.Errorf("get profession id err: %w", err)
+	}
+
+	return id, nil
+}
+
+// FindCredit returns the character name of an existing credit.
+func (repo *RepoPostgre) FindCredit(filmId uint64, personId uint64, professionId uint64) (*string, error) {
+	var character string
+	err := repo.db.QueryRow("SELECT COALESCE(character_name, '') FROM person_in_film "+
+		"WHERE id_film = $1 AND id_person = $2 AND id_profession = $3", filmId, personId, professionId).Scan(&character)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("find credit err: %w", err)
+	}
+
+	return &character, nil
+}
+
+func (repo *RepoPostgre) CreateCredit(filmId uint64, personId uint64, professionId uint64, character string) error {
+	_, err := repo.db.Exec("INSERT INTO person_in_film(id_film, id_person, id_profession, character_name) "+
+		"VALUES($1, $2, $3, $4)", filmId, personId, professionId, character)
+	if err != nil {
+		return fmt.Errorf("create credit err: %w", err)
+	}
+
+	return nil
+}
+
+func (repo *RepoPostgre) UpdateCredit(filmId uint64, personId uint64, professionId uint64, character string) error {
+	_, err := repo.db.Exec("UPDATE person_in_film SET character_name = $1 "+
+		"WHERE id_film = $2 AND id_person = $3 AND id_profession = $4", character, filmId, personId, professionId)
+	if err != nil {
+		return fmt.Errorf("update credit err: %w", err)
+	}
+
+	return nil
+}
+
+func (repo *RepoPostgre) FindCalendarDate(filmId uint64) (*CalendarDate, error) {
+	date := &CalendarDate{}
+	err := repo.db.QueryRow("SELECT release_month, release_day FROM calendar WHERE id = $1", filmId).
+		Scan(&date.Month, &date.Day)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("find calendar date err: %w", err)
+	}
+
+	return date, nil
+}
+
+func (repo *RepoPostgre) CreateCalendarDate(filmId uint64, date CalendarDate) error {
+	_, err := repo.db.Exec("INSERT INTO calendar(id, release_month, release_day) VALUES($1, $2, $3)",
+		filmId, date.Month, date.Day)
+	if err != nil {
+		return fmt.Errorf("create calendar date err: %w", err)
+	}
+
+	return nil
+}
+
+func (repo *RepoPostgre) UpdateCalendarDate(filmId uint64, date CalendarDate) error {
+	_, err := repo.db.Exec("UPDATE calendar SET release_month = $1, release_day = $2 WHERE id = $3",
+		date.Month, date.Day, filmId)
+	if err != nil {
+		return fmt.Errorf("update calendar date err: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,177 @@
+package catalog
+
+import (
+	"database/sql"
+	"fmt"
+	"reflect"
+	"regexp"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+)
+
+func TestFindGenre(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("cant create mock: %s", err)
+	}
+	defer db.Close()
+
+	selectRow := "SELECT id, COALESCE(external_id, ''), title FROM genre WHERE external_id = $1"
+	expect := &Genre{Id: 1, ExternalId: "drama", Title: "Драма"}
+
+	mock.ExpectQuery(
+		regexp.QuoteMeta(selectRow)).
+		WithArgs("drama").
+		WillReturnRows(sqlmock.NewRows([]string{"Id", "ExternalId", "Title"}).AddRow(1, "drama", "Драма"))
+
+	repo := &RepoPostgre{
+		db: db,
+	}
+
+	genre, err := repo.FindGenre("drama")
+	if err != nil {
+		t.Errorf("FindGenre error: %s", err)
+	}
+	if !reflect.DeepEqual(genre, expect) {
+		t.Errorf("results not match, want %v, have %v", expect, genre)
+		return
+	}
+
+	mock.ExpectQuery(
+		regexp.QuoteMeta(selectRow)).
+		WithArgs("noir").
+		WillReturnError(sql.ErrNoRows)
+
+	genre, err = repo.FindGenre("noir")
+	if err != nil || genre != nil {
+		t.Errorf("unknown genre must be nil without error, have %v, %v", genre, err)
+		return
+	}
+
+	mock.ExpectQuery(
+		regexp.QuoteMeta(selectRow)).
+		WithArgs("drama").
+		WillReturnError(fmt.Errorf("db_error"))
+
+	_, err = repo.FindGenre("drama")
+	if err == nil {
+		t.Errorf("expected error, got nil")
+		return
+	}
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("there were unfulfilled expectations: %s", err)
+	}
+}
+
+func TestCreateGenre(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("cant create mock: %s", err)
+	}
+	defer db.Close()
+
+	mock.ExpectQuery(
+		regexp.QuoteMeta("INSERT INTO genre(external_id, title) VALUES($1, $2) RETURNING id")).
+		WithArgs("drama", "Драма").
+		WillReturnRows(sqlmock.NewRows([]string{"Id"}).AddRow(5))
+
+	repo := &RepoPostgre{
+		db: db,
+	}
+
+	id, err := repo.CreateGenre(Genre{ExternalId: "drama", Title: "Драма"})
+	if err != nil {
+		t.Errorf("CreateGenre error: %s", err)
+	}
+	if id != 5 {
+		t.Errorf("expected id 5, have %d", id)
+	}
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("there were unfulfilled expectations: %s", err)
+	}
+}
+
+func TestFilmGenres(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("cant create mock: %s", err)
+	}
+	defer db.Close()
+
+	mock.ExpectQuery(
+		regexp.QuoteMeta("SELECT id_genre FROM films_genre WHERE id_film = $1 ORDER BY id_genre")).
+		WithArgs(3).
+		WillReturnRows(sqlmock.NewRows([]string{"Id"}).AddRow(1).AddRow(4))
+	mock.ExpectExec(
+		regexp.QuoteMeta("INSERT INTO films_genre(id_film, id_genre) SELECT $1, unnest($2::bigint[])")).
+		WithArgs(3, "{2,5}").
+		WillReturnResult(sqlmock.NewResult(0, 2))
+	mock.ExpectExec(
+		regexp.QuoteMeta("DELETE FROM films_genre WHERE id_film = $1 AND id_genre = ANY($2::bigint[])")).
+		WithArgs(3, "{4}").
+		WillReturnError(fmt.Errorf("db_error"))
+
+	repo := &RepoPostgre{
+		db: db,
+	}
+
+	genres, err := repo.GetFilmGenres(3)
+	if err != nil {
+		t.Errorf("GetFilmGenres error: %s", err)
+	}
+	if !reflect.DeepEqual(genres, []uint64{1, 4}) {
+		t.Errorf("results not match, have %v", genres)
+	}
+
+	err = repo.AddFilmGenres(3, []uint64{2, 5})
+	if err != nil {
+		t.Errorf("AddFilmGenres error: %s", err)
+	}
+
+	err = repo.RemoveFilmGenres(3, []uint64{4})
+	if err == nil {
+		t.Errorf("expected error, got nil")
+	}
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("there were unfulfilled expectations: %s", err)
+	}
+}
+
+func TestGetProfessionId(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("cant create mock: %s", err)
+	}
+	defer db.Close()
+
+	selectRow := "SELECT id FROM profession WHERE title = $1"
+	mock.ExpectQuery(
+		regexp.QuoteMeta(selectRow)).
+		WithArgs("актёр").
+		WillReturnRows(sqlmock.NewRows([]string{"Id"}).AddRow(2))
+	mock.ExpectQuery(
+		regexp.QuoteMeta(selectRow)).
+		WithArgs("каскадёр").
+		WillReturnError(sql.ErrNoRows)
+
+	repo := &RepoPostgre{
+		db: db,
+	}
+
+	id, err := repo.GetProfessionId("актёр")
+	if err != nil || id != 2 {
+		t.Errorf("expected id 2, have %d, %v", id, err)
+	}
+	id, err = repo.GetProfessionId("каскадёр")
+	if err != nil || id != 0 {
+		t.Errorf("unknown profession must be 0 without error, have %d, %v", id, err)
+	}
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("there were unfulfilled expectations: %s", err)
+	}
+}
@@ -1,13 +1,13 @@
 package crew
 
 import (
+	"context"
 	"database/sql"
 	"errors"
 	"fmt"
 	"log/slog"
 	"strconv"
 	"strings"
-	"time"
 
 	"github.com/go-park-mail-ru/2023_2_Vkladyshi/configs"
 	"github.com/go-park-mail-ru/2023_2_Vkladyshi/pkg/models"
@@ -18,6 +18,16 @@ import (
 
 //go:generate mockgen -source=repo_crew.go -destination=../../mocks/crew_repo_mock.go -package=mocks
 
+const (
+	SortByDate   = "date"
+	SortByRating = "rating"
+)
+
+var filmographyOrder = map[string]string{
+	SortByDate:   "film.release_date DESC, film.id ",
+	SortByRating: "COALESCE(AVG(users_comment.rating), 0) DESC, film.id ",
+}
+
 type ICrewRepo interface {
 	GetFilmDirectors(filmId uint64) ([]models.CrewItem, error)
 	GetFilmScenarists(filmId uint64) ([]models.CrewItem, error)
@@ -29,6 +39,10 @@ type ICrewRepo interface {
 	AddFavoriteActor(userId uint64, actorId uint64) error
 	RemoveFavoriteActor(userId uint64, actorId uint64) error
 	AddFilm(actors []uint64, filmId uint64) error
+	GetActorFilms(actorId uint64, sortBy string, first uint64, limit uint64) ([]models.FilmographyItem, error)
+	GetActorFilmsCount(actorId uint64) (map[string]uint64, error)
+	GetActorKnownFor(actorId uint64, limit uint64) ([]models.FilmItem, error)
+	GetCrewLinks() ([]models.CrewLink, error)
 }
 
 type RepoPostgre struct {
@@ -52,19 +66,17 @@ func GetCrewRepo(config *configs.DbDsnCfg, lg *slog.Logger) (*RepoPostgre, error
 
 	postgreDb := RepoPostgre{db: db}
 
-	go postgreDb.pingDb(config.Timer, lg)
 	return &postgreDb, nil
 }
 
-func (repo *RepoPostgre) pingDb(timer uint32, lg *slog.Logger) {
-	for {
-		err := repo.db.Ping()
-		if err != nil {
-			lg.Error("Repo Crew db ping error", "err", err.Error())
-		}
+// Ping lets the health checker tell whether the database is reachable.
+func (repo *RepoPostgre) Ping(ctx context.Context) error {
+	return repo.db.PingContext(ctx)
+}
 
-		time.Sleep(time.Duration(timer) * time.Second)
-	}
+// Close closes the connection pool once the service has stopped.
+func (repo *RepoPostgre) Close() error {
+	return repo.db.Close()
 }
 
 func (repo *RepoPostgre) GetFilmDirectors(filmId uint64) ([]models.CrewItem, error) {
@@ -172,7 +184,7 @@ func (repo *RepoPostgre) FindActor(name string, birthDate string, films []string
 	if name != "" {
 		s.WriteString("WHERE ")
 		hasWhere = true
-		s.WriteString("name LIKE '%' || $1 || '%'")
+		s.WriteString("name LIKE '%' || $1 || '%' ")
 		paramNum++
 		params = append(params, name)
 	}
@@ -219,7 +231,8 @@ func (repo *RepoPostgre) FindActor(name string, birthDate string, films []string
 		} else {
 			s.WriteString("AND ")
 		}
-		s.WriteString("country = $" + strconv.Itoa(paramNum))
+		s.WriteString("film.country = $" + strconv.Itoa(paramNum) + " ")
+		paramNum++
 		params = append(params, country)
 	}
 
@@ -324,3 +337,119 @@ func (repo *RepoPostgre) AddFilm(actors []uint64, filmId uint64) error {
 	}
 	return nil
 }
+
+// GetActorFilms pages the films of every profession of the actor on its
+// own, so a page holds films first+1 to first+limit of each profession.
+func (repo *RepoPostgre) GetActorFilms(actorId uint64, sortBy string, first uint64, limit uint64) ([]models.FilmographyItem, error) {
+	films := []models.FilmographyItem{}
+
+	order, ok := filmographyOrder[sortBy]
+	if !ok {
+		order = filmographyOrder[SortByDate]
+	}
+
+	rows, err := repo.db.Query(
+		"SELECT id, title, poster, year, profession, character_name, rating FROM ("+
+			"SELECT film.id, film.title, film.poster, COALESCE(EXTRACT(YEAR FROM film.release_date)::int, 0) AS year, "+
+			"profession.title AS profession, person_in_film.character_name, COALESCE(AVG(users_comment.rating), 0) AS rating, "+
+			"ROW_NUMBER() OVER (PARTITION BY profession.title ORDER BY "+order+") AS position FROM person_in_film "+
+			"JOIN film ON film.id = person_in_film.id_film "+
+			"JOIN profession ON profession.id = person_in_film.id_profession "+
+			"LEFT JOIN users_comment ON film.id = users_comment.id_film "+
+			"WHERE person_in_film.id_person = $1 "+
+			"GROUP BY film.id, profession.title, person_in_film.character_name"+
+			") AS filmography WHERE position > $2 AND position <= $3 "+
+			"ORDER BY profession, position", actorId, first, first+limit)
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		return nil, fmt.Errorf("get actor films err: %w", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		post := models.FilmographyItem{}
+		err := rows.Scan(&post.IdFilm, &post.Title, &post.Poster, &post.ReleaseYear, &post.Profession, &post.NameCharacter, &post.Rating)
+		if err != nil {
+			return nil, fmt.Errorf("get actor films scan err: %w", err)
+		}
+		films = append(films, post)
+	}
+
+	return films, nil
+}
+
+// GetActorFilmsCount returns the number of films of the actor by profession.
+func (repo *RepoPostgre) GetActorFilmsCount(actorId uint64) (map[string]uint64, error) {
+	counts := map[string]uint64{}
+
+	rows, err := repo.db.Query(
+		"SELECT profession.title, COUNT(*) FROM person_in_film "+
+			"JOIN profession ON profession.id = person_in_film.id_profession "+
+			"WHERE person_in_film.id_person = $1 "+
+			"GROUP BY profession.title", actorId)
+	if err != nil {
+		return nil, fmt.Errorf("get actor films count err: %w", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var profession string
+		var count uint64
+		if err := rows.Scan(&profession, &count); err != nil {
+			return nil, fmt.Errorf("get actor films count scan err: %w", err)
+		}
+		counts[profession] = count
+	}
+
+	return counts, nil
+}
+
+func (repo *RepoPostgre) GetActorKnownFor(actorId uint64, limit uint64) ([]models.FilmItem, error) {
+	films := []models.FilmItem{}
+
+	rows, err := repo.db.Query(
+		"SELECT film.id, film.title, film.poster, COALESCE(AVG(users_comment.rating), 0) FROM film "+
+			"LEFT JOIN users_comment ON film.id = users_comment.id_film "+
+			"WHERE film.id IN (SELECT id_film FROM person_in_film WHERE id_person = $1) "+
+			"GROUP BY film.id "+
+			"ORDER BY COUNT(users_comment.id_film) DESC, film.id "+
+			"LIMIT $2", actorId, limit)
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		return nil, fmt.Errorf("get actor known for err: %w", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		post := models.FilmItem{}
+		err := rows.Scan(&post.Id, &post.Title, &post.Poster, &post.Rating)
+		if err != nil {
+			return nil, fmt.Errorf("get actor known for scan err: %w", err)
+		}
+		films = append(films, post)
+	}
+
+	return films, nil
+}
+
+func (repo *RepoPostgre) GetCrewLinks() ([]models.CrewLink, error) {
+	links := []models.CrewLink{}
+
+	rows, err := repo.db.Query(
+		"SELECT DISTINCT film.id, film.title, film.poster, crew.id, crew.name, crew.photo FROM person_in_film " +
+			"JOIN film ON film.id = person_in_film.id_film " +
+			"JOIN crew ON crew.id = person_in_film.id_person")
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		return nil, fmt.Errorf("get crew links err: %w", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		post := models.CrewLink{}
+		err := rows.Scan(&post.IdFilm, &post.Title, &post.Poster, &post.IdActor, &post.NameActor, &post.ActorPhoto)
+		if err != nil {
+			return nil, fmt.Errorf("get crew links scan err: %w", err)
+		}
+		links = append(links, post)
+	}
+
+	return links, nil
+}
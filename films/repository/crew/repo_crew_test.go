@@ -495,3 +495,300 @@ func TestAddFilm(t *testing.T) {
 		return
 	}
 }
+
+func TestGetActorFilms(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("cant create mock: %s", err)
+	}
+	defer db.Close()
+
+	rows := sqlmock.NewRows([]string{"Id", "Title", "Poster", "Year", "Profession", "Character", "Rating"})
+
+	expect := []models.FilmographyItem{
+		{IdFilm: 1, Title: "t1", Poster: "p1", ReleaseYear: 2003, Profession: "актёр", NameCharacter: "c1", Rating: 7.5},
+	}
+
+	for _, item := range expect {
+		rows = rows.AddRow(item.IdFilm, item.Title, item.Poster, item.ReleaseYear, item.Profession, item.NameCharacter, item.Rating)
+	}
+
+	query := "SELECT id, title, poster, year, profession, character_name, rating FROM (" +
+		"SELECT film.id, film.title, film.poster, COALESCE(EXTRACT(YEAR FROM film.release_date)::int, 0) AS year, " +
+		"profession.title AS profession, person_in_film.character_name, COALESCE(AVG(users_comment.rating), 0) AS rating, " +
+		"ROW_NUMBER() OVER (PARTITION BY profession.title ORDER BY COALESCE(AVG(users_comment.rating), 0) DESC, film.id ) AS position FROM person_in_film " +
+		"JOIN film ON film.id = person_in_film.id_film " +
+		"JOIN profession ON profession.id = person_in_film.id_profession " +
+		"LEFT JOIN users_comment ON film.id = users_comment.id_film " +
+		"WHERE person_in_film.id_person = $1 " +
+		"GROUP BY film.id, profession.title, person_in_film.character_name" +
+		") AS filmography WHERE position > $2 AND position <= $3 " +
+		"ORDER BY profession, position"
+
+	mock.ExpectQuery(regexp.QuoteMeta(query)).
+		WithArgs(1, 10, 20).
+		WillReturnRows(rows)
+
+	repo := &RepoPostgre{
+		db: db,
+	}
+
+	films, err := repo.GetActorFilms(1, SortByRating, 10, 10)
+	if err != nil {
+		t.Errorf("GetActorFilms error: %s", err)
+	}
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("there were unfulfilled expectations: %s", err)
+		return
+	}
+
+	if !reflect.DeepEqual(films, expect) {
+		t.Errorf("results not match, want %v, have %v", expect, films)
+		return
+	}
+
+	mock.ExpectQuery(regexp.QuoteMeta("PARTITION BY profession.title ORDER BY film.release_date DESC, film.id ) AS position")).
+		WithArgs(1, 0, 10).
+		WillReturnError(fmt.Errorf("db_error"))
+
+	films, err = repo.GetActorFilms(1, "", 0, 10)
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("there were unfulfilled expectations: %s", err)
+		return
+	}
+	if err == nil {
+		t.Errorf("expected error, got nil")
+		return
+	}
+	if films != nil {
+		t.Errorf("get actor films error, films should be nil")
+	}
+}
+
+func TestGetActorFilmsCount(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("cant create mock: %s", err)
+	}
+	defer db.Close()
+
+	rows := sqlmock.NewRows([]string{"Profession", "Count"}).
+		AddRow("актёр", 2).
+		AddRow("режиссёр", 1)
+
+	query := "SELECT profession.title, COUNT(*) FROM person_in_film " +
+		"JOIN profession ON profession.id = person_in_film.id_profession " +
+		"WHERE person_in_film.id_person = $1 " +
+		"GROUP BY profession.title"
+
+	mock.ExpectQuery(regexp.QuoteMeta(query)).
+		WithArgs(1).
+		WillReturnRows(rows)
+
+	repo := &RepoPostgre{
+		db: db,
+	}
+
+	counts, err := repo.GetActorFilmsCount(1)
+	if err != nil {
+		t.Errorf("GetActorFilmsCount error: %s", err)
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("there were unfulfilled expectations: %s", err)
+		return
+	}
+	if !reflect.DeepEqual(counts, map[string]uint64{"актёр": 2, "режиссёр": 1}) {
+		t.Errorf("GetActorFilmsCount = %v", counts)
+	}
+}
+
+func TestGetActorKnownFor(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("cant create mock: %s", err)
+	}
+	defer db.Close()
+
+	rows := sqlmock.NewRows([]string{"Id", "Title", "Poster", "Rating"})
+
+	expect := []models.FilmItem{
+		{Id: 1, Title: "t1", Poster: "p1", Rating: 8},
+	}
+
+	for _, item := range expect {
+		rows = rows.AddRow(item.Id, item.Title, item.Poster, item.Rating)
+	}
+
+	query := "SELECT film.id, film.title, film.poster, COALESCE(AVG(users_comment.rating), 0) FROM film " +
+		"LEFT JOIN users_comment ON film.id = users_comment.id_film " +
+		"WHERE film.id IN (SELECT id_film FROM person_in_film WHERE id_person = $1) " +
+		"GROUP BY film.id " +
+		"ORDER BY COUNT(users_comment.id_film) DESC, film.id " +
+		"LIMIT $2"
+
+	mock.ExpectQuery(regexp.QuoteMeta(query)).
+		WithArgs(1, 5).
+		WillReturnRows(rows)
+
+	repo := &RepoPostgre{
+		db: db,
+	}
+
+	films, err := repo.GetActorKnownFor(1, 5)
+	if err != nil {
+		t.Errorf("GetActorKnownFor error: %s", err)
+	}
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("there were unfulfilled expectations: %s", err)
+		return
+	}
+
+	if !reflect.DeepEqual(films, expect) {
+		t.Errorf("results not match, want %v, have %v", expect, films)
+		return
+	}
+
+	mock.ExpectQuery(regexp.QuoteMeta(query)).
+		WithArgs(1, 5).
+		WillReturnError(fmt.Errorf("db_error"))
+
+	films, err = repo.GetActorKnownFor(1, 5)
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("there were unfulfilled expectations: %s", err)
+		return
+	}
+	if err == nil {
+		t.Errorf("expected error, got nil")
+		return
+	}
+	if films != nil {
+		t.Errorf("get known for error, films should be nil")
+	}
+}
+
+func TestGetCrewLinks(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("cant create mock: %s", err)
+	}
+	defer db.Close()
+
+	rows := sqlmock.NewRows([]string{"IdFilm", "Title", "Poster", "IdActor", "Name", "Photo"})
+
+	expect := []models.CrewLink{
+		{IdFilm: 1, Title: "t1", Poster: "p1", IdActor: 2, NameActor: "n2", ActorPhoto: "ph2"},
+	}
+
+	for _, item := range expect {
+		rows = rows.AddRow(item.IdFilm, item.Title, item.Poster, item.IdActor, item.NameActor, item.ActorPhoto)
+	}
+
+	query := "SELECT DISTINCT film.id, film.title, film.poster, crew.id, crew.name, crew.photo FROM person_in_film " +
+		"JOIN film ON film.id = person_in_film.id_film " +
+		"JOIN crew ON crew.id = person_in_film.id_person"
+
+	mock.ExpectQuery(regexp.QuoteMeta(query)).
+		WillReturnRows(rows)
+
+	repo := &RepoPostgre{
+		db: db,
+	}
+
+	links, err := repo.GetCrewLinks()
+	if err != nil {
+		t.Errorf("GetCrewLinks error: %s", err)
+	}
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("there were unfulfilled expectations: %s", err)
+		return
+	}
+
+	if !reflect.DeepEqual(links, expect) {
+		t.Errorf("results not match, want %v, have %v", expect, links)
+		return
+	}
+
+	mock.ExpectQuery(regexp.QuoteMeta(query)).
+		WillReturnError(fmt.Errorf("db_error"))
+
+	links, err = repo.GetCrewLinks()
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("there were unfulfilled expectations: %s", err)
+		return
+	}
+	if err == nil {
+		t.Errorf("expected error, got nil")
+		return
+	}
+	if links != nil {
+		t.Errorf("get crew links error, links should be nil")
+	}
+}
+
+func TestFindActor(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("cant create mock: %s", err)
+	}
+	defer db.Close()
+
+	rows := sqlmock.NewRows([]string{"Id", "Name", "Photo"})
+
+	expect := []models.Character{
+		{IdActor: 1, NameActor: "n1", ActorPhoto: "p1"},
+	}
+
+	for _, item := range expect {
+		rows = rows.AddRow(item.IdActor, item.NameActor, item.ActorPhoto)
+	}
+
+	selectRow := "SELECT DISTINCT crew.id, crew.name, crew.photo FROM crew " +
+		"JOIN person_in_film ON crew.id = person_in_film.id_person " +
+		"JOIN film ON person_in_film.id_film = film.id " +
+		"JOIN profession ON person_in_film.id_profession = profession.id "
+
+	mock.ExpectQuery(
+		regexp.QuoteMeta(selectRow+"WHERE name LIKE '%' || $1 || '%' AND film.country = $2 LIMIT $3 OFFSET $4")).
+		WithArgs("n", "Россия", 10, 0).
+		WillReturnRows(rows)
+
+	repo := &RepoPostgre{
+		db: db,
+	}
+
+	actors, err := repo.FindActor("n", "", []string{""}, []string{""}, "Россия", 0, 10)
+	if err != nil {
+		t.Errorf("FindActor error: %s", err)
+	}
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("there were unfulfilled expectations: %s", err)
+		return
+	}
+
+	if !reflect.DeepEqual(actors, expect) {
+		t.Errorf("results not match, want %v, have %v", expect, actors)
+		return
+	}
+
+	mock.ExpectQuery(
+		regexp.QuoteMeta(selectRow+"WHERE film.country = $1 LIMIT $2 OFFSET $3")).
+		WithArgs("Россия", 10, 0).
+		WillReturnError(fmt.Errorf("db_error"))
+
+	actors, err = repo.FindActor("", "", []string{""}, []string{""}, "Россия", 0, 10)
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("there were unfulfilled expectations: %s", err)
+		return
+	}
+	if err == nil {
+		t.Errorf("expected error, got nil")
+		return
+	}
+	if actors != nil {
+		t.Errorf("find actor error, actors should be nil")
+	}
+}
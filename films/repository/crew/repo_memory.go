@@ -0,0 +1,371 @@
+package crew
+
+import (
+	"cmp"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/go-park-mail-ru/2023_2_Vkladyshi/configs"
+	"github.com/go-park-mail-ru/2023_2_Vkladyshi/pkg/memory"
+	"github.com/go-park-mail-ru/2023_2_Vkladyshi/pkg/models"
+)
+
+type RepoMemory struct {
+	store *memory.Store
+}
+
+func GetCrewMemoryRepo(config *configs.DbDsnCfg) (*RepoMemory, error) {
+	store, err := memory.Open(config.Seed)
+	if err != nil {
+		return nil, fmt.Errorf("get crew repo: %w", err)
+	}
+
+	return &RepoMemory{store: store}, nil
+}
+
+// credits returns the film's crew with the given profession.
+func (repo *RepoMemory) credits(filmId uint64, profession string) []memory.Credit {
+	id := repo.store.ProfessionId(profession)
+	credits := []memory.Credit{}
+	for _, credit := range repo.store.Credits {
+		if credit.IdFilm == filmId && credit.IdProfession == id && repo.store.Person(credit.IdPerson) != nil {
+			credits = append(credits, credit)
+		}
+	}
+
+	return credits
+}
+
+func (repo *RepoMemory) crewItems(filmId uint64, profession string) []models.CrewItem {
+	crew := []models.CrewItem{}
+	for _, credit := range repo.credits(filmId, profession) {
+		person := repo.store.Person(credit.IdPerson)
+		crew = append(crew, models.CrewItem{Id: person.Id, Name: person.Name, Photo: person.Photo})
+	}
+
+	return crew
+}
+
+func (repo *RepoMemory) GetFilmDirectors(filmId uint64) ([]models.CrewItem, error) {
+	repo.store.RLock()
+	defer repo.store.RUnlock()
+
+	return repo.crewItems(filmId, "режиссёр"), nil
+}
+
+func (repo *RepoMemory) GetFilmScenarists(filmId uint64) ([]models.CrewItem, error) {
+	repo.store.RLock()
+	defer repo.store.RUnlock()
+
+	return repo.crewItems(filmId, "сценарист"), nil
+}
+
+func (repo *RepoMemory) GetFilmCharacters(filmId uint64) ([]models.Character, error) {
+	repo.store.RLock()
+	defer repo.store.RUnlock()
+
+	characters := []models.Character{}
+	for _, credit := range repo.credits(filmId, "актёр") {
+		person := repo.store.Person(credit.IdPerson)
+		characters = append(characters, models.Character{
+			IdActor:       person.Id,
+			NameActor:     person.Name,
+			ActorPhoto:    person.Photo,
+			NameCharacter: credit.Character,
+		})
+	}
+
+	return characters, nil
+}
+
+func (repo *RepoMemory) GetActor(actorId uint64) (*models.CrewItem, error) {
+	repo.store.RLock()
+	defer repo.store.RUnlock()
+
+	person := repo.store.Person(actorId)
+	if person == nil {
+		return &models.CrewItem{}, nil
+	}
+
+	return &models.CrewItem{
+		Id:        person.Id,
+		Name:      person.Name,
+		Birthdate: memory.Date(person.BirthDate),
+		Photo:     person.Photo,
+		Info:      person.Info,
+	}, nil
+}
+
+func (repo *RepoMemory) FindActor(name string, birthDate string, films []string, career []string, country string, first, limit uint64) ([]models.Character, error) {
+	repo.store.RLock()
+	defer repo.store.RUnlock()
+
+	actors := []models.Character{}
+	for _, person := range repo.store.Crew {
+		if name != "" && !strings.Contains(person.Name, name) {
+			continue
+		}
+		if birthDate != "" && person.BirthDate != birthDate {
+			continue
+		}
+		if country != "" && person.Country != country {
+			continue
+		}
+		if !repo.hasCredit(person.Id, films, career) {
+			continue
+		}
+		actors = append(actors, models.Character{IdActor: person.Id, NameActor: person.Name, ActorPhoto: person.Photo})
+	}
+
+	return memory.Paginate(actors, first, limit), nil
+}
+
+// hasCredit reports whether the person has worked on one of the films in one
+// of the professions. Lists starting with an empty string match anything.
+func (repo *RepoMemory) hasCredit(personId uint64, films []string, career []string) bool {
+	for _, credit := range repo.store.Credits {
+		if credit.IdPerson != personId {
+			continue
+		}
+		film := repo.store.Film(credit.IdFilm)
+		profession := repo.store.Profession(credit.IdProfession)
+		if film == nil || profession == nil {
+			continue
+		}
+		if len(films) != 0 && films[0] != "" && !slices.Contains(films, film.Title) {
+			continue
+		}
+		if len(career) != 0 && career[0] != "" && !slices.Contains(career, profession.Title) {
+			continue
+		}
+
+		return true
+	}
+
+	return false
+}
+
+func (repo *RepoMemory) GetFavoriteActors(userId uint64, start uint64, end uint64) ([]models.Character, error) {
+	repo.store.RLock()
+	defer repo.store.RUnlock()
+
+	actors := []models.Character{}
+	for _, favorite := range repo.store.FavoriteActors {
+		if favorite.IdUser != userId {
+			continue
+		}
+		if person := repo.store.Person(favorite.Id); person != nil {
+			actors = append(actors, models.Character{IdActor: person.Id, NameActor: person.Name, ActorPhoto: person.Photo})
+		}
+	}
+
+	return memory.Paginate(actors, start, end), nil
+}
+
+func (repo *RepoMemory) CheckActor(userId uint64, actorId uint64) (bool, error) {
+	repo.store.RLock()
+	defer repo.store.RUnlock()
+
+	return slices.Contains(repo.store.FavoriteActors, memory.Favorite{IdUser: userId, Id: actorId}), nil
+}
+
+func (repo *RepoMemory) AddFavoriteActor(userId uint64, actorId uint64) error {
+	repo.store.Lock()
+	defer repo.store.Unlock()
+
+	if repo.store.Person(actorId) == nil {
+		return fmt.Errorf("add favorite actor err: actor %d not found", actorId)
+	}
+	favorite := memory.Favorite{IdUser: userId, Id: actorId}
+	if slices.Contains(repo.store.FavoriteActors, favorite) {
+		return fmt.Errorf("add favorite actor err: actor %d is already favorite", actorId)
+	}
+	repo.store.FavoriteActors = append(repo.store.FavoriteActors, favorite)
+
+	return nil
+}
+
+func (repo *RepoMemory) RemoveFavoriteActor(userId uint64, actorId uint64) error {
+	repo.store.Lock()
+	defer repo.store.Unlock()
+
+	repo.store.FavoriteActors = slices.DeleteFunc(repo.store.FavoriteActors, func(favorite memory.Favorite) bool {
+		return favorite.IdUser == userId && favorite.Id == actorId
+	})
+
+	return nil
+}
+
+func (repo *RepoMemory) AddFilm(actors []uint64, filmId uint64) error {
+	repo.store.Lock()
+	defer repo.store.Unlock()
+
+	credits := make([]memory.Credit, 0, len(actors))
+	for _, actor := range actors {
+		credit := memory.Credit{IdFilm: filmId, IdPerson: actor, IdProfession: 1}
+		if repo.store.Film(filmId) == nil || repo.store.Person(actor) == nil {
+			return fmt.Errorf("add films actors error: film %d or actor %d not found", filmId, actor)
+		}
+		if slices.Contains(repo.store.Credits, credit) || slices.Contains(credits, credit) {
+			return fmt.Errorf("add films actors error: actor %d is already in film %d", actor, filmId)
+		}
+		credits = append(credits, credit)
+	}
+	repo.store.Credits = append(repo.store.Credits, credits...)
+
+	return nil
+}
+
+func (repo *RepoMemory) GetActorFilms(actorId uint64, sortBy string, first uint64, limit uint64) ([]models.FilmographyItem, error) {
+	repo.store.RLock()
+	defer repo.store.RUnlock()
+
+	films := []models.FilmographyItem{}
+	dates := map[uint64]string{}
+	for _, credit := range repo.store.Credits {
+		if credit.IdPerson != actorId {
+			continue
+		}
+		film := repo.store.Film(credit.IdFilm)
+		profession := repo.store.Profession(credit.IdProfession)
+		if film == nil || profession == nil {
+			continue
+		}
+
+		post := models.FilmographyItem{
+			IdFilm:        film.Id,
+			Title:         film.Title,
+			Poster:        film.Poster,
+			Profession:    profession.Title,
+			NameCharacter: credit.Character,
+		}
+		if len(film.ReleaseDate) >= 4 {
+			year, _ := strconv.ParseUint(film.ReleaseDate[:4], 10, 16)
+			post.ReleaseYear = uint16(year)
+		}
+		post.Rating, _ = repo.store.Rating(film.Id)
+		dates[film.Id] = film.ReleaseDate
+		films = append(films, post)
+	}
+
+	slices.SortStableFunc(films, func(a, b models.FilmographyItem) int {
+		if order := strings.Compare(a.Profession, b.Profession); order != 0 {
+			return order
+		}
+		if sortBy == SortByRating {
+			if order := cmp.Compare(b.Rating, a.Rating); order != 0 {
+				return order
+			}
+		} else if order := compareDatesDesc(dates[a.IdFilm], dates[b.IdFilm]); order != 0 {
+			return order
+		}
+
+		return cmp.Compare(a.IdFilm, b.IdFilm)
+	})
+
+	page := []models.FilmographyItem{}
+	for start := 0; start < len(films); {
+		end := start
+		for end < len(films) && films[end].Profession == films[start].Profession {
+			end++
+		}
+		page = append(page, memory.Paginate(films[start:end], first, limit)...)
+		start = end
+	}
+
+	return page, nil
+}
+
+// compareDatesDesc orders dates like ORDER BY ... DESC, missing dates first.
+func compareDatesDesc(a string, b string) int {
+	switch {
+	case a == b:
+		return 0
+	case a == "":
+		return -1
+	case b == "":
+		return 1
+	}
+
+	return strings.Compare(b, a)
+}
+
+func (repo *RepoMemory) GetActorFilmsCount(actorId uint64) (map[string]uint64, error) {
+	repo.store.RLock()
+	defer repo.store.RUnlock()
+
+	counts := map[string]uint64{}
+	for _, credit := range repo.store.Credits {
+		if credit.IdPerson != actorId {
+			continue
+		}
+		if profession := repo.store.Profession(credit.IdProfession); profession != nil {
+			counts[profession.Title]++
+		}
+	}
+
+	return counts, nil
+}
+
+func (repo *RepoMemory) GetActorKnownFor(actorId uint64, limit uint64) ([]models.FilmItem, error) {
+	repo.store.RLock()
+	defer repo.store.RUnlock()
+
+	films := []models.FilmItem{}
+	counts := map[uint64]uint64{}
+	for _, credit := range repo.store.Credits {
+		if credit.IdPerson != actorId || slices.ContainsFunc(films, func(film models.FilmItem) bool {
+			return film.Id == credit.IdFilm
+		}) {
+			continue
+		}
+		film := repo.store.Film(credit.IdFilm)
+		if film == nil {
+			continue
+		}
+
+		post := models.FilmItem{Id: film.Id, Title: film.Title, Poster: film.Poster}
+		post.Rating, counts[film.Id] = repo.store.Rating(film.Id)
+		films = append(films, post)
+	}
+
+	slices.SortFunc(films, func(a, b models.FilmItem) int {
+		if order := cmp.Compare(counts[b.Id], counts[a.Id]); order != 0 {
+			return order
+		}
+
+		return cmp.Compare(a.Id, b.Id)
+	})
+
+	return memory.Paginate(films, 0, limit), nil
+}
+
+func (repo *RepoMemory) GetCrewLinks() ([]models.CrewLink, error) {
+	repo.store.RLock()
+	defer repo.store.RUnlock()
+
+	links := []models.CrewLink{}
+	for _, credit := range repo.store.Credits {
+		film := repo.store.Film(credit.IdFilm)
+		person := repo.store.Person(credit.IdPerson)
+		if film == nil || person == nil {
+			continue
+		}
+
+		link := models.CrewLink{
+			IdFilm:     film.Id,
+			Title:      film.Title,
+			Poster:     film.Poster,
+			IdActor:    person.Id,
+			NameActor:  person.Name,
+			ActorPhoto: person.Photo,
+		}
+		if !slices.Contains(links, link) {
+			links = append(links, link)
+		}
+	}
+
+	return links, nil
+}
@@ -0,0 +1,121 @@
+package crew
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/go-park-mail-ru/2023_2_Vkladyshi/pkg/memory"
+	"github.com/go-park-mail-ru/2023_2_Vkladyshi/pkg/models"
+)
+
+func getMemoryRepo() *RepoMemory {
+	store, _ := memory.Load("")
+	store.Films = []memory.Film{
+		{Id: 1, Title: "Солярис", ReleaseDate: "1972-03-20"},
+		{Id: 2, Title: "Сталкер", ReleaseDate: "1979-05-25"},
+		{Id: 3, Title: "Зеркало"},
+	}
+	store.Crew = []memory.Person{
+		{Id: 1, Name: "Андрей Тарковский", Country: "СССР"},
+		{Id: 2, Name: "Донатас Банионис", Country: "Литва"},
+	}
+	store.Credits = []memory.Credit{
+		{IdFilm: 1, IdPerson: 1, IdProfession: 2},
+		{IdFilm: 2, IdPerson: 1, IdProfession: 2},
+		{IdFilm: 3, IdPerson: 1, IdProfession: 2},
+		{IdFilm: 1, IdPerson: 2, IdProfession: 1, Character: "Крис Кельвин"},
+	}
+	store.Comments = []memory.Comment{
+		{IdUser: 1, IdFilm: 2, Rating: 10},
+		{IdUser: 2, IdFilm: 2, Rating: 8},
+		{IdUser: 1, IdFilm: 1, Rating: 6},
+	}
+
+	return &RepoMemory{store: store}
+}
+
+func TestMemoryFilmCrew(t *testing.T) {
+	repo := getMemoryRepo()
+
+	directors, _ := repo.GetFilmDirectors(1)
+	if !reflect.DeepEqual(directors, []models.CrewItem{{Id: 1, Name: "Андрей Тарковский"}}) {
+		t.Errorf("GetFilmDirectors = %v", directors)
+	}
+	characters, _ := repo.GetFilmCharacters(1)
+	if !reflect.DeepEqual(characters, []models.Character{{IdActor: 2, NameActor: "Донатас Банионис", NameCharacter: "Крис Кельвин"}}) {
+		t.Errorf("GetFilmCharacters = %v", characters)
+	}
+	scenarists, _ := repo.GetFilmScenarists(1)
+	if len(scenarists) != 0 {
+		t.Errorf("GetFilmScenarists = %v", scenarists)
+	}
+
+	actors, _ := repo.FindActor("", "", []string{"Солярис"}, []string{"актёр"}, "", 0, 10)
+	if len(actors) != 1 || actors[0].IdActor != 2 {
+		t.Errorf("FindActor = %v", actors)
+	}
+	actors, _ = repo.FindActor("Андрей", "", []string{""}, []string{""}, "СССР", 0, 10)
+	if len(actors) != 1 || actors[0].IdActor != 1 {
+		t.Errorf("FindActor = %v", actors)
+	}
+}
+
+func TestMemoryActorFilms(t *testing.T) {
+	repo := getMemoryRepo()
+
+	testCases := map[string][]uint64{
+		SortByDate:   {3, 2, 1},
+		SortByRating: {2, 1, 3},
+	}
+	for sortBy, expect := range testCases {
+		films, err := repo.GetActorFilms(1, sortBy, 0, 10)
+		if err != nil {
+			t.Errorf("GetActorFilms error: %s", err)
+		}
+		have := []uint64{}
+		for _, film := range films {
+			have = append(have, film.IdFilm)
+		}
+		if !reflect.DeepEqual(have, expect) {
+			t.Errorf("%s: GetActorFilms = %v, want %v", sortBy, have, expect)
+		}
+	}
+
+	films, _ := repo.GetActorFilms(1, SortByDate, 1, 1)
+	if len(films) != 1 || films[0].ReleaseYear != 1979 || films[0].Rating != 9 || films[0].Profession != "режиссёр" {
+		t.Errorf("unexpected filmography item %v", films)
+	}
+
+	knownFor, _ := repo.GetActorKnownFor(1, 2)
+	if len(knownFor) != 2 || knownFor[0].Id != 2 || knownFor[1].Id != 1 {
+		t.Errorf("GetActorKnownFor = %v", knownFor)
+	}
+	if counts, _ := repo.GetActorFilmsCount(1); !reflect.DeepEqual(counts, map[string]uint64{"режиссёр": 3}) {
+		t.Errorf("GetActorFilmsCount = %v", counts)
+	}
+}
+
+func TestMemoryActorFilmsPages(t *testing.T) {
+	repo := getMemoryRepo()
+	repo.store.Credits = append(repo.store.Credits,
+		memory.Credit{IdFilm: 2, IdPerson: 1, IdProfession: 1, Character: "Прохожий"},
+		memory.Credit{IdFilm: 1, IdPerson: 1, IdProfession: 1, Character: "Гость"},
+	)
+
+	// Every profession is paged on its own, so the second page of one film
+	// each holds the second film of both professions.
+	films, _ := repo.GetActorFilms(1, SortByDate, 1, 1)
+	have := []string{}
+	for _, film := range films {
+		have = append(have, fmt.Sprintf("%s %d", film.Profession, film.IdFilm))
+	}
+	if want := []string{"актёр 1", "режиссёр 2"}; !reflect.DeepEqual(have, want) {
+		t.Errorf("GetActorFilms = %v, want %v", have, want)
+	}
+
+	counts, _ := repo.GetActorFilmsCount(1)
+	if !reflect.DeepEqual(counts, map[string]uint64{"актёр": 2, "режиссёр": 3}) {
+		t.Errorf("GetActorFilmsCount = %v", counts)
+	}
+}
@@ -1,13 +1,13 @@
 package film
 
 import (
+	"context"
 	"database/sql"
 	"errors"
 	"fmt"
 	"log/slog"
 	"strconv"
 	"strings"
-	"time"
 
 	"github.com/go-park-mail-ru/2023_2_Vkladyshi/configs"
 	"github.com/go-park-mail-ru/2023_2_Vkladyshi/pkg/models"
@@ -23,7 +23,8 @@ type IFilmsRepo interface {
 	GetFilm(filmId uint64) (*models.FilmItem, error)
 	GetFilmRating(filmId uint64) (float64, uint64, error)
 	FindFilm(title string, dateFrom string, dateTo string, ratingFrom float32, ratingTo float32,
-		mpaa string, genres []uint32, actors []string, first uint64, limit uint64,
+		mpaa string, genres []uint32, actors []string, runtimeFrom uint32, runtimeTo uint32, language string,
+		first uint64, limit uint64,
 	) ([]models.FilmItem, error)
 	GetFavoriteFilms(userId uint64, start uint64, end uint64) ([]models.FilmItem, error)
 	AddFavoriteFilm(userId uint64, filmId uint64) error
@@ -32,10 +33,13 @@ type IFilmsRepo interface {
 	AddRating(filmId uint64, userId uint64, rating uint16) error
 	HasUsersRating(userId uint64, filmId uint64) (bool, error)
 	AddFilm(film models.FilmItem) error
+	EditFilm(edit models.FilmEdit) error
 	GetFilmId(title string) (uint64, error)
+	CountPosterUsage(poster string) (uint64, error)
 	DeleteRating(idUser uint64, idFilm uint64) error
 	Trends() ([]models.FilmItem, error)
 	GetLasts(ids []uint64) ([]models.FilmItem, error)
+	GetFilmsByIds(ids []uint64) ([]models.FilmItem, error)
 }
 
 type RepoPostgre struct {
@@ -59,19 +63,17 @@ func GetFilmRepo(config *configs.DbDsnCfg, lg *slog.Logger) (*RepoPostgre, error
 
 	postgreDb := RepoPostgre{db: db}
 
-	go postgreDb.pingDb(config.Timer, lg)
 	return &postgreDb, nil
 }
 
-func (repo *RepoPostgre) pingDb(timer uint32, lg *slog.Logger) {
-	for {
-		err := repo.db.Ping()
-		if err != nil {
-			lg.Error("Repo Film db ping error", "err", err.Error())
-		}
+// Ping lets the health checker tell whether the database is reachable.
+func (repo *RepoPostgre) Ping(ctx context.Context) error {
+	return repo.db.PingContext(ctx)
+}
 
-		time.Sleep(time.Duration(timer) * time.Second)
-	}
+// Close closes the connection pool once the service has stopped.
+func (repo *RepoPostgre) Close() error {
+	return repo.db.Close()
 }
 
 func (repo *RepoPostgre) GetFilmsByGenre(genre uint64, start uint64, end uint64) ([]models.FilmItem, error) {
@@ -129,9 +131,13 @@ func (repo *RepoPostgre) GetFilms(start uint64, end uint64) ([]models.FilmItem,
 func (repo *RepoPostgre) GetFilm(filmId uint64) (*models.FilmItem, error) {
 	film := &models.FilmItem{}
 	err := repo.db.QueryRow(
-		"SELECT id, title, info, poster, release_date, country, mpaa FROM film "+
+		"SELECT id, title, info, poster, release_date, country, mpaa, "+
+			"COALESCE(original_title, ''), COALESCE(runtime, 0), COALESCE(budget, 0), COALESCE(box_office, 0), "+
+			"languages, trailers, COALESCE(imdb_id, ''), COALESCE(kinopoisk_id, '') FROM film "+
 			"WHERE id = $1", filmId).
-		Scan(&film.Id, &film.Title, &film.Info, &film.Poster, &film.ReleaseDate, &film.Country, &film.Mpaa)
+		Scan(&film.Id, &film.Title, &film.Info, &film.Poster, &film.ReleaseDate, &film.Country, &film.Mpaa,
+			&film.OriginalTitle, &film.Runtime, &film.Budget, &film.BoxOffice,
+			pq.Array(&film.Languages), pq.Array(&film.Trailers), &film.ImdbId, &film.KinopoiskId)
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
 			return film, nil
@@ -160,7 +166,8 @@ func (repo *RepoPostgre) GetFilmRating(filmId uint64) (float64, uint64, error) {
 }
 
 func (repo *RepoPostgre) FindFilm(title string, dateFrom string, dateTo string, ratingFrom float32, ratingTo float32,
-	mpaa string, genres []uint32, actors []string, first uint64, limit uint64,
+	mpaa string, genres []uint32, actors []string, runtimeFrom uint32, runtimeTo uint32, language string,
+	first uint64, limit uint64,
 ) ([]models.FilmItem, error) {
 
 	films := []models.FilmItem{}
@@ -177,7 +184,9 @@ func (repo *RepoPostgre) FindFilm(title string, dateFrom string, dateTo string,
 	if title != "" {
 		s.WriteString("WHERE ")
 		hasWhere = true
-		s.WriteString("fts @@ to_tsquery($" + strconv.Itoa(paramNum) + ") ")
+		s.WriteString("(fts @@ to_tsquery($" + strconv.Itoa(paramNum) + ") OR film.id IN (" +
+			"SELECT id_film FROM film_translation " +
+			"WHERE to_tsvector('simple', film_translation.title) @@ to_tsquery('simple', $" + strconv.Itoa(paramNum) + "))) ")
 		paramNum++
 		params = append(params, title)
 	}
@@ -229,6 +238,7 @@ func (repo *RepoPostgre) FindFilm(title string, dateFrom string, dateTo string,
 	if actors[0] != "" {
 		if !hasWhere {
 			s.WriteString("WHERE ")
+			hasWhere = true
 		} else {
 			s.WriteString("AND ")
 		}
@@ -237,6 +247,38 @@ func (repo *RepoPostgre) FindFilm(title string, dateFrom string, dateTo string,
 		paramNum++
 		params = append(params, pq.Array(actors))
 	}
+	if runtimeFrom != 0 {
+		if !hasWhere {
+			s.WriteString("WHERE ")
+			hasWhere = true
+		} else {
+			s.WriteString("AND ")
+		}
+		s.WriteString("film.runtime >= $" + strconv.Itoa(paramNum) + " ")
+		paramNum++
+		params = append(params, runtimeFrom)
+	}
+	if runtimeTo != 0 {
+		if !hasWhere {
+			s.WriteString("WHERE ")
+			hasWhere = true
+		} else {
+			s.WriteString("AND ")
+		}
+		s.WriteString("film.runtime <= $" + strconv.Itoa(paramNum) + " ")
+		paramNum++
+		params = append(params, runtimeTo)
+	}
+	if language != "" {
+		if !hasWhere {
+			s.WriteString("WHERE ")
+		} else {
+			s.WriteString("AND ")
+		}
+		s.WriteString("$" + strconv.Itoa(paramNum) + " = ANY (film.languages) ")
+		paramNum++
+		params = append(params, language)
+	}
 	s.WriteString(
 		"GROUP BY film.title, film.id " +
 			"HAVING (AVG(users_comment.rating) >= $" + strconv.Itoa(paramNum) + " AND AVG(users_comment.rating) <= $" + strconv.Itoa(paramNum+1) + ") " +
@@ -357,9 +399,12 @@ func (repo *RepoPostgre) HasUsersRating(userId uint64, filmId uint64) (bool, err
 }
 
 func (repo *RepoPostgre) AddFilm(film models.FilmItem) error {
-	_, err := repo.db.Exec("INSERT INTO film(title, info, poster, release_date, country, mpaa) "+
-		"VALUES($1, $2, $3, $4, $5, $6)",
-		film.Title, film.Info, film.Poster, film.ReleaseDate, film.Country, film.Mpaa)
+	_, err := repo.db.Exec("INSERT INTO film(title, info, poster, release_date, country, mpaa, "+
+		"original_title, runtime, budget, box_office, languages, trailers, imdb_id, kinopoisk_id) "+
+		"VALUES($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14)",
+		film.Title, film.Info, film.Poster, film.ReleaseDate, film.Country, film.Mpaa,
+		film.OriginalTitle, film.Runtime, film.Budget, film.BoxOffice,
+		pq.Array(film.Languages), pq.Array(film.Trailers), film.ImdbId, film.KinopoiskId)
 	if err != nil {
 		return fmt.Errorf("add film error: %w", err)
 	}
@@ -367,6 +412,97 @@ func (repo *RepoPostgre) AddFilm(film models.FilmItem) error {
 	return nil
 }
 
+// EditFilm writes the fields of edit that are set. Cleared dates, numbers
+// and ids are stored as NULL, like those of the films that never had them.
+func (repo *RepoPostgre) EditFilm(edit models.FilmEdit) error {
+	var s strings.Builder
+	paramNum := 1
+	var params []interface{}
+
+	set := func(column string, value interface{}) {
+		if paramNum != 1 {
+			s.WriteString(", ")
+		}
+		s.WriteString(column + " = $" + strconv.Itoa(paramNum))
+		paramNum++
+		params = append(params, value)
+	}
+
+	s.WriteString("UPDATE film SET ")
+	if edit.Title != nil {
+		set("title", *edit.Title)
+	}
+	if edit.Info != nil {
+		set("info", *edit.Info)
+	}
+	if edit.Poster != nil {
+		set("poster", *edit.Poster)
+	}
+	if edit.ReleaseDate != nil {
+		set("release_date", nullIfZero(*edit.ReleaseDate))
+	}
+	if edit.Country != nil {
+		set("country", *edit.Country)
+	}
+	if edit.Mpaa != nil {
+		set("mpaa", *edit.Mpaa)
+	}
+	if edit.OriginalTitle != nil {
+		set("original_title", nullIfZero(*edit.OriginalTitle))
+	}
+	if edit.Runtime != nil {
+		set("runtime", nullIfZero(*edit.Runtime))
+	}
+	if edit.Budget != nil {
+		set("budget", nullIfZero(*edit.Budget))
+	}
+	if edit.BoxOffice != nil {
+		set("box_office", nullIfZero(*edit.BoxOffice))
+	}
+	if edit.Languages != nil {
+		set("languages", textArray(*edit.Languages))
+	}
+	if edit.Trailers != nil {
+		set("trailers", textArray(*edit.Trailers))
+	}
+	if edit.ImdbId != nil {
+		set("imdb_id", nullIfZero(*edit.ImdbId))
+	}
+	if edit.KinopoiskId != nil {
+		set("kinopoisk_id", nullIfZero(*edit.KinopoiskId))
+	}
+	if paramNum == 1 {
+		return nil
+	}
+
+	s.WriteString(" WHERE id = $" + strconv.Itoa(paramNum))
+	params = append(params, edit.Id)
+	_, err := repo.db.Exec(s.String(), params...)
+	if err != nil {
+		return fmt.Errorf("edit film err: %w", err)
+	}
+
+	return nil
+}
+
+func nullIfZero[T comparable](value T) interface{} {
+	var zero T
+	if value == zero {
+		return nil
+	}
+
+	return value
+}
+
+// textArray keeps a cleared array column empty rather than NULL.
+func textArray(values []string) interface{} {
+	if values == nil {
+		values = []string{}
+	}
+
+	return pq.Array(values)
+}
+
 func (repo *RepoPostgre) GetFilmId(title string) (uint64, error) {
 	var id uint64
 	err := repo.db.QueryRow("SELECT id FROM film WHERE title = $1", title).Scan(&id)
@@ -377,6 +513,16 @@ func (repo *RepoPostgre) GetFilmId(title string) (uint64, error) {
 	return id, nil
 }
 
+func (repo *RepoPostgre) CountPosterUsage(poster string) (uint64, error) {
+	var count uint64
+	err := repo.db.QueryRow("SELECT COUNT(*) FROM film WHERE poster = $1", poster).Scan(&count)
+	if err != nil {
+		return 0, fmt.Errorf("count poster usage err: %w", err)
+	}
+
+	return count, nil
+}
+
 func (repo *RepoPostgre) DeleteRating(idUser uint64, idFilm uint64) error {
 	_, err := repo.db.Exec("DELETE FROM users_comment WHERE id_user = $1 AND id_film = $2", idUser, idFilm)
 	if err != nil {
@@ -435,3 +581,27 @@ func (repo *RepoPostgre) GetLasts(ids []uint64) ([]models.FilmItem, error) {
 
 	return films, nil
 }
+
+// GetFilmsByIds returns the films in the order of ids, unknown ids are skipped.
+func (repo *RepoPostgre) GetFilmsByIds(ids []uint64) ([]models.FilmItem, error) {
+	films := []models.FilmItem{}
+
+	rows, err := repo.db.Query("SELECT id, title, poster FROM film "+
+		"WHERE id = ANY($1::bigint[]) "+
+		"ORDER BY array_position($1::bigint[], id)", pq.Array(ids))
+	if err != nil {
+		return nil, fmt.Errorf("get films by ids err: %w", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		post := models.FilmItem{}
+		err := rows.Scan(&post.Id, &post.Title, &post.Poster)
+		if err != nil {
+			return nil, fmt.Errorf("get films by ids scan err: %w", err)
+		}
+		films = append(films, post)
+	}
+
+	return films, nil
+}
@@ -128,18 +128,24 @@ func TestGetFilm(t *testing.T) {
 	}
 	defer db.Close()
 
-	rows := sqlmock.NewRows([]string{"Id", "Title", "Info", "Poster", "ReleaseDate", "Country", "Mpaa"})
+	rows := sqlmock.NewRows([]string{"Id", "Title", "Info", "Poster", "ReleaseDate", "Country", "Mpaa",
+		"OriginalTitle", "Runtime", "Budget", "BoxOffice", "Languages", "Trailers", "ImdbId", "KinopoiskId"})
 
 	expect := []models.FilmItem{
-		{Id: 1, Title: "t1", Info: "i1", Poster: "url1", ReleaseDate: "date1", Country: "c1", Mpaa: "12"},
+		{Id: 1, Title: "t1", Info: "i1", Poster: "url1", ReleaseDate: "date1", Country: "c1", Mpaa: "12",
+			OriginalTitle: "ot1", Runtime: 120, Budget: 1000, BoxOffice: 2000, Languages: []string{"en", "ru"},
+			Trailers: []string{"url2"}, ImdbId: "tt1", KinopoiskId: "1"},
 	}
 
 	for _, item := range expect {
-		rows = rows.AddRow(item.Id, item.Title, item.Info, item.Poster, item.ReleaseDate, item.Country, item.Mpaa)
+		rows = rows.AddRow(item.Id, item.Title, item.Info, item.Poster, item.ReleaseDate, item.Country, item.Mpaa,
+			item.OriginalTitle, item.Runtime, item.Budget, item.BoxOffice, "{en,ru}", "{url2}", item.ImdbId, item.KinopoiskId)
 	}
 
 	mock.ExpectQuery(
-		regexp.QuoteMeta("SELECT id, title, info, poster, release_date, country, mpaa FROM film WHERE id = $1")).
+		regexp.QuoteMeta("SELECT id, title, info, poster, release_date, country, mpaa, " +
+			"COALESCE(original_title, ''), COALESCE(runtime, 0), COALESCE(budget, 0), COALESCE(box_office, 0), " +
+			"languages, trailers, COALESCE(imdb_id, ''), COALESCE(kinopoisk_id, '') FROM film WHERE id = $1")).
 		WithArgs(1).
 		WillReturnRows(rows)
 
@@ -163,7 +169,9 @@ func TestGetFilm(t *testing.T) {
 	}
 
 	mock.ExpectQuery(
-		regexp.QuoteMeta("SELECT id, title, info, poster, release_date, country, mpaa FROM film WHERE id = $1")).
+		regexp.QuoteMeta("SELECT id, title, info, poster, release_date, country, mpaa, " +
+			"COALESCE(original_title, ''), COALESCE(runtime, 0), COALESCE(budget, 0), COALESCE(box_office, 0), " +
+			"languages, trailers, COALESCE(imdb_id, ''), COALESCE(kinopoisk_id, '') FROM film WHERE id = $1")).
 		WithArgs(1).
 		WillReturnError(fmt.Errorf("db_error"))
 
@@ -269,7 +277,7 @@ func TestFindFilm(t *testing.T) {
 		db: db,
 	}
 
-	film, err := repo.FindFilm("", "", "", float32(0), float32(10), "", []uint32{}, []string{""}, 0, 1)
+	film, err := repo.FindFilm("", "", "", float32(0), float32(10), "", []uint32{}, []string{""}, 0, 0, "", 0, 1)
 	if err != nil {
 		t.Errorf("GetFilm error: %s", err)
 	}
@@ -288,7 +296,7 @@ func TestFindFilm(t *testing.T) {
 		WithArgs(float32(0), float32(10), uint64(0), uint64(0)).
 		WillReturnError(fmt.Errorf("db_error"))
 
-	film, err = repo.FindFilm("", "", "", float32(0), float32(10), "", []uint32{0}, []string{""}, 0, 0)
+	film, err = repo.FindFilm("", "", "", float32(0), float32(10), "", []uint32{0}, []string{""}, 0, 0, "", 0, 0)
 	if err == mock.ExpectationsWereMet() {
 		t.Errorf("there were unfulfilled expectations: %s", err)
 		return
@@ -303,6 +311,41 @@ func TestFindFilm(t *testing.T) {
 	}
 }
 
+func TestFindFilmFilters(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("cant create mock: %s", err)
+	}
+	defer db.Close()
+
+	rows := sqlmock.NewRows([]string{"Title", "Id", "Poster", "Rating"}).AddRow("t1", 1, "url1", 8)
+
+	selectStr := "SELECT DISTINCT film.title, film.id, film.poster, AVG(users_comment.rating) FROM film JOIN films_genre ON film.id = films_genre.id_film LEFT JOIN users_comment ON film.id = users_comment.id_film JOIN person_in_film ON film.id = person_in_film.id_film JOIN crew ON person_in_film.id_person = crew.id WHERE (fts @@ to_tsquery($1) OR film.id IN (SELECT id_film FROM film_translation WHERE to_tsvector('simple', film_translation.title) @@ to_tsquery('simple', $1))) AND film.runtime >= $2 AND film.runtime <= $3 AND $4 = ANY (film.languages) GROUP BY film.title, film.id HAVING (AVG(users_comment.rating) >= $5 AND AVG(users_comment.rating) <= $6) OR AVG(users_comment.rating) IS NULL ORDER BY film.title LIMIT $7 OFFSET $8"
+	mock.ExpectQuery(
+		regexp.QuoteMeta(selectStr)).
+		WithArgs("t1", uint32(90), uint32(150), "en", float32(0), float32(10), uint64(1), uint64(0)).
+		WillReturnRows(rows)
+
+	repo := &RepoPostgre{
+		db: db,
+	}
+
+	films, err := repo.FindFilm("t1", "", "", float32(0), float32(10), "", []uint32{}, []string{""}, 90, 150, "en", 0, 1)
+	if err != nil {
+		t.Errorf("FindFilm error: %s", err)
+	}
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("there were unfulfilled expectations: %s", err)
+		return
+	}
+
+	expect := []models.FilmItem{{Id: 1, Title: "t1", Poster: "url1", Rating: 8}}
+	if !reflect.DeepEqual(films, expect) {
+		t.Errorf("results not match, want %v, have %v", expect, films)
+	}
+}
+
 func TestGetFavoriteFilms(t *testing.T) {
 	db, mock, err := sqlmock.New()
 	if err != nil {
@@ -625,12 +668,16 @@ func TestAddFilm(t *testing.T) {
 		ReleaseDate: "rd",
 		Country:     "c",
 		Mpaa:        "m",
+		Runtime:     100,
+		Languages:   []string{"en"},
 	}
-	selectRow := "INSERT INTO film(title, info, poster, release_date, country, mpaa) VALUES($1, $2, $3, $4, $5, $6)"
+	selectRow := "INSERT INTO film(title, info, poster, release_date, country, mpaa, " +
+		"original_title, runtime, budget, box_office, languages, trailers, imdb_id, kinopoisk_id) " +
+		"VALUES($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14)"
 
 	mock.ExpectExec(
 		regexp.QuoteMeta(selectRow)).
-		WithArgs("t", "i", "p", "rd", "c", "m").WillReturnResult(sqlmock.NewResult(0, 1))
+		WithArgs("t", "i", "p", "rd", "c", "m", "", uint32(100), uint64(0), uint64(0), "{\"en\"}", nil, "", "").WillReturnResult(sqlmock.NewResult(0, 1))
 
 	repo := &RepoPostgre{
 		db: db,
@@ -648,7 +695,7 @@ func TestAddFilm(t *testing.T) {
 
 	mock.ExpectExec(
 		regexp.QuoteMeta(selectRow)).
-		WithArgs("t", "i", "p", "rd", "c", "m").WillReturnError(fmt.Errorf("repo err"))
+		WithArgs("t", "i", "p", "rd", "c", "m", "", uint32(100), uint64(0), uint64(0), "{\"en\"}", nil, "", "").WillReturnError(fmt.Errorf("repo err"))
 
 	err = repo.AddFilm(filmItem)
 	if err := mock.ExpectationsWereMet(); err != nil {
@@ -661,6 +708,79 @@ func TestAddFilm(t *testing.T) {
 	}
 }
 
+func TestEditFilm(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("cant create mock: %s", err)
+	}
+	defer db.Close()
+
+	title := "t"
+	runtime := uint32(100)
+	languages := []string{"en", "ru"}
+	edit := models.FilmEdit{
+		Id:        1,
+		Title:     &title,
+		Runtime:   &runtime,
+		Languages: &languages,
+	}
+	updateRow := "UPDATE film SET title = $1, runtime = $2, languages = $3 WHERE id = $4"
+
+	mock.ExpectExec(
+		regexp.QuoteMeta(updateRow)).
+		WithArgs("t", uint32(100), "{\"en\",\"ru\"}", uint64(1)).WillReturnResult(sqlmock.NewResult(0, 1))
+
+	repo := &RepoPostgre{
+		db: db,
+	}
+
+	err = repo.EditFilm(edit)
+	if err != nil {
+		t.Errorf("unexpected err: %s", err)
+	}
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("there were unfulfilled expectations: %s", err)
+		return
+	}
+
+	mock.ExpectExec(
+		regexp.QuoteMeta(updateRow)).
+		WithArgs("t", uint32(100), "{\"en\",\"ru\"}", uint64(1)).WillReturnError(fmt.Errorf("repo err"))
+
+	err = repo.EditFilm(edit)
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("there were unfulfilled expectations: %s", err)
+		return
+	}
+	if err == nil {
+		t.Errorf("expected error, got nil")
+		return
+	}
+
+	// Zero values clear the fields.
+	runtime = 0
+	var trailers []string
+	imdbId := ""
+	mock.ExpectExec(
+		regexp.QuoteMeta("UPDATE film SET runtime = $1, trailers = $2, imdb_id = $3 WHERE id = $4")).
+		WithArgs(nil, "{}", nil, uint64(1)).WillReturnResult(sqlmock.NewResult(0, 1))
+
+	err = repo.EditFilm(models.FilmEdit{Id: 1, Runtime: &runtime, Trailers: &trailers, ImdbId: &imdbId})
+	if err != nil {
+		t.Errorf("unexpected err: %s", err)
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("there were unfulfilled expectations: %s", err)
+		return
+	}
+
+	err = repo.EditFilm(models.FilmEdit{Id: 1})
+	if err != nil {
+		t.Errorf("unexpected err: %s", err)
+	}
+}
+
 func TestGetFilmId(t *testing.T) {
 	db, mock, err := sqlmock.New()
 	if err != nil {
@@ -723,6 +843,53 @@ func TestGetFilmId(t *testing.T) {
 	}
 }
 
+func TestCountPosterUsage(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("cant create mock: %s", err)
+	}
+	defer db.Close()
+
+	selectRow := "SELECT COUNT(*) FROM film WHERE poster = $1"
+
+	mock.ExpectQuery(
+		regexp.QuoteMeta(selectRow)).
+		WithArgs("/icons/p.jpg").
+		WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(2))
+
+	repo := &RepoPostgre{
+		db: db,
+	}
+
+	count, err := repo.CountPosterUsage("/icons/p.jpg")
+	if err != nil {
+		t.Errorf("CountPosterUsage error: %s", err)
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("there were unfulfilled expectations: %s", err)
+		return
+	}
+	if count != 2 {
+		t.Errorf("wanted 2, got %d", count)
+		return
+	}
+
+	mock.ExpectQuery(
+		regexp.QuoteMeta(selectRow)).
+		WithArgs("/icons/p.jpg").
+		WillReturnError(fmt.Errorf("db_error"))
+
+	_, err = repo.CountPosterUsage("/icons/p.jpg")
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("there were unfulfilled expectations: %s", err)
+		return
+	}
+	if err == nil {
+		t.Errorf("expected error, got nil")
+		return
+	}
+}
+
 func TestDeleteRating(t *testing.T) {
 	db, mock, err := sqlmock.New()
 	if err != nil {
@@ -764,3 +931,61 @@ func TestDeleteRating(t *testing.T) {
 		return
 	}
 }
+
+func TestGetFilmsByIds(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("cant create mock: %s", err)
+	}
+	defer db.Close()
+
+	rows := sqlmock.NewRows([]string{"Id", "Title", "Poster"})
+
+	expect := []models.FilmItem{
+		{Id: 2, Title: "t2", Poster: "url2"},
+		{Id: 1, Title: "t1", Poster: "url1"},
+	}
+
+	for _, item := range expect {
+		rows = rows.AddRow(item.Id, item.Title, item.Poster)
+	}
+
+	mock.ExpectQuery(
+		regexp.QuoteMeta("SELECT id, title, poster FROM film WHERE id = ANY($1::bigint[]) ORDER BY array_position($1::bigint[], id)")).
+		WithArgs(sqlmock.AnyArg()).
+		WillReturnRows(rows)
+
+	repo := &RepoPostgre{
+		db: db,
+	}
+
+	films, err := repo.GetFilmsByIds([]uint64{2, 1})
+	if err != nil {
+		t.Errorf("GetFilmsByIds error: %s", err)
+	}
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("there were unfulfilled expectations: %s", err)
+		return
+	}
+
+	if !reflect.DeepEqual(films, expect) {
+		t.Errorf("results not match, want %v, have %v", expect, films)
+		return
+	}
+
+	mock.ExpectQuery(
+		regexp.QuoteMeta("SELECT id, title, poster FROM film WHERE id = ANY($1::bigint[]) ORDER BY array_position($1::bigint[], id)")).
+		WithArgs(sqlmock.AnyArg()).
+		WillReturnError(fmt.Errorf("db_error"))
+
+	_, err = repo.GetFilmsByIds([]uint64{2, 1})
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("there were unfulfilled expectations: %s", err)
+		return
+	}
+	if err == nil {
+		t.Errorf("expected error, got nil")
+		return
+	}
+}
@@ -0,0 +1,523 @@
+package film
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"log/slog"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+	"unicode"
+
+	"github.com/go-park-mail-ru/2023_2_Vkladyshi/configs"
+	"github.com/go-park-mail-ru/2023_2_Vkladyshi/pkg/memory"
+	"github.com/go-park-mail-ru/2023_2_Vkladyshi/pkg/models"
+)
+
+type RepoMemory struct {
+	store *memory.Store
+}
+
+func GetFilmMemoryRepo(config *configs.DbDsnCfg) (*RepoMemory, error) {
+	store, err := memory.Open(config.Seed)
+	if err != nil {
+		return nil, fmt.Errorf("get film repo: %w", err)
+	}
+
+	return &RepoMemory{store: store}, nil
+}
+
+// byReleaseDate orders films like ORDER BY release_date DESC, which puts
+// films without a date first.
+func byReleaseDate(films []memory.Film) {
+	sort.SliceStable(films, func(i, j int) bool {
+		if films[i].ReleaseDate == "" || films[j].ReleaseDate == "" {
+			return films[i].ReleaseDate == "" && films[j].ReleaseDate != ""
+		}
+		return films[i].ReleaseDate > films[j].ReleaseDate
+	})
+}
+
+func shortItems(films []memory.Film) []models.FilmItem {
+	result := make([]models.FilmItem, 0, len(films))
+	for _, film := range films {
+		result = append(result, models.FilmItem{Id: film.Id, Title: film.Title, Poster: film.Poster})
+	}
+
+	return result
+}
+
+func (repo *RepoMemory) GetFilmsByGenre(genre uint64, start uint64, end uint64) ([]models.FilmItem, error) {
+	repo.store.RLock()
+	defer repo.store.RUnlock()
+
+	films := []memory.Film{}
+	for _, link := range repo.store.FilmGenres {
+		if link.IdGenre != genre {
+			continue
+		}
+		if film := repo.store.Film(link.IdFilm); film != nil {
+			films = append(films, *film)
+		}
+	}
+	byReleaseDate(films)
+
+	return shortItems(memory.Paginate(films, start, end)), nil
+}
+
+func (repo *RepoMemory) GetFilms(start uint64, end uint64) ([]models.FilmItem, error) {
+	repo.store.RLock()
+	defer repo.store.RUnlock()
+
+	films := slices.Clone(repo.store.Films)
+	byReleaseDate(films)
+
+	return shortItems(memory.Paginate(films, start, end)), nil
+}
+
+func (repo *RepoMemory) GetFilm(filmId uint64) (*models.FilmItem, error) {
+	repo.store.RLock()
+	defer repo.store.RUnlock()
+
+	film := repo.store.Film(filmId)
+	if film == nil {
+		return &models.FilmItem{}, nil
+	}
+
+	return &models.FilmItem{
+		Id:            film.Id,
+		Title:         film.Title,
+		Info:          film.Info,
+		Poster:        film.Poster,
+		ReleaseDate:   memory.Date(film.ReleaseDate),
+		Country:       film.Country,
+		Mpaa:          film.Mpaa,
+		OriginalTitle: film.OriginalTitle,
+		Runtime:       film.Runtime,
+		Budget:        film.Budget,
+		BoxOffice:     film.BoxOffice,
+		Languages:     slices.Clone(film.Languages),
+		Trailers:      slices.Clone(film.Trailers),
+		ImdbId:        film.ImdbId,
+		KinopoiskId:   film.KinopoiskId,
+	}, nil
+}
+
+func (repo *RepoMemory) GetFilmRating(filmId uint64) (float64, uint64, error) {
+	repo.store.RLock()
+	defer repo.store.RUnlock()
+
+	rating, number := repo.store.Rating(filmId)
+
+	return rating, number, nil
+}
+
+// FindFilm follows the Postgres query, which only returns films having at
+// least one genre and one crew member. Full-text search is approximated by
+// matching every word of the query against the titles, ignoring case.
+func (repo *RepoMemory) FindFilm(title string, dateFrom string, dateTo string, ratingFrom float32, ratingTo float32,
+	mpaa string, genres []uint32, actors []string, runtimeFrom uint32, runtimeTo uint32, language string,
+	first uint64, limit uint64,
+) ([]models.FilmItem, error) {
+	repo.store.RLock()
+	defer repo.store.RUnlock()
+
+	films := []models.FilmItem{}
+	for _, film := range repo.store.Films {
+		if title != "" && !repo.matchTitle(film, title) {
+			continue
+		}
+		if dateFrom != "" && (film.ReleaseDate == "" || film.ReleaseDate < dateFrom) {
+			continue
+		}
+		if dateTo != "" && (film.ReleaseDate == "" || film.ReleaseDate > dateTo) {
+			continue
+		}
+		if mpaa != "" && film.Mpaa != mpaa {
+			continue
+		}
+		if runtimeFrom != 0 && film.Runtime < runtimeFrom {
+			continue
+		}
+		if runtimeTo != 0 && film.Runtime > runtimeTo {
+			continue
+		}
+		if language != "" && !slices.Contains(film.Languages, language) {
+			continue
+		}
+		if !repo.hasGenre(film.Id, genres) || !repo.hasActor(film.Id, actors) {
+			continue
+		}
+
+		rating, number := repo.store.Rating(film.Id)
+		if number != 0 && (rating < float64(ratingFrom) || rating > float64(ratingTo)) {
+			continue
+		}
+		films = append(films, models.FilmItem{Id: film.Id, Title: film.Title, Poster: film.Poster, Rating: rating})
+	}
+	sort.SliceStable(films, func(i, j int) bool {
+		return films[i].Title < films[j].Title
+	})
+
+	return memory.Paginate(films, first, limit), nil
+}
+
+func (repo *RepoMemory) matchTitle(film memory.Film, query string) bool {
+	titles := []string{film.Title, film.OriginalTitle}
+	for _, translation := range repo.store.FilmTranslations {
+		if translation.Id == film.Id {
+			titles = append(titles, translation.Title)
+		}
+	}
+
+	words := strings.FieldsFunc(strings.ToLower(query), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+	for _, title := range titles {
+		title = strings.ToLower(title)
+		found := len(words) != 0
+		for _, word := range words {
+			found = found && strings.Contains(title, word)
+		}
+		if found {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (repo *RepoMemory) hasGenre(filmId uint64, genres []uint32) bool {
+	for _, link := range repo.store.FilmGenres {
+		if link.IdFilm == filmId && (len(genres) == 0 || slices.Contains(genres, uint32(link.IdGenre))) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (repo *RepoMemory) hasActor(filmId uint64, actors []string) bool {
+	for _, credit := range repo.store.Credits {
+		if credit.IdFilm != filmId {
+			continue
+		}
+		if len(actors) == 0 || actors[0] == "" {
+			return true
+		}
+		if person := repo.store.Person(credit.IdPerson); person != nil && slices.Contains(actors, person.Name) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (repo *RepoMemory) GetFavoriteFilms(userId uint64, start uint64, end uint64) ([]models.FilmItem, error) {
+	repo.store.RLock()
+	defer repo.store.RUnlock()
+
+	films := []memory.Film{}
+	for _, favorite := range repo.store.FavoriteFilms {
+		if favorite.IdUser != userId {
+			continue
+		}
+		if film := repo.store.Film(favorite.Id); film != nil {
+			films = append(films, *film)
+		}
+	}
+
+	return shortItems(memory.Paginate(films, start, end)), nil
+}
+
+func (repo *RepoMemory) AddFavoriteFilm(userId uint64, filmId uint64) error {
+	repo.store.Lock()
+	defer repo.store.Unlock()
+
+	if repo.store.Film(filmId) == nil {
+		return fmt.Errorf("add favorite film err: film %d not found", filmId)
+	}
+	favorite := memory.Favorite{IdUser: userId, Id: filmId}
+	if slices.Contains(repo.store.FavoriteFilms, favorite) {
+		return fmt.Errorf("add favorite film err: film %d is already favorite", filmId)
+	}
+	repo.store.FavoriteFilms = append(repo.store.FavoriteFilms, favorite)
+
+	return nil
+}
+
+func (repo *RepoMemory) RemoveFavoriteFilm(userId uint64, filmId uint64) error {
+	repo.store.Lock()
+	defer repo.store.Unlock()
+
+	repo.store.FavoriteFilms = slices.DeleteFunc(repo.store.FavoriteFilms, func(favorite memory.Favorite) bool {
+		return favorite.IdUser == userId && favorite.Id == filmId
+	})
+
+	return nil
+}
+
+func (repo *RepoMemory) CheckFilm(userId uint64, filmId uint64) (bool, error) {
+	repo.store.RLock()
+	defer repo.store.RUnlock()
+
+	return slices.Contains(repo.store.FavoriteFilms, memory.Favorite{IdUser: userId, Id: filmId}), nil
+}
+
+func (repo *RepoMemory) AddRating(filmId uint64, userId uint64, rating uint16) error {
+	repo.store.Lock()
+	defer repo.store.Unlock()
+
+	for _, comment := range repo.store.Comments {
+		if comment.IdUser == userId && comment.IdFilm == filmId {
+			return fmt.Errorf("AddComment: user %d has already rated film %d", userId, filmId)
+		}
+	}
+	repo.store.Comments = append(repo.store.Comments, memory.Comment{
+		IdUser: userId,
+		IdFilm: filmId,
+		Rating: rating,
+		Date:   time.Now(),
+	})
+
+	return nil
+}
+
+func (repo *RepoMemory) HasUsersRating(userId uint64, filmId uint64) (bool, error) {
+	repo.store.RLock()
+	defer repo.store.RUnlock()
+
+	for _, comment := range repo.store.Comments {
+		if comment.IdUser == userId && comment.IdFilm == filmId {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
+func (repo *RepoMemory) AddFilm(film models.FilmItem) error {
+	repo.store.Lock()
+	defer repo.store.Unlock()
+
+	repo.store.Films = append(repo.store.Films, memory.Film{
+		Id:            memory.NextId(repo.store.Films, func(f memory.Film) uint64 { return f.Id }),
+		Title:         film.Title,
+		Info:          film.Info,
+		Poster:        film.Poster,
+		ReleaseDate:   film.ReleaseDate,
+		Country:       film.Country,
+		Mpaa:          film.Mpaa,
+		OriginalTitle: film.OriginalTitle,
+		Runtime:       film.Runtime,
+		Budget:        film.Budget,
+		BoxOffice:     film.BoxOffice,
+		Languages:     slices.Clone(film.Languages),
+		Trailers:      slices.Clone(film.Trailers),
+		ImdbId:        film.ImdbId,
+		KinopoiskId:   film.KinopoiskId,
+	})
+
+	return nil
+}
+
+func (repo *RepoMemory) EditFilm(edit models.FilmEdit) error {
+	repo.store.Lock()
+	defer repo.store.Unlock()
+
+	stored := repo.store.Film(edit.Id)
+	if stored == nil {
+		return nil
+	}
+
+	if edit.Title != nil {
+		stored.Title = *edit.Title
+	}
+	if edit.Info != nil {
+		stored.Info = *edit.Info
+	}
+	if edit.Poster != nil {
+		stored.Poster = *edit.Poster
+	}
+	if edit.ReleaseDate != nil {
+		stored.ReleaseDate = *edit.ReleaseDate
+	}
+	if edit.Country != nil {
+		stored.Country = *edit.Country
+	}
+	if edit.Mpaa != nil {
+		stored.Mpaa = *edit.Mpaa
+	}
+	if edit.OriginalTitle != nil {
+		stored.OriginalTitle = *edit.OriginalTitle
+	}
+	if edit.Runtime != nil {
+		stored.Runtime = *edit.Runtime
+	}
+	if edit.Budget != nil {
+		stored.Budget = *edit.Budget
+	}
+	if edit.BoxOffice != nil {
+		stored.BoxOffice = *edit.BoxOffice
+	}
+	if edit.Languages != nil {
+		stored.Languages = slices.Clone(*edit.Languages)
+	}
+	if edit.Trailers != nil {
+		stored.Trailers = slices.Clone(*edit.Trailers)
+	}
+	if edit.ImdbId != nil {
+		stored.ImdbId = *edit.ImdbId
+	}
+	if edit.KinopoiskId != nil {
+		stored.KinopoiskId = *edit.KinopoiskId
+	}
+
+	return nil
+}
+
+func (repo *RepoMemory) GetFilmId(title string) (uint64, error) {
+	repo.store.RLock()
+	defer repo.store.RUnlock()
+
+	for _, film := range repo.store.Films {
+		if film.Title == title {
+			return film.Id, nil
+		}
+	}
+
+	return 0, fmt.Errorf("get film id err: %w", sql.ErrNoRows)
+}
+
+func (repo *RepoMemory) CountPosterUsage(poster string) (uint64, error) {
+	repo.store.RLock()
+	defer repo.store.RUnlock()
+
+	var count uint64
+	for _, film := range repo.store.Films {
+		if film.Poster == poster {
+			count++
+		}
+	}
+
+	return count, nil
+}
+
+func (repo *RepoMemory) DeleteRating(idUser uint64, idFilm uint64) error {
+	repo.store.Lock()
+	defer repo.store.Unlock()
+
+	repo.store.Comments = slices.DeleteFunc(repo.store.Comments, func(comment memory.Comment) bool {
+		return comment.IdUser == idUser && comment.IdFilm == idFilm
+	})
+
+	return nil
+}
+
+func (repo *RepoMemory) Trends() ([]models.FilmItem, error) {
+	repo.store.RLock()
+	defer repo.store.RUnlock()
+
+	since := time.Now().Add(-48 * time.Hour)
+	counts := map[uint64]int{}
+	films := []memory.Film{}
+	for _, comment := range repo.store.Comments {
+		if !comment.Date.After(since) {
+			continue
+		}
+		film := repo.store.Film(comment.IdFilm)
+		if film == nil {
+			continue
+		}
+		if counts[film.Id] == 0 {
+			films = append(films, *film)
+		}
+		counts[film.Id]++
+	}
+	sort.SliceStable(films, func(i, j int) bool {
+		return counts[films[i].Id] > counts[films[j].Id]
+	})
+
+	return shortItems(memory.Paginate(films, 0, 5)), nil
+}
+
+func (repo *RepoMemory) GetLasts(ids []uint64) ([]models.FilmItem, error) {
+	repo.store.RLock()
+	defer repo.store.RUnlock()
+
+	return shortItems(memory.Paginate(repo.byIds(ids), 0, 10)), nil
+}
+
+func (repo *RepoMemory) GetFilmsByIds(ids []uint64) ([]models.FilmItem, error) {
+	repo.store.RLock()
+	defer repo.store.RUnlock()
+
+	return shortItems(repo.byIds(ids)), nil
+}
+
+// byIds returns the known films in the order of ids, without repeats.
+func (repo *RepoMemory) byIds(ids []uint64) []memory.Film {
+	films := []memory.Film{}
+	for _, id := range ids {
+		if film := repo.store.Film(id); film != nil && !slices.ContainsFunc(films, func(f memory.Film) bool {
+			return f.Id == id
+		}) {
+			films = append(films, *film)
+		}
+	}
+
+	return films
+}
+
+type NearFilmsMemory struct {
+	kv *memory.KV
+}
+
+func GetNearFilmsMemoryRepo(NearFilmCfg configs.DbRedisCfg) *NearFilmsMemory {
+	return &NearFilmsMemory{kv: memory.OpenKV(fmt.Sprintf("%s/%d", NearFilmCfg.Host, NearFilmCfg.DbNumber))}
+}
+
+func (repo *NearFilmsMemory) AddNearFilm(ctx context.Context, active models.NearFilm, lg *slog.Logger) (bool, error) {
+	repo.kv.HSet("nearfilms:"+strconv.FormatUint(active.IdUser, 10), strconv.FormatUint(active.IdFilm, 10), "1")
+
+	return repo.CheckActiveNearFilm(ctx, strconv.FormatUint(active.IdUser, 10), strconv.FormatUint(active.IdFilm, 10), lg)
+}
+
+func (repo *NearFilmsMemory) CheckActiveNearFilm(ctx context.Context, uid string, fid string, lg *slog.Logger) (bool, error) {
+	return repo.kv.HExists("nearfilms:"+uid, fid), nil
+}
+
+func (repo *NearFilmsMemory) GetNearFilms(ctx context.Context, uid string, lg *slog.Logger) ([]models.NearFilm, error) {
+	var nearFilms []models.NearFilm
+	for idFilmStr := range repo.kv.HGetAll("nearfilms:" + uid) {
+		idFilm, err := strconv.ParseUint(idFilmStr, 10, 64)
+		if err != nil {
+			lg.Error("Error parsing IdFilm", "err", err.Error())
+			continue
+		}
+
+		idUser, err := strconv.ParseUint(uid, 10, 64)
+		if err != nil {
+			lg.Error("Error parsing IdUser", "err", err.Error())
+			continue
+		}
+
+		nearFilms = append(nearFilms, models.NearFilm{IdUser: idUser, IdFilm: idFilm})
+	}
+	sort.Slice(nearFilms, func(i, j int) bool {
+		return nearFilms[i].IdFilm < nearFilms[j].IdFilm
+	})
+
+	return nearFilms, nil
+}
+
+func (repo *NearFilmsMemory) DeleteNearFilm(ctx context.Context, uid string, fid string, lg *slog.Logger) (bool, error) {
+	if !repo.kv.HDel("nearfilms:"+uid, fid) {
+		lg.Info("Field " + fid + " does not exist in hash " + uid)
+	}
+
+	return true, nil
+}
@@ -0,0 +1,264 @@
+package film
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"log/slog"
+	"os"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/go-park-mail-ru/2023_2_Vkladyshi/pkg/memory"
+	"github.com/go-park-mail-ru/2023_2_Vkladyshi/pkg/models"
+)
+
+func getMemoryRepo() *RepoMemory {
+	return &RepoMemory{store: &memory.Store{Seed: memory.Seed{
+		Genres: []memory.Genre{{Id: 1, Title: "драма"}, {Id: 2, Title: "комедия"}},
+		Films: []memory.Film{
+			{Id: 1, Title: "Солярис", OriginalTitle: "Solaris", ReleaseDate: "1972-03-20", Runtime: 167, Languages: []string{"ru"}},
+			{Id: 2, Title: "Сталкер", ReleaseDate: "1979-05-25", Runtime: 161, Languages: []string{"ru"}},
+			{Id: 3, Title: "Без даты", Runtime: 90},
+			{Id: 4, Title: "Без жанра", ReleaseDate: "2000-01-01"},
+		},
+		FilmGenres: []memory.FilmGenre{{IdFilm: 1, IdGenre: 1}, {IdFilm: 2, IdGenre: 1}, {IdFilm: 3, IdGenre: 2}},
+		Crew:       []memory.Person{{Id: 1, Name: "Донатас Банионис"}, {Id: 2, Name: "Александр Кайдановский"}},
+		Credits: []memory.Credit{
+			{IdFilm: 1, IdPerson: 1, IdProfession: 1},
+			{IdFilm: 2, IdPerson: 2, IdProfession: 1},
+			{IdFilm: 3, IdPerson: 2, IdProfession: 1},
+			{IdFilm: 4, IdPerson: 1, IdProfession: 1},
+		},
+		FilmTranslations: []memory.Translation{{Id: 2, Lang: "en", Title: "Stalker"}},
+		Comments: []memory.Comment{
+			{IdUser: 1, IdFilm: 1, Rating: 9, Date: time.Now()},
+			{IdUser: 2, IdFilm: 1, Rating: 7, Date: time.Now()},
+			{IdUser: 1, IdFilm: 2, Rating: 3, Date: time.Now().Add(-72 * time.Hour)},
+		},
+	}}}
+}
+
+func ids(films []models.FilmItem) []uint64 {
+	result := []uint64{}
+	for _, film := range films {
+		result = append(result, film.Id)
+	}
+
+	return result
+}
+
+func TestMemoryGetFilms(t *testing.T) {
+	repo := getMemoryRepo()
+
+	films, err := repo.GetFilms(0, 3)
+	if err != nil {
+		t.Errorf("GetFilms error: %s", err)
+	}
+	if have := ids(films); !reflect.DeepEqual(have, []uint64{3, 4, 2}) {
+		t.Errorf("GetFilms = %v, want films without a date first", have)
+	}
+
+	films, err = repo.GetFilmsByGenre(1, 1, 5)
+	if err != nil {
+		t.Errorf("GetFilmsByGenre error: %s", err)
+	}
+	if have := ids(films); !reflect.DeepEqual(have, []uint64{1}) {
+		t.Errorf("GetFilmsByGenre = %v", have)
+	}
+
+	film, err := repo.GetFilm(1)
+	if err != nil {
+		t.Errorf("GetFilm error: %s", err)
+	}
+	if film.Title != "Солярис" || film.ReleaseDate != "1972-03-20T00:00:00Z" {
+		t.Errorf("unexpected film %v", film)
+	}
+	film, _ = repo.GetFilm(10)
+	if !reflect.DeepEqual(film, &models.FilmItem{}) {
+		t.Errorf("expected empty film, have %v", film)
+	}
+
+	rating, number, _ := repo.GetFilmRating(1)
+	if rating != 8 || number != 2 {
+		t.Errorf("GetFilmRating = %v, %v", rating, number)
+	}
+}
+
+func TestMemoryFindFilm(t *testing.T) {
+	repo := getMemoryRepo()
+
+	testCases := map[string]struct {
+		find   func() ([]models.FilmItem, error)
+		expect []uint64
+	}{
+		"all": {
+			find: func() ([]models.FilmItem, error) {
+				return repo.FindFilm("", "", "", 0, 10, "", nil, []string{""}, 0, 0, "", 0, 10)
+			},
+			expect: []uint64{3, 1, 2},
+		},
+		"title": {
+			find: func() ([]models.FilmItem, error) {
+				return repo.FindFilm("солярис", "", "", 0, 10, "", nil, []string{""}, 0, 0, "", 0, 10)
+			},
+			expect: []uint64{1},
+		},
+		"translated title": {
+			find: func() ([]models.FilmItem, error) {
+				return repo.FindFilm("stalker", "", "", 0, 10, "", nil, []string{""}, 0, 0, "", 0, 10)
+			},
+			expect: []uint64{2},
+		},
+		"filters": {
+			find: func() ([]models.FilmItem, error) {
+				return repo.FindFilm("", "1970-01-01", "", 0, 10, "", []uint32{1}, []string{"Александр Кайдановский"}, 100, 200, "ru", 0, 10)
+			},
+			expect: []uint64{2},
+		},
+		"rating": {
+			find: func() ([]models.FilmItem, error) {
+				return repo.FindFilm("", "", "", 5, 10, "", nil, []string{""}, 0, 0, "", 0, 10)
+			},
+			expect: []uint64{3, 1},
+		},
+		"paging": {
+			find: func() ([]models.FilmItem, error) {
+				return repo.FindFilm("", "", "", 0, 10, "", nil, []string{""}, 0, 0, "", 1, 1)
+			},
+			expect: []uint64{1},
+		},
+	}
+
+	for name, testCase := range testCases {
+		films, err := testCase.find()
+		if err != nil {
+			t.Errorf("%s: FindFilm error: %s", name, err)
+		}
+		if have := ids(films); !reflect.DeepEqual(have, testCase.expect) {
+			t.Errorf("%s: FindFilm = %v, want %v", name, have, testCase.expect)
+		}
+	}
+}
+
+func TestMemoryFavoritesAndRatings(t *testing.T) {
+	repo := getMemoryRepo()
+
+	err := repo.AddFavoriteFilm(1, 2)
+	if err != nil {
+		t.Errorf("AddFavoriteFilm error: %s", err)
+	}
+	if err := repo.AddFavoriteFilm(1, 2); err == nil {
+		t.Errorf("expected error for duplicate favorite")
+	}
+	if err := repo.AddFavoriteFilm(1, 10); err == nil {
+		t.Errorf("expected error for unknown film")
+	}
+	if found, _ := repo.CheckFilm(1, 2); !found {
+		t.Errorf("expected favorite film")
+	}
+	films, _ := repo.GetFavoriteFilms(1, 0, 10)
+	if have := ids(films); !reflect.DeepEqual(have, []uint64{2}) {
+		t.Errorf("GetFavoriteFilms = %v", have)
+	}
+	_ = repo.RemoveFavoriteFilm(1, 2)
+	if found, _ := repo.CheckFilm(1, 2); found {
+		t.Errorf("expected favorite film to be removed")
+	}
+
+	if err := repo.AddRating(1, 1, 5); err == nil {
+		t.Errorf("expected error for duplicate rating")
+	}
+	err = repo.AddRating(2, 2, 5)
+	if err != nil {
+		t.Errorf("AddRating error: %s", err)
+	}
+	if has, _ := repo.HasUsersRating(2, 2); !has {
+		t.Errorf("expected rating")
+	}
+
+	trends, _ := repo.Trends()
+	if have := ids(trends); !reflect.DeepEqual(have, []uint64{1, 2}) {
+		t.Errorf("Trends = %v", have)
+	}
+
+	_ = repo.DeleteRating(2, 2)
+	if has, _ := repo.HasUsersRating(2, 2); has {
+		t.Errorf("expected rating to be deleted")
+	}
+}
+
+func TestMemoryEditFilm(t *testing.T) {
+	repo := getMemoryRepo()
+
+	err := repo.AddFilm(models.FilmItem{Title: "Зеркало", Poster: "/posters/mirror.jpg"})
+	if err != nil {
+		t.Errorf("AddFilm error: %s", err)
+	}
+	id, err := repo.GetFilmId("Зеркало")
+	if err != nil || id != 5 {
+		t.Errorf("GetFilmId = %d, %v", id, err)
+	}
+	_, err = repo.GetFilmId("Андрей Рублёв")
+	if !errors.Is(err, sql.ErrNoRows) {
+		t.Errorf("expected sql.ErrNoRows, have %v", err)
+	}
+
+	info := "Воспоминания"
+	runtime := uint32(108)
+	languages := []string{"ru"}
+	err = repo.EditFilm(models.FilmEdit{Id: 5, Info: &info, Runtime: &runtime, Languages: &languages})
+	if err != nil {
+		t.Errorf("EditFilm error: %s", err)
+	}
+	film, _ := repo.GetFilm(5)
+	if film.Title != "Зеркало" || film.Info != "Воспоминания" || film.Runtime != 108 || len(film.Languages) != 1 {
+		t.Errorf("unexpected film %v", film)
+	}
+
+	runtime = 0
+	languages = []string{}
+	repo.EditFilm(models.FilmEdit{Id: 5, Runtime: &runtime, Languages: &languages})
+	film, _ = repo.GetFilm(5)
+	if film.Info != "Воспоминания" || film.Runtime != 0 || len(film.Languages) != 0 {
+		t.Errorf("expected runtime and languages to be cleared, got %v", film)
+	}
+	if count, _ := repo.CountPosterUsage("/posters/mirror.jpg"); count != 1 {
+		t.Errorf("CountPosterUsage = %d", count)
+	}
+
+	lasts, _ := repo.GetLasts([]uint64{5, 10, 1})
+	if have := ids(lasts); !reflect.DeepEqual(have, []uint64{5, 1}) {
+		t.Errorf("GetLasts = %v", have)
+	}
+
+	byIds, _ := repo.GetFilmsByIds([]uint64{10, 5, 1, 5})
+	if have := ids(byIds); !reflect.DeepEqual(have, []uint64{5, 1}) {
+		t.Errorf("GetFilmsByIds = %v", have)
+	}
+}
+
+func TestMemoryNearFilms(t *testing.T) {
+	lg := slog.New(slog.NewTextHandler(os.Stderr, nil))
+	repo := &NearFilmsMemory{kv: memory.NewKV()}
+	ctx := context.Background()
+
+	for _, id := range []uint64{3, 1} {
+		added, err := repo.AddNearFilm(ctx, models.NearFilm{IdUser: 7, IdFilm: id}, lg)
+		if err != nil || !added {
+			t.Errorf("AddNearFilm = %v, %v", added, err)
+		}
+	}
+
+	films, _ := repo.GetNearFilms(ctx, "7", lg)
+	expect := []models.NearFilm{{IdUser: 7, IdFilm: 1}, {IdUser: 7, IdFilm: 3}}
+	if !reflect.DeepEqual(films, expect) {
+		t.Errorf("GetNearFilms = %v", films)
+	}
+
+	_, _ = repo.DeleteNearFilm(ctx, "7", "1", lg)
+	if active, _ := repo.CheckActiveNearFilm(ctx, "7", "1", lg); active {
+		t.Errorf("expected near film to be deleted")
+	}
+}
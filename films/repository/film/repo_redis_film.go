@@ -4,32 +4,37 @@ import (
 	"context"
 	"log/slog"
 	"strconv"
-	"sync"
-	"time"
+	"sync/atomic"
 
 	"github.com/go-park-mail-ru/2023_2_Vkladyshi/configs"
 	"github.com/go-park-mail-ru/2023_2_Vkladyshi/pkg/models"
 	"github.com/go-redis/redis/v8"
 )
 
-var mutex sync.RWMutex
+type INearFilmsRepo interface {
+	AddNearFilm(ctx context.Context, active models.NearFilm, lg *slog.Logger) (bool, error)
+	CheckActiveNearFilm(ctx context.Context, uid string, fid string, lg *slog.Logger) (bool, error)
+	GetNearFilms(ctx context.Context, uid string, lg *slog.Logger) ([]models.NearFilm, error)
+	DeleteNearFilm(ctx context.Context, uid string, fid string, lg *slog.Logger) (bool, error)
+}
 
 type FilmRedisRepo struct {
-	filmRedisClient    *redis.Client
-	Connection         bool
+	filmRedisClient *redis.Client
+	connection      atomic.Bool
 }
 
-func (redisRepo *FilmRedisRepo) CheckRedisNearFilmConnection(NearFilmCfg configs.DbRedisCfg) {
-	ctx := context.Background()
-	for {
-		_, err := redisRepo.filmRedisClient.Ping(ctx).Result()
-		mutex.Lock()
-		mutex.RLock()
-		redisRepo.Connection = err == nil
-		mutex.Unlock()
-		mutex.RUnlock()
-		time.Sleep(time.Duration(NearFilmCfg.Timer) * time.Second)
-	}
+// Ping lets the health checker tell whether Redis is reachable. Calls made
+// while the last ping failed return at once.
+func (redisRepo *FilmRedisRepo) Ping(ctx context.Context) error {
+	_, err := redisRepo.filmRedisClient.Ping(ctx).Result()
+	redisRepo.connection.Store(err == nil)
+
+	return err
+}
+
+// Close closes the client once the service has stopped.
+func (redisRepo *FilmRedisRepo) Close() error {
+	return redisRepo.filmRedisClient.Close()
 }
 
 func GetFilmRedisRepo(NearFilmCfg configs.DbRedisCfg, lg *slog.Logger) (*FilmRedisRepo, error) {
@@ -46,17 +51,15 @@ func GetFilmRedisRepo(NearFilmCfg configs.DbRedisCfg, lg *slog.Logger) (*FilmRed
 	}
 
 	FilmRedisRepo := FilmRedisRepo{
-		filmRedisClient:    redisClient,
-		Connection:         true,
+		filmRedisClient: redisClient,
 	}
-
-	go FilmRedisRepo.CheckRedisNearFilmConnection(NearFilmCfg)
+	FilmRedisRepo.connection.Store(true)
 
 	return &FilmRedisRepo, nil
 }
 
 func (redisRepo *FilmRedisRepo) AddNearFilm(ctx context.Context, active models.NearFilm, lg *slog.Logger) (bool, error) {
-	if !redisRepo.Connection {
+	if !redisRepo.connection.Load() {
 		lg.Error("Redis NearFilm connection lost")
 		return false, nil
 	}
@@ -75,14 +78,14 @@ func (redisRepo *FilmRedisRepo) AddNearFilm(ctx context.Context, active models.N
 }
 
 func (redisRepo *FilmRedisRepo) CheckActiveNearFilm(ctx context.Context, uid string, fid string, lg *slog.Logger) (bool, error) {
-	if !redisRepo.Connection {
+	if !redisRepo.connection.Load() {
 		lg.Error("Redis NearFilm connection lost")
 		return false, nil
 	}
 
 	exists, err := redisRepo.filmRedisClient.HExists(ctx, "nearfilms:"+uid, fid).Result()
 	if err != nil {
-		lg.Error("HExists request could not be completed ", err)
+		lg.Error("HExists request could not be completed", "err", err.Error())
 		return false, err
 	}
 
@@ -90,14 +93,14 @@ func (redisRepo *FilmRedisRepo) CheckActiveNearFilm(ctx context.Context, uid str
 }
 
 func (redisRepo *FilmRedisRepo) GetNearFilms(ctx context.Context, uid string, lg *slog.Logger) ([]models.NearFilm, error) {
-	if !redisRepo.Connection {
+	if !redisRepo.connection.Load() {
 		lg.Error("Redis NearFilm connection lost")
 		return nil, nil
 	}
 
 	result, err := redisRepo.filmRedisClient.HGetAll(ctx, "nearfilms:"+uid).Result()
 	if err != nil {
-		lg.Error("HGetAll request could not be completed:", err)
+		lg.Error("HGetAll request could not be completed", "err", err.Error())
 		return nil, err
 	}
 
@@ -105,13 +108,13 @@ func (redisRepo *FilmRedisRepo) GetNearFilms(ctx context.Context, uid string, lg
 	for idFilmStr := range result {
 		idFilm, err := strconv.ParseUint(idFilmStr, 10, 64)
 		if err != nil {
-			lg.Error("Error parsing IdFilm:", err)
+			lg.Error("Error parsing IdFilm", "err", err.Error())
 			continue
 		}
 
 		idUser, err := strconv.ParseUint(uid, 10, 64)
 		if err != nil {
-			lg.Error("Error parsing IdUser:", err)
+			lg.Error("Error parsing IdUser", "err", err.Error())
 			continue
 		}
 
@@ -125,11 +128,10 @@ func (redisRepo *FilmRedisRepo) GetNearFilms(ctx context.Context, uid string, lg
 	return nearFilms, nil
 }
 
-
 func (redisRepo *FilmRedisRepo) DeleteNearFilm(ctx context.Context, uid string, fid string, lg *slog.Logger) (bool, error) {
 	deletedCount, err := redisRepo.filmRedisClient.HDel(ctx, "nearfilms:"+uid, fid).Result()
 	if err != nil {
-		lg.Error("HDEL request could not be completed:", err)
+		lg.Error("HDEL request could not be completed", "err", err.Error())
 		return false, err
 	}
 
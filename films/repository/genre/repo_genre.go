@@ -1,13 +1,13 @@
 package genre
 
 import (
+	"context"
 	"database/sql"
 	"errors"
 	"fmt"
 	"log/slog"
 	"strconv"
 	"strings"
-	"time"
 
 	"github.com/go-park-mail-ru/2023_2_Vkladyshi/configs"
 	"github.com/go-park-mail-ru/2023_2_Vkladyshi/pkg/models"
@@ -46,19 +46,17 @@ func GetGenreRepo(config *configs.DbDsnCfg, lg *slog.Logger) (*RepoPostgre, erro
 
 	postgreDb := RepoPostgre{db: db}
 
-	go postgreDb.pingDb(config.Timer, lg)
 	return &postgreDb, nil
 }
 
-func (repo *RepoPostgre) pingDb(timer uint32, lg *slog.Logger) {
-	for {
-		err := repo.db.Ping()
-		if err != nil {
-			lg.Error("Repo Genre db ping error", "err", err.Error())
-		}
+// Ping lets the health checker tell whether the database is reachable.
+func (repo *RepoPostgre) Ping(ctx context.Context) error {
+	return repo.db.PingContext(ctx)
+}
 
-		time.Sleep(time.Duration(timer) * time.Second)
-	}
+// Close closes the connection pool once the service has stopped.
+func (repo *RepoPostgre) Close() error {
+	return repo.db.Close()
 }
 
 func (repo *RepoPostgre) GetFilmGenres(filmId uint64) ([]models.GenreItem, error) {
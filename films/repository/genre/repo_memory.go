@@ -0,0 +1,109 @@
+package genre
+
+import (
+	"cmp"
+	"fmt"
+	"slices"
+
+	"github.com/go-park-mail-ru/2023_2_Vkladyshi/configs"
+	"github.com/go-park-mail-ru/2023_2_Vkladyshi/pkg/memory"
+	"github.com/go-park-mail-ru/2023_2_Vkladyshi/pkg/models"
+	"github.com/go-park-mail-ru/2023_2_Vkladyshi/pkg/requests"
+)
+
+type RepoMemory struct {
+	store *memory.Store
+}
+
+func GetGenreMemoryRepo(config *configs.DbDsnCfg) (*RepoMemory, error) {
+	store, err := memory.Open(config.Seed)
+	if err != nil {
+		return nil, fmt.Errorf("get genre repo: %w", err)
+	}
+
+	return &RepoMemory{store: store}, nil
+}
+
+func (repo *RepoMemory) GetFilmGenres(filmId uint64) ([]models.GenreItem, error) {
+	repo.store.RLock()
+	defer repo.store.RUnlock()
+
+	genres := []models.GenreItem{}
+	for _, link := range repo.store.FilmGenres {
+		if link.IdFilm != filmId {
+			continue
+		}
+		if genre := repo.store.Genre(link.IdGenre); genre != nil {
+			genres = append(genres, models.GenreItem{Id: genre.Id, Title: genre.Title})
+		}
+	}
+
+	return genres, nil
+}
+
+func (repo *RepoMemory) GetGenreById(genreId uint64) (string, error) {
+	repo.store.RLock()
+	defer repo.store.RUnlock()
+
+	genre := repo.store.Genre(genreId)
+	if genre == nil {
+		return "", nil
+	}
+
+	return genre.Title, nil
+}
+
+func (repo *RepoMemory) AddFilm(genres []uint64, filmId uint64) error {
+	repo.store.Lock()
+	defer repo.store.Unlock()
+
+	links := make([]memory.FilmGenre, 0, len(genres))
+	for _, genre := range genres {
+		link := memory.FilmGenre{IdFilm: filmId, IdGenre: genre}
+		if repo.store.Film(filmId) == nil || repo.store.Genre(genre) == nil {
+			return fmt.Errorf("add films genres error: film %d or genre %d not found", filmId, genre)
+		}
+		if slices.Contains(repo.store.FilmGenres, link) || slices.Contains(links, link) {
+			return fmt.Errorf("add films genres error: film %d already has genre %d", filmId, genre)
+		}
+		links = append(links, link)
+	}
+	repo.store.FilmGenres = append(repo.store.FilmGenres, links...)
+
+	return nil
+}
+
+func (repo *RepoMemory) UsersStatistics(idUser uint64) ([]requests.UsersStatisticsResponse, error) {
+	repo.store.RLock()
+	defer repo.store.RUnlock()
+
+	response := []requests.UsersStatisticsResponse{}
+	sums := map[uint64]uint64{}
+	for _, comment := range repo.store.Comments {
+		if comment.IdUser != idUser || repo.store.Film(comment.IdFilm) == nil {
+			continue
+		}
+		for _, link := range repo.store.FilmGenres {
+			if link.IdFilm != comment.IdFilm || repo.store.Genre(link.IdGenre) == nil {
+				continue
+			}
+			i := slices.IndexFunc(response, func(stat requests.UsersStatisticsResponse) bool {
+				return stat.GenreId == link.IdGenre
+			})
+			if i == -1 {
+				response = append(response, requests.UsersStatisticsResponse{GenreId: link.IdGenre})
+				i = len(response) - 1
+			}
+			response[i].Count++
+			sums[link.IdGenre] += uint64(comment.Rating)
+		}
+	}
+	for i := range response {
+		response[i].Avg = float64(sums[response[i].GenreId]) / float64(response[i].Count)
+	}
+	slices.SortFunc(response, func(a, b requests.UsersStatisticsResponse) int {
+		return cmp.Compare(a.GenreId, b.GenreId)
+	})
+
+	return response, nil
+}
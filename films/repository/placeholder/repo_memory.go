@@ -0,0 +1,110 @@
+package placeholder
+
+import (
+	"cmp"
+	"fmt"
+	"slices"
+
+	"github.com/go-park-mail-ru/2023_2_Vkladyshi/configs"
+	"github.com/go-park-mail-ru/2023_2_Vkladyshi/pkg/memory"
+	"github.com/go-park-mail-ru/2023_2_Vkladyshi/pkg/models"
+)
+
+type RepoMemory struct {
+	store *memory.Store
+}
+
+func GetPlaceholderMemoryRepo(config *configs.DbDsnCfg) (*RepoMemory, error) {
+	store, err := memory.Open(config.Seed)
+	if err != nil {
+		return nil, fmt.Errorf("get placeholder repo: %w", err)
+	}
+
+	return &RepoMemory{store: store}, nil
+}
+
+func (repo *RepoMemory) GetFilmPlaceholders(ids []uint64) (map[uint64]models.Placeholder, error) {
+	repo.store.RLock()
+	defer repo.store.RUnlock()
+
+	result := map[uint64]models.Placeholder{}
+	for _, film := range repo.store.Films {
+		if film.Placeholder != nil && slices.Contains(ids, film.Id) {
+			result[film.Id] = *film.Placeholder
+		}
+	}
+
+	return result, nil
+}
+
+func (repo *RepoMemory) GetPersonPlaceholders(ids []uint64) (map[uint64]models.Placeholder, error) {
+	repo.store.RLock()
+	defer repo.store.RUnlock()
+
+	result := map[uint64]models.Placeholder{}
+	for _, person := range repo.store.Crew {
+		if person.Placeholder != nil && slices.Contains(ids, person.Id) {
+			result[person.Id] = *person.Placeholder
+		}
+	}
+
+	return result, nil
+}
+
+func (repo *RepoMemory) SetFilmPlaceholder(id uint64, placeholder models.Placeholder) error {
+	repo.store.Lock()
+	defer repo.store.Unlock()
+
+	if film := repo.store.Film(id); film != nil {
+		film.Placeholder = &placeholder
+	}
+
+	return nil
+}
+
+func (repo *RepoMemory) SetPersonPlaceholder(id uint64, placeholder models.Placeholder) error {
+	repo.store.Lock()
+	defer repo.store.Unlock()
+
+	if person := repo.store.Person(id); person != nil {
+		person.Placeholder = &placeholder
+	}
+
+	return nil
+}
+
+func (repo *RepoMemory) GetFilmImages(after uint64, limit uint64, missingOnly bool) ([]Image, error) {
+	repo.store.RLock()
+	defer repo.store.RUnlock()
+
+	result := []Image{}
+	for _, film := range repo.store.Films {
+		if film.Id > after && film.Poster != "" && (!missingOnly || film.Placeholder == nil) {
+			result = append(result, Image{Id: film.Id, Url: film.Poster})
+		}
+	}
+
+	return firstImages(result, limit), nil
+}
+
+func (repo *RepoMemory) GetPersonImages(after uint64, limit uint64, missingOnly bool) ([]Image, error) {
+	repo.store.RLock()
+	defer repo.store.RUnlock()
+
+	result := []Image{}
+	for _, person := range repo.store.Crew {
+		if person.Id > after && person.Photo != "" && (!missingOnly || person.Placeholder == nil) {
+			result = append(result, Image{Id: person.Id, Url: person.Photo})
+		}
+	}
+
+	return firstImages(result, limit), nil
+}
+
+func firstImages(images []Image, limit uint64) []Image {
+	slices.SortFunc(images, func(a, b Image) int {
+		return cmp.Compare(a.Id, b.Id)
+	})
+
+	return memory.Paginate(images, 0, limit)
+}
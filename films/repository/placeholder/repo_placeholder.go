@@ -0,0 +1,158 @@
+package placeholder
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"log/slog"
+
+	"github.com/go-park-mail-ru/2023_2_Vkladyshi/configs"
+	"github.com/go-park-mail-ru/2023_2_Vkladyshi/pkg/models"
+	"github.com/lib/pq"
+
+	_ "github.com/jackc/pgx/stdlib"
+)
+
+//go:generate mockgen -source=repo_placeholder.go -destination=../../mocks/placeholder_repo_mock.go -package=mocks
+
+// Image is a stored poster or photo the placeholder is computed from.
+type Image struct {
+	Id  uint64
+	Url string
+}
+
+type IPlaceholderRepo interface {
+	GetFilmPlaceholders(ids []uint64) (map[uint64]models.Placeholder, error)
+	GetPersonPlaceholders(ids []uint64) (map[uint64]models.Placeholder, error)
+	SetFilmPlaceholder(id uint64, placeholder models.Placeholder) error
+	SetPersonPlaceholder(id uint64, placeholder models.Placeholder) error
+	GetFilmImages(after uint64, limit uint64, missingOnly bool) ([]Image, error)
+	GetPersonImages(after uint64, limit uint64, missingOnly bool) ([]Image, error)
+}
+
+type RepoPostgre struct {
+	db *sql.DB
+}
+
+func GetPlaceholderRepo(config *configs.DbDsnCfg, lg *slog.Logger) (*RepoPostgre, error) {
+	dsn := fmt.Sprintf("user=%s dbname=%s password= %s host=%s port=%d sslmode=%s",
+		config.User, config.DbName, config.Password, config.Host, config.Port, config.Sslmode)
+	db, err := sql.Open("pgx", dsn)
+	if err != nil {
+		lg.Error("sql open error", "err", err.Error())
+		return nil, fmt.Errorf("get placeholder repo: %w", err)
+	}
+	err = db.Ping()
+	if err != nil {
+		lg.Error("sql ping error", "err", err.Error())
+		return nil, fmt.Errorf("get placeholder repo: %w", err)
+	}
+	db.SetMaxOpenConns(config.MaxOpenConns)
+
+	postgreDb := RepoPostgre{db: db}
+
+	return &postgreDb, nil
+}
+
+// Ping lets the health checker tell whether the database is reachable.
+func (repo *RepoPostgre) Ping(ctx context.Context) error {
+	return repo.db.PingContext(ctx)
+}
+
+// Close closes the connection pool once the service has stopped.
+func (repo *RepoPostgre) Close() error {
+	return repo.db.Close()
+}
+
+func (repo *RepoPostgre) GetFilmPlaceholders(ids []uint64) (map[uint64]models.Placeholder, error) {
+	return repo.getPlaceholders(
+		"SELECT id, poster_blurhash, COALESCE(poster_color, ''), COALESCE(poster_accent, '') FROM film "+
+			"WHERE id = ANY($1) AND poster_blurhash IS NOT NULL", ids)
+}
+
+func (repo *RepoPostgre) GetPersonPlaceholders(ids []uint64) (map[uint64]models.Placeholder, error) {
+	return repo.getPlaceholders(
+		"SELECT id, photo_blurhash, COALESCE(photo_color, ''), COALESCE(photo_accent, '') FROM crew "+
+			"WHERE id = ANY($1) AND photo_blurhash IS NOT NULL", ids)
+}
+
+func (repo *RepoPostgre) getPlaceholders(query string, ids []uint64) (map[uint64]models.Placeholder, error) {
+	result := map[uint64]models.Placeholder{}
+
+	rows, err := repo.db.Query(query, pq.Array(ids))
+	if err != nil {
+		return nil, fmt.Errorf("get placeholders err: %w", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var id uint64
+		var placeholder models.Placeholder
+		err := rows.Scan(&id, &placeholder.Blurhash, &placeholder.Dominant, &placeholder.Accent)
+		if err != nil {
+			return nil, fmt.Errorf("get placeholders scan err: %w", err)
+		}
+		result[id] = placeholder
+	}
+
+	return result, nil
+}
+
+func (repo *RepoPostgre) SetFilmPlaceholder(id uint64, placeholder models.Placeholder) error {
+	_, err := repo.db.Exec(
+		"UPDATE film SET poster_blurhash = $1, poster_color = $2, poster_accent = $3 WHERE id = $4",
+		placeholder.Blurhash, placeholder.Dominant, placeholder.Accent, id)
+	if err != nil {
+		return fmt.Errorf("set film placeholder err: %w", err)
+	}
+
+	return nil
+}
+
+func (repo *RepoPostgre) SetPersonPlaceholder(id uint64, placeholder models.Placeholder) error {
+	_, err := repo.db.Exec(
+		"UPDATE crew SET photo_blurhash = $1, photo_color = $2, photo_accent = $3 WHERE id = $4",
+		placeholder.Blurhash, placeholder.Dominant, placeholder.Accent, id)
+	if err != nil {
+		return fmt.Errorf("set person placeholder err: %w", err)
+	}
+
+	return nil
+}
+
+func (repo *RepoPostgre) GetFilmImages(after uint64, limit uint64, missingOnly bool) ([]Image, error) {
+	return repo.getImages("SELECT id, poster FROM film WHERE id > $1 AND poster <> ''",
+		" AND poster_blurhash IS NULL", after, limit, missingOnly)
+}
+
+func (repo *RepoPostgre) GetPersonImages(after uint64, limit uint64, missingOnly bool) ([]Image, error) {
+	return repo.getImages("SELECT id, photo FROM crew WHERE id > $1 AND photo <> ''",
+		" AND photo_blurhash IS NULL", after, limit, missingOnly)
+}
+
+// getImages pages through images ordered by id, so the backfill can resume
+// from the last processed id.
+func (repo *RepoPostgre) getImages(query string, missing string, after uint64, limit uint64, missingOnly bool) ([]Image, error) {
+	if missingOnly {
+		query += missing
+	}
+	query += " ORDER BY id LIMIT $2"
+
+	result := []Image{}
+	rows, err := repo.db.Query(query, after, limit)
+	if err != nil {
+		return nil, fmt.Errorf("get images err: %w", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var image Image
+		err := rows.Scan(&image.Id, &image.Url)
+		if err != nil {
+			return nil, fmt.Errorf("get images scan err: %w", err)
+		}
+		result = append(result, image)
+	}
+
+	return result, nil
+}
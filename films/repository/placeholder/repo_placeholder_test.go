@@ -0,0 +1,178 @@
+package placeholder
+
+import (
+	"fmt"
+	"reflect"
+	"regexp"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/go-park-mail-ru/2023_2_Vkladyshi/pkg/models"
+)
+
+func TestGetFilmPlaceholders(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("cant create mock: %s", err)
+	}
+	defer db.Close()
+
+	rows := sqlmock.NewRows([]string{"Id", "Blurhash", "Dominant", "Accent"})
+
+	expect := map[uint64]models.Placeholder{
+		1: {Blurhash: "LEHV6nWB2yk8pyo0adR*.7kCMdnj", Dominant: "#102030", Accent: "#c04020"},
+	}
+	rows = rows.AddRow(1, expect[1].Blurhash, expect[1].Dominant, expect[1].Accent)
+
+	selectRow := "SELECT id, poster_blurhash, COALESCE(poster_color, ''), COALESCE(poster_accent, '') FROM film " +
+		"WHERE id = ANY($1) AND poster_blurhash IS NOT NULL"
+	mock.ExpectQuery(
+		regexp.QuoteMeta(selectRow)).
+		WithArgs("{1,2}").
+		WillReturnRows(rows)
+
+	repo := &RepoPostgre{
+		db: db,
+	}
+
+	placeholders, err := repo.GetFilmPlaceholders([]uint64{1, 2})
+	if err != nil {
+		t.Errorf("GetFilmPlaceholders error: %s", err)
+	}
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("there were unfulfilled expectations: %s", err)
+		return
+	}
+
+	if !reflect.DeepEqual(placeholders, expect) {
+		t.Errorf("results not match, want %v, have %v", expect, placeholders)
+		return
+	}
+
+	mock.ExpectQuery(
+		regexp.QuoteMeta(selectRow)).
+		WithArgs("{1,2}").
+		WillReturnError(fmt.Errorf("db_error"))
+
+	placeholders, err = repo.GetFilmPlaceholders([]uint64{1, 2})
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("there were unfulfilled expectations: %s", err)
+		return
+	}
+	if err == nil {
+		t.Errorf("expected error, got nil")
+		return
+	}
+	if placeholders != nil {
+		t.Errorf("get placeholders must be nil")
+		return
+	}
+}
+
+func TestSetPersonPlaceholder(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("cant create mock: %s", err)
+	}
+	defer db.Close()
+
+	placeholder := models.Placeholder{Blurhash: "hash", Dominant: "#000000", Accent: "#ffffff"}
+	updateRow := "UPDATE crew SET photo_blurhash = $1, photo_color = $2, photo_accent = $3 WHERE id = $4"
+
+	mock.ExpectExec(
+		regexp.QuoteMeta(updateRow)).
+		WithArgs("hash", "#000000", "#ffffff", 3).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+
+	repo := &RepoPostgre{
+		db: db,
+	}
+
+	err = repo.SetPersonPlaceholder(3, placeholder)
+	if err != nil {
+		t.Errorf("SetPersonPlaceholder error: %s", err)
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("there were unfulfilled expectations: %s", err)
+		return
+	}
+
+	mock.ExpectExec(
+		regexp.QuoteMeta(updateRow)).
+		WithArgs("hash", "#000000", "#ffffff", 3).
+		WillReturnError(fmt.Errorf("db_error"))
+
+	err = repo.SetPersonPlaceholder(3, placeholder)
+	if err == nil {
+		t.Errorf("expected error, got nil")
+		return
+	}
+}
+
+func TestGetFilmImages(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("cant create mock: %s", err)
+	}
+	defer db.Close()
+
+	expect := []Image{
+		{Id: 4, Url: "/icons/a.jpg"},
+		{Id: 7, Url: "/icons/b.png"},
+	}
+
+	testCases := map[string]struct {
+		missingOnly bool
+		query       string
+	}{
+		"missing only": {
+			missingOnly: true,
+			query: "SELECT id, poster FROM film WHERE id > $1 AND poster <> '' AND poster_blurhash IS NULL " +
+				"ORDER BY id LIMIT $2",
+		},
+		"all": {
+			missingOnly: false,
+			query:       "SELECT id, poster FROM film WHERE id > $1 AND poster <> '' ORDER BY id LIMIT $2",
+		},
+	}
+
+	repo := &RepoPostgre{
+		db: db,
+	}
+
+	for name, curr := range testCases {
+		rows := sqlmock.NewRows([]string{"Id", "Poster"})
+		for _, item := range expect {
+			rows = rows.AddRow(item.Id, item.Url)
+		}
+		mock.ExpectQuery(
+			"^"+regexp.QuoteMeta(curr.query)+"$").
+			WithArgs(3, 10).
+			WillReturnRows(rows)
+
+		result, err := repo.GetFilmImages(3, 10, curr.missingOnly)
+		if err != nil {
+			t.Errorf("%s: GetFilmImages error: %s", name, err)
+		}
+		if err := mock.ExpectationsWereMet(); err != nil {
+			t.Errorf("%s: there were unfulfilled expectations: %s", name, err)
+			return
+		}
+		if !reflect.DeepEqual(result, expect) {
+			t.Errorf("%s: results not match, want %v, have %v", name, expect, result)
+			return
+		}
+	}
+
+	mock.ExpectQuery(
+		regexp.QuoteMeta("SELECT id, poster FROM film")).
+		WithArgs(0, 10).
+		WillReturnError(fmt.Errorf("db_error"))
+
+	_, err = repo.GetFilmImages(0, 10, true)
+	if err == nil {
+		t.Errorf("expected error, got nil")
+		return
+	}
+}
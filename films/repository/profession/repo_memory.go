@@ -0,0 +1,42 @@
+package profession
+
+import (
+	"fmt"
+	"slices"
+
+	"github.com/go-park-mail-ru/2023_2_Vkladyshi/configs"
+	"github.com/go-park-mail-ru/2023_2_Vkladyshi/pkg/memory"
+	"github.com/go-park-mail-ru/2023_2_Vkladyshi/pkg/models"
+)
+
+type RepoMemory struct {
+	store *memory.Store
+}
+
+func GetProfessionMemoryRepo(config *configs.DbDsnCfg) (*RepoMemory, error) {
+	store, err := memory.Open(config.Seed)
+	if err != nil {
+		return nil, fmt.Errorf("get prof repo: %w", err)
+	}
+
+	return &RepoMemory{store: store}, nil
+}
+
+func (repo *RepoMemory) GetActorsProfessions(actorId uint64) ([]models.ProfessionItem, error) {
+	repo.store.RLock()
+	defer repo.store.RUnlock()
+
+	professions := []models.ProfessionItem{}
+	for _, credit := range repo.store.Credits {
+		if credit.IdPerson != actorId {
+			continue
+		}
+		profession := repo.store.Profession(credit.IdProfession)
+		if profession == nil || slices.Contains(professions, models.ProfessionItem{Title: profession.Title}) {
+			continue
+		}
+		professions = append(professions, models.ProfessionItem{Title: profession.Title})
+	}
+
+	return professions, nil
+}
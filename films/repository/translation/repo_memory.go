@@ -0,0 +1,79 @@
+package translation
+
+import (
+	"fmt"
+	"slices"
+
+	"github.com/go-park-mail-ru/2023_2_Vkladyshi/configs"
+	"github.com/go-park-mail-ru/2023_2_Vkladyshi/pkg/memory"
+	"github.com/go-park-mail-ru/2023_2_Vkladyshi/pkg/models"
+)
+
+type RepoMemory struct {
+	store *memory.Store
+}
+
+func GetTranslationMemoryRepo(config *configs.DbDsnCfg) (*RepoMemory, error) {
+	store, err := memory.Open(config.Seed)
+	if err != nil {
+		return nil, fmt.Errorf("get translation repo: %w", err)
+	}
+
+	return &RepoMemory{store: store}, nil
+}
+
+func (repo *RepoMemory) GetFilmTranslations(ids []uint64, langs []string) ([]models.Translation, error) {
+	return repo.getTranslations(repo.store.FilmTranslations, ids, langs)
+}
+
+func (repo *RepoMemory) GetPersonTranslations(ids []uint64, langs []string) ([]models.Translation, error) {
+	return repo.getTranslations(repo.store.PersonTranslations, ids, langs)
+}
+
+func (repo *RepoMemory) GetGenreTranslations(ids []uint64, langs []string) ([]models.Translation, error) {
+	return repo.getTranslations(repo.store.GenreTranslations, ids, langs)
+}
+
+func (repo *RepoMemory) getTranslations(table []memory.Translation, ids []uint64, langs []string) ([]models.Translation, error) {
+	repo.store.RLock()
+	defer repo.store.RUnlock()
+
+	translations := []models.Translation{}
+	for _, translation := range table {
+		if slices.Contains(ids, translation.Id) && slices.Contains(langs, translation.Lang) {
+			translations = append(translations, models.Translation(translation))
+		}
+	}
+
+	return translations, nil
+}
+
+func (repo *RepoMemory) AddTranslation(entity string, translation models.Translation) error {
+	repo.store.Lock()
+	defer repo.store.Unlock()
+
+	var table *[]memory.Translation
+	switch entity {
+	case EntityFilm:
+		table = &repo.store.FilmTranslations
+	case EntityPerson:
+		table = &repo.store.PersonTranslations
+	case EntityGenre:
+		table = &repo.store.GenreTranslations
+		translation.Info = ""
+	default:
+		return fmt.Errorf("add translation err: unknown entity %q", entity)
+	}
+
+	row := memory.Translation(translation)
+	i := slices.IndexFunc(*table, func(stored memory.Translation) bool {
+		return stored.Id == row.Id && stored.Lang == row.Lang
+	})
+	if i == -1 {
+		*table = append(*table, row)
+	} else {
+		(*table)[i] = row
+	}
+
+	return nil
+}
@@ -0,0 +1,128 @@
+package translation
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"log/slog"
+
+	"github.com/go-park-mail-ru/2023_2_Vkladyshi/configs"
+	"github.com/go-park-mail-ru/2023_2_Vkladyshi/pkg/models"
+	"github.com/lib/pq"
+
+	_ "github.com/jackc/pgx/stdlib"
+)
+
+const (
+	EntityFilm   = "film"
+	EntityPerson = "person"
+	EntityGenre  = "genre"
+)
+
+//go:generate mockgen -source=repo_translation.go -destination=../../mocks/translation_repo_mock.go -package=mocks
+
+type ITranslationRepo interface {
+	GetFilmTranslations(ids []uint64, langs []string) ([]models.Translation, error)
+	GetPersonTranslations(ids []uint64, langs []string) ([]models.Translation, error)
+	GetGenreTranslations(ids []uint64, langs []string) ([]models.Translation, error)
+	AddTranslation(entity string, translation models.Translation) error
+}
+
+type RepoPostgre struct {
+	db *sql.DB
+}
+
+func GetTranslationRepo(config *configs.DbDsnCfg, lg *slog.Logger) (*RepoPostgre, error) {
+	dsn := fmt.Sprintf("user=%s dbname=%s password= %s host=%s port=%d sslmode=%s",
+		config.User, config.DbName, config.Password, config.Host, config.Port, config.Sslmode)
+	db, err := sql.Open("pgx", dsn)
+	if err != nil {
+		lg.Error("sql open error", "err", err.Error())
+		return nil, fmt.Errorf("get translation repo: %w", err)
+	}
+	err = db.Ping()
+	if err != nil {
+		lg.Error("sql ping error", "err", err.Error())
+		return nil, fmt.Errorf("get translation repo: %w", err)
+	}
+	db.SetMaxOpenConns(config.MaxOpenConns)
+
+	postgreDb := RepoPostgre{db: db}
+
+	return &postgreDb, nil
+}
+
+// Ping lets the health checker tell whether the database is reachable.
+func (repo *RepoPostgre) Ping(ctx context.Context) error {
+	return repo.db.PingContext(ctx)
+}
+
+// Close closes the connection pool once the service has stopped.
+func (repo *RepoPostgre) Close() error {
+	return repo.db.Close()
+}
+
+func (repo *RepoPostgre) GetFilmTranslations(ids []uint64, langs []string) ([]models.Translation, error) {
+	return repo.getTranslations(
+		"SELECT id_film, lang, title, COALESCE(info, '') FROM film_translation "+
+			"WHERE id_film = ANY($1) AND lang = ANY($2)", ids, langs)
+}
+
+func (repo *RepoPostgre) GetPersonTranslations(ids []uint64, langs []string) ([]models.Translation, error) {
+	return repo.getTranslations(
+		"SELECT id_person, lang, name, COALESCE(info, '') FROM person_translation "+
+			"WHERE id_person = ANY($1) AND lang = ANY($2)", ids, langs)
+}
+
+func (repo *RepoPostgre) GetGenreTranslations(ids []uint64, langs []string) ([]models.Translation, error) {
+	return repo.getTranslations(
+		"SELECT id_genre, lang, title, '' FROM genre_translation "+
+			"WHERE id_genre = ANY($1) AND lang = ANY($2)", ids, langs)
+}
+
+func (repo *RepoPostgre) getTranslations(query string, ids []uint64, langs []string) ([]models.Translation, error) {
+	translations := []models.Translation{}
+
+	rows, err := repo.db.Query(query, pq.Array(ids), pq.Array(langs))
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		return nil, fmt.Errorf("get translations err: %w", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		post := models.Translation{}
+		err := rows.Scan(&post.Id, &post.Lang, &post.Title, &post.Info)
+		if err != nil {
+			return nil, fmt.Errorf("get translations scan err: %w", err)
+		}
+		translations = append(translations, post)
+	}
+
+	return translations, nil
+}
+
+func (repo *RepoPostgre) AddTranslation(entity string, translation models.Translation) error {
+	var err error
+	switch entity {
+	case EntityFilm:
+		_, err = repo.db.Exec("INSERT INTO film_translation(id_film, lang, title, info) VALUES($1, $2, $3, $4) "+
+			"ON CONFLICT (id_film, lang) DO UPDATE SET title = EXCLUDED.title, info = EXCLUDED.info",
+			translation.Id, translation.Lang, translation.Title, translation.Info)
+	case EntityPerson:
+		_, err = repo.db.Exec("INSERT INTO person_translation(id_person, lang, name, info) VALUES($1, $2, $3, $4) "+
+			"ON CONFLICT (id_person, lang) DO UPDATE SET name = EXCLUDED.name, info = EXCLUDED.info",
+			translation.Id, translation.Lang, translation.Title, translation.Info)
+	case EntityGenre:
+		_, err = repo.db.Exec("INSERT INTO genre_translation(id_genre, lang, title) VALUES($1, $2, $3) "+
+			"ON CONFLICT (id_genre, lang) DO UPDATE SET title = EXCLUDED.title",
+			translation.Id, translation.Lang, translation.Title)
+	default:
+		return fmt.Errorf("add translation err: unknown entity %q", entity)
+	}
+	if err != nil {
+		return fmt.Errorf("add translation err: %w", err)
+	}
+
+	return nil
+}
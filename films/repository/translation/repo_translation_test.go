@@ -0,0 +1,123 @@
+package translation
+
+import (
+	"fmt"
+	"reflect"
+	"regexp"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/go-park-mail-ru/2023_2_Vkladyshi/pkg/models"
+)
+
+func TestGetFilmTranslations(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("cant create mock: %s", err)
+	}
+	defer db.Close()
+
+	rows := sqlmock.NewRows([]string{"Id", "Lang", "Title", "Info"})
+
+	expect := []models.Translation{
+		{Id: 1, Lang: "en", Title: "t1", Info: "i1"},
+		{Id: 2, Lang: "de", Title: "t2"},
+	}
+
+	for _, item := range expect {
+		rows = rows.AddRow(item.Id, item.Lang, item.Title, item.Info)
+	}
+
+	selectRow := "SELECT id_film, lang, title, COALESCE(info, '') FROM film_translation WHERE id_film = ANY($1) AND lang = ANY($2)"
+	mock.ExpectQuery(
+		regexp.QuoteMeta(selectRow)).
+		WithArgs("{1,2}", "{\"en\",\"de\"}").
+		WillReturnRows(rows)
+
+	repo := &RepoPostgre{
+		db: db,
+	}
+
+	translations, err := repo.GetFilmTranslations([]uint64{1, 2}, []string{"en", "de"})
+	if err != nil {
+		t.Errorf("GetFilmTranslations error: %s", err)
+	}
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("there were unfulfilled expectations: %s", err)
+		return
+	}
+
+	if !reflect.DeepEqual(translations, expect) {
+		t.Errorf("results not match, want %v, have %v", expect, translations)
+		return
+	}
+
+	mock.ExpectQuery(
+		regexp.QuoteMeta(selectRow)).
+		WithArgs("{1,2}", "{\"en\",\"de\"}").
+		WillReturnError(fmt.Errorf("db_error"))
+
+	translations, err = repo.GetFilmTranslations([]uint64{1, 2}, []string{"en", "de"})
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("there were unfulfilled expectations: %s", err)
+		return
+	}
+	if err == nil {
+		t.Errorf("expected error, got nil")
+		return
+	}
+	if translations != nil {
+		t.Errorf("get translations must be nil")
+		return
+	}
+}
+
+func TestAddTranslation(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("cant create mock: %s", err)
+	}
+	defer db.Close()
+
+	item := models.Translation{Id: 1, Lang: "en", Title: "t", Info: "i"}
+	insertRow := "INSERT INTO person_translation(id_person, lang, name, info) VALUES($1, $2, $3, $4) " +
+		"ON CONFLICT (id_person, lang) DO UPDATE SET name = EXCLUDED.name, info = EXCLUDED.info"
+
+	mock.ExpectExec(
+		regexp.QuoteMeta(insertRow)).
+		WithArgs(1, "en", "t", "i").WillReturnResult(sqlmock.NewResult(0, 1))
+
+	repo := &RepoPostgre{
+		db: db,
+	}
+
+	err = repo.AddTranslation(EntityPerson, item)
+	if err != nil {
+		t.Errorf("unexpected err: %s", err)
+	}
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("there were unfulfilled expectations: %s", err)
+		return
+	}
+
+	mock.ExpectExec(
+		regexp.QuoteMeta(insertRow)).
+		WithArgs(1, "en", "t", "i").WillReturnError(fmt.Errorf("repo err"))
+
+	err = repo.AddTranslation(EntityPerson, item)
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("there were unfulfilled expectations: %s", err)
+		return
+	}
+	if err == nil {
+		t.Errorf("expected error, got nil")
+		return
+	}
+
+	err = repo.AddTranslation("collection", item)
+	if err == nil {
+		t.Errorf("expected error, got nil")
+	}
+}
@@ -0,0 +1,51 @@
+package usecase
+
+import (
+	"fmt"
+
+	"github.com/go-park-mail-ru/2023_2_Vkladyshi/pkg/models"
+)
+
+// GetFilmCards returns the short cards of the films in the order of ids,
+// unknown ids are skipped.
+func (core *Core) GetFilmCards(langs []string, ids []uint64) ([]models.FilmItem, error) {
+	films, err := core.films.GetFilmsByIds(ids)
+	if err != nil {
+		core.lg.Error("get film cards error", "err", err.Error())
+		return nil, fmt.Errorf("get film cards err: %w", err)
+	}
+	core.setPosterMedia(films)
+	err = core.localizeFilms(films, langs)
+	if err != nil {
+		return nil, fmt.Errorf("get film cards err: %w", err)
+	}
+
+	return films, nil
+}
+
+func (core *Core) FilmExists(filmId uint64) (bool, error) {
+	films, err := core.films.GetFilmsByIds([]uint64{filmId})
+	if err != nil {
+		core.lg.Error("film exists error", "err", err.Error())
+		return false, fmt.Errorf("film exists err: %w", err)
+	}
+
+	return len(films) != 0, nil
+}
+
+// FavoriteFilmIds returns those of filmIds that the user has in favorites.
+func (core *Core) FavoriteFilmIds(userId uint64, filmIds []uint64) ([]uint64, error) {
+	favorites := []uint64{}
+	for _, filmId := range filmIds {
+		found, err := core.films.CheckFilm(userId, filmId)
+		if err != nil {
+			core.lg.Error("favorite film ids error", "err", err.Error())
+			return nil, fmt.Errorf("favorite film ids err: %w", err)
+		}
+		if found {
+			favorites = append(favorites, filmId)
+		}
+	}
+
+	return favorites, nil
+}
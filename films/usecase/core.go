@@ -9,42 +9,59 @@ import (
 	"time"
 
 	auth "github.com/go-park-mail-ru/2023_2_Vkladyshi/authorization/proto"
-	"github.com/go-park-mail-ru/2023_2_Vkladyshi/configs"
+	comments "github.com/go-park-mail-ru/2023_2_Vkladyshi/comments/proto"
 	"github.com/go-park-mail-ru/2023_2_Vkladyshi/films/repository/calendar"
 	"github.com/go-park-mail-ru/2023_2_Vkladyshi/films/repository/crew"
 	"github.com/go-park-mail-ru/2023_2_Vkladyshi/films/repository/film"
 	"github.com/go-park-mail-ru/2023_2_Vkladyshi/films/repository/genre"
+	"github.com/go-park-mail-ru/2023_2_Vkladyshi/films/repository/placeholder"
 	"github.com/go-park-mail-ru/2023_2_Vkladyshi/films/repository/profession"
+	"github.com/go-park-mail-ru/2023_2_Vkladyshi/films/repository/translation"
+	"github.com/go-park-mail-ru/2023_2_Vkladyshi/pkg/grpcclient"
+	"github.com/go-park-mail-ru/2023_2_Vkladyshi/pkg/healthcheck"
+	"github.com/go-park-mail-ru/2023_2_Vkladyshi/pkg/images"
+	"github.com/go-park-mail-ru/2023_2_Vkladyshi/pkg/lifecycle"
+	"github.com/go-park-mail-ru/2023_2_Vkladyshi/pkg/locale"
+	"github.com/go-park-mail-ru/2023_2_Vkladyshi/pkg/middleware"
 	"github.com/go-park-mail-ru/2023_2_Vkladyshi/pkg/models"
 	"github.com/go-park-mail-ru/2023_2_Vkladyshi/pkg/requests"
+	"github.com/go-park-mail-ru/2023_2_Vkladyshi/pkg/storage"
 	"google.golang.org/grpc"
-	"google.golang.org/grpc/credentials/insecure"
 )
 
 var (
-	ErrNotFound      = errors.New("not found")
-	ErrFoundFavorite = errors.New("found favorite")
+	ErrNotFound       = errors.New("not found")
+	ErrFoundFavorite  = errors.New("found favorite")
+	ErrBadSort        = errors.New("bad sort")
+	ErrBadTranslation = errors.New("bad translation")
 )
 
+const knownForLimit = 5
+
 //go:generate mockgen -source=core.go -destination=../mocks/core_mock.go -package=mocks
 
 type ICore interface {
-	GetFilmsAndGenreTitle(genreId uint64, start uint64, end uint64) ([]models.FilmItem, string, error)
-	GetFilmInfo(filmId uint64) (*requests.FilmResponse, error)
-	GetActorInfo(actorId uint64) (*requests.ActorResponse, error)
+	GetFilmsAndGenreTitle(langs []string, genreId uint64, start uint64, end uint64) ([]models.FilmItem, string, error)
+	GetFilmInfo(langs []string, filmId uint64) (*requests.FilmResponse, error)
+	GetActorInfo(langs []string, actorId uint64, sortBy string, first uint64, limit uint64) (*requests.ActorResponse, error)
 	GetActorsCareer(actorId uint64) ([]models.ProfessionItem, error)
 	GetGenre(genreId uint64) (string, error)
-	FindFilm(title string, dateFrom string, dateTo string, ratingFrom float32, ratingTo float32,
-		mpaa string, genres []uint32, actors []string, first uint64, limit uint64,
+	FindFilm(langs []string, title string, dateFrom string, dateTo string, ratingFrom float32, ratingTo float32,
+		mpaa string, genres []uint32, actors []string, runtimeFrom uint32, runtimeTo uint32, language string,
+		first uint64, limit uint64,
 	) ([]models.FilmItem, error)
-	FavoriteFilms(userId uint64, start uint64, end uint64) ([]models.FilmItem, error)
+	FavoriteFilms(langs []string, userId uint64, start uint64, end uint64) ([]models.FilmItem, error)
 	FavoriteFilmsAdd(userId uint64, filmId uint64) error
 	FavoriteFilmsRemove(userId uint64, filmId uint64) error
-	GetCalendar() (*requests.CalendarResponse, error)
-	GetUserId(ctx context.Context, sid string) (uint64, error)
+	GetCalendar(langs []string) (*requests.CalendarResponse, error)
+	ValidateSession(ctx context.Context, sid string) (*middleware.Principal, error)
 	FindActor(name string, birthDate string, films []string, career []string, country string, first, limit uint64) ([]models.Character, error)
 	AddRating(filmId uint64, userId uint64, rating uint16) (bool, error)
 	AddFilm(film models.FilmItem, genres []uint64, actors []uint64) error
+	EditFilm(edit models.FilmEdit) error
+	SavePoster(ctx context.Context, img *images.Image) (string, error)
+	ReplacePoster(ctx context.Context, filmId uint64, img *images.Image) (string, error)
+	AddTranslation(entity string, translation models.Translation) error
 	FavoriteActors(userId uint64, start uint64, end uint64) ([]models.Character, error)
 	FavoriteActorsAdd(userId uint64, filmId uint64) error
 	FavoriteActorsRemove(userId uint64, filmId uint64) error
@@ -52,54 +69,75 @@ type ICore interface {
 	GetNearFilms(ctx context.Context, userId uint64, lg *slog.Logger) ([]models.NearFilm, error)
 	AddNearFilm(ctx context.Context, active models.NearFilm, lg *slog.Logger) (bool, error)
 	UsersStatistics(idUser uint64) ([]requests.UsersStatisticsResponse, error)
-	Trends() ([]models.FilmItem, error)
-	GetLastSeen([]models.NearFilm) ([]models.FilmItem, error)
+	Trends(langs []string) ([]models.FilmItem, error)
+	GetLastSeen(langs []string, films []models.NearFilm) ([]models.FilmItem, error)
+	ActorsPath(from uint64, to uint64) (*requests.ActorsPathResponse, error)
+	Collaborators(actorId uint64, limit uint64) ([]models.Collaborator, error)
+	GetFilmPage(ctx context.Context, langs []string, filmId uint64, userId uint64) (*requests.FilmPageResponse, error)
+	GetFilmCards(langs []string, ids []uint64) ([]models.FilmItem, error)
+	FilmExists(filmId uint64) (bool, error)
+	FavoriteFilmIds(userId uint64, filmIds []uint64) ([]uint64, error)
 }
 
 type Core struct {
-	lg         *slog.Logger
-	films      film.IFilmsRepo
-	genres     genre.IGenreRepo
-	crew       crew.ICrewRepo
-	profession profession.IProfessionRepo
-	calendar   calendar.ICalendarRepo
-	client     auth.AuthorizationClient
-	nearFilms  *film.FilmRedisRepo
+	lg           *slog.Logger
+	films        film.IFilmsRepo
+	genres       genre.IGenreRepo
+	crew         crew.ICrewRepo
+	profession   profession.IProfessionRepo
+	calendar     calendar.ICalendarRepo
+	translations translation.ITranslationRepo
+	placeholders placeholder.IPlaceholderRepo
+	client       auth.AuthorizationClient
+	comments     comments.CommentsClient
+	nearFilms    film.INearFilmsRepo
+	storage      storage.Storage
+	graph        *collabGraph
 }
 
-func GetClient(port string) (auth.AuthorizationClient, error) {
-	conn, err := grpc.Dial(port, grpc.WithTransportCredentials(insecure.NewCredentials()))
+// GetClient connects to the authorization server and adds it to the
+// dependencies checker watches.
+func GetClient(port string, checker *healthcheck.Checker, opts ...grpc.DialOption) (auth.AuthorizationClient, error) {
+	conn, err := grpcclient.Dial(port, grpcclient.DefaultConfig("auth", auth.Authorization_ServiceDesc.ServiceName), opts...)
 	if err != nil {
 		return nil, fmt.Errorf("grpc connect err: %w", err)
 	}
+	checker.Add("grpc auth", grpcclient.HealthCheck(conn, auth.Authorization_ServiceDesc.ServiceName))
 	client := auth.NewAuthorizationClient(conn)
 
 	return client, nil
 }
 
-func GetCore(cfg_sql *configs.DbDsnCfg, lg *slog.Logger,
+func GetCore(client auth.AuthorizationClient, commentsClient comments.CommentsClient, lg *slog.Logger,
 	films film.IFilmsRepo, genres genre.IGenreRepo, actors crew.ICrewRepo, professions profession.IProfessionRepo, calendar calendar.ICalendarRepo,
-	nearFilms *film.FilmRedisRepo) *Core {
-	client, err := GetClient(cfg_sql.GrpcPort)
-	if err != nil {
-		lg.Error("get client error", "err", err.Error())
-		return nil
-	}
-
+	translations translation.ITranslationRepo, placeholders placeholder.IPlaceholderRepo, nearFilms film.INearFilmsRepo,
+	store storage.Storage) *Core {
 	core := Core{
-		lg:         lg.With("module", "core"),
-		films:      films,
-		genres:     genres,
-		crew:       actors,
-		profession: professions,
-		calendar:   calendar,
-		client:     client,
-		nearFilms:  nearFilms,
+		lg:           lg.With("module", "core"),
+		films:        films,
+		genres:       genres,
+		crew:         actors,
+		profession:   professions,
+		calendar:     calendar,
+		translations: translations,
+		placeholders: placeholders,
+		client:       client,
+		comments:     commentsClient,
+		nearFilms:    nearFilms,
+		storage:      store,
+		graph:        newCollabGraph(),
 	}
 	return &core
 }
 
-func (core *Core) GetFilmsAndGenreTitle(genreId uint64, start uint64, end uint64) ([]models.FilmItem, string, error) {
+// Close closes the database clients of the repositories once the servers
+// have stopped.
+func (core *Core) Close() error {
+	return lifecycle.Close(core.nearFilms, core.placeholders, core.translations, core.calendar,
+		core.profession, core.crew, core.genres, core.films)
+}
+
+func (core *Core) GetFilmsAndGenreTitle(langs []string, genreId uint64, start uint64, end uint64) ([]models.FilmItem, string, error) {
 	var films []models.FilmItem
 	var err error
 
@@ -119,10 +157,21 @@ func (core *Core) GetFilmsAndGenreTitle(genreId uint64, start uint64, end uint64
 		return nil, "", fmt.Errorf("GetFilms err: %w", err)
 	}
 
-	return films, genre, nil
+	core.setPosterMedia(films)
+	err = core.localizeFilms(films, langs)
+	if err != nil {
+		return nil, "", fmt.Errorf("GetFilms err: %w", err)
+	}
+	localized := []models.GenreItem{{Id: genreId, Title: genre}}
+	err = core.localizeGenres(localized, langs)
+	if err != nil {
+		return nil, "", fmt.Errorf("GetFilms err: %w", err)
+	}
+
+	return films, localized[0].Title, nil
 }
 
-func (core *Core) GetFilmInfo(filmId uint64) (*requests.FilmResponse, error) {
+func (core *Core) GetFilmInfo(langs []string, filmId uint64) (*requests.FilmResponse, error) {
 	film, err := core.films.GetFilm(filmId)
 	if err != nil {
 		core.lg.Error("get film error", "err", err.Error())
@@ -162,6 +211,17 @@ func (core *Core) GetFilmInfo(filmId uint64) (*requests.FilmResponse, error) {
 		return nil, fmt.Errorf("get film scenarists err: %w", err)
 	}
 
+	films := []models.FilmItem{*film}
+	core.setPosterMedia(films)
+	*film = films[0]
+	core.setCrewPlaceholders(directors)
+	core.setCrewPlaceholders(scenarists)
+	core.setCharacterPlaceholders(characters)
+	err = core.localizeFilmInfo(langs, film, genres, directors, scenarists, characters)
+	if err != nil {
+		return nil, fmt.Errorf("get film err: %w", err)
+	}
+
 	result := requests.FilmResponse{
 		Film:       *film,
 		Genres:     genres,
@@ -175,7 +235,15 @@ func (core *Core) GetFilmInfo(filmId uint64) (*requests.FilmResponse, error) {
 	return &result, nil
 }
 
-func (core *Core) GetActorInfo(actorId uint64) (*requests.ActorResponse, error) {
+func (core *Core) GetActorInfo(langs []string, actorId uint64, sortBy string, first uint64, limit uint64) (*requests.ActorResponse, error) {
+	switch sortBy {
+	case "":
+		sortBy = crew.SortByDate
+	case crew.SortByDate, crew.SortByRating:
+	default:
+		return nil, ErrBadSort
+	}
+
 	actor, err := core.crew.GetActor(actorId)
 	if err != nil {
 		core.lg.Error("get actor error", "err", err.Error())
@@ -191,17 +259,71 @@ func (core *Core) GetActorInfo(actorId uint64) (*requests.ActorResponse, error)
 		return nil, fmt.Errorf("get actor profession err: %w", err)
 	}
 
+	films, err := core.crew.GetActorFilms(actorId, sortBy, first, limit)
+	if err != nil {
+		core.lg.Error("get actor films error", "err", err.Error())
+		return nil, fmt.Errorf("get actor films err: %w", err)
+	}
+
+	counts, err := core.crew.GetActorFilmsCount(actorId)
+	if err != nil {
+		core.lg.Error("get actor films count error", "err", err.Error())
+		return nil, fmt.Errorf("get actor films count err: %w", err)
+	}
+
+	knownFor, err := core.crew.GetActorKnownFor(actorId, knownForLimit)
+	if err != nil {
+		core.lg.Error("get actor known for error", "err", err.Error())
+		return nil, fmt.Errorf("get actor known for err: %w", err)
+	}
+
+	core.setPosterMedia(knownFor)
+	persons := []models.CrewItem{*actor}
+	core.setCrewPlaceholders(persons)
+	*actor = persons[0]
+	err = core.localizeActorInfo(langs, actor, films, knownFor)
+	if err != nil {
+		return nil, fmt.Errorf("get actor err: %w", err)
+	}
+
+	var total uint64
+	for _, count := range counts {
+		total += count
+	}
 	result := requests.ActorResponse{
-		Name:      actor.Name,
-		Photo:     actor.Photo,
-		BirthDate: actor.Birthdate,
-		Country:   actor.Country,
-		Info:      actor.Info,
-		Career:    career,
+		Name:        actor.Name,
+		Photo:       actor.Photo,
+		BirthDate:   actor.Birthdate,
+		Country:     actor.Country,
+		Info:        actor.Info,
+		Career:      career,
+		Filmography: groupFilmography(films, counts),
+		FilmsTotal:  total,
+		KnownFor:    knownFor,
+		Placeholder: actor.Placeholder,
 	}
 	return &result, nil
 }
 
+// groupFilmography groups a page of films by profession, total tells how
+// many films each profession has over all pages.
+func groupFilmography(films []models.FilmographyItem, counts map[string]uint64) []requests.FilmographyGroup {
+	groups := []requests.FilmographyGroup{}
+	index := map[string]int{}
+
+	for _, film := range films {
+		i, ok := index[film.Profession]
+		if !ok {
+			i = len(groups)
+			index[film.Profession] = i
+			groups = append(groups, requests.FilmographyGroup{Profession: film.Profession, Total: counts[film.Profession]})
+		}
+		groups[i].Films = append(groups[i].Films, film)
+	}
+
+	return groups
+}
+
 func (core *Core) GetActorsCareer(actorId uint64) ([]models.ProfessionItem, error) {
 	career, err := core.profession.GetActorsProfessions(actorId)
 	if err != nil {
@@ -222,11 +344,13 @@ func (core *Core) GetGenre(genreId uint64) (string, error) {
 	return genre, nil
 }
 
-func (core *Core) FindFilm(title string, dateFrom string, dateTo string, ratingFrom float32, ratingTo float32,
-	mpaa string, genres []uint32, actors []string, first uint64, limit uint64,
+func (core *Core) FindFilm(langs []string, title string, dateFrom string, dateTo string, ratingFrom float32, ratingTo float32,
+	mpaa string, genres []uint32, actors []string, runtimeFrom uint32, runtimeTo uint32, language string,
+	first uint64, limit uint64,
 ) ([]models.FilmItem, error) {
 
-	films, err := core.films.FindFilm(title, dateFrom, dateTo, ratingFrom, ratingTo, mpaa, genres, actors, first, limit)
+	films, err := core.films.FindFilm(title, dateFrom, dateTo, ratingFrom, ratingTo, mpaa, genres, actors,
+		runtimeFrom, runtimeTo, language, first, limit)
 	if err != nil {
 		core.lg.Error("find film error", "err", err.Error())
 		return nil, fmt.Errorf("find film err: %w", err)
@@ -235,16 +359,27 @@ func (core *Core) FindFilm(title string, dateFrom string, dateTo string, ratingF
 	if len(films) == 0 {
 		return nil, ErrNotFound
 	}
+	core.setPosterMedia(films)
+	core.setReviewsCount(films)
+	err = core.localizeFilms(films, langs)
+	if err != nil {
+		return nil, fmt.Errorf("find film err: %w", err)
+	}
 
 	return films, nil
 }
 
-func (core *Core) FavoriteFilms(userId uint64, start uint64, end uint64) ([]models.FilmItem, error) {
+func (core *Core) FavoriteFilms(langs []string, userId uint64, start uint64, end uint64) ([]models.FilmItem, error) {
 	films, err := core.films.GetFavoriteFilms(userId, start, end)
 	if err != nil {
 		core.lg.Error("favorite films error", "err", err.Error())
 		return nil, fmt.Errorf("favorite films err: %w", err)
 	}
+	core.setPosterMedia(films)
+	err = core.localizeFilms(films, langs)
+	if err != nil {
+		return nil, fmt.Errorf("favorite films err: %w", err)
+	}
 
 	return films, nil
 }
@@ -278,10 +413,10 @@ func (core *Core) FavoriteFilmsRemove(userId uint64, filmId uint64) error {
 	return nil
 }
 
-func (core *Core) GetCalendar() (*requests.CalendarResponse, error) {
+func (core *Core) GetCalendar(langs []string) (*requests.CalendarResponse, error) {
 	result := &requests.CalendarResponse{}
 
-	news, err := core.calendar.GetCalendar()
+	news, err := core.calendar.GetCalendar(locale.Preferred(langs))
 	if err != nil {
 		core.lg.Error("get calendar error", "err", err.Error())
 		return nil, fmt.Errorf("get calendar err: %w", err)
@@ -289,21 +424,30 @@ func (core *Core) GetCalendar() (*requests.CalendarResponse, error) {
 
 	result.Days = news
 	result.CurrentDay = uint8(time.Now().Day())
-	result.MonthName = time.Now().Month().String()
-	result.MonthText = "Новинки этого месяца"
+	result.MonthName = locale.MonthName(langs, time.Now().Month())
+	result.MonthText = locale.MonthText(langs)
 
 	return result, nil
 }
 
-func (core *Core) GetUserId(ctx context.Context, sid string) (uint64, error) {
-	request := auth.FindIdRequest{Sid: sid}
-
-	response, err := core.client.GetId(ctx, &request)
+func (core *Core) ValidateSession(ctx context.Context, sid string) (*middleware.Principal, error) {
+	response, err := core.client.ValidateSession(ctx, &auth.ValidateSessionRequest{Sid: sid})
 	if err != nil {
-		core.lg.Error("get user id error", "err", err.Error())
-		return 0, fmt.Errorf("get user id err: %w", err)
+		core.lg.Error("validate session error", "err", err.Error())
+		return nil, fmt.Errorf("validate session err: %w", err)
+	}
+
+	principal := &middleware.Principal{
+		Id:    uint64(response.Id),
+		Login: response.Login,
+		Name:  response.Name,
+		Role:  response.Role,
 	}
-	return uint64(response.Value), nil
+	if response.ExpiresAt != 0 {
+		principal.ExpiresAt = time.Unix(response.ExpiresAt, 0)
+	}
+
+	return principal, nil
 }
 
 func (core *Core) FindActor(name string, birthDate string, films []string, career []string, country string, first, limit uint64) ([]models.Character, error) {
@@ -315,6 +459,7 @@ func (core *Core) FindActor(name string, birthDate string, films []string, caree
 	if len(actors) == 0 {
 		return nil, ErrNotFound
 	}
+	core.setCharacterPlaceholders(actors)
 
 	return actors, nil
 }
@@ -363,15 +508,213 @@ func (core *Core) AddFilm(film models.FilmItem, genres []uint64, actors []uint64
 		return fmt.Errorf("add film err: %w", err)
 	}
 
+	core.savePosterPlaceholder(id, film.Placeholder)
+	core.addGraphLinks(id, film, actors)
+
+	return nil
+}
+
+func (core *Core) EditFilm(edit models.FilmEdit) error {
+	prev, err := core.films.GetFilm(edit.Id)
+	if err != nil {
+		core.lg.Error("get film error", "err", err.Error())
+		return fmt.Errorf("edit film err: %w", err)
+	}
+	if prev.Title == "" {
+		return ErrNotFound
+	}
+
+	err = core.films.EditFilm(edit)
+	if err != nil {
+		core.lg.Error("edit film error", "err", err.Error())
+		return fmt.Errorf("edit film err: %w", err)
+	}
+
+	if core.graph != nil && (edit.Title != nil || edit.Poster != nil) {
+		core.graph.invalidate()
+	}
+
+	return nil
+}
+
+func (core *Core) SavePoster(ctx context.Context, img *images.Image) (string, error) {
+	poster, err := images.Save(ctx, core.storage, "icons", img)
+	if err != nil {
+		core.lg.Error("save poster error", "err", err.Error())
+		return "", fmt.Errorf("save poster err: %w", err)
+	}
+
+	return poster, nil
+}
+
+func (core *Core) ReplacePoster(ctx context.Context, filmId uint64, img *images.Image) (string, error) {
+	prev, err := core.films.GetFilm(filmId)
+	if err != nil {
+		core.lg.Error("get film error", "err", err.Error())
+		return "", fmt.Errorf("replace poster err: %w", err)
+	}
+	if prev.Title == "" {
+		return "", ErrNotFound
+	}
+
+	poster, err := core.SavePoster(ctx, img)
+	if err != nil {
+		return "", err
+	}
+
+	err = core.EditFilm(models.FilmEdit{Id: filmId, Poster: &poster})
+	if err != nil {
+		return "", err
+	}
+	core.savePosterPlaceholder(filmId, &img.Placeholder)
+
+	if prev.Poster != poster {
+		core.removeOrphanPoster(ctx, prev.Poster)
+	}
+
+	return poster, nil
+}
+
+// removeOrphanPoster deletes a replaced poster once no film refers to it.
+// Failures are only logged: the new poster is already in place.
+func (core *Core) removeOrphanPoster(ctx context.Context, poster string) {
+	if poster == "" {
+		return
+	}
+
+	count, err := core.films.CountPosterUsage(poster)
+	if err != nil {
+		core.lg.Error("count poster usage error", "err", err.Error())
+		return
+	}
+	if count > 0 {
+		return
+	}
+
+	err = images.Delete(ctx, core.storage, poster)
+	if err != nil {
+		core.lg.Error("delete poster error", "err", err.Error())
+	}
+}
+
+func (core *Core) AddTranslation(entity string, item models.Translation) error {
+	switch entity {
+	case translation.EntityFilm, translation.EntityPerson, translation.EntityGenre:
+	default:
+		return ErrBadTranslation
+	}
+	if item.Id == 0 || item.Title == "" {
+		return ErrBadTranslation
+	}
+	chain := locale.Chain(item.Lang, "")
+	if chain[0] == locale.Default {
+		return ErrBadTranslation
+	}
+	item.Lang = chain[0]
+
+	err := core.translations.AddTranslation(entity, item)
+	if err != nil {
+		core.lg.Error("add translation error", "err", err.Error())
+		return fmt.Errorf("add translation err: %w", err)
+	}
+
 	return nil
 }
 
+func (core *Core) addGraphLinks(filmId uint64, film models.FilmItem, actors []uint64) {
+	if core.graph == nil || !core.graph.isLoaded() {
+		return
+	}
+
+	links := make([]models.CrewLink, 0, len(actors))
+	for _, actorId := range actors {
+		person, found := core.graph.person(actorId)
+		if !found {
+			actor, err := core.crew.GetActor(actorId)
+			if err != nil {
+				core.lg.Error("graph update error", "err", err.Error())
+				core.graph.invalidate()
+				return
+			}
+			person = models.Character{IdActor: actorId, NameActor: actor.Name, ActorPhoto: actor.Photo}
+		}
+		links = append(links, models.CrewLink{
+			IdFilm:     filmId,
+			Title:      film.Title,
+			Poster:     film.Poster,
+			IdActor:    actorId,
+			NameActor:  person.NameActor,
+			ActorPhoto: person.ActorPhoto,
+		})
+	}
+
+	core.graph.addLinks(links)
+}
+
+// loadGraph loads the graph on first use and again once it is older than
+// its ttl.
+func (core *Core) loadGraph() error {
+	if core.graph.isLoaded() {
+		return nil
+	}
+	core.graph.loading.Lock()
+	defer core.graph.loading.Unlock()
+	if core.graph.isLoaded() {
+		return nil
+	}
+
+	links, err := core.crew.GetCrewLinks()
+	if err != nil {
+		return fmt.Errorf("load graph err: %w", err)
+	}
+	core.graph.load(links)
+
+	return nil
+}
+
+func (core *Core) ActorsPath(from uint64, to uint64) (*requests.ActorsPathResponse, error) {
+	err := core.loadGraph()
+	if err != nil {
+		core.lg.Error("actors path error", "err", err.Error())
+		return nil, fmt.Errorf("actors path err: %w", err)
+	}
+
+	actors, films, found := core.graph.shortestPath(from, to)
+	if !found {
+		return nil, ErrNotFound
+	}
+	core.setPosterMedia(films)
+	core.setCharacterPlaceholders(actors)
+
+	result := requests.ActorsPathResponse{
+		Degrees: uint64(len(films)),
+		Actors:  actors,
+		Films:   films,
+	}
+	return &result, nil
+}
+
+func (core *Core) Collaborators(actorId uint64, limit uint64) ([]models.Collaborator, error) {
+	err := core.loadGraph()
+	if err != nil {
+		core.lg.Error("collaborators error", "err", err.Error())
+		return nil, fmt.Errorf("collaborators err: %w", err)
+	}
+
+	if _, found := core.graph.person(actorId); !found {
+		return nil, ErrNotFound
+	}
+
+	return core.graph.collaborators(actorId, limit), nil
+}
+
 func (core *Core) FavoriteActors(userId uint64, start uint64, end uint64) ([]models.Character, error) {
 	actors, err := core.crew.GetFavoriteActors(userId, start, end)
 	if err != nil {
 		core.lg.Error("favorite actors error", "err", err.Error())
 		return nil, fmt.Errorf("favorite actors err: %w", err)
 	}
+	core.setCharacterPlaceholders(actors)
 
 	return actors, nil
 }
@@ -434,6 +777,14 @@ func (c *Core) AddNearFilm(ctx context.Context, active models.NearFilm, lg *slog
 }
 
 func (core *Core) UsersStatistics(idUser uint64) ([]requests.UsersStatisticsResponse, error) {
+	if core.comments != nil {
+		stats, err := core.usersStatistics(idUser)
+		if err == nil {
+			return stats, nil
+		}
+		core.lg.Error("users statistics from comments error", "err", err.Error())
+	}
+
 	stats, err := core.genres.UsersStatistics(idUser)
 	if err != nil {
 		core.lg.Error("users statistics error", "err", err.Error())
@@ -443,17 +794,22 @@ func (core *Core) UsersStatistics(idUser uint64) ([]requests.UsersStatisticsResp
 	return stats, nil
 }
 
-func (core *Core) Trends() ([]models.FilmItem, error) {
+func (core *Core) Trends(langs []string) ([]models.FilmItem, error) {
 	trends, err := core.films.Trends()
 	if err != nil {
 		core.lg.Error("trends error", "err", err.Error())
 		return nil, fmt.Errorf("trends err: %w", err)
 	}
+	core.setPosterMedia(trends)
+	err = core.localizeFilms(trends, langs)
+	if err != nil {
+		return nil, fmt.Errorf("trends err: %w", err)
+	}
 
 	return trends, nil
 }
 
-func (core *Core) GetLastSeen(filmsIds []models.NearFilm) ([]models.FilmItem, error) {
+func (core *Core) GetLastSeen(langs []string, filmsIds []models.NearFilm) ([]models.FilmItem, error) {
 	ids := []uint64{}
 	for _, id := range filmsIds {
 		ids = append(ids, id.IdFilm)
@@ -468,6 +824,11 @@ func (core *Core) GetLastSeen(filmsIds []models.NearFilm) ([]models.FilmItem, er
 	if len(films) == 0 {
 		return nil, ErrNotFound
 	}
+	core.setPosterMedia(films)
+	err = core.localizeFilms(films, langs)
+	if err != nil {
+		return nil, fmt.Errorf("GetLastSeen err: %w", err)
+	}
 
 	return films, nil
 }
@@ -2,17 +2,26 @@ package usecase
 
 import (
 	"bytes"
+	"context"
 	"errors"
 	"fmt"
 	"log/slog"
 	"reflect"
+	"strings"
 	"testing"
 	"time"
 
+	auth "github.com/go-park-mail-ru/2023_2_Vkladyshi/authorization/proto"
+	comments "github.com/go-park-mail-ru/2023_2_Vkladyshi/comments/proto"
+	"github.com/go-park-mail-ru/2023_2_Vkladyshi/configs"
 	"github.com/go-park-mail-ru/2023_2_Vkladyshi/films/mocks"
+	"github.com/go-park-mail-ru/2023_2_Vkladyshi/pkg/images"
+	"github.com/go-park-mail-ru/2023_2_Vkladyshi/pkg/middleware"
 	"github.com/go-park-mail-ru/2023_2_Vkladyshi/pkg/models"
 	"github.com/go-park-mail-ru/2023_2_Vkladyshi/pkg/requests"
+	"github.com/go-park-mail-ru/2023_2_Vkladyshi/pkg/storage"
 	"github.com/golang/mock/gomock"
+	"google.golang.org/grpc"
 )
 
 func TestGetCalendar(t *testing.T) {
@@ -21,17 +30,17 @@ func TestGetCalendar(t *testing.T) {
 
 	expectedDay := models.DayItem{DayNumber: 1, DayNews: "n"}
 	expectedDays := []models.DayItem{expectedDay}
-	expected := &requests.CalendarResponse{MonthName: time.Now().Month().String(), MonthText: "Новинки этого месяца", CurrentDay: uint8(time.Now().Day()), Days: expectedDays}
+	expected := &requests.CalendarResponse{MonthName: time.Now().Month().String(), MonthText: "New this month", CurrentDay: uint8(time.Now().Day()), Days: expectedDays}
 
 	mockObj := mocks.NewMockICalendarRepo(mockCtrl)
-	firstCall := mockObj.EXPECT().GetCalendar().Return(expectedDays, nil)
-	mockObj.EXPECT().GetCalendar().After(firstCall).Return(nil, fmt.Errorf("repo_error"))
+	firstCall := mockObj.EXPECT().GetCalendar([]string{"en"}).Return(expectedDays, nil)
+	mockObj.EXPECT().GetCalendar([]string{}).After(firstCall).Return(nil, fmt.Errorf("repo_error"))
 
 	var buff bytes.Buffer
 	logger := slog.New(slog.NewJSONHandler(&buff, nil))
 	core := Core{calendar: mockObj, lg: logger}
 
-	result, err := core.GetCalendar()
+	result, err := core.GetCalendar([]string{"en", "ru"})
 	if err != nil {
 		t.Errorf("unexpected error %s", err)
 		return
@@ -41,7 +50,7 @@ func TestGetCalendar(t *testing.T) {
 		return
 	}
 
-	result, err = core.GetCalendar()
+	result, err = core.GetCalendar([]string{"ru"})
 	if err == nil {
 		t.Errorf("wanted error")
 		return
@@ -131,15 +140,15 @@ func TestFindFilm(t *testing.T) {
 	expected := []models.FilmItem{expectedFilm}
 
 	mockObj := mocks.NewMockIFilmsRepo(mockCtrl)
-	firstCall := mockObj.EXPECT().FindFilm(string("t"), string("df"), string("dt"), float32(0), float32(10), string(""), nil, nil, uint64(0), uint64(1)).Return(expected, nil)
-	mockObj.EXPECT().FindFilm(string("t0"), string("df"), string("dt"), float32(0), float32(10), string(""), nil, nil, uint64(0), uint64(0)).After(firstCall).Return(nil, fmt.Errorf("repo_error"))
-	mockObj.EXPECT().FindFilm(string("t10"), string("df"), string("dt"), float32(0), float32(10), string(""), nil, nil, uint64(1), uint64(1)).Return([]models.FilmItem{}, nil)
+	firstCall := mockObj.EXPECT().FindFilm(string("t"), string("df"), string("dt"), float32(0), float32(10), string(""), nil, nil, uint32(0), uint32(0), string(""), uint64(0), uint64(1)).Return(expected, nil)
+	mockObj.EXPECT().FindFilm(string("t0"), string("df"), string("dt"), float32(0), float32(10), string(""), nil, nil, uint32(0), uint32(0), string(""), uint64(0), uint64(0)).After(firstCall).Return(nil, fmt.Errorf("repo_error"))
+	mockObj.EXPECT().FindFilm(string("t10"), string("df"), string("dt"), float32(0), float32(10), string(""), nil, nil, uint32(0), uint32(0), string(""), uint64(1), uint64(1)).Return([]models.FilmItem{}, nil)
 
 	var buff bytes.Buffer
 	logger := slog.New(slog.NewJSONHandler(&buff, nil))
 	core := Core{films: mockObj, lg: logger}
 
-	result, err := core.FindFilm("t", "df", "dt", 0, 10, "", nil, nil, 0, 1)
+	result, err := core.FindFilm(nil, "t", "df", "dt", 0, 10, "", nil, nil, 0, 0, "", 0, 1)
 	if err != nil {
 		t.Errorf("unexpected error %s", err)
 		return
@@ -149,7 +158,7 @@ func TestFindFilm(t *testing.T) {
 		return
 	}
 
-	result, err = core.FindFilm("t0", "df", "dt", 0, 10, "", nil, nil, 0, 0)
+	result, err = core.FindFilm(nil, "t0", "df", "dt", 0, 10, "", nil, nil, 0, 0, "", 0, 0)
 	if err == nil {
 		t.Errorf("wanted error")
 		return
@@ -159,7 +168,7 @@ func TestFindFilm(t *testing.T) {
 		return
 	}
 
-	result, err = core.FindFilm("t10", "df", "dt", 0, 10, "", nil, nil, 1, 1)
+	result, err = core.FindFilm(nil, "t10", "df", "dt", 0, 10, "", nil, nil, 0, 0, "", 1, 1)
 	if !errors.Is(err, ErrNotFound) {
 		t.Errorf("expected not found")
 		return
@@ -239,7 +248,7 @@ func TestGetFilmsAndGenreTitle(t *testing.T) {
 	logger := slog.New(slog.NewJSONHandler(&buff, nil))
 	core := Core{films: mockObj, genres: mockGenres, lg: logger}
 
-	films, genre, err := core.GetFilmsAndGenreTitle(0, 1, 1)
+	films, genre, err := core.GetFilmsAndGenreTitle(nil, 0, 1, 1)
 	if err != nil {
 		t.Errorf("unexpected error %s", err)
 		return
@@ -253,7 +262,7 @@ func TestGetFilmsAndGenreTitle(t *testing.T) {
 		return
 	}
 
-	films, genre, err = core.GetFilmsAndGenreTitle(0, 1, 0)
+	films, genre, err = core.GetFilmsAndGenreTitle(nil, 0, 1, 0)
 	if err == nil {
 		t.Errorf("wanted error")
 		return
@@ -267,7 +276,7 @@ func TestGetFilmsAndGenreTitle(t *testing.T) {
 		return
 	}
 
-	films, genre, err = core.GetFilmsAndGenreTitle(10, 1, 1)
+	films, genre, err = core.GetFilmsAndGenreTitle(nil, 10, 1, 1)
 	if err == nil {
 		t.Errorf("wanted error")
 		return
@@ -289,23 +298,44 @@ func TestGetActorInfo(t *testing.T) {
 	expProf := models.ProfessionItem{Title: "t"}
 	expectedCareer := []models.ProfessionItem{expProf}
 	expectedActor := &models.CrewItem{Name: "n"}
-	expected := &requests.ActorResponse{Name: expectedActor.Name, Career: expectedCareer}
+	expectedFilms := []models.FilmographyItem{
+		{IdFilm: 1, Profession: "актёр", NameCharacter: "c1"},
+		{IdFilm: 2, Profession: "режиссёр"},
+		{IdFilm: 3, Profession: "актёр", NameCharacter: "c3"},
+	}
+	expectedKnownFor := []models.FilmItem{{Id: 3}}
+	expected := &requests.ActorResponse{
+		Name:   expectedActor.Name,
+		Career: expectedCareer,
+		Filmography: []requests.FilmographyGroup{
+			{Profession: "актёр", Total: 2, Films: []models.FilmographyItem{expectedFilms[0], expectedFilms[2]}},
+			{Profession: "режиссёр", Total: 1, Films: []models.FilmographyItem{expectedFilms[1]}},
+		},
+		FilmsTotal: 3,
+		KnownFor:   expectedKnownFor,
+	}
 
 	mockObj := mocks.NewMockICrewRepo(mockCtrl)
 	firstCall := mockObj.EXPECT().GetActor(uint64(1)).Return(expectedActor, nil)
 	mockObj.EXPECT().GetActor(uint64(2)).After(firstCall).Return(nil, fmt.Errorf("repo_error"))
 	mockObj.EXPECT().GetActor(uint64(3)).Return(&models.CrewItem{}, nil)
 	mockObj.EXPECT().GetActor(uint64(4)).Return(expectedActor, nil)
+	mockObj.EXPECT().GetActor(uint64(5)).Return(expectedActor, nil)
+	mockObj.EXPECT().GetActorFilms(uint64(1), "rating", uint64(0), uint64(10)).Return(expectedFilms, nil)
+	mockObj.EXPECT().GetActorFilms(uint64(5), "date", uint64(0), uint64(10)).Return(nil, fmt.Errorf("repo_error"))
+	mockObj.EXPECT().GetActorFilmsCount(uint64(1)).Return(map[string]uint64{"актёр": 2, "режиссёр": 1}, nil)
+	mockObj.EXPECT().GetActorKnownFor(uint64(1), uint64(5)).Return(expectedKnownFor, nil)
 
 	mockProf := mocks.NewMockIProfessionRepo(mockCtrl)
 	mockProf.EXPECT().GetActorsProfessions(uint64(1)).Return(expectedCareer, nil)
 	mockProf.EXPECT().GetActorsProfessions(uint64(4)).Return(nil, fmt.Errorf("repo_error"))
+	mockProf.EXPECT().GetActorsProfessions(uint64(5)).Return(expectedCareer, nil)
 
 	var buff bytes.Buffer
 	logger := slog.New(slog.NewJSONHandler(&buff, nil))
 	core := Core{crew: mockObj, profession: mockProf, lg: logger}
 
-	result, err := core.GetActorInfo(1)
+	result, err := core.GetActorInfo(nil, 1, "rating", 0, 10)
 	if err != nil {
 		t.Errorf("unexpected error %s", err)
 		return
@@ -315,7 +345,7 @@ func TestGetActorInfo(t *testing.T) {
 		return
 	}
 
-	result, err = core.GetActorInfo(2)
+	result, err = core.GetActorInfo(nil, 2, "", 0, 10)
 	if err == nil {
 		t.Errorf("wanted error")
 		return
@@ -325,7 +355,7 @@ func TestGetActorInfo(t *testing.T) {
 		return
 	}
 
-	result, err = core.GetActorInfo(3)
+	result, err = core.GetActorInfo(nil, 3, "", 0, 10)
 	if !errors.Is(err, ErrNotFound) {
 		t.Errorf("expected not found")
 		return
@@ -335,7 +365,17 @@ func TestGetActorInfo(t *testing.T) {
 		return
 	}
 
-	result, err = core.GetActorInfo(4)
+	result, err = core.GetActorInfo(nil, 4, "", 0, 10)
+	if err == nil {
+		t.Errorf("wanted error")
+		return
+	}
+	if result != nil {
+		t.Errorf("unexpected result")
+		return
+	}
+
+	result, err = core.GetActorInfo(nil, 5, "", 0, 10)
 	if err == nil {
 		t.Errorf("wanted error")
 		return
@@ -344,6 +384,16 @@ func TestGetActorInfo(t *testing.T) {
 		t.Errorf("unexpected result")
 		return
 	}
+
+	result, err = core.GetActorInfo(nil, 1, "title", 0, 10)
+	if !errors.Is(err, ErrBadSort) {
+		t.Errorf("expected bad sort")
+		return
+	}
+	if result != nil {
+		t.Errorf("unexpected result")
+		return
+	}
 }
 
 func TestGetFilmInfo(t *testing.T) {
@@ -397,7 +447,7 @@ func TestGetFilmInfo(t *testing.T) {
 	logger := slog.New(slog.NewJSONHandler(&buff, nil))
 	core := Core{films: mockFilm, genres: mockGenres, crew: mockCrew, lg: logger}
 
-	result, err := core.GetFilmInfo(1)
+	result, err := core.GetFilmInfo(nil, 1)
 	if !errors.Is(err, ErrNotFound) {
 		t.Errorf("wanted not found error")
 		return
@@ -408,7 +458,7 @@ func TestGetFilmInfo(t *testing.T) {
 	}
 
 	for i := 0; i < 6; i++ {
-		result, err = core.GetFilmInfo(1)
+		result, err = core.GetFilmInfo(nil, 1)
 		if err == nil {
 			t.Errorf("wanted error")
 			return
@@ -419,7 +469,7 @@ func TestGetFilmInfo(t *testing.T) {
 		}
 	}
 
-	result, err = core.GetFilmInfo(1)
+	result, err = core.GetFilmInfo(nil, 1)
 	if err != nil {
 		t.Errorf("wanted no errors")
 		return
@@ -445,7 +495,7 @@ func TestFavoriteFilms(t *testing.T) {
 	logger := slog.New(slog.NewJSONHandler(&buff, nil))
 	core := Core{films: mockObj, lg: logger}
 
-	result, err := core.FavoriteFilms(1, 1, 1)
+	result, err := core.FavoriteFilms(nil, 1, 1, 1)
 	if err != nil {
 		t.Errorf("unexpected error %s", err)
 		return
@@ -455,7 +505,7 @@ func TestFavoriteFilms(t *testing.T) {
 		return
 	}
 
-	result, err = core.FavoriteFilms(1, 1, 1)
+	result, err = core.FavoriteFilms(nil, 1, 1, 1)
 	if err == nil {
 		t.Errorf("wanted error")
 		return
@@ -860,7 +910,7 @@ func TestTrends(t *testing.T) {
 	for _, curr := range testCases {
 		mockObj.EXPECT().Trends().Return(curr.result, curr.err).Times(1)
 
-		res, err := core.Trends()
+		res, err := core.Trends(nil)
 		if !errors.Is(err, curr.err) {
 			t.Errorf("Unexpected error. wanted %s, got %s", curr.err, err)
 		}
@@ -870,6 +920,34 @@ func TestTrends(t *testing.T) {
 	}
 }
 
+func TestTrendsPosterVariants(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	hash := strings.Repeat("ab", 32)
+	mockObj := mocks.NewMockIFilmsRepo(mockCtrl)
+	mockObj.EXPECT().Trends().Return([]models.FilmItem{
+		{Id: 1, Poster: "/icons/" + hash + ".jpg"},
+		{Id: 2, Poster: "/icons/old.png"},
+	}, nil).Times(1)
+
+	var buff bytes.Buffer
+	logger := slog.New(slog.NewJSONHandler(&buff, nil))
+	core := Core{films: mockObj, lg: logger}
+
+	res, err := core.Trends(nil)
+	if err != nil {
+		t.Errorf("unexpected error %s", err)
+		return
+	}
+	if res[0].PosterVariants["small_webp"] != "/icons/"+hash+"_small.webp" {
+		t.Errorf("unexpected variants %v", res[0].PosterVariants)
+	}
+	if res[1].PosterVariants != nil {
+		t.Errorf("expected no variants for legacy poster, got %v", res[1].PosterVariants)
+	}
+}
+
 func TestGetLastSeen(t *testing.T) {
 	testCases := map[string]struct {
 		err    error
@@ -901,7 +979,7 @@ func TestGetLastSeen(t *testing.T) {
 	for _, curr := range testCases {
 		mockObj.EXPECT().GetLasts([]uint64{1, 2}).Return(curr.result, curr.err).Times(1)
 
-		res, err := core.GetLastSeen([]models.NearFilm{{IdFilm: 1}, {IdFilm: 2}})
+		res, err := core.GetLastSeen(nil, []models.NearFilm{{IdFilm: 1}, {IdFilm: 2}})
 		if !errors.Is(err, curr.err) {
 			t.Errorf("Unexpected error. wanted %s, got %s", curr.err, err)
 		}
@@ -910,3 +988,732 @@ func TestGetLastSeen(t *testing.T) {
 		}
 	}
 }
+
+func TestActorsPath(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	links := []models.CrewLink{
+		{IdFilm: 1, Title: "f1", IdActor: 1, NameActor: "a1"},
+		{IdFilm: 1, Title: "f1", IdActor: 2, NameActor: "a2"},
+		{IdFilm: 2, Title: "f2", IdActor: 2, NameActor: "a2"},
+		{IdFilm: 2, Title: "f2", IdActor: 3, NameActor: "a3"},
+		{IdFilm: 3, Title: "f3", IdActor: 4, NameActor: "a4"},
+	}
+	expected := &requests.ActorsPathResponse{
+		Degrees: 2,
+		Actors: []models.Character{
+			{IdActor: 1, NameActor: "a1"},
+			{IdActor: 2, NameActor: "a2"},
+			{IdActor: 3, NameActor: "a3"},
+		},
+		Films: []models.FilmItem{{Id: 1, Title: "f1"}, {Id: 2, Title: "f2"}},
+	}
+
+	mockCrew := mocks.NewMockICrewRepo(mockCtrl)
+	firstCall := mockCrew.EXPECT().GetCrewLinks().Return(nil, fmt.Errorf("repo_error"))
+	mockCrew.EXPECT().GetCrewLinks().After(firstCall).Return(links, nil).Times(1)
+
+	var buff bytes.Buffer
+	logger := slog.New(slog.NewJSONHandler(&buff, nil))
+	core := Core{crew: mockCrew, lg: logger, graph: newCollabGraph()}
+
+	result, err := core.ActorsPath(1, 3)
+	if err == nil {
+		t.Errorf("wanted error")
+		return
+	}
+	if result != nil {
+		t.Errorf("unexpected result")
+		return
+	}
+
+	result, err = core.ActorsPath(1, 3)
+	if err != nil {
+		t.Errorf("unexpected error %s", err)
+		return
+	}
+	if !reflect.DeepEqual(expected, result) {
+		t.Errorf("wanted %v, had %v", expected, result)
+		return
+	}
+
+	result, err = core.ActorsPath(2, 2)
+	if err != nil {
+		t.Errorf("unexpected error %s", err)
+		return
+	}
+	if result.Degrees != 0 || len(result.Actors) != 1 {
+		t.Errorf("wanted zero degrees, had %v", result)
+		return
+	}
+
+	result, err = core.ActorsPath(1, 4)
+	if !errors.Is(err, ErrNotFound) {
+		t.Errorf("expected not found")
+		return
+	}
+	if result != nil {
+		t.Errorf("unexpected result")
+		return
+	}
+
+	result, err = core.ActorsPath(1, 5)
+	if !errors.Is(err, ErrNotFound) {
+		t.Errorf("expected not found")
+		return
+	}
+	if result != nil {
+		t.Errorf("unexpected result")
+		return
+	}
+}
+
+func TestCollaborators(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	links := []models.CrewLink{
+		{IdFilm: 1, IdActor: 1, NameActor: "a1"},
+		{IdFilm: 1, IdActor: 2, NameActor: "a2"},
+		{IdFilm: 1, IdActor: 3, NameActor: "a3"},
+		{IdFilm: 2, IdActor: 1, NameActor: "a1"},
+		{IdFilm: 2, IdActor: 3, NameActor: "a3"},
+	}
+	expected := []models.Collaborator{
+		{IdActor: 3, NameActor: "a3", Count: 2},
+		{IdActor: 2, NameActor: "a2", Count: 1},
+	}
+
+	mockCrew := mocks.NewMockICrewRepo(mockCtrl)
+	mockCrew.EXPECT().GetCrewLinks().Return(links, nil).Times(1)
+	mockCrew.EXPECT().GetActor(uint64(4)).Return(&models.CrewItem{Id: 4, Name: "a4"}, nil).Times(1)
+
+	mockFilm := mocks.NewMockIFilmsRepo(mockCtrl)
+	mockFilm.EXPECT().AddFilm(models.FilmItem{Title: "f3"}).Return(nil)
+	mockFilm.EXPECT().GetFilmId("f3").Return(uint64(3), nil)
+
+	mockGenres := mocks.NewMockIGenreRepo(mockCtrl)
+	mockGenres.EXPECT().AddFilm(nil, uint64(3)).Return(nil)
+	mockCrew.EXPECT().AddFilm([]uint64{1, 4}, uint64(3)).Return(nil)
+
+	var buff bytes.Buffer
+	logger := slog.New(slog.NewJSONHandler(&buff, nil))
+	core := Core{crew: mockCrew, films: mockFilm, genres: mockGenres, lg: logger, graph: newCollabGraph()}
+
+	result, err := core.Collaborators(1, 10)
+	if err != nil {
+		t.Errorf("unexpected error %s", err)
+		return
+	}
+	if !reflect.DeepEqual(expected, result) {
+		t.Errorf("wanted %v, had %v", expected, result)
+		return
+	}
+
+	result, err = core.Collaborators(1, 1)
+	if err != nil {
+		t.Errorf("unexpected error %s", err)
+		return
+	}
+	if !reflect.DeepEqual(expected[:1], result) {
+		t.Errorf("wanted %v, had %v", expected[:1], result)
+		return
+	}
+
+	err = core.AddFilm(models.FilmItem{Title: "f3"}, nil, []uint64{1, 4})
+	if err != nil {
+		t.Errorf("unexpected error %s", err)
+		return
+	}
+
+	result, err = core.Collaborators(4, 10)
+	if err != nil {
+		t.Errorf("unexpected error %s", err)
+		return
+	}
+	if !reflect.DeepEqual([]models.Collaborator{{IdActor: 1, NameActor: "a1", Count: 1}}, result) {
+		t.Errorf("unexpected result %v", result)
+		return
+	}
+
+	result, err = core.Collaborators(5, 10)
+	if !errors.Is(err, ErrNotFound) {
+		t.Errorf("expected not found")
+		return
+	}
+	if result != nil {
+		t.Errorf("unexpected result")
+		return
+	}
+}
+
+func TestCollaboratorsLimit(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	var links []models.CrewLink
+	for id := uint64(1); id <= 2*maxCollaborators; id++ {
+		links = append(links, models.CrewLink{IdFilm: 1, IdActor: id})
+	}
+	mockCrew := mocks.NewMockICrewRepo(mockCtrl)
+	mockCrew.EXPECT().GetCrewLinks().Return(links, nil).Times(1)
+
+	var buff bytes.Buffer
+	logger := slog.New(slog.NewJSONHandler(&buff, nil))
+	core := Core{crew: mockCrew, lg: logger, graph: newCollabGraph()}
+
+	result, err := core.Collaborators(1, 10*maxCollaborators)
+	if err != nil || len(result) != maxCollaborators {
+		t.Errorf("expected %d collaborators, got %d, %v", maxCollaborators, len(result), err)
+	}
+	result, err = core.Collaborators(1, 0)
+	if err != nil || len(result) != defaultCollaborators {
+		t.Errorf("expected %d collaborators, got %d, %v", defaultCollaborators, len(result), err)
+	}
+}
+
+func TestCollaboratorsReload(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	links := []models.CrewLink{
+		{IdFilm: 1, IdActor: 1, NameActor: "a1"},
+		{IdFilm: 1, IdActor: 2, NameActor: "a2"},
+	}
+	imported := append(links, models.CrewLink{IdFilm: 2, IdActor: 1, NameActor: "a1"},
+		models.CrewLink{IdFilm: 2, IdActor: 3, NameActor: "a3"})
+
+	mockCrew := mocks.NewMockICrewRepo(mockCtrl)
+	firstCall := mockCrew.EXPECT().GetCrewLinks().Return(links, nil).Times(1)
+	mockCrew.EXPECT().GetCrewLinks().After(firstCall).Return(imported, nil).Times(1)
+
+	var buff bytes.Buffer
+	logger := slog.New(slog.NewJSONHandler(&buff, nil))
+	now := time.Now()
+	graph := newCollabGraph()
+	graph.now = func() time.Time { return now }
+	core := Core{crew: mockCrew, lg: logger, graph: graph}
+
+	result, err := core.Collaborators(1, 0)
+	if err != nil || len(result) != 1 {
+		t.Errorf("Collaborators = %v, %v", result, err)
+		return
+	}
+
+	// Links written by the importer show up once the graph expires.
+	now = now.Add(graphTtl - time.Second)
+	if result, _ = core.Collaborators(1, 0); len(result) != 1 {
+		t.Errorf("expected the graph to be cached, had %v", result)
+		return
+	}
+	now = now.Add(time.Second)
+	result, err = core.Collaborators(1, 0)
+	if err != nil || len(result) != 2 {
+		t.Errorf("expected the graph to be reloaded, had %v, %v", result, err)
+	}
+}
+
+func TestEditFilm(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	mockFilm := mocks.NewMockIFilmsRepo(mockCtrl)
+	mockFilm.EXPECT().GetFilm(uint64(1)).Return(nil, fmt.Errorf("repo_err")).Times(1)
+	mockFilm.EXPECT().GetFilm(uint64(2)).Return(&models.FilmItem{}, nil).Times(1)
+	mockFilm.EXPECT().GetFilm(uint64(3)).Return(&models.FilmItem{Id: 3, Title: "t"}, nil).Times(2)
+
+	runtime := uint32(90)
+	budget := uint64(0)
+	mockFilm.EXPECT().EditFilm(models.FilmEdit{Id: 3, Runtime: &runtime}).Return(fmt.Errorf("repo_err")).Times(1)
+	mockFilm.EXPECT().EditFilm(models.FilmEdit{Id: 3, Budget: &budget}).Return(nil).Times(1)
+
+	var buff bytes.Buffer
+	logger := slog.New(slog.NewJSONHandler(&buff, nil))
+	core := Core{films: mockFilm, lg: logger}
+
+	testCases := map[string]struct {
+		film     models.FilmEdit
+		hasErr   bool
+		notFound bool
+	}{
+		"get film err": {
+			film:   models.FilmEdit{Id: 1},
+			hasErr: true,
+		},
+		"not found": {
+			film:     models.FilmEdit{Id: 2},
+			hasErr:   true,
+			notFound: true,
+		},
+		"edit film err": {
+			film:   models.FilmEdit{Id: 3, Runtime: &runtime},
+			hasErr: true,
+		},
+		"OK": {
+			film: models.FilmEdit{Id: 3, Budget: &budget},
+		},
+	}
+
+	for _, curr := range testCases {
+		err := core.EditFilm(curr.film)
+		if curr.hasErr != (err != nil) {
+			t.Errorf("unexpected error %v", err)
+			return
+		}
+		if curr.notFound != errors.Is(err, ErrNotFound) {
+			t.Errorf("expected not found, got %v", err)
+			return
+		}
+	}
+}
+
+func TestGetFilmsLocalized(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	films := []models.FilmItem{{Id: 1, Title: "Фильм 1"}, {Id: 2, Title: "Фильм 2"}, {Id: 3, Title: "Фильм 3"}}
+
+	mockFilm := mocks.NewMockIFilmsRepo(mockCtrl)
+	mockGenre := mocks.NewMockIGenreRepo(mockCtrl)
+	mockTranslation := mocks.NewMockITranslationRepo(mockCtrl)
+
+	mockFilm.EXPECT().GetFilmsByGenre(uint64(4), uint64(0), uint64(3)).Return(films, nil).Times(1)
+	mockGenre.EXPECT().GetGenreById(uint64(4)).Return("Драма", nil).Times(1)
+	mockTranslation.EXPECT().GetFilmTranslations([]uint64{1, 2, 3}, []string{"de", "en"}).Return([]models.Translation{
+		{Id: 1, Lang: "en", Title: "Film 1"},
+		{Id: 1, Lang: "de", Title: "Der Film 1"},
+		{Id: 2, Lang: "en", Title: "Film 2"},
+	}, nil).Times(1)
+	mockTranslation.EXPECT().GetGenreTranslations([]uint64{4}, []string{"de", "en"}).Return([]models.Translation{
+		{Id: 4, Lang: "en", Title: "Drama"},
+	}, nil).Times(1)
+
+	var buff bytes.Buffer
+	logger := slog.New(slog.NewJSONHandler(&buff, nil))
+	core := Core{films: mockFilm, genres: mockGenre, translations: mockTranslation, lg: logger}
+
+	result, genre, err := core.GetFilmsAndGenreTitle([]string{"de", "en", "ru"}, 4, 0, 3)
+	if err != nil {
+		t.Errorf("unexpected error %s", err)
+		return
+	}
+
+	expected := []models.FilmItem{{Id: 1, Title: "Der Film 1"}, {Id: 2, Title: "Film 2"}, {Id: 3, Title: "Фильм 3"}}
+	if !reflect.DeepEqual(expected, result) {
+		t.Errorf("wanted %v, had %v", expected, result)
+		return
+	}
+	if genre != "Drama" {
+		t.Errorf("wanted Drama, had %s", genre)
+		return
+	}
+
+	mockFilm.EXPECT().GetFilmsByGenre(uint64(4), uint64(0), uint64(3)).Return(films, nil).Times(1)
+	mockGenre.EXPECT().GetGenreById(uint64(4)).Return("Драма", nil).Times(1)
+	mockTranslation.EXPECT().GetFilmTranslations([]uint64{1, 2, 3}, []string{"en"}).Return(nil, fmt.Errorf("repo_err")).Times(1)
+
+	_, _, err = core.GetFilmsAndGenreTitle([]string{"en", "ru"}, 4, 0, 3)
+	if err == nil {
+		t.Errorf("wanted error")
+		return
+	}
+}
+
+func TestAddTranslation(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	mockTranslation := mocks.NewMockITranslationRepo(mockCtrl)
+	mockTranslation.EXPECT().AddTranslation("film", models.Translation{Id: 1, Lang: "en", Title: "t"}).Return(nil).Times(1)
+	mockTranslation.EXPECT().AddTranslation("genre", models.Translation{Id: 2, Lang: "de", Title: "t"}).Return(fmt.Errorf("repo_err")).Times(1)
+
+	var buff bytes.Buffer
+	logger := slog.New(slog.NewJSONHandler(&buff, nil))
+	core := Core{translations: mockTranslation, lg: logger}
+
+	testCases := map[string]struct {
+		entity  string
+		item    models.Translation
+		hasErr  bool
+		isBadRq bool
+	}{
+		"unknown entity": {
+			entity:  "collection",
+			item:    models.Translation{Id: 1, Lang: "en", Title: "t"},
+			hasErr:  true,
+			isBadRq: true,
+		},
+		"default lang": {
+			entity:  "film",
+			item:    models.Translation{Id: 1, Lang: "ru", Title: "t"},
+			hasErr:  true,
+			isBadRq: true,
+		},
+		"empty title": {
+			entity:  "film",
+			item:    models.Translation{Id: 1, Lang: "en"},
+			hasErr:  true,
+			isBadRq: true,
+		},
+		"repo err": {
+			entity: "genre",
+			item:   models.Translation{Id: 2, Lang: "de-DE", Title: "t"},
+			hasErr: true,
+		},
+		"OK": {
+			entity: "film",
+			item:   models.Translation{Id: 1, Lang: "EN", Title: "t"},
+		},
+	}
+
+	for name, curr := range testCases {
+		err := core.AddTranslation(curr.entity, curr.item)
+		if curr.hasErr != (err != nil) {
+			t.Errorf("%s: unexpected error %v", name, err)
+			return
+		}
+		if curr.isBadRq != errors.Is(err, ErrBadTranslation) {
+			t.Errorf("%s: expected bad translation, got %v", name, err)
+			return
+		}
+	}
+}
+
+func TestReplacePoster(t *testing.T) {
+	oldHash := strings.Repeat("ab", 32)
+	newHash := strings.Repeat("cd", 32)
+	oldPoster := "/icons/" + oldHash + ".jpg"
+
+	testCases := map[string]struct {
+		usage   uint64
+		removed bool
+	}{
+		"orphan removed": {
+			usage:   0,
+			removed: true,
+		},
+		"shared kept": {
+			usage:   1,
+			removed: false,
+		},
+	}
+
+	for name, curr := range testCases {
+		t.Run(name, func(t *testing.T) {
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+
+			root := t.TempDir()
+			store := storage.NewLocal(configs.LocalStoreCfg{Root: root})
+			for _, variant := range images.Variants(oldPoster) {
+				key, _ := store.Key(variant)
+				err := store.Put(context.Background(), key, []byte("old"), "image/jpeg")
+				if err != nil {
+					t.Fatalf("put error %s", err)
+				}
+			}
+
+			img := &images.Image{Hash: newHash, Files: map[string][]byte{newHash + ".jpg": []byte("new")}}
+
+			mockObj := mocks.NewMockIFilmsRepo(mockCtrl)
+			mockObj.EXPECT().GetFilm(uint64(1)).Return(&models.FilmItem{Id: 1, Title: "t", Poster: oldPoster}, nil).Times(2)
+			poster := "/icons/" + newHash + ".jpg"
+			mockObj.EXPECT().EditFilm(models.FilmEdit{Id: 1, Poster: &poster}).Return(nil).Times(1)
+			mockObj.EXPECT().CountPosterUsage(oldPoster).Return(curr.usage, nil).Times(1)
+
+			var buff bytes.Buffer
+			logger := slog.New(slog.NewJSONHandler(&buff, nil))
+			core := Core{films: mockObj, lg: logger, storage: store}
+
+			poster, err := core.ReplacePoster(context.Background(), 1, img)
+			if err != nil {
+				t.Errorf("unexpected error %s", err)
+				return
+			}
+			if poster != "/icons/"+newHash+".jpg" {
+				t.Errorf("unexpected poster %s", poster)
+			}
+
+			found, _ := store.Exists(context.Background(), "icons/"+newHash+".jpg")
+			if !found {
+				t.Errorf("new poster not saved")
+			}
+			for _, variant := range images.Variants(oldPoster) {
+				key, _ := store.Key(variant)
+				found, _ := store.Exists(context.Background(), key)
+				if found == curr.removed {
+					t.Errorf("%s: wanted removed %t", key, curr.removed)
+				}
+			}
+		})
+	}
+}
+
+func TestReplacePosterNotFound(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	mockObj := mocks.NewMockIFilmsRepo(mockCtrl)
+	mockObj.EXPECT().GetFilm(uint64(1)).Return(&models.FilmItem{}, nil).Times(1)
+
+	var buff bytes.Buffer
+	logger := slog.New(slog.NewJSONHandler(&buff, nil))
+	core := Core{films: mockObj, lg: logger}
+
+	_, err := core.ReplacePoster(context.Background(), 1, &images.Image{})
+	if !errors.Is(err, ErrNotFound) {
+		t.Errorf("expected ErrNotFound, got %v", err)
+	}
+}
+
+func TestTrendsPlaceholders(t *testing.T) {
+	testCases := map[string]struct {
+		placeholders map[uint64]models.Placeholder
+		err          error
+		expect       *models.Placeholder
+	}{
+		"found": {
+			placeholders: map[uint64]models.Placeholder{1: {Blurhash: "hash", Dominant: "#000000", Accent: "#ff0000"}},
+			expect:       &models.Placeholder{Blurhash: "hash", Dominant: "#000000", Accent: "#ff0000"},
+		},
+		"repo error is ignored": {
+			err:    fmt.Errorf("repo err"),
+			expect: nil,
+		},
+	}
+
+	for name, curr := range testCases {
+		t.Run(name, func(t *testing.T) {
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+
+			mockObj := mocks.NewMockIFilmsRepo(mockCtrl)
+			mockObj.EXPECT().Trends().Return([]models.FilmItem{{Id: 1}, {Id: 2}}, nil).Times(1)
+			mockPlaceholders := mocks.NewMockIPlaceholderRepo(mockCtrl)
+			mockPlaceholders.EXPECT().GetFilmPlaceholders([]uint64{1, 2}).Return(curr.placeholders, curr.err).Times(1)
+
+			var buff bytes.Buffer
+			logger := slog.New(slog.NewJSONHandler(&buff, nil))
+			core := Core{films: mockObj, placeholders: mockPlaceholders, lg: logger}
+
+			res, err := core.Trends(nil)
+			if err != nil {
+				t.Errorf("unexpected error %s", err)
+				return
+			}
+			if !reflect.DeepEqual(res[0].Placeholder, curr.expect) {
+				t.Errorf("unexpected placeholder %v, want %v", res[0].Placeholder, curr.expect)
+			}
+			if res[1].Placeholder != nil {
+				t.Errorf("expected no placeholder, got %v", res[1].Placeholder)
+			}
+		})
+	}
+}
+
+type commentsClient struct {
+	comments.CommentsClient
+	response *comments.FilmCommentsResponse
+	stats    []*comments.FilmStats
+	ratings  []*comments.Comment
+	err      error
+}
+
+func (c *commentsClient) GetFilmsStats(ctx context.Context, in *comments.FilmsStatsRequest, opts ...grpc.CallOption) (*comments.FilmsStatsResponse, error) {
+	return &comments.FilmsStatsResponse{Stats: c.stats}, c.err
+}
+
+func (c *commentsClient) GetUserComments(ctx context.Context, in *comments.UserCommentsRequest, opts ...grpc.CallOption) (*comments.CommentsResponse, error) {
+	if c.err != nil {
+		return nil, c.err
+	}
+	first := min((in.Page-1)*in.PerPage, uint64(len(c.ratings)))
+	last := min(first+in.PerPage, uint64(len(c.ratings)))
+
+	return &comments.CommentsResponse{Comments: c.ratings[first:last]}, nil
+}
+
+func (c *commentsClient) GetFilmComments(ctx context.Context, in *comments.FilmCommentsRequest, opts ...grpc.CallOption) (*comments.FilmCommentsResponse, error) {
+	return c.response, c.err
+}
+
+func TestGetFilmPage(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	film := &models.FilmItem{Title: "t"}
+	mockFilm := mocks.NewMockIFilmsRepo(mockCtrl)
+	mockFilm.EXPECT().GetFilm(uint64(1)).Return(film, nil).Times(3)
+	mockFilm.EXPECT().GetFilm(uint64(2)).Return(&models.FilmItem{}, nil).Times(1)
+	mockFilm.EXPECT().GetFilmRating(uint64(1)).Return(9.8, uint64(100), nil).AnyTimes()
+	checked := mockFilm.EXPECT().CheckFilm(uint64(5), uint64(1)).Return(true, nil).Times(1)
+	failed := mockFilm.EXPECT().CheckFilm(uint64(5), uint64(1)).Return(false, fmt.Errorf("repo_error")).Times(1).After(checked)
+	release := make(chan struct{})
+	defer close(release)
+	mockFilm.EXPECT().CheckFilm(uint64(5), uint64(1)).DoAndReturn(func(userId uint64, filmId uint64) (bool, error) {
+		<-release
+		return true, nil
+	}).Times(1).After(failed)
+
+	mockGenres := mocks.NewMockIGenreRepo(mockCtrl)
+	mockGenres.EXPECT().GetFilmGenres(uint64(1)).Return(nil, nil).AnyTimes()
+
+	mockCrew := mocks.NewMockICrewRepo(mockCtrl)
+	mockCrew.EXPECT().GetFilmDirectors(uint64(1)).Return(nil, nil).AnyTimes()
+	mockCrew.EXPECT().GetFilmScenarists(uint64(1)).Return(nil, nil).AnyTimes()
+	mockCrew.EXPECT().GetFilmCharacters(uint64(1)).Return(nil, nil).AnyTimes()
+
+	client := &commentsClient{response: &comments.FilmCommentsResponse{
+		Comments: []*comments.Comment{{IdUser: 3, Name: "n", IdFilm: 1, Rating: 8, Text: "c"}},
+		Own:      &comments.Comment{IdUser: 5, IdFilm: 1, Rating: 7},
+	}}
+
+	var buff bytes.Buffer
+	logger := slog.New(slog.NewJSONHandler(&buff, nil))
+	core := Core{films: mockFilm, genres: mockGenres, crew: mockCrew, comments: client, lg: logger}
+
+	result, err := core.GetFilmPage(context.Background(), nil, 1, 5)
+	if err != nil {
+		t.Errorf("unexpected error: %s", err)
+		return
+	}
+	expected := &requests.FilmPageResponse{
+		FilmResponse: requests.FilmResponse{Film: *film, Rating: 9.8, Number: 100},
+		Comments:     []models.CommentItem{{IdUser: 3, Username: "n", IdFilm: 1, Rating: 8, Comment: "c"}},
+		OwnComment:   &models.CommentItem{IdUser: 5, IdFilm: 1, Rating: 7},
+		IsFavorite:   true,
+	}
+	if !reflect.DeepEqual(result, expected) {
+		t.Errorf("wanted %v, got %v", expected, result)
+	}
+
+	client.err = fmt.Errorf("unavailable")
+	result, err = core.GetFilmPage(context.Background(), nil, 1, 5)
+	if err != nil {
+		t.Errorf("unexpected error: %s", err)
+		return
+	}
+	if len(result.Comments) != 0 || result.OwnComment != nil || result.IsFavorite ||
+		!reflect.DeepEqual(result.Unavailable, []string{PartComments, PartFavorite}) {
+		t.Errorf("unexpected partial result %v", result)
+	}
+
+	// A part still loading at the deadline is given up on.
+	client.err = nil
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	result, err = core.GetFilmPage(ctx, nil, 1, 5)
+	if err != nil {
+		t.Errorf("unexpected error: %s", err)
+		return
+	}
+	if len(result.Comments) != 1 || result.IsFavorite || !reflect.DeepEqual(result.Unavailable, []string{PartFavorite}) {
+		t.Errorf("unexpected partial result %v", result)
+	}
+
+	_, err = core.GetFilmPage(context.Background(), nil, 2, 0)
+	if !errors.Is(err, ErrNotFound) {
+		t.Errorf("wanted not found error, got %v", err)
+	}
+}
+
+func TestUsersStatisticsFromComments(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	client := &commentsClient{}
+	for i := uint64(1); i <= reviewsPageSize; i++ {
+		client.ratings = append(client.ratings, &comments.Comment{IdFilm: 1, Rating: 6})
+	}
+	client.ratings = append(client.ratings, &comments.Comment{IdFilm: 2, Rating: 9})
+
+	mockGenres := mocks.NewMockIGenreRepo(mockCtrl)
+	mockGenres.EXPECT().GetFilmGenres(uint64(1)).Return([]models.GenreItem{{Id: 3}}, nil).Times(reviewsPageSize)
+	mockGenres.EXPECT().GetFilmGenres(uint64(2)).Return([]models.GenreItem{{Id: 3}, {Id: 1}}, nil).Times(1)
+	fallback := []requests.UsersStatisticsResponse{{GenreId: 7}}
+	mockGenres.EXPECT().UsersStatistics(uint64(5)).Return(fallback, nil).Times(1)
+
+	var buff bytes.Buffer
+	logger := slog.New(slog.NewJSONHandler(&buff, nil))
+	core := Core{genres: mockGenres, comments: client, lg: logger}
+
+	result, err := core.UsersStatistics(5)
+	if err != nil {
+		t.Errorf("unexpected error %s", err)
+	}
+	expected := []requests.UsersStatisticsResponse{
+		{GenreId: 1, Count: 1, Avg: 9},
+		{GenreId: 3, Count: reviewsPageSize + 1, Avg: float64(6*reviewsPageSize+9) / (reviewsPageSize + 1)},
+	}
+	if !reflect.DeepEqual(result, expected) {
+		t.Errorf("wanted %v, got %v", expected, result)
+	}
+
+	client.err = fmt.Errorf("unavailable")
+	result, err = core.UsersStatistics(5)
+	if err != nil || !reflect.DeepEqual(result, fallback) {
+		t.Errorf("expected the local statistics, got %v, %v", result, err)
+	}
+}
+
+func TestFindFilmReviewsCount(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	mockObj := mocks.NewMockIFilmsRepo(mockCtrl)
+	mockObj.EXPECT().FindFilm("t", "", "", float32(0), float32(10), "", nil, nil, uint32(0), uint32(0), "", uint64(0), uint64(2)).
+		Return([]models.FilmItem{{Id: 1, Title: "a"}, {Id: 2, Title: "b"}}, nil).Times(2)
+
+	client := &commentsClient{stats: []*comments.FilmStats{{FilmId: 2, Count: 4, Average: 8}}}
+	var buff bytes.Buffer
+	logger := slog.New(slog.NewJSONHandler(&buff, nil))
+	core := Core{films: mockObj, comments: client, lg: logger}
+
+	result, err := core.FindFilm(nil, "t", "", "", 0, 10, "", nil, nil, 0, 0, "", 0, 2)
+	if err != nil {
+		t.Errorf("unexpected error %s", err)
+		return
+	}
+	if result[0].ReviewsCount != 0 || result[1].ReviewsCount != 4 {
+		t.Errorf("unexpected reviews count %v", result)
+	}
+
+	client.err = fmt.Errorf("unavailable")
+	result, err = core.FindFilm(nil, "t", "", "", 0, 10, "", nil, nil, 0, 0, "", 0, 2)
+	if err != nil || len(result) != 2 {
+		t.Errorf("expected films without counts, got %v, %v", result, err)
+	}
+}
+
+type authClient struct {
+	auth.AuthorizationClient
+	response *auth.ValidateSessionResponse
+	err      error
+}
+
+func (c *authClient) ValidateSession(ctx context.Context, in *auth.ValidateSessionRequest, opts ...grpc.CallOption) (*auth.ValidateSessionResponse, error) {
+	return c.response, c.err
+}
+
+func TestValidateSession(t *testing.T) {
+	var buff bytes.Buffer
+	logger := slog.New(slog.NewJSONHandler(&buff, nil))
+	client := &authClient{response: &auth.ValidateSessionResponse{Id: 3, Login: "l", Name: "n", Role: "admin", ExpiresAt: 1700000000}}
+	core := Core{client: client, lg: logger}
+
+	principal, err := core.ValidateSession(context.Background(), "sid")
+	if err != nil {
+		t.Errorf("ValidateSession error: %s", err)
+	}
+	expect := &middleware.Principal{Id: 3, Login: "l", Name: "n", Role: "admin", ExpiresAt: time.Unix(1700000000, 0)}
+	if !reflect.DeepEqual(principal, expect) {
+		t.Errorf("wanted %v, got %v", expect, principal)
+	}
+
+	client.err = errors.New("unauthenticated")
+	_, err = core.ValidateSession(context.Background(), "sid")
+	if err == nil {
+		t.Errorf("expected error, got nil")
+	}
+}
@@ -0,0 +1,219 @@
+package usecase
+
+import (
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/go-park-mail-ru/2023_2_Vkladyshi/pkg/models"
+)
+
+// graphTtl bounds how long crew links written outside the service, by the
+// importer or straight in the database, take to show up in the graph.
+const graphTtl = 5 * time.Minute
+
+// defaultCollaborators is the number of collaborators returned when no
+// limit is given.
+const defaultCollaborators = 10
+
+// maxCollaborators bounds the number of collaborators returned at once.
+const maxCollaborators = 100
+
+type collabGraph struct {
+	// loading lets one caller reload an expired graph while the others wait.
+	loading     sync.Mutex
+	mu          sync.RWMutex
+	ttl         time.Duration
+	now         func() time.Time
+	loaded      bool
+	loadedAt    time.Time
+	persons     map[uint64]models.Character
+	films       map[uint64]models.FilmItem
+	personFilms map[uint64]map[uint64]struct{}
+	filmPersons map[uint64]map[uint64]struct{}
+}
+
+func newCollabGraph() *collabGraph {
+	return &collabGraph{ttl: graphTtl, now: time.Now}
+}
+
+func (g *collabGraph) isLoaded() bool {
+	g.mu.RLock()
+	defer g.mu.RUnlock()
+
+	return g.loaded && g.now().Sub(g.loadedAt) < g.ttl
+}
+
+func (g *collabGraph) load(links []models.CrewLink) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	g.persons = map[uint64]models.Character{}
+	g.films = map[uint64]models.FilmItem{}
+	g.personFilms = map[uint64]map[uint64]struct{}{}
+	g.filmPersons = map[uint64]map[uint64]struct{}{}
+	for _, link := range links {
+		g.addLink(link)
+	}
+	g.loaded = true
+	g.loadedAt = g.now()
+}
+
+func (g *collabGraph) invalidate() {
+	g.mu.Lock()
+	g.loaded = false
+	g.mu.Unlock()
+}
+
+func (g *collabGraph) addLinks(links []models.CrewLink) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	if !g.loaded {
+		return
+	}
+	for _, link := range links {
+		g.addLink(link)
+	}
+}
+
+func (g *collabGraph) addLink(link models.CrewLink) {
+	g.persons[link.IdActor] = models.Character{IdActor: link.IdActor, NameActor: link.NameActor, ActorPhoto: link.ActorPhoto}
+	g.films[link.IdFilm] = models.FilmItem{Id: link.IdFilm, Title: link.Title, Poster: link.Poster}
+
+	if g.personFilms[link.IdActor] == nil {
+		g.personFilms[link.IdActor] = map[uint64]struct{}{}
+	}
+	g.personFilms[link.IdActor][link.IdFilm] = struct{}{}
+
+	if g.filmPersons[link.IdFilm] == nil {
+		g.filmPersons[link.IdFilm] = map[uint64]struct{}{}
+	}
+	g.filmPersons[link.IdFilm][link.IdActor] = struct{}{}
+}
+
+func (g *collabGraph) person(id uint64) (models.Character, bool) {
+	g.mu.RLock()
+	defer g.mu.RUnlock()
+
+	p, ok := g.persons[id]
+	return p, ok
+}
+
+func (g *collabGraph) shortestPath(from uint64, to uint64) ([]models.Character, []models.FilmItem, bool) {
+	g.mu.RLock()
+	defer g.mu.RUnlock()
+
+	if _, ok := g.persons[from]; !ok {
+		return nil, nil, false
+	}
+	if _, ok := g.persons[to]; !ok {
+		return nil, nil, false
+	}
+
+	type step struct {
+		person uint64
+		film   uint64
+	}
+	prev := map[uint64]step{from: {}}
+	visitedFilms := map[uint64]struct{}{}
+	queue := []uint64{from}
+
+	for len(queue) > 0 && !containsKey(prev, to) {
+		person := queue[0]
+		queue = queue[1:]
+
+		for _, film := range sortedKeys(g.personFilms[person]) {
+			if _, ok := visitedFilms[film]; ok {
+				continue
+			}
+			visitedFilms[film] = struct{}{}
+
+			for _, next := range sortedKeys(g.filmPersons[film]) {
+				if _, ok := prev[next]; ok {
+					continue
+				}
+				prev[next] = step{person: person, film: film}
+				queue = append(queue, next)
+			}
+		}
+	}
+
+	if !containsKey(prev, to) {
+		return nil, nil, false
+	}
+
+	actors := []models.Character{g.persons[to]}
+	films := []models.FilmItem{}
+	for current := to; current != from; {
+		s := prev[current]
+		films = append(films, g.films[s.film])
+		actors = append(actors, g.persons[s.person])
+		current = s.person
+	}
+	reverse(actors)
+	reverse(films)
+
+	return actors, films, true
+}
+
+func (g *collabGraph) collaborators(id uint64, limit uint64) []models.Collaborator {
+	g.mu.RLock()
+	defer g.mu.RUnlock()
+
+	counts := map[uint64]uint64{}
+	for film := range g.personFilms[id] {
+		for person := range g.filmPersons[film] {
+			if person != id {
+				counts[person]++
+			}
+		}
+	}
+
+	result := make([]models.Collaborator, 0, len(counts))
+	for person, count := range counts {
+		p := g.persons[person]
+		result = append(result, models.Collaborator{
+			IdActor:    p.IdActor,
+			NameActor:  p.NameActor,
+			ActorPhoto: p.ActorPhoto,
+			Count:      count,
+		})
+	}
+	sort.Slice(result, func(i, j int) bool {
+		if result[i].Count != result[j].Count {
+			return result[i].Count > result[j].Count
+		}
+		return result[i].IdActor < result[j].IdActor
+	})
+	if limit == 0 {
+		limit = defaultCollaborators
+	}
+	limit = min(limit, maxCollaborators)
+	if uint64(len(result)) > limit {
+		result = result[:limit]
+	}
+
+	return result
+}
+
+func containsKey[V any](m map[uint64]V, key uint64) bool {
+	_, ok := m[key]
+	return ok
+}
+
+func sortedKeys(m map[uint64]struct{}) []uint64 {
+	keys := make([]uint64, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Slice(keys, func(i, j int) bool { return keys[i] < keys[j] })
+
+	return keys
+}
+
+func reverse[T any](s []T) {
+	for i, j := 0, len(s)-1; i < j; i, j = i+1, j-1 {
+		s[i], s[j] = s[j], s[i]
+	}
+}
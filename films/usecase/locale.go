@@ -0,0 +1,206 @@
+package usecase
+
+import (
+	"fmt"
+
+	"github.com/go-park-mail-ru/2023_2_Vkladyshi/pkg/locale"
+	"github.com/go-park-mail-ru/2023_2_Vkladyshi/pkg/models"
+)
+
+type translationsGetter func(ids []uint64, langs []string) ([]models.Translation, error)
+
+// translate picks, for every id, the translation in the earliest language of
+// the fallback chain. Ids without a preferred translation keep their original
+// (Default language) values and are absent from the result.
+func (core *Core) translate(get translationsGetter, ids []uint64, langs []string) (map[uint64]models.Translation, error) {
+	preferred := locale.Preferred(langs)
+	if core.translations == nil || len(preferred) == 0 || len(ids) == 0 {
+		return nil, nil
+	}
+
+	translations, err := get(ids, preferred)
+	if err != nil {
+		core.lg.Error("get translations error", "err", err.Error())
+		return nil, fmt.Errorf("get translations err: %w", err)
+	}
+
+	rank := map[string]int{}
+	for i, lang := range preferred {
+		rank[lang] = i
+	}
+
+	result := map[uint64]models.Translation{}
+	for _, translation := range translations {
+		best, ok := result[translation.Id]
+		if !ok || rank[translation.Lang] < rank[best.Lang] {
+			result[translation.Id] = translation
+		}
+	}
+
+	return result, nil
+}
+
+func (core *Core) localizeFilms(films []models.FilmItem, langs []string) error {
+	if core.translations == nil {
+		return nil
+	}
+
+	ids := make([]uint64, 0, len(films))
+	for _, film := range films {
+		ids = append(ids, film.Id)
+	}
+	translations, err := core.translate(core.translations.GetFilmTranslations, ids, langs)
+	if err != nil {
+		return err
+	}
+
+	for i := range films {
+		if translation, ok := translations[films[i].Id]; ok {
+			films[i].Title = translation.Title
+			if translation.Info != "" {
+				films[i].Info = translation.Info
+			}
+		}
+	}
+
+	return nil
+}
+
+func (core *Core) localizeFilmography(films []models.FilmographyItem, langs []string) error {
+	if core.translations == nil {
+		return nil
+	}
+
+	ids := make([]uint64, 0, len(films))
+	for _, film := range films {
+		ids = append(ids, film.IdFilm)
+	}
+	translations, err := core.translate(core.translations.GetFilmTranslations, ids, langs)
+	if err != nil {
+		return err
+	}
+
+	for i := range films {
+		if translation, ok := translations[films[i].IdFilm]; ok {
+			films[i].Title = translation.Title
+		}
+	}
+
+	return nil
+}
+
+func (core *Core) localizeGenres(genres []models.GenreItem, langs []string) error {
+	if core.translations == nil {
+		return nil
+	}
+
+	ids := make([]uint64, 0, len(genres))
+	for _, genre := range genres {
+		ids = append(ids, genre.Id)
+	}
+	translations, err := core.translate(core.translations.GetGenreTranslations, ids, langs)
+	if err != nil {
+		return err
+	}
+
+	for i := range genres {
+		if translation, ok := translations[genres[i].Id]; ok {
+			genres[i].Title = translation.Title
+		}
+	}
+
+	return nil
+}
+
+func (core *Core) localizeCrew(persons []models.CrewItem, langs []string) error {
+	if core.translations == nil {
+		return nil
+	}
+
+	ids := make([]uint64, 0, len(persons))
+	for _, person := range persons {
+		ids = append(ids, person.Id)
+	}
+	translations, err := core.translate(core.translations.GetPersonTranslations, ids, langs)
+	if err != nil {
+		return err
+	}
+
+	for i := range persons {
+		if translation, ok := translations[persons[i].Id]; ok {
+			persons[i].Name = translation.Title
+			if translation.Info != "" {
+				persons[i].Info = translation.Info
+			}
+		}
+	}
+
+	return nil
+}
+
+func (core *Core) localizeCharacters(characters []models.Character, langs []string) error {
+	if core.translations == nil {
+		return nil
+	}
+
+	ids := make([]uint64, 0, len(characters))
+	for _, character := range characters {
+		ids = append(ids, character.IdActor)
+	}
+	translations, err := core.translate(core.translations.GetPersonTranslations, ids, langs)
+	if err != nil {
+		return err
+	}
+
+	for i := range characters {
+		if translation, ok := translations[characters[i].IdActor]; ok {
+			characters[i].NameActor = translation.Title
+		}
+	}
+
+	return nil
+}
+
+func (core *Core) localizeFilmInfo(langs []string, film *models.FilmItem, genres []models.GenreItem,
+	directors []models.CrewItem, scenarists []models.CrewItem, characters []models.Character,
+) error {
+	films := []models.FilmItem{*film}
+	err := core.localizeFilms(films, langs)
+	if err != nil {
+		return err
+	}
+	*film = films[0]
+
+	err = core.localizeGenres(genres, langs)
+	if err != nil {
+		return err
+	}
+	err = core.localizeCrew(directors, langs)
+	if err != nil {
+		return err
+	}
+	err = core.localizeCrew(scenarists, langs)
+	if err != nil {
+		return err
+	}
+
+	return core.localizeCharacters(characters, langs)
+}
+
+func (core *Core) localizeActorInfo(langs []string, actor *models.CrewItem, films []models.FilmographyItem,
+	knownFor []models.FilmItem,
+) error {
+	persons := []models.CrewItem{*actor}
+	err := core.localizeCrew(persons, langs)
+	if err != nil {
+		return err
+	}
+	*actor = persons[0]
+
+	err = core.localizeFilmography(films, langs)
+	if err != nil {
+		return err
+	}
+
+	return core.localizeFilms(knownFor, langs)
+}
@@ -0,0 +1,137 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	comments "github.com/go-park-mail-ru/2023_2_Vkladyshi/comments/proto"
+	"github.com/go-park-mail-ru/2023_2_Vkladyshi/pkg/grpcclient"
+	"github.com/go-park-mail-ru/2023_2_Vkladyshi/pkg/models"
+	"github.com/go-park-mail-ru/2023_2_Vkladyshi/pkg/requests"
+	"google.golang.org/grpc"
+)
+
+const (
+	filmPageComments = 10
+	filmPageTimeout  = 2 * time.Second
+)
+
+// Parts of the film page that may be missing from the response.
+const (
+	PartComments = "comments"
+	PartFavorite = "favorite"
+)
+
+// CommentsClientConfig retries only the calls of the comments service that
+// change nothing.
+func CommentsClientConfig() grpcclient.Config {
+	return grpcclient.DefaultConfig("comments",
+		comments.Comments_GetFilmComments_FullMethodName, comments.Comments_GetFilmsStats_FullMethodName,
+		comments.Comments_GetUserComments_FullMethodName, comments.Comments_GetLatestComments_FullMethodName)
+}
+
+func GetCommentsClient(adress string, opts ...grpc.DialOption) (comments.CommentsClient, error) {
+	conn, err := grpcclient.Dial(adress, CommentsClientConfig(), opts...)
+	if err != nil {
+		return nil, fmt.Errorf("grpc connect err: %w", err)
+	}
+
+	return comments.NewCommentsClient(conn), nil
+}
+
+type commentsPart struct {
+	page *comments.FilmCommentsResponse
+	err  error
+}
+
+type favoritePart struct {
+	favorite bool
+	err      error
+}
+
+// GetFilmPage returns the film with the first page of comments and, for a
+// signed in user, their own rating and whether the film is a favorite. The
+// other parts are loaded while the film is, and only the film is required:
+// parts that fail or are not loaded by the deadline are listed in
+// Unavailable.
+func (core *Core) GetFilmPage(ctx context.Context, langs []string, filmId uint64, userId uint64) (*requests.FilmPageResponse, error) {
+	ctx, cancel := context.WithTimeout(ctx, filmPageTimeout)
+	defer cancel()
+
+	// The channels are buffered, so the parts given up on do not block.
+	commentsDone := make(chan commentsPart, 1)
+	go func() {
+		page, err := core.comments.GetFilmComments(ctx, &comments.FilmCommentsRequest{
+			FilmId:   filmId,
+			ViewerId: userId,
+			Page:     1,
+			PerPage:  filmPageComments,
+		})
+		commentsDone <- commentsPart{page: page, err: err}
+	}()
+	var favoriteDone chan favoritePart
+	if userId != 0 {
+		favoriteDone = make(chan favoritePart, 1)
+		go func() {
+			favorite, err := core.films.CheckFilm(userId, filmId)
+			favoriteDone <- favoritePart{favorite: favorite, err: err}
+		}()
+	}
+
+	film, err := core.GetFilmInfo(langs, filmId)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &requests.FilmPageResponse{
+		FilmResponse: *film,
+		Comments:     []models.CommentItem{},
+	}
+
+	var part commentsPart
+	select {
+	case part = <-commentsDone:
+	case <-ctx.Done():
+		part.err = ctx.Err()
+	}
+	if part.err != nil {
+		core.lg.Error("get film page comments error", "err", part.err.Error())
+		result.Unavailable = append(result.Unavailable, PartComments)
+	} else {
+		for _, comment := range part.page.Comments {
+			result.Comments = append(result.Comments, fromProto(comment))
+		}
+		if part.page.Own != nil {
+			own := fromProto(part.page.Own)
+			result.OwnComment = &own
+		}
+	}
+
+	if favoriteDone != nil {
+		var part favoritePart
+		select {
+		case part = <-favoriteDone:
+		case <-ctx.Done():
+			part.err = ctx.Err()
+		}
+		if part.err != nil {
+			core.lg.Error("get film page favorite error", "err", part.err.Error())
+			result.Unavailable = append(result.Unavailable, PartFavorite)
+		}
+		result.IsFavorite = part.favorite
+	}
+
+	return result, nil
+}
+
+func fromProto(comment *comments.Comment) models.CommentItem {
+	return models.CommentItem{
+		IdUser:   comment.IdUser,
+		Username: comment.Name,
+		IdFilm:   comment.IdFilm,
+		Rating:   uint16(comment.Rating),
+		Comment:  comment.Text,
+		Photo:    comment.Photo,
+	}
+}
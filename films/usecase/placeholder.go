@@ -0,0 +1,92 @@
+package usecase
+
+import (
+	"github.com/go-park-mail-ru/2023_2_Vkladyshi/pkg/images"
+	"github.com/go-park-mail-ru/2023_2_Vkladyshi/pkg/models"
+)
+
+type placeholdersGetter func(ids []uint64) (map[uint64]models.Placeholder, error)
+
+// getPlaceholders never fails the request: placeholders only make cards look
+// nicer while images load, so errors are logged and the response goes out
+// without them.
+func (core *Core) getPlaceholders(get placeholdersGetter, ids []uint64) map[uint64]models.Placeholder {
+	if core.placeholders == nil || len(ids) == 0 {
+		return nil
+	}
+
+	result, err := get(ids)
+	if err != nil {
+		core.lg.Error("get placeholders error", "err", err.Error())
+		return nil
+	}
+
+	return result
+}
+
+func (core *Core) setPosterMedia(films []models.FilmItem) {
+	ids := make([]uint64, 0, len(films))
+	for i := range films {
+		films[i].PosterVariants = images.Variants(films[i].Poster)
+		ids = append(ids, films[i].Id)
+	}
+	if core.placeholders == nil {
+		return
+	}
+
+	placeholders := core.getPlaceholders(core.placeholders.GetFilmPlaceholders, ids)
+	for i := range films {
+		if placeholder, ok := placeholders[films[i].Id]; ok {
+			films[i].Placeholder = &placeholder
+		}
+	}
+}
+
+func (core *Core) setCrewPlaceholders(persons []models.CrewItem) {
+	if core.placeholders == nil {
+		return
+	}
+
+	ids := make([]uint64, 0, len(persons))
+	for _, person := range persons {
+		ids = append(ids, person.Id)
+	}
+
+	placeholders := core.getPlaceholders(core.placeholders.GetPersonPlaceholders, ids)
+	for i := range persons {
+		if placeholder, ok := placeholders[persons[i].Id]; ok {
+			persons[i].Placeholder = &placeholder
+		}
+	}
+}
+
+func (core *Core) setCharacterPlaceholders(characters []models.Character) {
+	if core.placeholders == nil {
+		return
+	}
+
+	ids := make([]uint64, 0, len(characters))
+	for _, character := range characters {
+		ids = append(ids, character.IdActor)
+	}
+
+	placeholders := core.getPlaceholders(core.placeholders.GetPersonPlaceholders, ids)
+	for i := range characters {
+		if placeholder, ok := placeholders[characters[i].IdActor]; ok {
+			characters[i].Placeholder = &placeholder
+		}
+	}
+}
+
+// savePosterPlaceholder is best effort as well: the backfill command picks up
+// films whose placeholder could not be saved.
+func (core *Core) savePosterPlaceholder(filmId uint64, placeholder *models.Placeholder) {
+	if core.placeholders == nil || placeholder == nil || placeholder.Blurhash == "" {
+		return
+	}
+
+	err := core.placeholders.SetFilmPlaceholder(filmId, *placeholder)
+	if err != nil {
+		core.lg.Error("save placeholder error", "err", err.Error())
+	}
+}
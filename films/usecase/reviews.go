@@ -0,0 +1,98 @@
+package usecase
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"slices"
+	"time"
+
+	comments "github.com/go-park-mail-ru/2023_2_Vkladyshi/comments/proto"
+	"github.com/go-park-mail-ru/2023_2_Vkladyshi/pkg/models"
+	"github.com/go-park-mail-ru/2023_2_Vkladyshi/pkg/requests"
+)
+
+const (
+	reviewsTimeout  = time.Second
+	reviewsPageSize = 100
+)
+
+// setReviewsCount adds the number of ratings from the comments service. The
+// films are still shown when the service is unavailable, just without it.
+func (core *Core) setReviewsCount(films []models.FilmItem) {
+	if core.comments == nil || len(films) == 0 {
+		return
+	}
+
+	ids := make([]uint64, len(films))
+	for i := range films {
+		ids[i] = films[i].Id
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), reviewsTimeout)
+	defer cancel()
+	response, err := core.comments.GetFilmsStats(ctx, &comments.FilmsStatsRequest{FilmIds: ids})
+	if err != nil {
+		core.lg.Error("get films stats error", "err", err.Error())
+		return
+	}
+
+	counts := make(map[uint64]uint64, len(response.Stats))
+	for _, stats := range response.Stats {
+		counts[stats.FilmId] = stats.Count
+	}
+	for i := range films {
+		films[i].ReviewsCount = counts[films[i].Id]
+	}
+}
+
+// usersStatistics groups the ratings the comments service has for a user by
+// the genres of the rated films.
+func (core *Core) usersStatistics(idUser uint64) ([]requests.UsersStatisticsResponse, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), reviewsTimeout)
+	defer cancel()
+
+	var ratings []*comments.Comment
+	for page := uint64(1); ; page++ {
+		response, err := core.comments.GetUserComments(ctx, &comments.UserCommentsRequest{
+			UserId:  idUser,
+			Page:    page,
+			PerPage: reviewsPageSize,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("get user comments err: %w", err)
+		}
+		ratings = append(ratings, response.Comments...)
+		if len(response.Comments) < reviewsPageSize {
+			break
+		}
+	}
+
+	byGenre := map[uint64]*requests.UsersStatisticsResponse{}
+	for _, rating := range ratings {
+		genres, err := core.genres.GetFilmGenres(rating.IdFilm)
+		if err != nil {
+			return nil, fmt.Errorf("get film genres err: %w", err)
+		}
+		for _, genre := range genres {
+			stats, ok := byGenre[genre.Id]
+			if !ok {
+				stats = &requests.UsersStatisticsResponse{GenreId: genre.Id}
+				byGenre[genre.Id] = stats
+			}
+			stats.Avg += float64(rating.Rating)
+			stats.Count++
+		}
+	}
+
+	result := make([]requests.UsersStatisticsResponse, 0, len(byGenre))
+	for _, stats := range byGenre {
+		stats.Avg /= float64(stats.Count)
+		result = append(result, *stats)
+	}
+	slices.SortFunc(result, func(a, b requests.UsersStatisticsResponse) int {
+		return cmp.Compare(a.GenreId, b.GenreId)
+	})
+
+	return result, nil
+}
@@ -0,0 +1,40 @@
+package gateway
+
+import (
+	"net/http"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// handleCors adds the CORS headers for an allowed origin and answers
+// preflight requests itself. It reports whether the request is done.
+func (g *Gateway) handleCors(w http.ResponseWriter, r *http.Request) bool {
+	origin := r.Header.Get("Origin")
+	allowed := origin != "" && (slices.Contains(g.cors.AllowedOrigins, origin) ||
+		slices.Contains(g.cors.AllowedOrigins, "*"))
+
+	header := w.Header()
+	header.Add("Vary", "Origin")
+	if allowed {
+		header.Set("Access-Control-Allow-Origin", origin)
+		if g.cors.AllowCredentials {
+			header.Set("Access-Control-Allow-Credentials", "true")
+		}
+	}
+
+	if r.Method != http.MethodOptions || r.Header.Get("Access-Control-Request-Method") == "" {
+		return false
+	}
+
+	if allowed {
+		header.Set("Access-Control-Allow-Methods", strings.Join(g.cors.AllowedMethods, ", "))
+		header.Set("Access-Control-Allow-Headers", strings.Join(g.cors.AllowedHeaders, ", "))
+		if g.cors.MaxAge > 0 {
+			header.Set("Access-Control-Max-Age", strconv.Itoa(g.cors.MaxAge))
+		}
+	}
+	w.WriteHeader(http.StatusNoContent)
+
+	return true
+}
@@ -0,0 +1,138 @@
+package gateway
+
+import (
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"net/http/httputil"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	auth "github.com/go-park-mail-ru/2023_2_Vkladyshi/authorization/proto"
+	"github.com/go-park-mail-ru/2023_2_Vkladyshi/configs"
+	"github.com/go-park-mail-ru/2023_2_Vkladyshi/pkg/middleware"
+	"github.com/go-park-mail-ru/2023_2_Vkladyshi/pkg/requests"
+)
+
+type route struct {
+	prefix string
+	proxy  *httputil.ReverseProxy
+}
+
+type Gateway struct {
+	routes    []route
+	upstreams []configs.UpstreamCfg
+	cors      configs.CorsCfg
+	secret    string
+	client    auth.AuthorizationClient
+	scraper   *http.Client
+	ct        *requests.Collector
+	lg        *slog.Logger
+}
+
+func New(config *configs.GatewayCfg, client auth.AuthorizationClient, lg *slog.Logger) (*Gateway, error) {
+	if config.Secret == "" {
+		return nil, errors.New("gateway secret is not set")
+	}
+
+	gateway := &Gateway{
+		upstreams: config.Metrics,
+		cors:      config.Cors,
+		secret:    config.Secret,
+		client:    client,
+		scraper:   &http.Client{Timeout: 5 * time.Second},
+		ct:        requests.GetCollector(),
+		lg:        lg,
+	}
+
+	for _, cfg := range config.Routes {
+		target, err := url.Parse(cfg.Upstream)
+		if err != nil {
+			return nil, fmt.Errorf("parse upstream err: %w", err)
+		}
+		if target.Scheme == "" || target.Host == "" {
+			return nil, fmt.Errorf("upstream %q of %q is not an absolute url", cfg.Upstream, cfg.Prefix)
+		}
+
+		proxy := httputil.NewSingleHostReverseProxy(target)
+		proxy.ErrorHandler = gateway.proxyError
+		gateway.routes = append(gateway.routes, route{prefix: cfg.Prefix, proxy: proxy})
+	}
+	// The longest prefix wins, so "/api/v1/comment" is matched before "/api/v1/".
+	sort.SliceStable(gateway.routes, func(i, j int) bool {
+		return len(gateway.routes[i].prefix) > len(gateway.routes[j].prefix)
+	})
+
+	return gateway, nil
+}
+
+func (g *Gateway) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	setSecurityHeaders(w.Header())
+	if g.handleCors(w, r) {
+		return
+	}
+
+	if r.URL.Path == "/metrics" {
+		g.Metrics(w, r)
+		return
+	}
+
+	proxy := g.match(r.URL.Path)
+	if proxy == nil {
+		g.ct.SendResponse(w, r, requests.Response{Status: http.StatusNotFound}, g.lg, time.Now())
+		return
+	}
+
+	r.Header.Del(middleware.UserIdHeader)
+	r.Header.Del(middleware.UserRoleHeader)
+	r.Header.Del(middleware.GatewayTokenHeader)
+	g.authenticate(r)
+
+	proxy.ServeHTTP(w, r)
+}
+
+func (g *Gateway) match(path string) *httputil.ReverseProxy {
+	for _, route := range g.routes {
+		if strings.HasPrefix(path, route.prefix) {
+			return route.proxy
+		}
+	}
+
+	return nil
+}
+
+// authenticate adds the identity headers for a valid session. Requests with
+// a missing or stale session go upstream anonymously, like AuthCheck does.
+// The token tells the upstream TrustGateway that the session is checked.
+func (g *Gateway) authenticate(r *http.Request) {
+	r.Header.Set(middleware.GatewayTokenHeader, g.secret)
+
+	session, err := r.Cookie("session_id")
+	if err != nil {
+		return
+	}
+
+	user, err := g.client.ValidateSession(r.Context(), &auth.ValidateSessionRequest{Sid: session.Value})
+	if err != nil {
+		g.lg.Error("auth check error", "err", err.Error())
+		return
+	}
+	r.Header.Set(middleware.UserIdHeader, strconv.FormatInt(user.Id, 10))
+	r.Header.Set(middleware.UserRoleHeader, user.Role)
+}
+
+func (g *Gateway) proxyError(w http.ResponseWriter, r *http.Request, err error) {
+	g.lg.Error("upstream error", "path", r.URL.Path, "err", err.Error())
+	g.ct.SendResponse(w, r, requests.Response{Status: http.StatusBadGateway}, g.lg, time.Now())
+}
+
+func setSecurityHeaders(header http.Header) {
+	header.Set("X-Content-Type-Options", "nosniff")
+	header.Set("X-Frame-Options", "DENY")
+	header.Set("Referrer-Policy", "no-referrer")
+	header.Set("Content-Security-Policy", "default-src 'none'; frame-ancestors 'none'")
+}
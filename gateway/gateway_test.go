@@ -0,0 +1,215 @@
+package gateway
+
+import (
+	"context"
+	"errors"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	auth "github.com/go-park-mail-ru/2023_2_Vkladyshi/authorization/proto"
+	"github.com/go-park-mail-ru/2023_2_Vkladyshi/configs"
+	"github.com/go-park-mail-ru/2023_2_Vkladyshi/pkg/middleware"
+	"google.golang.org/grpc"
+)
+
+type authClient struct {
+	auth.AuthorizationClient
+	calls int
+}
+
+func (c *authClient) ValidateSession(ctx context.Context, in *auth.ValidateSessionRequest, opts ...grpc.CallOption) (*auth.ValidateSessionResponse, error) {
+	c.calls++
+	if in.Sid != "good" {
+		return nil, errors.New("no session")
+	}
+
+	return &auth.ValidateSessionResponse{Id: 7, Login: "root", Role: "admin"}, nil
+}
+
+// upstream answers with its name and the identity headers it received.
+func upstream(name string) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		io.WriteString(w, name+" "+r.URL.Path+" "+r.Header.Get(middleware.UserIdHeader)+" "+r.Header.Get(middleware.UserRoleHeader))
+	}))
+}
+
+func newGateway(t *testing.T, client auth.AuthorizationClient, routes ...configs.RouteCfg) *Gateway {
+	config := &configs.GatewayCfg{
+		Routes: routes,
+		Cors: configs.CorsCfg{
+			AllowedOrigins:   []string{"https://movie-hub.ru"},
+			AllowedMethods:   []string{"GET", "POST"},
+			AllowedHeaders:   []string{"Content-Type"},
+			AllowCredentials: true,
+			MaxAge:           600,
+		},
+		Secret: "secret",
+	}
+	gw, err := New(config, client, slog.New(slog.NewTextHandler(io.Discard, nil)))
+	if err != nil {
+		t.Fatalf("New error: %s", err)
+	}
+
+	return gw
+}
+
+func TestRouting(t *testing.T) {
+	films := upstream("films")
+	defer films.Close()
+	comments := upstream("comments")
+	defer comments.Close()
+
+	client := &authClient{}
+	gw := newGateway(t, client,
+		configs.RouteCfg{Prefix: "/api/v1/", Upstream: films.URL},
+		configs.RouteCfg{Prefix: "/api/v1/comment", Upstream: comments.URL})
+
+	testCases := map[string]struct {
+		path    string
+		session string
+		expect  string
+	}{
+		"films":     {"/api/v1/film", "", "films /api/v1/film  "},
+		"comments":  {"/api/v1/comment/add", "", "comments /api/v1/comment/add  "},
+		"signed in": {"/api/v1/film", "good", "films /api/v1/film 7 admin"},
+		"stale":     {"/api/v1/film", "bad", "films /api/v1/film  "},
+	}
+
+	for name, curr := range testCases {
+		r := httptest.NewRequest(http.MethodGet, curr.path, nil)
+		r.Header.Set(middleware.UserIdHeader, "1")
+		r.Header.Set(middleware.UserRoleHeader, "admin")
+		if curr.session != "" {
+			r.AddCookie(&http.Cookie{Name: "session_id", Value: curr.session})
+		}
+		w := httptest.NewRecorder()
+		gw.ServeHTTP(w, r)
+
+		if w.Body.String() != curr.expect {
+			t.Errorf("%s: want %q, have %q", name, curr.expect, w.Body.String())
+		}
+		if w.Header().Get("X-Content-Type-Options") != "nosniff" {
+			t.Errorf("%s: security headers not set", name)
+		}
+	}
+	if client.calls != 2 {
+		t.Errorf("expected 2 session checks, have %d", client.calls)
+	}
+
+	w := httptest.NewRecorder()
+	gw.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/unknown", nil))
+	if !strings.Contains(w.Body.String(), `"status":404`) {
+		t.Errorf("unknown path not rejected: %s", w.Body.String())
+	}
+}
+
+func TestGatewayToken(t *testing.T) {
+	sessions := &upstreamCore{}
+	films := httptest.NewServer(middleware.TrustGateway(middleware.AuthCheck(
+		http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if user, ok := middleware.PrincipalFrom(r.Context()); ok {
+				io.WriteString(w, user.Role)
+			}
+		}), sessions, slog.New(slog.NewTextHandler(io.Discard, nil))), "secret"))
+	defer films.Close()
+
+	for secret, expect := range map[string]string{"secret": "admin", "other": "upstream"} {
+		sessions.calls = 0
+		client := &authClient{}
+		config := &configs.GatewayCfg{Routes: []configs.RouteCfg{{Prefix: "/api/v1/", Upstream: films.URL}}, Secret: secret}
+		gw, err := New(config, client, slog.New(slog.NewTextHandler(io.Discard, nil)))
+		if err != nil {
+			t.Fatalf("New error: %s", err)
+		}
+
+		r := httptest.NewRequest(http.MethodGet, "/api/v1/film", nil)
+		r.Header.Set(middleware.GatewayTokenHeader, "secret")
+		r.AddCookie(&http.Cookie{Name: "session_id", Value: "good"})
+		w := httptest.NewRecorder()
+		gw.ServeHTTP(w, r)
+
+		// The gateway replaces the token sent by the client with its own, so
+		// an upstream with another secret checks the session itself.
+		if w.Body.String() != expect || client.calls != 1 {
+			t.Errorf("secret %q: want %q, have %q after %d gateway checks", secret, expect, w.Body.String(), client.calls)
+		}
+		if secret == "secret" && sessions.calls != 0 || secret == "other" && sessions.calls != 1 {
+			t.Errorf("secret %q: unexpected %d upstream checks", secret, sessions.calls)
+		}
+	}
+}
+
+func TestGatewayNoSecret(t *testing.T) {
+	config := &configs.GatewayCfg{Routes: []configs.RouteCfg{{Prefix: "/api/v1/", Upstream: "http://films"}}}
+	_, err := New(config, &authClient{}, slog.New(slog.NewTextHandler(io.Discard, nil)))
+	if err == nil {
+		t.Errorf("expected the gateway not to start without a secret")
+	}
+}
+
+// upstreamCore is the session check of an upstream service.
+type upstreamCore struct {
+	calls int
+}
+
+func (c *upstreamCore) ValidateSession(ctx context.Context, sid string) (*middleware.Principal, error) {
+	c.calls++
+	return &middleware.Principal{Id: 7, Role: "upstream"}, nil
+}
+
+func TestCors(t *testing.T) {
+	gw := newGateway(t, &authClient{})
+
+	r := httptest.NewRequest(http.MethodOptions, "/api/v1/film", nil)
+	r.Header.Set("Origin", "https://movie-hub.ru")
+	r.Header.Set("Access-Control-Request-Method", "POST")
+	w := httptest.NewRecorder()
+	gw.ServeHTTP(w, r)
+
+	if w.Code != http.StatusNoContent {
+		t.Errorf("preflight status %d", w.Code)
+	}
+	if w.Header().Get("Access-Control-Allow-Origin") != "https://movie-hub.ru" ||
+		w.Header().Get("Access-Control-Allow-Methods") != "GET, POST" ||
+		w.Header().Get("Access-Control-Allow-Credentials") != "true" {
+		t.Errorf("unexpected preflight headers %v", w.Header())
+	}
+
+	r.Header.Set("Origin", "https://evil.example")
+	w = httptest.NewRecorder()
+	gw.ServeHTTP(w, r)
+	if w.Header().Get("Access-Control-Allow-Origin") != "" {
+		t.Errorf("foreign origin allowed")
+	}
+}
+
+func TestMetrics(t *testing.T) {
+	films := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		io.WriteString(w, "# TYPE Hits_Req counter\nHits_Req{path=\"/api/v1/film\",status=\"200\"} 3\n")
+	}))
+	defer films.Close()
+	down := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer down.Close()
+
+	gw := newGateway(t, &authClient{})
+	gw.upstreams = []configs.UpstreamCfg{{Service: "films", Url: films.URL}, {Service: "comments", Url: down.URL}}
+
+	w := httptest.NewRecorder()
+	gw.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/metrics", nil))
+
+	for _, expect := range []string{
+		`Hits_Req{path="/api/v1/film",service="films",status="200"} 3`,
+		`gateway_upstream_up{service="films"} 1`,
+		`gateway_upstream_up{service="comments"} 0`,
+	} {
+		if !strings.Contains(w.Body.String(), expect) {
+			t.Errorf("metrics miss %q:\n%s", expect, w.Body.String())
+		}
+	}
+}
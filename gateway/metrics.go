@@ -0,0 +1,131 @@
+package gateway
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sort"
+	"sync"
+
+	"github.com/prometheus/client_golang/prometheus"
+	dto "github.com/prometheus/client_model/go"
+	"github.com/prometheus/common/expfmt"
+	"google.golang.org/protobuf/proto"
+)
+
+type scrape struct {
+	families map[string]*dto.MetricFamily
+	err      error
+}
+
+// Metrics merges the metrics of the gateway and of every upstream into one
+// page. Each series gets a service label, and gateway_upstream_up tells
+// which upstreams could not be scraped.
+func (g *Gateway) Metrics(w http.ResponseWriter, r *http.Request) {
+	scrapes := make([]scrape, len(g.upstreams))
+	var wg sync.WaitGroup
+	for i, upstream := range g.upstreams {
+		wg.Add(1)
+		go func(i int, url string) {
+			defer wg.Done()
+			scrapes[i].families, scrapes[i].err = g.scrape(r.Context(), url)
+		}(i, upstream.Url)
+	}
+	wg.Wait()
+
+	families := map[string]*dto.MetricFamily{}
+	own, err := prometheus.DefaultGatherer.Gather()
+	if err != nil {
+		g.lg.Error("gather metrics error", "err", err.Error())
+	}
+	for _, family := range own {
+		g.merge(families, family, "gateway")
+	}
+
+	up := &dto.MetricFamily{
+		Name: proto.String("gateway_upstream_up"),
+		Help: proto.String("Whether the last scrape of the upstream succeeded."),
+		Type: dto.MetricType_GAUGE.Enum(),
+	}
+	for i, upstream := range g.upstreams {
+		value := 1.0
+		if scrapes[i].err != nil {
+			g.lg.Error("scrape upstream error", "service", upstream.Service, "err", scrapes[i].err.Error())
+			value = 0
+		}
+		for _, family := range scrapes[i].families {
+			g.merge(families, family, upstream.Service)
+		}
+		up.Metric = append(up.Metric, &dto.Metric{
+			Label: []*dto.LabelPair{{Name: proto.String("service"), Value: proto.String(upstream.Service)}},
+			Gauge: &dto.Gauge{Value: proto.Float64(value)},
+		})
+	}
+	families[up.GetName()] = up
+
+	names := make([]string, 0, len(families))
+	for name := range families {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	w.Header().Set("Content-Type", string(expfmt.FmtText))
+	for _, name := range names {
+		_, err = expfmt.MetricFamilyToText(w, families[name])
+		if err != nil {
+			g.lg.Error("failed to send metrics", "err", err.Error())
+			return
+		}
+	}
+}
+
+func (g *Gateway) scrape(ctx context.Context, url string) (map[string]*dto.MetricFamily, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return nil, fmt.Errorf("scrape err: %w", err)
+	}
+	resp, err := g.scraper.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("scrape err: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("scrape err: status %d", resp.StatusCode)
+	}
+
+	var parser expfmt.TextParser
+	families, err := parser.TextToMetricFamilies(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("parse metrics err: %w", err)
+	}
+
+	return families, nil
+}
+
+// merge adds the series of family to families under the service label. A
+// service label set by the upstream itself is kept as exported_service.
+func (g *Gateway) merge(families map[string]*dto.MetricFamily, family *dto.MetricFamily, service string) {
+	merged, ok := families[family.GetName()]
+	if !ok {
+		merged = &dto.MetricFamily{Name: family.Name, Help: family.Help, Type: family.Type}
+		families[family.GetName()] = merged
+	}
+	if merged.GetType() != family.GetType() {
+		g.lg.Error("metric type mismatch", "metric", family.GetName(), "service", service)
+		return
+	}
+
+	for _, metric := range family.Metric {
+		for _, label := range metric.Label {
+			if label.GetName() == "service" {
+				label.Name = proto.String("exported_service")
+			}
+		}
+		metric.Label = append(metric.Label, &dto.LabelPair{Name: proto.String("service"), Value: proto.String(service)})
+		sort.Slice(metric.Label, func(i, j int) bool {
+			return metric.Label[i].GetName() < metric.Label[j].GetName()
+		})
+		merged.Metric = append(merged.Metric, metric)
+	}
+}
@@ -0,0 +1,158 @@
+package grpcclient
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// ErrBreakerOpen is returned without calling the server while the breaker
+// is open, with the Unavailable code.
+var ErrBreakerOpen = status.Error(codes.Unavailable, "circuit breaker is open")
+
+type State int
+
+const (
+	StateClosed State = iota
+	StateHalfOpen
+	StateOpen
+)
+
+func (state State) String() string {
+	switch state {
+	case StateHalfOpen:
+		return "half-open"
+	case StateOpen:
+		return "open"
+	}
+
+	return "closed"
+}
+
+// breaker opens after threshold calls in a row have failed and rejects calls
+// for openTimeout. Then one probe call is let through: the breaker closes if
+// it succeeds and opens again if it fails.
+type breaker struct {
+	mutex       sync.Mutex
+	state       State
+	failures    int
+	probing     bool
+	openedAt    time.Time
+	threshold   int
+	openTimeout time.Duration
+	now         func() time.Time
+	onChange    func(State)
+}
+
+func newBreaker(threshold int, openTimeout time.Duration, onChange func(State)) *breaker {
+	return &breaker{
+		threshold:   threshold,
+		openTimeout: openTimeout,
+		now:         time.Now,
+		onChange:    onChange,
+	}
+}
+
+func (b *breaker) State() State {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+
+	return b.state
+}
+
+func (b *breaker) allow() bool {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+
+	switch b.state {
+	case StateOpen:
+		if b.now().Sub(b.openedAt) < b.openTimeout {
+			return false
+		}
+		b.setState(StateHalfOpen)
+		b.probing = true
+		return true
+	case StateHalfOpen:
+		if b.probing {
+			return false
+		}
+		b.probing = true
+		return true
+	}
+
+	return true
+}
+
+func (b *breaker) record(failed bool) {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+
+	b.probing = false
+	if !failed {
+		b.failures = 0
+		b.setState(StateClosed)
+		return
+	}
+
+	b.failures++
+	if b.state == StateHalfOpen || b.failures >= b.threshold {
+		b.openedAt = b.now()
+		b.setState(StateOpen)
+	}
+}
+
+func (b *breaker) setState(state State) {
+	if b.state == state {
+		return
+	}
+	b.state = state
+	if b.onChange != nil {
+		b.onChange(state)
+	}
+}
+
+// release lets the next probe through when a probe tells nothing about the
+// server, because the caller has canceled it.
+func (b *breaker) release() {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+
+	b.probing = false
+}
+
+// isFailure tells the errors of an unhealthy server from the answers of a
+// working one, like NotFound.
+func isFailure(err error) bool {
+	switch status.Code(err) {
+	case codes.Unavailable, codes.DeadlineExceeded, codes.ResourceExhausted:
+		return true
+	}
+
+	return false
+}
+
+func (b *breaker) interceptor(rejected func()) grpc.UnaryClientInterceptor {
+	return func(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn,
+		invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		if !b.allow() {
+			rejected()
+			return ErrBreakerOpen
+		}
+
+		// A call canceled or timed out by its caller tells nothing about the
+		// server. Only the deadline of the client, set after the breaker, is
+		// counted as a failure.
+		err := invoker(ctx, method, req, reply, cc, opts...)
+		if err != nil && ctx.Err() != nil {
+			b.release()
+			return err
+		}
+		b.record(isFailure(err))
+
+		return err
+	}
+}
@@ -0,0 +1,189 @@
+package grpcclient
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/go-park-mail-ru/2023_2_Vkladyshi/pkg/interceptors"
+	"github.com/prometheus/client_golang/prometheus"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	_ "google.golang.org/grpc/health" // client side health checking
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+)
+
+type Config struct {
+	// Service names the server in the metrics.
+	Service string
+	// Timeout is the deadline of calls made without one.
+	Timeout time.Duration
+	// Retry lists the calls that are safe to repeat: full method names like
+	// "/auth.Authorization/GetId" or service names for all their methods.
+	Retry       []string
+	MaxAttempts int
+	// FailureThreshold failed calls in a row open the breaker for OpenTimeout.
+	FailureThreshold int
+	OpenTimeout      time.Duration
+}
+
+func DefaultConfig(service string, retry ...string) Config {
+	return Config{
+		Service:          service,
+		Timeout:          2 * time.Second,
+		Retry:            retry,
+		MaxAttempts:      3,
+		FailureThreshold: 5,
+		OpenTimeout:      10 * time.Second,
+	}
+}
+
+var (
+	once         sync.Once
+	breakerState *prometheus.GaugeVec
+	rejected     *prometheus.CounterVec
+)
+
+// metrics registers the collectors once, so clients created in one process
+// share them.
+func metrics() {
+	once.Do(func() {
+		breakerState = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Name: "grpc_client_breaker_state",
+			Help: "Circuit breaker state of the gRPC client: 0 closed, 1 half-open, 2 open.",
+		}, []string{"service"})
+		rejected = prometheus.NewCounterVec(prometheus.CounterOpts{
+			Name: "grpc_client_breaker_rejected_total",
+			Help: "Calls rejected by the open circuit breaker.",
+		}, []string{"service"})
+
+		prometheus.MustRegister(breakerState, rejected)
+	})
+}
+
+// Dial connects to target with deadlines, retries, a circuit breaker, health
+// checking and the metrics and request ids of interceptors. Unless opts set other credentials the connection is not
+// encrypted.
+func Dial(target string, config Config, opts ...grpc.DialOption) (*grpc.ClientConn, error) {
+	serviceConfig, err := buildServiceConfig(config)
+	if err != nil {
+		return nil, fmt.Errorf("grpc service config err: %w", err)
+	}
+
+	metrics()
+	state := breakerState.WithLabelValues(config.Service)
+	state.Set(float64(StateClosed))
+	b := newBreaker(config.FailureThreshold, config.OpenTimeout, func(s State) {
+		state.Set(float64(s))
+	})
+	rejectedCalls := rejected.WithLabelValues(config.Service)
+
+	dialOpts := []grpc.DialOption{
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		grpc.WithDefaultServiceConfig(serviceConfig),
+		grpc.WithChainUnaryInterceptor(interceptors.UnaryClient(config.Service),
+			b.interceptor(rejectedCalls.Inc), deadline(config.Timeout)),
+		grpc.WithChainStreamInterceptor(interceptors.StreamClient(config.Service)),
+	}
+	conn, err := grpc.Dial(target, append(dialOpts, opts...)...)
+	if err != nil {
+		return nil, fmt.Errorf("grpc connect err: %w", err)
+	}
+
+	return conn, nil
+}
+
+func deadline(timeout time.Duration) grpc.UnaryClientInterceptor {
+	return func(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn,
+		invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		if _, ok := ctx.Deadline(); !ok && timeout > 0 {
+			var cancel context.CancelFunc
+			ctx, cancel = context.WithTimeout(ctx, timeout)
+			defer cancel()
+		}
+
+		return invoker(ctx, method, req, reply, cc, opts...)
+	}
+}
+
+type methodName struct {
+	Service string `json:"service"`
+	Method  string `json:"method,omitempty"`
+}
+
+type retryPolicy struct {
+	MaxAttempts          int      `json:"maxAttempts"`
+	InitialBackoff       string   `json:"initialBackoff"`
+	MaxBackoff           string   `json:"maxBackoff"`
+	BackoffMultiplier    float64  `json:"backoffMultiplier"`
+	RetryableStatusCodes []string `json:"retryableStatusCodes"`
+}
+
+type methodConfig struct {
+	Name        []methodName `json:"name"`
+	RetryPolicy retryPolicy  `json:"retryPolicy"`
+}
+
+type serviceConfig struct {
+	LoadBalancingConfig []map[string]struct{} `json:"loadBalancingConfig"`
+	MethodConfig        []methodConfig        `json:"methodConfig,omitempty"`
+	HealthCheckConfig   struct {
+		ServiceName string `json:"serviceName"`
+	} `json:"healthCheckConfig"`
+}
+
+// buildServiceConfig turns on health checking, which needs round_robin, and
+// retries of the listed calls while the server is unavailable.
+func buildServiceConfig(config Config) (string, error) {
+	result := serviceConfig{LoadBalancingConfig: []map[string]struct{}{{"round_robin": {}}}}
+
+	if len(config.Retry) != 0 && config.MaxAttempts > 1 {
+		names := make([]methodName, 0, len(config.Retry))
+		for _, name := range config.Retry {
+			service, method, _ := strings.Cut(strings.TrimPrefix(name, "/"), "/")
+			if service == "" {
+				return "", fmt.Errorf("bad method name %q", name)
+			}
+			names = append(names, methodName{Service: service, Method: method})
+		}
+
+		result.MethodConfig = []methodConfig{{
+			Name: names,
+			RetryPolicy: retryPolicy{
+				MaxAttempts:          config.MaxAttempts,
+				InitialBackoff:       "0.1s",
+				MaxBackoff:           "1s",
+				BackoffMultiplier:    2,
+				RetryableStatusCodes: []string{"UNAVAILABLE"},
+			},
+		}}
+	}
+
+	data, err := json.Marshal(result)
+	if err != nil {
+		return "", err
+	}
+
+	return string(data), nil
+}
+
+// HealthCheck asks the grpc.health.v1 service behind conn whether service is
+// serving, for the health checker of the caller.
+func HealthCheck(conn grpc.ClientConnInterface, service string) func(ctx context.Context) error {
+	client := healthpb.NewHealthClient(conn)
+
+	return func(ctx context.Context) error {
+		response, err := client.Check(ctx, &healthpb.HealthCheckRequest{Service: service})
+		if err != nil {
+			return err
+		}
+		if response.Status != healthpb.HealthCheckResponse_SERVING {
+			return fmt.Errorf("%s is %s", service, response.Status)
+		}
+
+		return nil
+	}
+}
@@ -0,0 +1,168 @@
+package grpcclient
+
+import (
+	"context"
+	"net"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+func TestBreaker(t *testing.T) {
+	now := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	var states []State
+	b := newBreaker(2, time.Second, func(s State) { states = append(states, s) })
+	b.now = func() time.Time { return now }
+
+	b.record(true)
+	if !b.allow() || b.State() != StateClosed {
+		t.Errorf("expected the breaker to stay closed after one failure")
+	}
+	b.record(true)
+	if b.allow() || b.State() != StateOpen {
+		t.Errorf("expected the breaker to open")
+	}
+
+	now = now.Add(time.Second)
+	if !b.allow() || b.State() != StateHalfOpen {
+		t.Errorf("expected a probe after the open timeout")
+	}
+	if b.allow() {
+		t.Errorf("expected one probe at a time")
+	}
+	b.record(true)
+	if b.State() != StateOpen {
+		t.Errorf("expected a failed probe to open the breaker")
+	}
+
+	now = now.Add(time.Second)
+	b.allow()
+	b.record(false)
+	if b.State() != StateClosed {
+		t.Errorf("expected a good probe to close the breaker")
+	}
+
+	expect := []State{StateOpen, StateHalfOpen, StateOpen, StateHalfOpen, StateClosed}
+	if len(states) != len(expect) {
+		t.Fatalf("states = %v", states)
+	}
+	for i := range expect {
+		if states[i] != expect[i] {
+			t.Errorf("states = %v", states)
+		}
+	}
+}
+
+// healthServer fails the first calls and hangs on the service "slow".
+type healthServer struct {
+	healthpb.UnimplementedHealthServer
+	failures atomic.Int32
+	calls    atomic.Int32
+}
+
+func (s *healthServer) Check(ctx context.Context, req *healthpb.HealthCheckRequest) (*healthpb.HealthCheckResponse, error) {
+	s.calls.Add(1)
+	if req.Service == "slow" {
+		<-ctx.Done()
+		return nil, ctx.Err()
+	}
+	if s.failures.Add(-1) >= 0 {
+		return nil, status.Error(codes.Unavailable, "try again")
+	}
+
+	return &healthpb.HealthCheckResponse{Status: healthpb.HealthCheckResponse_SERVING}, nil
+}
+
+func dial(t *testing.T, config Config) (*healthServer, healthpb.HealthClient) {
+	lis := bufconn.Listen(1 << 20)
+	server := &healthServer{}
+	s := grpc.NewServer()
+	healthpb.RegisterHealthServer(s, server)
+	go s.Serve(lis)
+	t.Cleanup(s.Stop)
+
+	conn, err := Dial("test", config, grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+		return lis.DialContext(ctx)
+	}))
+	if err != nil {
+		t.Fatalf("Dial error: %s", err)
+	}
+	t.Cleanup(func() { conn.Close() })
+
+	return server, healthpb.NewHealthClient(conn)
+}
+
+func TestDialRetries(t *testing.T) {
+	config := DefaultConfig(t.Name(), healthpb.Health_ServiceDesc.ServiceName)
+	server, client := dial(t, config)
+
+	server.failures.Store(2)
+	_, err := client.Check(context.Background(), &healthpb.HealthCheckRequest{})
+	if err != nil {
+		t.Errorf("expected the call to succeed after retries, got %s", err)
+	}
+	if server.calls.Load() != 3 {
+		t.Errorf("expected 3 attempts, have %d", server.calls.Load())
+	}
+}
+
+func TestDialDeadlineAndBreaker(t *testing.T) {
+	config := DefaultConfig(t.Name())
+	config.Timeout = 10 * time.Millisecond
+	config.FailureThreshold = 2
+	config.OpenTimeout = time.Minute
+	server, client := dial(t, config)
+	rejectedBefore := testutil.ToFloat64(rejected.WithLabelValues(t.Name()))
+
+	for i := 0; i < 2; i++ {
+		_, err := client.Check(context.Background(), &healthpb.HealthCheckRequest{Service: "slow"})
+		if status.Code(err) != codes.DeadlineExceeded {
+			t.Errorf("expected deadline exceeded, got %v", err)
+		}
+	}
+
+	_, err := client.Check(context.Background(), &healthpb.HealthCheckRequest{})
+	if err != ErrBreakerOpen {
+		t.Errorf("expected the open breaker to reject the call, got %v", err)
+	}
+	if server.calls.Load() != 2 {
+		t.Errorf("expected the rejected call not to reach the server, have %d calls", server.calls.Load())
+	}
+
+	if state := testutil.ToFloat64(breakerState.WithLabelValues(t.Name())); state != float64(StateOpen) {
+		t.Errorf("breaker state metric = %v", state)
+	}
+	if count := testutil.ToFloat64(rejected.WithLabelValues(t.Name())) - rejectedBefore; count != 1 {
+		t.Errorf("rejected metric grew by %v", count)
+	}
+}
+
+func TestCallerDeadlineKeepsBreakerClosed(t *testing.T) {
+	config := DefaultConfig(t.Name())
+	config.Timeout = time.Minute
+	config.FailureThreshold = 1
+	config.OpenTimeout = time.Minute
+	_, client := dial(t, config)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	_, err := client.Check(ctx, &healthpb.HealthCheckRequest{Service: "slow"})
+	if status.Code(err) != codes.DeadlineExceeded {
+		t.Errorf("expected deadline exceeded, got %v", err)
+	}
+
+	_, err = client.Check(context.Background(), &healthpb.HealthCheckRequest{})
+	if err != nil {
+		t.Errorf("expected the deadline of the caller not to open the breaker, got %v", err)
+	}
+	if state := testutil.ToFloat64(breakerState.WithLabelValues(t.Name())); state != float64(StateClosed) {
+		t.Errorf("breaker state metric = %v", state)
+	}
+}
@@ -0,0 +1,261 @@
+package healthcheck
+
+import (
+	"context"
+	"encoding/json"
+	"log/slog"
+	"net/http"
+	"sync"
+	"time"
+
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+)
+
+const (
+	DefaultInterval = 10 * time.Second
+	checkTimeout    = 2 * time.Second
+)
+
+type Check func(ctx context.Context) error
+
+// Pinger is a dependency that can tell whether it is reachable, like a
+// Postgres or Redis repository. Memory repositories are not.
+type Pinger interface {
+	Ping(ctx context.Context) error
+}
+
+type Status struct {
+	Name      string  `json:"name"`
+	Healthy   bool    `json:"healthy"`
+	LatencyMs float64 `json:"latency_ms"`
+	Error     string  `json:"error,omitempty"`
+}
+
+type dependency struct {
+	name  string
+	check Check
+}
+
+// Checker checks the dependencies of a service in the background and keeps
+// their latest status for the probes. A nil Checker checks nothing, so tests
+// and tools can go without one.
+type Checker struct {
+	mutex     sync.RWMutex
+	lg        *slog.Logger
+	deps      []dependency
+	statuses  map[string]Status
+	ready     bool
+	listeners []func(ready bool)
+}
+
+func NewChecker(lg *slog.Logger) *Checker {
+	return &Checker{lg: lg.With("module", "health"), statuses: map[string]Status{}}
+}
+
+func (c *Checker) Add(name string, check Check) {
+	if c == nil {
+		return
+	}
+
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	c.deps = append(c.deps, dependency{name: name, check: check})
+	c.ready = false
+}
+
+// AddPinger adds dependency if it is a Pinger and skips it otherwise.
+func (c *Checker) AddPinger(name string, dependency interface{}) {
+	if pinger, ok := dependency.(Pinger); ok {
+		c.Add(name, pinger.Ping)
+	}
+}
+
+// OnChange calls listener with the current readiness and again every time it
+// changes.
+func (c *Checker) OnChange(listener func(ready bool)) {
+	if c == nil {
+		return
+	}
+
+	c.mutex.Lock()
+	c.listeners = append(c.listeners, listener)
+	ready := c.isReady()
+	c.mutex.Unlock()
+
+	listener(ready)
+}
+
+// CheckAll checks every dependency at once. A dependency is logged when it
+// goes down and when it comes back, not on every failed check.
+func (c *Checker) CheckAll(ctx context.Context) {
+	if c == nil {
+		return
+	}
+
+	c.mutex.RLock()
+	deps := c.deps
+	c.mutex.RUnlock()
+
+	results := make([]Status, len(deps))
+	var wg sync.WaitGroup
+	for i, dep := range deps {
+		wg.Add(1)
+		go func(i int, dep dependency) {
+			defer wg.Done()
+			results[i] = run(ctx, dep)
+		}(i, dep)
+	}
+	wg.Wait()
+
+	c.mutex.Lock()
+	wasReady := c.isReady()
+	ready := true
+	for _, result := range results {
+		prev, checked := c.statuses[result.Name]
+		switch {
+		case !result.Healthy && (!checked || prev.Healthy):
+			c.lg.Error("dependency is down", "dependency", result.Name, "err", result.Error)
+		case result.Healthy && checked && !prev.Healthy:
+			c.lg.Info("dependency is up", "dependency", result.Name)
+		}
+		c.statuses[result.Name] = result
+		ready = ready && result.Healthy
+	}
+	changed := ready != wasReady
+	c.ready = ready
+	listeners := c.listeners
+	c.mutex.Unlock()
+
+	if changed {
+		for _, listener := range listeners {
+			listener(ready)
+		}
+	}
+}
+
+func run(ctx context.Context, dep dependency) Status {
+	ctx, cancel := context.WithTimeout(ctx, checkTimeout)
+	defer cancel()
+
+	start := time.Now()
+	err := dep.check(ctx)
+	status := Status{
+		Name:      dep.name,
+		Healthy:   err == nil,
+		LatencyMs: float64(time.Since(start).Microseconds()) / 1000,
+	}
+	if err != nil {
+		status.Error = err.Error()
+	}
+
+	return status
+}
+
+// Run checks the dependencies every interval until ctx is done.
+func (c *Checker) Run(ctx context.Context, interval time.Duration) {
+	if c == nil {
+		return
+	}
+	if interval <= 0 {
+		interval = DefaultInterval
+	}
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		c.CheckAll(ctx)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// Statuses returns the latest status of each dependency in the order they
+// were added, dependencies not checked yet are left out.
+func (c *Checker) Statuses() []Status {
+	if c == nil {
+		return nil
+	}
+
+	c.mutex.RLock()
+	defer c.mutex.RUnlock()
+
+	statuses := make([]Status, 0, len(c.deps))
+	for _, dep := range c.deps {
+		if status, ok := c.statuses[dep.name]; ok {
+			statuses = append(statuses, status)
+		}
+	}
+
+	return statuses
+}
+
+// Ready tells whether every dependency was healthy at the last check.
+func (c *Checker) Ready() bool {
+	if c == nil {
+		return true
+	}
+
+	c.mutex.RLock()
+	defer c.mutex.RUnlock()
+
+	return c.isReady()
+}
+
+func (c *Checker) isReady() bool {
+	return c.ready || len(c.deps) == 0
+}
+
+type readiness struct {
+	Ready        bool     `json:"ready"`
+	Dependencies []Status `json:"dependencies"`
+}
+
+// Register adds /healthz, which answers while the process is up, and
+// /readyz, which answers 503 while a dependency is down, to mx.
+func (c *Checker) Register(mx *http.ServeMux) {
+	if c == nil {
+		return
+	}
+
+	mx.HandleFunc("/healthz", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	})
+	mx.HandleFunc("/readyz", c.readyz)
+}
+
+func (c *Checker) readyz(w http.ResponseWriter, r *http.Request) {
+	body := readiness{Ready: c.Ready(), Dependencies: c.Statuses()}
+	data, err := json.Marshal(body)
+	if err != nil {
+		w.WriteHeader(http.StatusInternalServerError)
+		c.lg.Error("failed to pack json", "err", err.Error())
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	if !body.Ready {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}
+	w.Write(data)
+}
+
+// ServeGrpc keeps the grpc.health.v1 status of the whole server and of the
+// named services in step with the readiness of c.
+func (c *Checker) ServeGrpc(server *health.Server, services ...string) {
+	c.OnChange(func(ready bool) {
+		status := healthpb.HealthCheckResponse_NOT_SERVING
+		if ready {
+			status = healthpb.HealthCheckResponse_SERVING
+		}
+		server.SetServingStatus("", status)
+		for _, service := range services {
+			server.SetServingStatus(service, status)
+		}
+	})
+}
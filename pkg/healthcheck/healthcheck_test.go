@@ -0,0 +1,112 @@
+package healthcheck
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+)
+
+type pinger struct {
+	err error
+}
+
+func (p *pinger) Ping(ctx context.Context) error {
+	return p.err
+}
+
+func readyz(t *testing.T, mx *http.ServeMux) (int, readiness) {
+	w := httptest.NewRecorder()
+	mx.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/readyz", nil))
+
+	var body readiness
+	if err := json.Unmarshal(w.Body.Bytes(), &body); err != nil {
+		t.Fatalf("bad readyz body %q: %s", w.Body.String(), err)
+	}
+
+	return w.Code, body
+}
+
+func TestChecker(t *testing.T) {
+	checker := NewChecker(slog.New(slog.NewTextHandler(io.Discard, nil)))
+	db := &pinger{}
+	checker.AddPinger("postgres films", db)
+	checker.AddPinger("memory genres", struct{}{})
+	checker.Add("grpc auth", func(ctx context.Context) error { return nil })
+
+	var changes []bool
+	checker.OnChange(func(ready bool) { changes = append(changes, ready) })
+	server := health.NewServer()
+	checker.ServeGrpc(server, "films.Films")
+
+	mx := http.NewServeMux()
+	checker.Register(mx)
+
+	code, body := readyz(t, mx)
+	if code != http.StatusServiceUnavailable || body.Ready || len(body.Dependencies) != 0 {
+		t.Errorf("expected not ready before the first check, got %d %+v", code, body)
+	}
+
+	checker.CheckAll(context.Background())
+	code, body = readyz(t, mx)
+	if code != http.StatusOK || !body.Ready || len(body.Dependencies) != 2 {
+		t.Errorf("expected ready, got %d %+v", code, body)
+	}
+
+	db.err = errors.New("connection refused")
+	checker.CheckAll(context.Background())
+	code, body = readyz(t, mx)
+	if code != http.StatusServiceUnavailable || body.Ready {
+		t.Errorf("expected not ready, got %d %+v", code, body)
+	}
+	if dep := body.Dependencies[0]; dep.Name != "postgres films" || dep.Healthy || dep.Error != "connection refused" {
+		t.Errorf("unexpected status %+v", dep)
+	}
+	if dep := body.Dependencies[1]; dep.Name != "grpc auth" || !dep.Healthy {
+		t.Errorf("unexpected status %+v", dep)
+	}
+
+	response, err := server.Check(context.Background(), &healthpb.HealthCheckRequest{Service: "films.Films"})
+	if err != nil || response.Status != healthpb.HealthCheckResponse_NOT_SERVING {
+		t.Errorf("expected the grpc status to follow, got %v %v", response, err)
+	}
+
+	if len(changes) != 3 || changes[0] || !changes[1] || changes[2] {
+		t.Errorf("changes = %v", changes)
+	}
+
+	w := httptest.NewRecorder()
+	mx.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/healthz", nil))
+	if w.Code != http.StatusOK {
+		t.Errorf("expected healthz to answer while a dependency is down, got %d", w.Code)
+	}
+}
+
+func TestNoDependencies(t *testing.T) {
+	checker := NewChecker(slog.New(slog.NewTextHandler(io.Discard, nil)))
+	server := health.NewServer()
+	checker.ServeGrpc(server)
+
+	if !checker.Ready() {
+		t.Errorf("expected a service without dependencies to be ready")
+	}
+	response, err := server.Check(context.Background(), &healthpb.HealthCheckRequest{})
+	if err != nil || response.Status != healthpb.HealthCheckResponse_SERVING {
+		t.Errorf("expected serving, got %v %v", response, err)
+	}
+
+	var nilChecker *Checker
+	nilChecker.Add("postgres films", func(ctx context.Context) error { return nil })
+	nilChecker.CheckAll(context.Background())
+	nilChecker.Register(http.NewServeMux())
+	if !nilChecker.Ready() {
+		t.Errorf("expected a nil checker to be ready")
+	}
+}
@@ -0,0 +1,255 @@
+package images
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"image"
+	"image/color"
+	"image/jpeg"
+	"io"
+	"net/http"
+	"regexp"
+	"strings"
+
+	_ "image/gif"
+	_ "image/png"
+
+	"github.com/HugoSmits86/nativewebp"
+	"github.com/go-park-mail-ru/2023_2_Vkladyshi/pkg/models"
+	"github.com/go-park-mail-ru/2023_2_Vkladyshi/pkg/storage"
+	"golang.org/x/image/draw"
+	_ "golang.org/x/image/webp"
+)
+
+const (
+	MaxUploadSize = 10 << 20
+	maxPixels     = 50_000_000
+	maxWidth      = 2000
+	jpegQuality   = 85
+)
+
+var (
+	ErrTooLarge    = errors.New("image too large")
+	ErrUnsupported = errors.New("unsupported image type")
+	ErrBadImage    = errors.New("bad image")
+)
+
+type Size struct {
+	Name  string
+	Width int
+}
+
+// Sizes are the thumbnails generated next to the full image. Every size,
+// including the full one, is stored both as JPEG and as WebP.
+var Sizes = []Size{
+	{Name: "small", Width: 160},
+	{Name: "medium", Width: 320},
+	{Name: "large", Width: 640},
+}
+
+var allowedTypes = map[string]struct{}{
+	"image/jpeg": {},
+	"image/png":  {},
+	"image/gif":  {},
+	"image/webp": {},
+}
+
+var hashedName = regexp.MustCompile(`^(.*/)?([0-9a-f]{64})\.jpg$`)
+
+type Image struct {
+	Hash        string
+	Files       map[string][]byte
+	Placeholder models.Placeholder
+}
+
+func (img *Image) Name() string {
+	return img.Hash + ".jpg"
+}
+
+// Process validates and decodes an upload and re-encodes it into every
+// variant. Re-encoding drops EXIF and any other embedded metadata.
+func Process(r io.Reader) (*Image, error) {
+	data, err := io.ReadAll(io.LimitReader(r, MaxUploadSize+1))
+	if err != nil {
+		return nil, fmt.Errorf("read image err: %w", err)
+	}
+	if len(data) > MaxUploadSize {
+		return nil, ErrTooLarge
+	}
+	if _, ok := allowedTypes[http.DetectContentType(data)]; !ok {
+		return nil, ErrUnsupported
+	}
+
+	config, _, err := image.DecodeConfig(bytes.NewReader(data))
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", ErrBadImage, err.Error())
+	}
+	if config.Width <= 0 || config.Height <= 0 {
+		return nil, ErrBadImage
+	}
+	if config.Width*config.Height > maxPixels {
+		return nil, ErrTooLarge
+	}
+
+	src, _, err := image.Decode(bytes.NewReader(data))
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", ErrBadImage, err.Error())
+	}
+
+	sum := sha256.Sum256(data)
+	result := &Image{Hash: hex.EncodeToString(sum[:]), Files: map[string][]byte{}}
+
+	err = result.add(result.Hash, resize(src, maxWidth))
+	if err != nil {
+		return nil, err
+	}
+	for _, size := range Sizes {
+		err = result.add(result.Hash+"_"+size.Name, resize(src, size.Width))
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	result.Placeholder, err = Describe(src)
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+func (img *Image) add(name string, src image.Image) error {
+	var buf bytes.Buffer
+	err := jpeg.Encode(&buf, src, &jpeg.Options{Quality: jpegQuality})
+	if err != nil {
+		return fmt.Errorf("encode jpeg err: %w", err)
+	}
+	img.Files[name+".jpg"] = buf.Bytes()
+
+	var webpBuf bytes.Buffer
+	err = nativewebp.Encode(&webpBuf, src, nil)
+	if err != nil {
+		return fmt.Errorf("encode webp err: %w", err)
+	}
+	img.Files[name+".webp"] = webpBuf.Bytes()
+
+	return nil
+}
+
+// resize scales src down to width keeping the aspect ratio and flattens
+// transparency onto white, since the JPEG variants have no alpha channel.
+func resize(src image.Image, width int) image.Image {
+	bounds := src.Bounds()
+	if bounds.Dx() < width {
+		width = bounds.Dx()
+	}
+	height := bounds.Dy() * width / bounds.Dx()
+	if height == 0 {
+		height = 1
+	}
+
+	dst := image.NewRGBA(image.Rect(0, 0, width, height))
+	draw.Draw(dst, dst.Bounds(), image.NewUniform(color.White), image.Point{}, draw.Src)
+	draw.CatmullRom.Scale(dst, dst.Bounds(), src, bounds, draw.Over, nil)
+
+	return dst
+}
+
+// Save puts every variant under dir and returns the URL of the full size
+// JPEG. Objects are content addressed, so existing ones are kept as is.
+func Save(ctx context.Context, store storage.Storage, dir string, img *Image) (string, error) {
+	dir = strings.Trim(dir, "/")
+	for name, content := range img.Files {
+		key := dir + "/" + name
+		found, err := store.Exists(ctx, key)
+		if err != nil {
+			return "", fmt.Errorf("save image err: %w", err)
+		}
+		if found {
+			continue
+		}
+
+		err = store.Put(ctx, key, content, contentType(name))
+		if err != nil {
+			return "", fmt.Errorf("save image err: %w", err)
+		}
+	}
+
+	return store.URL(dir + "/" + img.Name()), nil
+}
+
+// Delete removes an image saved by Save together with all of its variants.
+// Other URLs, such as the default avatar shipped with the frontend, are left
+// alone.
+func Delete(ctx context.Context, store storage.Storage, url string) error {
+	for _, variant := range Variants(url) {
+		key, ok := store.Key(variant)
+		if !ok {
+			continue
+		}
+		err := store.Delete(ctx, key)
+		if err != nil {
+			return fmt.Errorf("delete image err: %w", err)
+		}
+	}
+
+	return nil
+}
+
+func contentType(name string) string {
+	if strings.HasSuffix(name, ".webp") {
+		return "image/webp"
+	}
+
+	return "image/jpeg"
+}
+
+// Variants lists the URLs of every stored variant of an image saved by Save.
+// Images uploaded before the pipeline existed have no variants.
+func Variants(url string) map[string]string {
+	match := hashedName.FindStringSubmatch(url)
+	if match == nil {
+		return nil
+	}
+	base := match[1] + match[2]
+
+	variants := map[string]string{
+		"original":      base + ".jpg",
+		"original_webp": base + ".webp",
+	}
+	for _, size := range Sizes {
+		variants[size.Name] = base + "_" + size.Name + ".jpg"
+		variants[size.Name+"_webp"] = base + "_" + size.Name + ".webp"
+	}
+
+	return variants
+}
+
+func StatusCode(err error) int {
+	switch {
+	case errors.Is(err, ErrTooLarge):
+		return http.StatusRequestEntityTooLarge
+	case errors.Is(err, ErrUnsupported):
+		return http.StatusUnsupportedMediaType
+	case errors.Is(err, ErrBadImage):
+		return http.StatusBadRequest
+	default:
+		return http.StatusInternalServerError
+	}
+}
+
+// FormStatus maps an error of parsing an upload form to the response status.
+// Bodies cut short by http.MaxBytesReader are too large, the rest are bad
+// requests.
+func FormStatus(err error) int {
+	var tooLarge *http.MaxBytesError
+	if errors.As(err, &tooLarge) {
+		return http.StatusRequestEntityTooLarge
+	}
+
+	return http.StatusBadRequest
+}
@@ -0,0 +1,341 @@
+package images
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"image"
+	"image/color"
+	"image/gif"
+	"image/jpeg"
+	"image/png"
+	"mime/multipart"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/HugoSmits86/nativewebp"
+	"github.com/go-park-mail-ru/2023_2_Vkladyshi/configs"
+	"github.com/go-park-mail-ru/2023_2_Vkladyshi/pkg/storage"
+)
+
+func solid(width int, height int, c color.Color) *image.RGBA {
+	img := image.NewRGBA(image.Rect(0, 0, width, height))
+	for y := 0; y < height; y++ {
+		for x := 0; x < width; x++ {
+			img.Set(x, y, c)
+		}
+	}
+
+	return img
+}
+
+func encode(t *testing.T, format string, img image.Image) []byte {
+	var buf bytes.Buffer
+	var err error
+	switch format {
+	case "jpeg":
+		err = jpeg.Encode(&buf, img, nil)
+	case "png":
+		err = png.Encode(&buf, img)
+	case "gif":
+		err = gif.Encode(&buf, img, nil)
+	case "webp":
+		err = nativewebp.Encode(&buf, img, nil)
+	}
+	if err != nil {
+		t.Fatalf("encode %s err: %s", format, err)
+	}
+
+	return buf.Bytes()
+}
+
+func TestProcess(t *testing.T) {
+	red := solid(800, 400, color.RGBA{R: 255, A: 255})
+
+	for _, format := range []string{"jpeg", "png", "gif", "webp"} {
+		data := encode(t, format, red)
+		img, err := Process(bytes.NewReader(data))
+		if err != nil {
+			t.Errorf("%s: unexpected error %s", format, err)
+			continue
+		}
+		if len(img.Hash) != 64 {
+			t.Errorf("%s: unexpected hash %q", format, img.Hash)
+		}
+		if img.Name() != img.Hash+".jpg" {
+			t.Errorf("%s: unexpected name %s", format, img.Name())
+		}
+		if len(img.Files) != 2*(len(Sizes)+1) {
+			t.Errorf("%s: unexpected number of files %d", format, len(img.Files))
+		}
+
+		widths := map[string]int{img.Hash: 800}
+		for _, size := range Sizes {
+			widths[img.Hash+"_"+size.Name] = size.Width
+		}
+		for name, width := range widths {
+			config, kind, err := image.DecodeConfig(bytes.NewReader(img.Files[name+".jpg"]))
+			if err != nil || kind != "jpeg" {
+				t.Errorf("%s: %s.jpg is not a jpeg: %s %v", format, name, kind, err)
+				continue
+			}
+			if config.Width != width || config.Height != width/2 {
+				t.Errorf("%s: %s.jpg is %dx%d, want %dx%d", format, name, config.Width, config.Height, width, width/2)
+			}
+			config, kind, err = image.DecodeConfig(bytes.NewReader(img.Files[name+".webp"]))
+			if err != nil || kind != "webp" {
+				t.Errorf("%s: %s.webp is not a webp: %s %v", format, name, kind, err)
+				continue
+			}
+			if config.Width != width {
+				t.Errorf("%s: %s.webp is %d wide, want %d", format, name, config.Width, width)
+			}
+		}
+		// JPEG is lossy, the other formats keep the colour exact.
+		if format != "jpeg" && img.Placeholder.Dominant != "#ff0000" {
+			t.Errorf("%s: unexpected dominant colour %s", format, img.Placeholder.Dominant)
+		}
+	}
+}
+
+func TestProcessErrors(t *testing.T) {
+	huge := encode(t, "png", image.NewGray(image.Rect(0, 0, 10000, 5001)))
+
+	testCases := map[string]struct {
+		data []byte
+		err  error
+	}{
+		"too large upload": {
+			data: make([]byte, MaxUploadSize+1),
+			err:  ErrTooLarge,
+		},
+		"too many pixels": {
+			data: huge,
+			err:  ErrTooLarge,
+		},
+		"text": {
+			data: []byte("definitely not an image"),
+			err:  ErrUnsupported,
+		},
+		"bmp": {
+			data: append([]byte("BM"), make([]byte, 64)...),
+			err:  ErrUnsupported,
+		},
+		"broken png": {
+			data: encode(t, "png", solid(10, 10, color.White))[:40],
+			err:  ErrBadImage,
+		},
+	}
+
+	for name, curr := range testCases {
+		_, err := Process(bytes.NewReader(curr.data))
+		if !errors.Is(err, curr.err) {
+			t.Errorf("%s: expected %v, got %v", name, curr.err, err)
+		}
+	}
+}
+
+func TestResize(t *testing.T) {
+	testCases := map[string]struct {
+		width  int
+		height int
+		target int
+		want   image.Point
+	}{
+		"downscale": {
+			width:  1000,
+			height: 1500,
+			target: 200,
+			want:   image.Point{X: 200, Y: 300},
+		},
+		"capped at max width": {
+			width:  3000,
+			height: 1500,
+			target: maxWidth,
+			want:   image.Point{X: maxWidth, Y: maxWidth / 2},
+		},
+		"no upscale": {
+			width:  100,
+			height: 50,
+			target: 640,
+			want:   image.Point{X: 100, Y: 50},
+		},
+		"thin strip": {
+			width:  1000,
+			height: 1,
+			target: 10,
+			want:   image.Point{X: 10, Y: 1},
+		},
+	}
+
+	for name, curr := range testCases {
+		got := resize(solid(curr.width, curr.height, color.Black), curr.target).Bounds().Size()
+		if got != curr.want {
+			t.Errorf("%s: got %v, want %v", name, got, curr.want)
+		}
+	}
+
+	transparent := resize(image.NewRGBA(image.Rect(0, 0, 4, 4)), 4)
+	r, g, b, a := transparent.At(1, 1).RGBA()
+	if r>>8 != 255 || g>>8 != 255 || b>>8 != 255 || a>>8 != 255 {
+		t.Errorf("expected transparency to be flattened onto white, got %d %d %d %d", r>>8, g>>8, b>>8, a>>8)
+	}
+}
+
+type failingStore struct {
+	storage.Storage
+	failExists bool
+	puts       int
+}
+
+func (f *failingStore) Exists(ctx context.Context, key string) (bool, error) {
+	if f.failExists {
+		return false, fmt.Errorf("exists failed")
+	}
+	return false, nil
+}
+
+func (f *failingStore) Put(ctx context.Context, key string, data []byte, contentType string) error {
+	f.puts++
+	return fmt.Errorf("put failed")
+}
+
+func TestSave(t *testing.T) {
+	ctx := context.Background()
+	root := t.TempDir()
+	store := storage.NewLocal(configs.LocalStoreCfg{Root: root, BaseURL: "/media/"})
+
+	img, err := Process(bytes.NewReader(encode(t, "png", solid(400, 200, color.White))))
+	if err != nil {
+		t.Fatalf("unexpected error %s", err)
+	}
+
+	url, err := Save(ctx, store, "/posters/", img)
+	if err != nil {
+		t.Fatalf("unexpected error %s", err)
+	}
+	if url != "/media/posters/"+img.Name() {
+		t.Errorf("unexpected url %s", url)
+	}
+	for name, content := range img.Files {
+		data, err := store.Get(ctx, "posters/"+name)
+		if err != nil || !bytes.Equal(data, content) {
+			t.Errorf("%s was not saved: %v", name, err)
+		}
+	}
+
+	err = store.Put(ctx, "posters/"+img.Name(), []byte("kept"), "image/jpeg")
+	if err != nil {
+		t.Fatalf("unexpected error %s", err)
+	}
+	_, err = Save(ctx, store, "posters", img)
+	if err != nil {
+		t.Errorf("unexpected error %s", err)
+	}
+	data, _ := store.Get(ctx, "posters/"+img.Name())
+	if string(data) != "kept" {
+		t.Errorf("expected existing object to be kept")
+	}
+
+	err = Delete(ctx, store, url)
+	if err != nil {
+		t.Errorf("unexpected error %s", err)
+	}
+	for name := range img.Files {
+		found, _ := store.Exists(ctx, "posters/"+name)
+		if found {
+			t.Errorf("%s was not deleted", name)
+		}
+	}
+
+	_, err = Save(ctx, &failingStore{failExists: true}, "posters", img)
+	if err == nil {
+		t.Errorf("expected exists error")
+	}
+	failing := &failingStore{}
+	_, err = Save(ctx, failing, "posters", img)
+	if err == nil || failing.puts != 1 {
+		t.Errorf("expected to stop at the first put error, got %v after %d puts", err, failing.puts)
+	}
+}
+
+func TestVariants(t *testing.T) {
+	hash := strings.Repeat("ab", 32)
+
+	variants := Variants("/media/posters/" + hash + ".jpg")
+	if len(variants) != 2*(len(Sizes)+1) {
+		t.Fatalf("unexpected variants %v", variants)
+	}
+	if variants["original"] != "/media/posters/"+hash+".jpg" || variants["original_webp"] != "/media/posters/"+hash+".webp" {
+		t.Errorf("unexpected original variants %v", variants)
+	}
+	for _, size := range Sizes {
+		if variants[size.Name] != "/media/posters/"+hash+"_"+size.Name+".jpg" {
+			t.Errorf("unexpected %s variant %s", size.Name, variants[size.Name])
+		}
+		if variants[size.Name+"_webp"] != "/media/posters/"+hash+"_"+size.Name+".webp" {
+			t.Errorf("unexpected %s webp variant %s", size.Name, variants[size.Name+"_webp"])
+		}
+	}
+
+	if variants := Variants(hash + ".jpg"); variants["original"] != hash+".jpg" {
+		t.Errorf("unexpected variants of a bare name %v", variants)
+	}
+	for _, url := range []string{"/icons/default.jpg", "/media/posters/" + hash + ".png", "/media/posters/" + hash[1:] + ".jpg", ""} {
+		if variants := Variants(url); variants != nil {
+			t.Errorf("%q: expected no variants, got %v", url, variants)
+		}
+	}
+}
+
+func TestStatusCode(t *testing.T) {
+	testCases := map[error]int{
+		ErrTooLarge:                            http.StatusRequestEntityTooLarge,
+		ErrUnsupported:                         http.StatusUnsupportedMediaType,
+		ErrBadImage:                            http.StatusBadRequest,
+		fmt.Errorf("%w: eof", ErrBadImage):     http.StatusBadRequest,
+		fmt.Errorf("wrapped: %w", ErrTooLarge): http.StatusRequestEntityTooLarge,
+		fmt.Errorf("encode jpeg err: %w", nil): http.StatusInternalServerError,
+		context.DeadlineExceeded:               http.StatusInternalServerError,
+	}
+
+	for err, want := range testCases {
+		if got := StatusCode(err); got != want {
+			t.Errorf("%v: got %d, want %d", err, got, want)
+		}
+	}
+}
+
+func TestFormStatus(t *testing.T) {
+	body, contentType := uploadBody(t, make([]byte, 64))
+	r := httptest.NewRequest(http.MethodPost, "/", body)
+	r.Header.Set("Content-Type", contentType)
+	r.Body = http.MaxBytesReader(httptest.NewRecorder(), r.Body, 16)
+	err := r.ParseMultipartForm(16)
+	if got := FormStatus(err); got != http.StatusRequestEntityTooLarge {
+		t.Errorf("cut body: got %d, want %d", got, http.StatusRequestEntityTooLarge)
+	}
+
+	r = httptest.NewRequest(http.MethodPost, "/", strings.NewReader("not a form"))
+	r.Header.Set("Content-Type", "multipart/form-data; boundary=x")
+	err = r.ParseMultipartForm(16)
+	if got := FormStatus(err); got != http.StatusBadRequest {
+		t.Errorf("broken form: got %d, want %d", got, http.StatusBadRequest)
+	}
+}
+
+func uploadBody(t *testing.T, data []byte) (*bytes.Buffer, string) {
+	var body bytes.Buffer
+	writer := multipart.NewWriter(&body)
+	part, err := writer.CreateFormFile("photo", "photo")
+	if err != nil {
+		t.Fatalf("create form file err: %s", err)
+	}
+	_, _ = part.Write(data)
+	_ = writer.Close()
+
+	return &body, writer.FormDataContentType()
+}
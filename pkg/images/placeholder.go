@@ -0,0 +1,137 @@
+package images
+
+import (
+	"bytes"
+	"fmt"
+	"image"
+	"math"
+
+	"github.com/buckket/go-blurhash"
+	"github.com/go-park-mail-ru/2023_2_Vkladyshi/pkg/models"
+)
+
+const (
+	placeholderWidth = 32
+	blurhashX        = 4
+	blurhashY        = 3
+	// accentMinShare is the smallest part of the image a colour has to
+	// cover to be picked as the accent.
+	accentMinShare = 0.02
+	// accentMinDistance keeps the accent visibly different from the
+	// dominant colour.
+	accentMinDistance = 64
+)
+
+type bucket struct {
+	count   int
+	r, g, b int
+}
+
+func (b bucket) color() (uint8, uint8, uint8) {
+	return uint8(b.r / b.count), uint8(b.g / b.count), uint8(b.b / b.count)
+}
+
+// Describe computes the placeholder of an image: its blurhash and the
+// dominant and accent colours.
+func Describe(src image.Image) (models.Placeholder, error) {
+	small := resize(src, placeholderWidth)
+
+	hash, err := blurhash.Encode(blurhashX, blurhashY, small)
+	if err != nil {
+		return models.Placeholder{}, fmt.Errorf("blurhash err: %w", err)
+	}
+	dominant, accent := palette(small)
+
+	return models.Placeholder{Blurhash: hash, Dominant: dominant, Accent: accent}, nil
+}
+
+// DescribeBytes decodes a stored image and computes its placeholder.
+func DescribeBytes(data []byte) (models.Placeholder, error) {
+	src, _, err := image.Decode(bytes.NewReader(data))
+	if err != nil {
+		return models.Placeholder{}, fmt.Errorf("%w: %s", ErrBadImage, err.Error())
+	}
+
+	return Describe(src)
+}
+
+// palette quantizes the image to 4 bits per channel. The most populated
+// bucket is the dominant colour, the most saturated of the remaining
+// noticeable buckets is the accent.
+func palette(src image.Image) (string, string) {
+	buckets := map[uint16]*bucket{}
+	total := 0
+
+	bounds := src.Bounds()
+	for y := bounds.Min.Y; y < bounds.Max.Y; y++ {
+		for x := bounds.Min.X; x < bounds.Max.X; x++ {
+			r, g, b, _ := src.At(x, y).RGBA()
+			r, g, b = r>>8, g>>8, b>>8
+			key := uint16(r>>4)<<8 | uint16(g>>4)<<4 | uint16(b>>4)
+
+			curr, ok := buckets[key]
+			if !ok {
+				curr = &bucket{}
+				buckets[key] = curr
+			}
+			curr.count++
+			curr.r += int(r)
+			curr.g += int(g)
+			curr.b += int(b)
+			total++
+		}
+	}
+
+	var dominant *bucket
+	var dominantKey uint16
+	for key, curr := range buckets {
+		if dominant == nil || curr.count > dominant.count || curr.count == dominant.count && key < dominantKey {
+			dominant, dominantKey = curr, key
+		}
+	}
+	if dominant == nil {
+		return "", ""
+	}
+	dr, dg, db := dominant.color()
+
+	accent, accentKey := dominant, dominantKey
+	bestScore := 0.0
+	for key, curr := range buckets {
+		if float64(curr.count) < accentMinShare*float64(total) {
+			continue
+		}
+		r, g, b := curr.color()
+		if distance(r, g, b, dr, dg, db) < accentMinDistance {
+			continue
+		}
+		score := saturation(r, g, b) * math.Sqrt(float64(curr.count))
+		if score > bestScore || score == bestScore && score > 0 && key < accentKey {
+			accent, accentKey, bestScore = curr, key, score
+		}
+	}
+	ar, ag, ab := accent.color()
+
+	return hexColor(dr, dg, db), hexColor(ar, ag, ab)
+}
+
+func distance(r1, g1, b1, r2, g2, b2 uint8) float64 {
+	dr := float64(r1) - float64(r2)
+	dg := float64(g1) - float64(g2)
+	db := float64(b1) - float64(b2)
+
+	return math.Sqrt(dr*dr + dg*dg + db*db)
+}
+
+func saturation(r, g, b uint8) float64 {
+	maxC := math.Max(float64(r), math.Max(float64(g), float64(b)))
+	minC := math.Min(float64(r), math.Min(float64(g), float64(b)))
+	if maxC == 0 {
+		return 0
+	}
+
+	return (maxC - minC) / maxC
+}
+
+func hexColor(r, g, b uint8) string {
+	return fmt.Sprintf("#%02x%02x%02x", r, g, b)
+}
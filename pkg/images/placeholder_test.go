@@ -0,0 +1,125 @@
+package images
+
+import (
+	"errors"
+	"image"
+	"image/color"
+	"testing"
+
+	"github.com/go-park-mail-ru/2023_2_Vkladyshi/pkg/models"
+)
+
+// stripes paints rows of src from the top, each colour covering the given
+// number of rows.
+func stripes(src *image.RGBA, rows []int, colors []color.RGBA) *image.RGBA {
+	y := 0
+	for i, count := range rows {
+		for end := y + count; y < end; y++ {
+			for x := 0; x < src.Bounds().Dx(); x++ {
+				src.Set(x, y, colors[i])
+			}
+		}
+	}
+
+	return src
+}
+
+func TestDescribe(t *testing.T) {
+	testCases := map[string]struct {
+		color color.RGBA
+		want  models.Placeholder
+	}{
+		"black": {
+			color: color.RGBA{A: 255},
+			want:  models.Placeholder{Blurhash: "L00000fQfQfQfQfQfQfQfQfQfQfQ", Dominant: "#000000", Accent: "#000000"},
+		},
+		"red": {
+			color: color.RGBA{R: 255, A: 255},
+			want:  models.Placeholder{Blurhash: "LDTI:j]9fQ]9|co1fQo1fQfQfQfQ", Dominant: "#ff0000", Accent: "#ff0000"},
+		},
+		"blue": {
+			color: color.RGBA{R: 0x33, G: 0x66, B: 0x99, A: 255},
+			want:  models.Placeholder{Blurhash: "L35?}ktofQtot:j]fQj]fQfQfQfQ", Dominant: "#336699", Accent: "#336699"},
+		},
+	}
+
+	for name, curr := range testCases {
+		got, err := Describe(solid(64, 48, curr.color))
+		if err != nil {
+			t.Errorf("%s: unexpected error %s", name, err)
+			continue
+		}
+		if got != curr.want {
+			t.Errorf("%s: got %+v, want %+v", name, got, curr.want)
+		}
+	}
+}
+
+func TestDescribeBytes(t *testing.T) {
+	red := solid(64, 48, color.RGBA{R: 255, A: 255})
+	want, _ := Describe(red)
+
+	got, err := DescribeBytes(encode(t, "png", red))
+	if err != nil {
+		t.Errorf("unexpected error %s", err)
+	}
+	if got != want {
+		t.Errorf("got %+v, want %+v", got, want)
+	}
+
+	_, err = DescribeBytes([]byte("not an image"))
+	if !errors.Is(err, ErrBadImage) {
+		t.Errorf("expected ErrBadImage, got %v", err)
+	}
+}
+
+func TestPalette(t *testing.T) {
+	dark := color.RGBA{R: 0x20, G: 0x20, B: 0x20, A: 255}
+	red := color.RGBA{R: 0xf0, G: 0x10, B: 0x10, A: 255}
+	grey := color.RGBA{R: 0x80, G: 0x80, B: 0x80, A: 255}
+	teal := color.RGBA{R: 0x10, G: 0x80, B: 0x80, A: 255}
+
+	testCases := map[string]struct {
+		img      image.Image
+		dominant string
+		accent   string
+	}{
+		"saturated accent": {
+			img:      stripes(solid(100, 100, dark), []int{10, 10}, []color.RGBA{red, grey}),
+			dominant: "#202020",
+			accent:   "#f01010",
+		},
+		"accent too small": {
+			img:      stripes(solid(100, 100, dark), []int{1}, []color.RGBA{red}),
+			dominant: "#202020",
+			accent:   "#202020",
+		},
+		"accent too close to dominant": {
+			img:      stripes(solid(100, 100, dark), []int{30}, []color.RGBA{{R: 0x40, G: 0x20, B: 0x20, A: 255}}),
+			dominant: "#202020",
+			accent:   "#202020",
+		},
+		"most saturated wins": {
+			img:      stripes(solid(100, 100, grey), []int{20, 20}, []color.RGBA{teal, red}),
+			dominant: "#808080",
+			accent:   "#f01010",
+		},
+		"tie picks the lowest bucket": {
+			img:      stripes(solid(10, 10, red), []int{5}, []color.RGBA{teal}),
+			dominant: "#108080",
+			accent:   "#f01010",
+		},
+		"empty image": {
+			img:      image.NewRGBA(image.Rect(0, 0, 0, 0)),
+			dominant: "",
+			accent:   "",
+		},
+	}
+
+	for name, curr := range testCases {
+		dominant, accent := palette(curr.img)
+		if dominant != curr.dominant || accent != curr.accent {
+			t.Errorf("%s: got %s %s, want %s %s", name, dominant, accent, curr.dominant, curr.accent)
+		}
+	}
+}
@@ -0,0 +1,193 @@
+package interceptors
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"runtime/debug"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Codes maps the domain errors of a service, matched with errors.Is, to the
+// status codes its server answers with.
+type Codes map[error]codes.Code
+
+// ErrInternal replaces errors that are neither statuses nor known, so that
+// their details stay in the log of the server.
+var ErrInternal = status.Error(codes.Internal, "internal error")
+
+func toStatus(err error, known Codes) error {
+	if err == nil {
+		return nil
+	}
+	if _, ok := status.FromError(err); ok {
+		return err
+	}
+
+	switch {
+	case errors.Is(err, context.Canceled):
+		return status.Error(codes.Canceled, err.Error())
+	case errors.Is(err, context.DeadlineExceeded):
+		return status.Error(codes.DeadlineExceeded, err.Error())
+	}
+	for domain, code := range known {
+		if errors.Is(err, domain) {
+			return status.Error(code, err.Error())
+		}
+	}
+
+	return ErrInternal
+}
+
+// serverFault tells the codes that point to a problem of the server from
+// the answers to a bad call.
+func serverFault(code codes.Code) bool {
+	switch code {
+	case codes.Unknown, codes.Internal, codes.DataLoss, codes.Unimplemented, codes.Unavailable:
+		return true
+	}
+
+	return false
+}
+
+type server struct {
+	service string
+	lg      *slog.Logger
+	known   Codes
+}
+
+// ServerOptions gives every call to the server of service a request id, a
+// log line and latency and status code metrics, turns panics into
+// codes.Internal and answers the errors of known with their codes.
+func ServerOptions(service string, lg *slog.Logger, known Codes) []grpc.ServerOption {
+	metrics()
+	s := &server{service: service, lg: lg.With("module", "grpc"), known: known}
+
+	return []grpc.ServerOption{
+		grpc.ChainUnaryInterceptor(s.unary),
+		grpc.ChainStreamInterceptor(s.stream),
+	}
+}
+
+func (s *server) call(ctx context.Context, method string, handler func(context.Context) error) error {
+	start := time.Now()
+	id := incomingRequestId(ctx)
+	lg := s.lg.With("request_id", id, "method", method)
+
+	err := s.recover(WithRequestId(ctx, id), lg, handler)
+	result := toStatus(err, s.known)
+	code := status.Code(result)
+	serverMetrics.observe(s.service, method, code, start)
+
+	duration := time.Since(start).Milliseconds()
+	if serverFault(code) {
+		lg.Error("grpc call failed", "code", code.String(), "duration_ms", duration, "err", err.Error())
+	} else {
+		lg.Info("grpc call", "code", code.String(), "duration_ms", duration)
+	}
+
+	return result
+}
+
+func (s *server) recover(ctx context.Context, lg *slog.Logger, handler func(context.Context) error) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			lg.Error("grpc panic", "panic", fmt.Sprint(r), "stack", string(debug.Stack()))
+			err = ErrInternal
+		}
+	}()
+
+	return handler(ctx)
+}
+
+func (s *server) unary(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	var resp interface{}
+	err := s.call(ctx, info.FullMethod, func(ctx context.Context) error {
+		var err error
+		resp, err = handler(ctx, req)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+type serverStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (stream *serverStream) Context() context.Context {
+	return stream.ctx
+}
+
+func (s *server) stream(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	return s.call(ss.Context(), info.FullMethod, func(ctx context.Context) error {
+		return handler(srv, &serverStream{ServerStream: ss, ctx: ctx})
+	})
+}
+
+// UnaryClient passes the request id of the context on to the server and
+// records the latency and status codes of the calls to service.
+func UnaryClient(service string) grpc.UnaryClientInterceptor {
+	metrics()
+
+	return func(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn,
+		invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		start := time.Now()
+		ctx = outgoingRequestId(ctx)
+		err := invoker(ctx, method, req, reply, cc, opts...)
+		clientMetrics.observe(service, method, status.Code(err), start)
+
+		return err
+	}
+}
+
+type clientStream struct {
+	grpc.ClientStream
+	done func(error)
+}
+
+func (stream *clientStream) RecvMsg(m interface{}) error {
+	err := stream.ClientStream.RecvMsg(m)
+	if errors.Is(err, io.EOF) {
+		stream.done(nil)
+	} else if err != nil {
+		stream.done(err)
+	}
+
+	return err
+}
+
+// StreamClient is UnaryClient for streams, a stream is recorded when it
+// ends.
+func StreamClient(service string) grpc.StreamClientInterceptor {
+	metrics()
+
+	return func(ctx context.Context, desc *grpc.StreamDesc, cc *grpc.ClientConn, method string,
+		streamer grpc.Streamer, opts ...grpc.CallOption) (grpc.ClientStream, error) {
+		start := time.Now()
+		ctx = outgoingRequestId(ctx)
+		stream, err := streamer(ctx, desc, cc, method, opts...)
+		if err != nil {
+			clientMetrics.observe(service, method, status.Code(err), start)
+			return nil, err
+		}
+
+		recorded := false
+		return &clientStream{ClientStream: stream, done: func(err error) {
+			if !recorded {
+				recorded = true
+				clientMetrics.observe(service, method, status.Code(err), start)
+			}
+		}}, nil
+	}
+}
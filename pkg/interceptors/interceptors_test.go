@@ -0,0 +1,153 @@
+package interceptors
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"log/slog"
+	"net"
+	"strings"
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+var errMissing = errors.New("missing")
+
+// healthServer answers by the requested service name and remembers the
+// request id of the last call.
+type healthServer struct {
+	healthpb.UnimplementedHealthServer
+	requestId string
+}
+
+func (s *healthServer) Check(ctx context.Context, req *healthpb.HealthCheckRequest) (*healthpb.HealthCheckResponse, error) {
+	s.requestId, _ = RequestId(ctx)
+
+	switch req.Service {
+	case "panic":
+		panic("boom")
+	case "missing":
+		return nil, errMissing
+	case "broken":
+		return nil, errors.New("connection refused")
+	case "denied":
+		return nil, status.Error(codes.PermissionDenied, "denied")
+	}
+
+	return &healthpb.HealthCheckResponse{Status: healthpb.HealthCheckResponse_SERVING}, nil
+}
+
+func (s *healthServer) Watch(req *healthpb.HealthCheckRequest, stream healthpb.Health_WatchServer) error {
+	s.requestId, _ = RequestId(stream.Context())
+
+	return errMissing
+}
+
+func serve(t *testing.T, service string) (*healthServer, healthpb.HealthClient, *bytes.Buffer) {
+	var logs bytes.Buffer
+	lg := slog.New(slog.NewJSONHandler(&logs, nil))
+
+	lis := bufconn.Listen(1 << 20)
+	server := &healthServer{}
+	s := grpc.NewServer(ServerOptions(service, lg, Codes{errMissing: codes.NotFound})...)
+	healthpb.RegisterHealthServer(s, server)
+	go s.Serve(lis)
+	t.Cleanup(s.Stop)
+
+	conn, err := grpc.Dial("bufnet",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+			return lis.DialContext(ctx)
+		}),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		grpc.WithUnaryInterceptor(UnaryClient(service)),
+		grpc.WithStreamInterceptor(StreamClient(service)),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { conn.Close() })
+
+	return server, healthpb.NewHealthClient(conn), &logs
+}
+
+func TestUnary(t *testing.T) {
+	server, client, logs := serve(t, t.Name())
+	method := healthpb.Health_Check_FullMethodName
+
+	cases := []struct {
+		service string
+		code    codes.Code
+	}{
+		{"", codes.OK},
+		{"panic", codes.Internal},
+		{"missing", codes.NotFound},
+		{"broken", codes.Internal},
+		{"denied", codes.PermissionDenied},
+	}
+	for _, c := range cases {
+		_, err := client.Check(context.Background(), &healthpb.HealthCheckRequest{Service: c.service})
+		if status.Code(err) != c.code {
+			t.Errorf("%q: expected %s, got %v", c.service, c.code, err)
+		}
+		if count := testutil.ToFloat64(serverMetrics.handled.WithLabelValues(t.Name(), method, c.code.String())); count < 1 {
+			t.Errorf("%q: server metric not recorded", c.service)
+		}
+		if count := testutil.ToFloat64(clientMetrics.handled.WithLabelValues(t.Name(), method, c.code.String())); count < 1 {
+			t.Errorf("%q: client metric not recorded", c.service)
+		}
+	}
+
+	_, err := client.Check(context.Background(), &healthpb.HealthCheckRequest{Service: "broken"})
+	if strings.Contains(status.Convert(err).Message(), "connection refused") {
+		t.Errorf("expected the details of an internal error to stay on the server")
+	}
+	if !strings.Contains(logs.String(), "connection refused") || !strings.Contains(logs.String(), `"panic":"boom"`) {
+		t.Errorf("expected the error and the panic in the log, have %s", logs.String())
+	}
+
+	_, err = client.Check(WithRequestId(context.Background(), "abc"), &healthpb.HealthCheckRequest{})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if server.requestId != "abc" {
+		t.Errorf("expected the request id to reach the server, have %q", server.requestId)
+	}
+	if !strings.Contains(logs.String(), `"request_id":"abc"`) {
+		t.Errorf("expected the request id in the log")
+	}
+
+	client.Check(context.Background(), &healthpb.HealthCheckRequest{})
+	if server.requestId == "" || server.requestId == "abc" {
+		t.Errorf("expected a new request id, have %q", server.requestId)
+	}
+}
+
+func TestStream(t *testing.T) {
+	server, client, _ := serve(t, t.Name())
+
+	stream, err := client.Watch(WithRequestId(context.Background(), "abc"), &healthpb.HealthCheckRequest{})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if _, err = stream.Recv(); status.Code(err) != codes.NotFound {
+		t.Errorf("expected not found, got %v", err)
+	}
+	if server.requestId != "abc" {
+		t.Errorf("expected the request id to reach the server, have %q", server.requestId)
+	}
+
+	method := healthpb.Health_Watch_FullMethodName
+	if count := testutil.ToFloat64(serverMetrics.handled.WithLabelValues(t.Name(), method, "NotFound")); count != 1 {
+		t.Errorf("server metric = %v", count)
+	}
+	if count := testutil.ToFloat64(clientMetrics.handled.WithLabelValues(t.Name(), method, "NotFound")); count != 1 {
+		t.Errorf("client metric = %v", count)
+	}
+}
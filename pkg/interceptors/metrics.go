@@ -0,0 +1,51 @@
+package interceptors
+
+import (
+	"sync"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"google.golang.org/grpc/codes"
+)
+
+type callMetrics struct {
+	handled *prometheus.CounterVec
+	latency *prometheus.HistogramVec
+}
+
+var (
+	once          sync.Once
+	serverMetrics *callMetrics
+	clientMetrics *callMetrics
+)
+
+func newCallMetrics(side string) *callMetrics {
+	return &callMetrics{
+		handled: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Name: "grpc_" + side + "_handled_total",
+			Help: "gRPC calls by method and status code.",
+		}, []string{"service", "method", "code"}),
+		latency: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Name:    "grpc_" + side + "_handling_seconds",
+			Help:    "gRPC call latency by method.",
+			Buckets: prometheus.DefBuckets,
+		}, []string{"service", "method"}),
+	}
+}
+
+// metrics registers the collectors once, so the servers and clients of one
+// process share them.
+func metrics() {
+	once.Do(func() {
+		serverMetrics = newCallMetrics("server")
+		clientMetrics = newCallMetrics("client")
+
+		prometheus.MustRegister(serverMetrics.handled, serverMetrics.latency,
+			clientMetrics.handled, clientMetrics.latency)
+	})
+}
+
+func (m *callMetrics) observe(service string, method string, code codes.Code, start time.Time) {
+	m.handled.WithLabelValues(service, method, code.String()).Inc()
+	m.latency.WithLabelValues(service, method).Observe(time.Since(start).Seconds())
+}
@@ -0,0 +1,53 @@
+package interceptors
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+
+	"google.golang.org/grpc/metadata"
+)
+
+// RequestIdHeader carries the request id between services in the gRPC
+// metadata.
+const RequestIdHeader = "x-request-id"
+
+type requestIdKey struct{}
+
+func WithRequestId(ctx context.Context, id string) context.Context {
+	return context.WithValue(ctx, requestIdKey{}, id)
+}
+
+func RequestId(ctx context.Context) (string, bool) {
+	id, ok := ctx.Value(requestIdKey{}).(string)
+	return id, ok && id != ""
+}
+
+func newRequestId() string {
+	id := make([]byte, 16)
+	rand.Read(id)
+
+	return hex.EncodeToString(id)
+}
+
+// incomingRequestId returns the id the caller has sent or a new one.
+func incomingRequestId(ctx context.Context) string {
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		if ids := md.Get(RequestIdHeader); len(ids) != 0 && ids[0] != "" {
+			return ids[0]
+		}
+	}
+
+	return newRequestId()
+}
+
+// outgoingRequestId passes the id of ctx on to the server, a call outside of
+// a request gets a new id.
+func outgoingRequestId(ctx context.Context) context.Context {
+	id, ok := RequestId(ctx)
+	if !ok {
+		id = newRequestId()
+	}
+
+	return metadata.AppendToOutgoingContext(ctx, RequestIdHeader, id)
+}
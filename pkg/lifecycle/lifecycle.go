@@ -0,0 +1,179 @@
+package lifecycle
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"os"
+	"os/signal"
+	"sync"
+	"syscall"
+	"time"
+
+	"google.golang.org/grpc"
+)
+
+// DefaultTimeout bounds how long the servers may drain their calls.
+const DefaultTimeout = 10 * time.Second
+
+var errServerStopped = errors.New("server stopped")
+
+type server struct {
+	name     string
+	serve    func() error
+	shutdown func(ctx context.Context) error
+}
+
+type closer struct {
+	name  string
+	close func() error
+}
+
+// Runner starts the servers and background workers of a service and stops
+// them in order on SIGINT or SIGTERM: the servers drain their calls, the
+// workers see their context done, then the clients of the databases are
+// closed.
+type Runner struct {
+	lg      *slog.Logger
+	timeout time.Duration
+	ctx     context.Context
+	cancel  context.CancelFunc
+	workers sync.WaitGroup
+	servers []server
+	closers []closer
+}
+
+func New(lg *slog.Logger, timeout time.Duration) *Runner {
+	if timeout <= 0 {
+		timeout = DefaultTimeout
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+
+	return &Runner{lg: lg.With("module", "lifecycle"), timeout: timeout, ctx: ctx, cancel: cancel}
+}
+
+// Go runs worker at once, its context is done when the servers have
+// stopped.
+func (r *Runner) Go(name string, worker func(ctx context.Context)) {
+	r.workers.Add(1)
+	go func() {
+		defer r.workers.Done()
+		worker(r.ctx)
+		r.lg.Info("worker stopped", "worker", name)
+	}()
+}
+
+// Serve adds a server that Run starts with serve and stops with shutdown.
+// Servers are stopped one by one in the reverse order, so a server added
+// after the servers it calls stops before them.
+func (r *Runner) Serve(name string, serve func() error, shutdown func(ctx context.Context) error) {
+	r.servers = append(r.servers, server{name: name, serve: serve, shutdown: shutdown})
+}
+
+func (r *Runner) ServeHttp(name string, srv *http.Server) {
+	r.Serve(name, func() error {
+		err := srv.ListenAndServe()
+		if errors.Is(err, http.ErrServerClosed) {
+			return nil
+		}
+		return err
+	}, srv.Shutdown)
+}
+
+// OnClose adds a function that Run calls after the workers have stopped.
+// They are called in the reverse order, so a client is closed before the
+// ones it was created from.
+func (r *Runner) OnClose(name string, close func() error) {
+	r.closers = append(r.closers, closer{name: name, close: close})
+}
+
+// Run serves until ctx is done, the process gets SIGINT or SIGTERM or a
+// server fails, then stops everything. It returns the error of the failed
+// server, if any.
+func (r *Runner) Run(ctx context.Context) error {
+	ctx, stop := signal.NotifyContext(ctx, os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	errs := make(chan error, len(r.servers))
+	for _, srv := range r.servers {
+		go func(srv server) {
+			err := srv.serve()
+			if err == nil {
+				err = errServerStopped
+			}
+			errs <- fmt.Errorf("%s: %w", srv.name, err)
+		}(srv)
+	}
+
+	var err error
+	select {
+	case <-ctx.Done():
+		r.lg.Info("shutting down")
+	case err = <-errs:
+		r.lg.Error("server failed, shutting down", "err", err.Error())
+	}
+
+	r.shutdown()
+
+	return err
+}
+
+func (r *Runner) shutdown() {
+	ctx, cancel := context.WithTimeout(context.Background(), r.timeout)
+	defer cancel()
+
+	for i := len(r.servers) - 1; i >= 0; i-- {
+		srv := r.servers[i]
+		if err := srv.shutdown(ctx); err != nil {
+			r.lg.Error("server shutdown error", "server", srv.name, "err", err.Error())
+		}
+	}
+
+	r.cancel()
+	r.workers.Wait()
+
+	for i := len(r.closers) - 1; i >= 0; i-- {
+		c := r.closers[i]
+		if err := c.close(); err != nil {
+			r.lg.Error("close error", "client", c.name, "err", err.Error())
+		}
+	}
+	r.lg.Info("stopped")
+}
+
+// StopGrpc lets s finish the calls in flight and cuts the ones still open,
+// like watch streams, when ctx is done.
+func StopGrpc(ctx context.Context, s *grpc.Server) error {
+	done := make(chan struct{})
+	go func() {
+		s.GracefulStop()
+		close(done)
+	}()
+
+	select {
+	case <-done:
+		return nil
+	case <-ctx.Done():
+		s.Stop()
+		<-done
+		return ctx.Err()
+	}
+}
+
+// Close closes the dependencies that are io.Closers in the given order and
+// skips the rest, like memory repositories.
+func Close(dependencies ...interface{}) error {
+	var errs []error
+	for _, dependency := range dependencies {
+		if c, ok := dependency.(io.Closer); ok {
+			if err := c.Close(); err != nil {
+				errs = append(errs, err)
+			}
+		}
+	}
+
+	return errors.Join(errs...)
+}
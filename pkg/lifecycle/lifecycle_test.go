@@ -0,0 +1,208 @@
+package lifecycle
+
+import (
+	"context"
+	"errors"
+	"io"
+	"log/slog"
+	"net"
+	"net/http"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+var discard = slog.New(slog.NewTextHandler(io.Discard, nil))
+
+// events records the order in which the parts of a service stop.
+type events struct {
+	mutex sync.Mutex
+	list  []string
+}
+
+func (e *events) add(event string) {
+	e.mutex.Lock()
+	defer e.mutex.Unlock()
+	e.list = append(e.list, event)
+}
+
+func (e *events) String() string {
+	e.mutex.Lock()
+	defer e.mutex.Unlock()
+	return strings.Join(e.list, ",")
+}
+
+type client struct {
+	name   string
+	events *events
+}
+
+func (c *client) Close() error {
+	c.events.add(c.name)
+	return nil
+}
+
+func TestRun(t *testing.T) {
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	started := make(chan struct{})
+	release := make(chan struct{})
+	srv := &http.Server{Handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		close(started)
+		<-release
+		w.Write([]byte("done"))
+	})}
+
+	e := &events{}
+	runner := New(discard, time.Second)
+	runner.OnClose("postgres", func() error {
+		e.add("postgres")
+		return nil
+	})
+	runner.OnClose("redis", func() error {
+		e.add("redis")
+		return errors.New("already closed")
+	})
+	runner.Go("worker", func(ctx context.Context) {
+		<-ctx.Done()
+		e.add("worker")
+	})
+	runner.Serve("http", func() error {
+		err := srv.Serve(lis)
+		if errors.Is(err, http.ErrServerClosed) {
+			return nil
+		}
+		return err
+	}, func(ctx context.Context) error {
+		e.add("http")
+		return srv.Shutdown(ctx)
+	})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan error)
+	go func() {
+		done <- runner.Run(ctx)
+	}()
+
+	body := make(chan string)
+	go func() {
+		resp, err := http.Get("http://" + lis.Addr().String())
+		if err != nil {
+			body <- err.Error()
+			return
+		}
+		defer resp.Body.Close()
+		data, _ := io.ReadAll(resp.Body)
+		body <- string(data)
+	}()
+
+	<-started
+	cancel()
+	time.Sleep(50 * time.Millisecond)
+	close(release)
+
+	if got := <-body; got != "done" {
+		t.Errorf("expected the request in flight to finish, got %q", got)
+	}
+	if err := <-done; err != nil {
+		t.Errorf("unexpected error: %s", err)
+	}
+	if got := e.String(); got != "http,worker,redis,postgres" {
+		t.Errorf("stopped in the order %s", got)
+	}
+}
+
+func TestServerFails(t *testing.T) {
+	e := &events{}
+	runner := New(discard, time.Second)
+	runner.Go("worker", func(ctx context.Context) {
+		<-ctx.Done()
+		e.add("worker")
+	})
+
+	stop := make(chan struct{})
+	runner.Serve("grpc", func() error {
+		<-stop
+		return nil
+	}, func(ctx context.Context) error {
+		e.add("grpc")
+		close(stop)
+		return nil
+	})
+	runner.Serve("http", func() error {
+		return errors.New("address already in use")
+	}, func(ctx context.Context) error {
+		e.add("http")
+		return nil
+	})
+
+	err := runner.Run(context.Background())
+	if err == nil || err.Error() != "http: address already in use" {
+		t.Errorf("expected the error of the failed server, got %v", err)
+	}
+	if got := e.String(); got != "http,grpc,worker" {
+		t.Errorf("stopped in the order %s", got)
+	}
+}
+
+func TestStopGrpc(t *testing.T) {
+	lis := bufconn.Listen(1 << 20)
+	s := grpc.NewServer()
+	healthServer := health.NewServer()
+	healthpb.RegisterHealthServer(s, healthServer)
+	served := make(chan error)
+	go func() {
+		served <- s.Serve(lis)
+	}()
+
+	conn, err := grpc.Dial("bufnet",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+			return lis.DialContext(ctx)
+		}),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer conn.Close()
+
+	// A watch stream never ends by itself, so the graceful stop has to be
+	// cut at the deadline.
+	stream, err := healthpb.NewHealthClient(conn).Watch(context.Background(), &healthpb.HealthCheckRequest{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err = stream.Recv(); err != nil {
+		t.Fatal(err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+	if err = StopGrpc(ctx, s); !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("expected the deadline, got %v", err)
+	}
+	if err = <-served; err != nil {
+		t.Errorf("unexpected serve error: %s", err)
+	}
+}
+
+func TestClose(t *testing.T) {
+	e := &events{}
+	err := Close(&client{"redis", e}, struct{}{}, nil, &client{"postgres", e})
+	if err != nil {
+		t.Errorf("unexpected error: %s", err)
+	}
+	if got := e.String(); got != "redis,postgres" {
+		t.Errorf("closed %s", got)
+	}
+}
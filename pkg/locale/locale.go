@@ -0,0 +1,136 @@
+package locale
+
+import (
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const Default = "ru"
+
+var monthNames = map[string][12]string{
+	"ru": {"Январь", "Февраль", "Март", "Апрель", "Май", "Июнь",
+		"Июль", "Август", "Сентябрь", "Октябрь", "Ноябрь", "Декабрь"},
+	"en": {"January", "February", "March", "April", "May", "June",
+		"July", "August", "September", "October", "November", "December"},
+}
+
+var monthTexts = map[string]string{
+	"ru": "Новинки этого месяца",
+	"en": "New this month",
+}
+
+// FromRequest builds the fallback chain of languages for a request: the lang
+// query parameter first, then Accept-Language by weight, then Default.
+func FromRequest(r *http.Request) []string {
+	return Chain(r.URL.Query().Get("lang"), r.Header.Get("Accept-Language"))
+}
+
+func Chain(lang string, acceptLanguage string) []string {
+	type weighted struct {
+		lang   string
+		weight float64
+	}
+
+	var accepted []weighted
+	for _, part := range strings.Split(acceptLanguage, ",") {
+		tag, params, _ := strings.Cut(strings.TrimSpace(part), ";")
+		weight, ok := quality(params)
+		if !ok || weight == 0 {
+			continue
+		}
+		accepted = append(accepted, weighted{lang: tag, weight: weight})
+	}
+	sort.SliceStable(accepted, func(i, j int) bool { return accepted[i].weight > accepted[j].weight })
+
+	chain := []string{}
+	seen := map[string]struct{}{}
+	add := func(tag string) {
+		tag = normalize(tag)
+		if tag == "" {
+			return
+		}
+		if _, ok := seen[tag]; ok {
+			return
+		}
+		seen[tag] = struct{}{}
+		chain = append(chain, tag)
+	}
+
+	add(lang)
+	for _, item := range accepted {
+		add(item.lang)
+	}
+	add(Default)
+
+	return chain
+}
+
+// quality reads the q parameter of a language range, 1 without one. A
+// malformed weight drops the range.
+func quality(params string) (float64, bool) {
+	for _, param := range strings.Split(params, ";") {
+		q, ok := strings.CutPrefix(strings.TrimSpace(param), "q=")
+		if !ok {
+			continue
+		}
+		weight, err := strconv.ParseFloat(q, 64)
+		if err != nil || !(weight >= 0 && weight <= 1) {
+			return 0, false
+		}
+		return weight, true
+	}
+
+	return 1, true
+}
+
+// Preferred returns the part of the chain that is preferred over Default,
+// i.e. the languages that need a stored translation.
+func Preferred(chain []string) []string {
+	for i, lang := range chain {
+		if lang == Default {
+			return chain[:i]
+		}
+	}
+
+	return chain
+}
+
+func MonthName(chain []string, month time.Month) string {
+	return monthNames[uiLang(chain)][month-1]
+}
+
+func MonthText(chain []string) string {
+	return monthTexts[uiLang(chain)]
+}
+
+func uiLang(chain []string) string {
+	for _, lang := range chain {
+		if _, ok := monthTexts[lang]; ok {
+			return lang
+		}
+	}
+
+	return Default
+}
+
+func normalize(tag string) string {
+	tag = strings.ToLower(strings.TrimSpace(tag))
+	if tag == "*" {
+		return ""
+	}
+	tag, _, _ = strings.Cut(tag, "-")
+	tag, _, _ = strings.Cut(tag, "_")
+	if len(tag) < 2 || len(tag) > 3 {
+		return ""
+	}
+	for _, r := range tag {
+		if r < 'a' || r > 'z' {
+			return ""
+		}
+	}
+
+	return tag
+}
@@ -0,0 +1,80 @@
+package locale
+
+import (
+	"net/http/httptest"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestChain(t *testing.T) {
+	testCases := map[string]struct {
+		lang           string
+		acceptLanguage string
+		chain          []string
+	}{
+		"nothing":             {chain: []string{"ru"}},
+		"lang parameter":      {lang: "en", chain: []string{"en", "ru"}},
+		"lang before header":  {lang: "de", acceptLanguage: "en", chain: []string{"de", "en", "ru"}},
+		"by weight":           {acceptLanguage: "de;q=0.5, en;q=0.9, fr", chain: []string{"fr", "en", "de", "ru"}},
+		"equal weights":       {acceptLanguage: "en;q=0.8, de;q=0.8", chain: []string{"en", "de", "ru"}},
+		"default in header":   {acceptLanguage: "ru, en;q=0.5", chain: []string{"ru", "en"}},
+		"zero weight":         {acceptLanguage: "en;q=0, de", chain: []string{"de", "ru"}},
+		"other parameters":    {acceptLanguage: "en;level=1;q=0.2, de;q=0.4", chain: []string{"de", "en", "ru"}},
+		"wildcard":            {acceptLanguage: "*, en;q=0.5", chain: []string{"en", "ru"}},
+		"region":              {acceptLanguage: "en-US, ru-RU;q=0.5", chain: []string{"en", "ru"}},
+		"region duplicates":   {acceptLanguage: "en-GB, en-US;q=0.9, en;q=0.8", chain: []string{"en", "ru"}},
+		"underscore region":   {lang: "pt_BR", chain: []string{"pt", "ru"}},
+		"case":                {lang: "EN", acceptLanguage: "De-AT", chain: []string{"en", "de", "ru"}},
+		"malformed weight":    {acceptLanguage: "en;q=high, de", chain: []string{"de", "ru"}},
+		"weight above one":    {acceptLanguage: "en;q=2, de;q=0.5", chain: []string{"de", "ru"}},
+		"weight not a number": {acceptLanguage: "en;q=NaN, de", chain: []string{"de", "ru"}},
+		"malformed tags":      {lang: "e", acceptLanguage: "english, 12, , ;q=0.5, e1", chain: []string{"ru"}},
+	}
+
+	for name, curr := range testCases {
+		chain := Chain(curr.lang, curr.acceptLanguage)
+		if !reflect.DeepEqual(chain, curr.chain) {
+			t.Errorf("%s: Chain(%q, %q) = %v, want %v", name, curr.lang, curr.acceptLanguage, chain, curr.chain)
+		}
+	}
+}
+
+func TestFromRequest(t *testing.T) {
+	r := httptest.NewRequest("GET", "/api/v1/films?lang=en", nil)
+	r.Header.Set("Accept-Language", "de-DE,de;q=0.9")
+
+	if chain := FromRequest(r); !reflect.DeepEqual(chain, []string{"en", "de", "ru"}) {
+		t.Errorf("FromRequest = %v", chain)
+	}
+}
+
+func TestPreferred(t *testing.T) {
+	testCases := map[string]struct {
+		chain     []string
+		preferred []string
+	}{
+		"default only":  {chain: []string{"ru"}, preferred: []string{}},
+		"before":        {chain: []string{"en", "de", "ru"}, preferred: []string{"en", "de"}},
+		"default first": {chain: []string{"ru", "en"}, preferred: []string{}},
+		"no default":    {chain: []string{"en"}, preferred: []string{"en"}},
+	}
+
+	for name, curr := range testCases {
+		if preferred := Preferred(curr.chain); !reflect.DeepEqual(preferred, curr.preferred) {
+			t.Errorf("%s: Preferred = %v, want %v", name, preferred, curr.preferred)
+		}
+	}
+}
+
+func TestMonth(t *testing.T) {
+	if name := MonthName([]string{"de", "en", "ru"}, time.March); name != "March" {
+		t.Errorf("MonthName = %q", name)
+	}
+	if name := MonthName([]string{"de"}, time.March); name != "Март" {
+		t.Errorf("expected the default language, got %q", name)
+	}
+	if text := MonthText([]string{"en"}); text != "New this month" {
+		t.Errorf("MonthText = %q", text)
+	}
+}
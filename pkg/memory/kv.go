@@ -0,0 +1,188 @@
+package memory
+
+import (
+	"sync"
+	"time"
+)
+
+// KV stands in for a Redis database: string keys with an optional TTL,
+// hashes without one and pub/sub channels.
+type KV struct {
+	mutex       sync.Mutex
+	now         func() time.Time
+	values      map[string]value
+	hashes      map[string]map[string]string
+	subscribers map[string][]chan string
+}
+
+type value struct {
+	data      string
+	expiresAt time.Time
+}
+
+var (
+	kvsMutex sync.Mutex
+	kvs      = map[string]*KV{}
+)
+
+// OpenKV returns the database with the given name, shared by the process.
+func OpenKV(name string) *KV {
+	kvsMutex.Lock()
+	defer kvsMutex.Unlock()
+
+	if kv, ok := kvs[name]; ok {
+		return kv
+	}
+	kv := NewKV()
+	kvs[name] = kv
+
+	return kv
+}
+
+func NewKV() *KV {
+	return &KV{
+		now:         time.Now,
+		values:      map[string]value{},
+		hashes:      map[string]map[string]string{},
+		subscribers: map[string][]chan string{},
+	}
+}
+
+// Set stores a value, ttl 0 keeps it forever.
+func (kv *KV) Set(key string, data string, ttl time.Duration) {
+	kv.mutex.Lock()
+	defer kv.mutex.Unlock()
+
+	item := value{data: data}
+	if ttl > 0 {
+		item.expiresAt = kv.now().Add(ttl)
+	}
+	kv.values[key] = item
+}
+
+func (kv *KV) Get(key string) (string, bool) {
+	data, _, ok := kv.GetWithExpiry(key)
+
+	return data, ok
+}
+
+// GetWithExpiry also returns when the value expires, zero for no ttl.
+func (kv *KV) GetWithExpiry(key string) (string, time.Time, bool) {
+	kv.mutex.Lock()
+	defer kv.mutex.Unlock()
+
+	item, ok := kv.values[key]
+	if !ok {
+		return "", time.Time{}, false
+	}
+	if !item.expiresAt.IsZero() && !kv.now().Before(item.expiresAt) {
+		delete(kv.values, key)
+		return "", time.Time{}, false
+	}
+
+	return item.data, item.expiresAt, true
+}
+
+func (kv *KV) Del(key string) bool {
+	kv.mutex.Lock()
+	defer kv.mutex.Unlock()
+
+	_, ok := kv.values[key]
+	delete(kv.values, key)
+
+	return ok
+}
+
+func (kv *KV) HSet(key string, field string, data string) {
+	kv.mutex.Lock()
+	defer kv.mutex.Unlock()
+
+	hash, ok := kv.hashes[key]
+	if !ok {
+		hash = map[string]string{}
+		kv.hashes[key] = hash
+	}
+	hash[field] = data
+}
+
+func (kv *KV) HExists(key string, field string) bool {
+	kv.mutex.Lock()
+	defer kv.mutex.Unlock()
+
+	_, ok := kv.hashes[key][field]
+
+	return ok
+}
+
+func (kv *KV) HGetAll(key string) map[string]string {
+	kv.mutex.Lock()
+	defer kv.mutex.Unlock()
+
+	result := map[string]string{}
+	for field, data := range kv.hashes[key] {
+		result[field] = data
+	}
+
+	return result
+}
+
+func (kv *KV) HDel(key string, field string) bool {
+	kv.mutex.Lock()
+	defer kv.mutex.Unlock()
+
+	hash, ok := kv.hashes[key]
+	if !ok {
+		return false
+	}
+	_, ok = hash[field]
+	delete(hash, field)
+	if len(hash) == 0 {
+		delete(kv.hashes, key)
+	}
+
+	return ok
+}
+
+// Publish sends data to the current subscribers of channel and returns how
+// many got it. Like Redis, nothing is kept for later subscribers, and a
+// subscriber that does not keep up loses messages.
+func (kv *KV) Publish(channel string, data string) int {
+	kv.mutex.Lock()
+	defer kv.mutex.Unlock()
+
+	received := 0
+	for _, messages := range kv.subscribers[channel] {
+		select {
+		case messages <- data:
+			received++
+		default:
+		}
+	}
+
+	return received
+}
+
+// Subscribe returns the messages published to channel until cancel is called.
+func (kv *KV) Subscribe(channel string) (<-chan string, func()) {
+	kv.mutex.Lock()
+	defer kv.mutex.Unlock()
+
+	messages := make(chan string, 64)
+	kv.subscribers[channel] = append(kv.subscribers[channel], messages)
+
+	cancel := func() {
+		kv.mutex.Lock()
+		defer kv.mutex.Unlock()
+
+		subscribers := kv.subscribers[channel]
+		for i, subscriber := range subscribers {
+			if subscriber == messages {
+				kv.subscribers[channel] = append(subscribers[:i], subscribers[i+1:]...)
+				close(messages)
+				return
+			}
+		}
+	}
+
+	return messages, cancel
+}
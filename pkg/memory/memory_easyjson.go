@@ -0,0 +1,1501 @@
+// Code generated by easyjson for marshaling/unmarshaling. DO NOT EDIT.
+
+package memory
+
+import (
+	json "encoding/json"
+	models "github.com/go-park-mail-ru/2023_2_Vkladyshi/pkg/models"
+	easyjson "github.com/mailru/easyjson"
+	jlexer "github.com/mailru/easyjson/jlexer"
+	jwriter "github.com/mailru/easyjson/jwriter"
+)
+
+// suppress unused package warning
+var (
+	_ *json.RawMessage
+	_ *jlexer.Lexer
+	_ *jwriter.Writer
+	_ easyjson.Marshaler
+)
+
+func easyjsonA17ce059DecodeGithubComGoParkMailRu20232VkladyshiPkgMemory(in *jlexer.Lexer, out *Seed) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "genre":
+			if in.IsNull() {
+				in.Skip()
+				out.Genres = nil
+			} else {
+				in.Delim('[')
+				if out.Genres == nil {
+					if !in.IsDelim(']') {
+						out.Genres = make([]Genre, 0, 2)
+					} else {
+						out.Genres = []Genre{}
+					}
+				} else {
+					out.Genres = (out.Genres)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v1 Genre
+					easyjsonA17ce059DecodeGithubComGoParkMailRu20232VkladyshiPkgMemory1(in, &v1)
+					out.Genres = append(out.Genres, v1)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "film":
+			if in.IsNull() {
+				in.Skip()
+				out.Films = nil
+			} else {
+				in.Delim('[')
+				if out.Films == nil {
+					if !in.IsDelim(']') {
+						out.Films = make([]Film, 0, 0)
+					} else {
+						out.Films = []Film{}
+					}
+				} else {
+					out.Films = (out.Films)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v2 Film
+					easyjsonA17ce059DecodeGithubComGoParkMailRu20232VkladyshiPkgMemory2(in, &v2)
+					out.Films = append(out.Films, v2)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "films_genre":
+			if in.IsNull() {
+				in.Skip()
+				out.FilmGenres = nil
+			} else {
+				in.Delim('[')
+				if out.FilmGenres == nil {
+					if !in.IsDelim(']') {
+						out.FilmGenres = make([]FilmGenre, 0, 4)
+					} else {
+						out.FilmGenres = []FilmGenre{}
+					}
+				} else {
+					out.FilmGenres = (out.FilmGenres)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v3 FilmGenre
+					easyjsonA17ce059DecodeGithubComGoParkMailRu20232VkladyshiPkgMemory3(in, &v3)
+					out.FilmGenres = append(out.FilmGenres, v3)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "crew":
+			if in.IsNull() {
+				in.Skip()
+				out.Crew = nil
+			} else {
+				in.Delim('[')
+				if out.Crew == nil {
+					if !in.IsDelim(']') {
+						out.Crew = make([]Person, 0, 0)
+					} else {
+						out.Crew = []Person{}
+					}
+				} else {
+					out.Crew = (out.Crew)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v4 Person
+					easyjsonA17ce059DecodeGithubComGoParkMailRu20232VkladyshiPkgMemory4(in, &v4)
+					out.Crew = append(out.Crew, v4)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "profession":
+			if in.IsNull() {
+				in.Skip()
+				out.Professions = nil
+			} else {
+				in.Delim('[')
+				if out.Professions == nil {
+					if !in.IsDelim(']') {
+						out.Professions = make([]Profession, 0, 2)
+					} else {
+						out.Professions = []Profession{}
+					}
+				} else {
+					out.Professions = (out.Professions)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v5 Profession
+					easyjsonA17ce059DecodeGithubComGoParkMailRu20232VkladyshiPkgMemory5(in, &v5)
+					out.Professions = append(out.Professions, v5)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "person_in_film":
+			if in.IsNull() {
+				in.Skip()
+				out.Credits = nil
+			} else {
+				in.Delim('[')
+				if out.Credits == nil {
+					if !in.IsDelim(']') {
+						out.Credits = make([]Credit, 0, 1)
+					} else {
+						out.Credits = []Credit{}
+					}
+				} else {
+					out.Credits = (out.Credits)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v6 Credit
+					easyjsonA17ce059DecodeGithubComGoParkMailRu20232VkladyshiPkgMemory6(in, &v6)
+					out.Credits = append(out.Credits, v6)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "calendar":
+			if in.IsNull() {
+				in.Skip()
+				out.Calendar = nil
+			} else {
+				in.Delim('[')
+				if out.Calendar == nil {
+					if !in.IsDelim(']') {
+						out.Calendar = make([]CalendarDate, 0, 4)
+					} else {
+						out.Calendar = []CalendarDate{}
+					}
+				} else {
+					out.Calendar = (out.Calendar)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v7 CalendarDate
+					easyjsonA17ce059DecodeGithubComGoParkMailRu20232VkladyshiPkgMemory7(in, &v7)
+					out.Calendar = append(out.Calendar, v7)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "film_translation":
+			if in.IsNull() {
+				in.Skip()
+				out.FilmTranslations = nil
+			} else {
+				in.Delim('[')
+				if out.FilmTranslations == nil {
+					if !in.IsDelim(']') {
+						out.FilmTranslations = make([]Translation, 0, 1)
+					} else {
+						out.FilmTranslations = []Translation{}
+					}
+				} else {
+					out.FilmTranslations = (out.FilmTranslations)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v8 Translation
+					easyjsonA17ce059DecodeGithubComGoParkMailRu20232VkladyshiPkgMemory8(in, &v8)
+					out.FilmTranslations = append(out.FilmTranslations, v8)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "person_translation":
+			if in.IsNull() {
+				in.Skip()
+				out.PersonTranslations = nil
+			} else {
+				in.Delim('[')
+				if out.PersonTranslations == nil {
+					if !in.IsDelim(']') {
+						out.PersonTranslations = make([]Translation, 0, 1)
+					} else {
+						out.PersonTranslations = []Translation{}
+					}
+				} else {
+					out.PersonTranslations = (out.PersonTranslations)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v9 Translation
+					easyjsonA17ce059DecodeGithubComGoParkMailRu20232VkladyshiPkgMemory8(in, &v9)
+					out.PersonTranslations = append(out.PersonTranslations, v9)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "genre_translation":
+			if in.IsNull() {
+				in.Skip()
+				out.GenreTranslations = nil
+			} else {
+				in.Delim('[')
+				if out.GenreTranslations == nil {
+					if !in.IsDelim(']') {
+						out.GenreTranslations = make([]Translation, 0, 1)
+					} else {
+						out.GenreTranslations = []Translation{}
+					}
+				} else {
+					out.GenreTranslations = (out.GenreTranslations)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v10 Translation
+					easyjsonA17ce059DecodeGithubComGoParkMailRu20232VkladyshiPkgMemory8(in, &v10)
+					out.GenreTranslations = append(out.GenreTranslations, v10)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "users_comment":
+			if in.IsNull() {
+				in.Skip()
+				out.Comments = nil
+			} else {
+				in.Delim('[')
+				if out.Comments == nil {
+					if !in.IsDelim(']') {
+						out.Comments = make([]Comment, 0, 1)
+					} else {
+						out.Comments = []Comment{}
+					}
+				} else {
+					out.Comments = (out.Comments)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v11 Comment
+					easyjsonA17ce059DecodeGithubComGoParkMailRu20232VkladyshiPkgMemory9(in, &v11)
+					out.Comments = append(out.Comments, v11)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "users_favorite_film":
+			if in.IsNull() {
+				in.Skip()
+				out.FavoriteFilms = nil
+			} else {
+				in.Delim('[')
+				if out.FavoriteFilms == nil {
+					if !in.IsDelim(']') {
+						out.FavoriteFilms = make([]Favorite, 0, 4)
+					} else {
+						out.FavoriteFilms = []Favorite{}
+					}
+				} else {
+					out.FavoriteFilms = (out.FavoriteFilms)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v12 Favorite
+					easyjsonA17ce059DecodeGithubComGoParkMailRu20232VkladyshiPkgMemory10(in, &v12)
+					out.FavoriteFilms = append(out.FavoriteFilms, v12)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "users_favorite_actor":
+			if in.IsNull() {
+				in.Skip()
+				out.FavoriteActors = nil
+			} else {
+				in.Delim('[')
+				if out.FavoriteActors == nil {
+					if !in.IsDelim(']') {
+						out.FavoriteActors = make([]Favorite, 0, 4)
+					} else {
+						out.FavoriteActors = []Favorite{}
+					}
+				} else {
+					out.FavoriteActors = (out.FavoriteActors)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v13 Favorite
+					easyjsonA17ce059DecodeGithubComGoParkMailRu20232VkladyshiPkgMemory10(in, &v13)
+					out.FavoriteActors = append(out.FavoriteActors, v13)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "profile":
+			if in.IsNull() {
+				in.Skip()
+				out.Profiles = nil
+			} else {
+				in.Delim('[')
+				if out.Profiles == nil {
+					if !in.IsDelim(']') {
+						out.Profiles = make([]Profile, 0, 0)
+					} else {
+						out.Profiles = []Profile{}
+					}
+				} else {
+					out.Profiles = (out.Profiles)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v14 Profile
+					easyjsonA17ce059DecodeGithubComGoParkMailRu20232VkladyshiPkgMemory11(in, &v14)
+					out.Profiles = append(out.Profiles, v14)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonA17ce059EncodeGithubComGoParkMailRu20232VkladyshiPkgMemory(out *jwriter.Writer, in Seed) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"genre\":"
+		out.RawString(prefix[1:])
+		if in.Genres == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v15, v16 := range in.Genres {
+				if v15 > 0 {
+					out.RawByte(',')
+				}
+				easyjsonA17ce059EncodeGithubComGoParkMailRu20232VkladyshiPkgMemory1(out, v16)
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"film\":"
+		out.RawString(prefix)
+		if in.Films == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v17, v18 := range in.Films {
+				if v17 > 0 {
+					out.RawByte(',')
+				}
+				easyjsonA17ce059EncodeGithubComGoParkMailRu20232VkladyshiPkgMemory2(out, v18)
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"films_genre\":"
+		out.RawString(prefix)
+		if in.FilmGenres == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v19, v20 := range in.FilmGenres {
+				if v19 > 0 {
+					out.RawByte(',')
+				}
+				easyjsonA17ce059EncodeGithubComGoParkMailRu20232VkladyshiPkgMemory3(out, v20)
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"crew\":"
+		out.RawString(prefix)
+		if in.Crew == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v21, v22 := range in.Crew {
+				if v21 > 0 {
+					out.RawByte(',')
+				}
+				easyjsonA17ce059EncodeGithubComGoParkMailRu20232VkladyshiPkgMemory4(out, v22)
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"profession\":"
+		out.RawString(prefix)
+		if in.Professions == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v23, v24 := range in.Professions {
+				if v23 > 0 {
+					out.RawByte(',')
+				}
+				easyjsonA17ce059EncodeGithubComGoParkMailRu20232VkladyshiPkgMemory5(out, v24)
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"person_in_film\":"
+		out.RawString(prefix)
+		if in.Credits == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v25, v26 := range in.Credits {
+				if v25 > 0 {
+					out.RawByte(',')
+				}
+				easyjsonA17ce059EncodeGithubComGoParkMailRu20232VkladyshiPkgMemory6(out, v26)
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"calendar\":"
+		out.RawString(prefix)
+		if in.Calendar == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v27, v28 := range in.Calendar {
+				if v27 > 0 {
+					out.RawByte(',')
+				}
+				easyjsonA17ce059EncodeGithubComGoParkMailRu20232VkladyshiPkgMemory7(out, v28)
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"film_translation\":"
+		out.RawString(prefix)
+		if in.FilmTranslations == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v29, v30 := range in.FilmTranslations {
+				if v29 > 0 {
+					out.RawByte(',')
+				}
+				easyjsonA17ce059EncodeGithubComGoParkMailRu20232VkladyshiPkgMemory8(out, v30)
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"person_translation\":"
+		out.RawString(prefix)
+		if in.PersonTranslations == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v31, v32 := range in.PersonTranslations {
+				if v31 > 0 {
+					out.RawByte(',')
+				}
+				easyjsonA17ce059EncodeGithubComGoParkMailRu20232VkladyshiPkgMemory8(out, v32)
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"genre_translation\":"
+		out.RawString(prefix)
+		if in.GenreTranslations == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v33, v34 := range in.GenreTranslations {
+				if v33 > 0 {
+					out.RawByte(',')
+				}
+				easyjsonA17ce059EncodeGithubComGoParkMailRu20232VkladyshiPkgMemory8(out, v34)
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"users_comment\":"
+		out.RawString(prefix)
+		if in.Comments == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v35, v36 := range in.Comments {
+				if v35 > 0 {
+					out.RawByte(',')
+				}
+				easyjsonA17ce059EncodeGithubComGoParkMailRu20232VkladyshiPkgMemory9(out, v36)
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"users_favorite_film\":"
+		out.RawString(prefix)
+		if in.FavoriteFilms == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v37, v38 := range in.FavoriteFilms {
+				if v37 > 0 {
+					out.RawByte(',')
+				}
+				easyjsonA17ce059EncodeGithubComGoParkMailRu20232VkladyshiPkgMemory10(out, v38)
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"users_favorite_actor\":"
+		out.RawString(prefix)
+		if in.FavoriteActors == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v39, v40 := range in.FavoriteActors {
+				if v39 > 0 {
+					out.RawByte(',')
+				}
+				easyjsonA17ce059EncodeGithubComGoParkMailRu20232VkladyshiPkgMemory10(out, v40)
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"profile\":"
+		out.RawString(prefix)
+		if in.Profiles == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v41, v42 := range in.Profiles {
+				if v41 > 0 {
+					out.RawByte(',')
+				}
+				easyjsonA17ce059EncodeGithubComGoParkMailRu20232VkladyshiPkgMemory11(out, v42)
+			}
+			out.RawByte(']')
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v Seed) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonA17ce059EncodeGithubComGoParkMailRu20232VkladyshiPkgMemory(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v Seed) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonA17ce059EncodeGithubComGoParkMailRu20232VkladyshiPkgMemory(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *Seed) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonA17ce059DecodeGithubComGoParkMailRu20232VkladyshiPkgMemory(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *Seed) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonA17ce059DecodeGithubComGoParkMailRu20232VkladyshiPkgMemory(l, v)
+}
+func easyjsonA17ce059DecodeGithubComGoParkMailRu20232VkladyshiPkgMemory11(in *jlexer.Lexer, out *Profile) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "id":
+			out.Id = uint64(in.Uint64())
+		case "name":
+			out.Name = string(in.String())
+		case "birth_date":
+			out.BirthDate = string(in.String())
+		case "photo":
+			out.Photo = string(in.String())
+		case "login":
+			out.Login = string(in.String())
+		case "password":
+			out.Password = string(in.String())
+		case "email":
+			out.Email = string(in.String())
+		case "registration_date":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.RegistrationDate).UnmarshalJSON(data))
+			}
+		case "role":
+			out.Role = string(in.String())
+		case "is_subscribed":
+			out.IsSubscribed = bool(in.Bool())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonA17ce059EncodeGithubComGoParkMailRu20232VkladyshiPkgMemory11(out *jwriter.Writer, in Profile) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"id\":"
+		out.RawString(prefix[1:])
+		out.Uint64(uint64(in.Id))
+	}
+	{
+		const prefix string = ",\"name\":"
+		out.RawString(prefix)
+		out.String(string(in.Name))
+	}
+	{
+		const prefix string = ",\"birth_date\":"
+		out.RawString(prefix)
+		out.String(string(in.BirthDate))
+	}
+	{
+		const prefix string = ",\"photo\":"
+		out.RawString(prefix)
+		out.String(string(in.Photo))
+	}
+	{
+		const prefix string = ",\"login\":"
+		out.RawString(prefix)
+		out.String(string(in.Login))
+	}
+	{
+		const prefix string = ",\"password\":"
+		out.RawString(prefix)
+		out.String(string(in.Password))
+	}
+	{
+		const prefix string = ",\"email\":"
+		out.RawString(prefix)
+		out.String(string(in.Email))
+	}
+	{
+		const prefix string = ",\"registration_date\":"
+		out.RawString(prefix)
+		out.Raw((in.RegistrationDate).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"role\":"
+		out.RawString(prefix)
+		out.String(string(in.Role))
+	}
+	{
+		const prefix string = ",\"is_subscribed\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.IsSubscribed))
+	}
+	out.RawByte('}')
+}
+func easyjsonA17ce059DecodeGithubComGoParkMailRu20232VkladyshiPkgMemory10(in *jlexer.Lexer, out *Favorite) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "id_user":
+			out.IdUser = uint64(in.Uint64())
+		case "id":
+			out.Id = uint64(in.Uint64())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonA17ce059EncodeGithubComGoParkMailRu20232VkladyshiPkgMemory10(out *jwriter.Writer, in Favorite) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"id_user\":"
+		out.RawString(prefix[1:])
+		out.Uint64(uint64(in.IdUser))
+	}
+	{
+		const prefix string = ",\"id\":"
+		out.RawString(prefix)
+		out.Uint64(uint64(in.Id))
+	}
+	out.RawByte('}')
+}
+func easyjsonA17ce059DecodeGithubComGoParkMailRu20232VkladyshiPkgMemory9(in *jlexer.Lexer, out *Comment) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "id_user":
+			out.IdUser = uint64(in.Uint64())
+		case "id_film":
+			out.IdFilm = uint64(in.Uint64())
+		case "rating":
+			out.Rating = uint16(in.Uint16())
+		case "comment":
+			out.Comment = string(in.String())
+		case "date":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.Date).UnmarshalJSON(data))
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonA17ce059EncodeGithubComGoParkMailRu20232VkladyshiPkgMemory9(out *jwriter.Writer, in Comment) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"id_user\":"
+		out.RawString(prefix[1:])
+		out.Uint64(uint64(in.IdUser))
+	}
+	{
+		const prefix string = ",\"id_film\":"
+		out.RawString(prefix)
+		out.Uint64(uint64(in.IdFilm))
+	}
+	{
+		const prefix string = ",\"rating\":"
+		out.RawString(prefix)
+		out.Uint16(uint16(in.Rating))
+	}
+	{
+		const prefix string = ",\"comment\":"
+		out.RawString(prefix)
+		out.String(string(in.Comment))
+	}
+	{
+		const prefix string = ",\"date\":"
+		out.RawString(prefix)
+		out.Raw((in.Date).MarshalJSON())
+	}
+	out.RawByte('}')
+}
+func easyjsonA17ce059DecodeGithubComGoParkMailRu20232VkladyshiPkgMemory8(in *jlexer.Lexer, out *Translation) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "id":
+			out.Id = uint64(in.Uint64())
+		case "lang":
+			out.Lang = string(in.String())
+		case "title":
+			out.Title = string(in.String())
+		case "info":
+			out.Info = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonA17ce059EncodeGithubComGoParkMailRu20232VkladyshiPkgMemory8(out *jwriter.Writer, in Translation) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"id\":"
+		out.RawString(prefix[1:])
+		out.Uint64(uint64(in.Id))
+	}
+	{
+		const prefix string = ",\"lang\":"
+		out.RawString(prefix)
+		out.String(string(in.Lang))
+	}
+	{
+		const prefix string = ",\"title\":"
+		out.RawString(prefix)
+		out.String(string(in.Title))
+	}
+	{
+		const prefix string = ",\"info\":"
+		out.RawString(prefix)
+		out.String(string(in.Info))
+	}
+	out.RawByte('}')
+}
+func easyjsonA17ce059DecodeGithubComGoParkMailRu20232VkladyshiPkgMemory7(in *jlexer.Lexer, out *CalendarDate) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "id":
+			out.IdFilm = uint64(in.Uint64())
+		case "release_month":
+			out.Month = uint8(in.Uint8())
+		case "release_day":
+			out.Day = uint8(in.Uint8())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonA17ce059EncodeGithubComGoParkMailRu20232VkladyshiPkgMemory7(out *jwriter.Writer, in CalendarDate) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"id\":"
+		out.RawString(prefix[1:])
+		out.Uint64(uint64(in.IdFilm))
+	}
+	{
+		const prefix string = ",\"release_month\":"
+		out.RawString(prefix)
+		out.Uint8(uint8(in.Month))
+	}
+	{
+		const prefix string = ",\"release_day\":"
+		out.RawString(prefix)
+		out.Uint8(uint8(in.Day))
+	}
+	out.RawByte('}')
+}
+func easyjsonA17ce059DecodeGithubComGoParkMailRu20232VkladyshiPkgMemory6(in *jlexer.Lexer, out *Credit) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "id_film":
+			out.IdFilm = uint64(in.Uint64())
+		case "id_person":
+			out.IdPerson = uint64(in.Uint64())
+		case "id_profession":
+			out.IdProfession = uint64(in.Uint64())
+		case "character_name":
+			out.Character = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonA17ce059EncodeGithubComGoParkMailRu20232VkladyshiPkgMemory6(out *jwriter.Writer, in Credit) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"id_film\":"
+		out.RawString(prefix[1:])
+		out.Uint64(uint64(in.IdFilm))
+	}
+	{
+		const prefix string = ",\"id_person\":"
+		out.RawString(prefix)
+		out.Uint64(uint64(in.IdPerson))
+	}
+	{
+		const prefix string = ",\"id_profession\":"
+		out.RawString(prefix)
+		out.Uint64(uint64(in.IdProfession))
+	}
+	{
+		const prefix string = ",\"character_name\":"
+		out.RawString(prefix)
+		out.String(string(in.Character))
+	}
+	out.RawByte('}')
+}
+func easyjsonA17ce059DecodeGithubComGoParkMailRu20232VkladyshiPkgMemory5(in *jlexer.Lexer, out *Profession) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "id":
+			out.Id = uint64(in.Uint64())
+		case "title":
+			out.Title = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonA17ce059EncodeGithubComGoParkMailRu20232VkladyshiPkgMemory5(out *jwriter.Writer, in Profession) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"id\":"
+		out.RawString(prefix[1:])
+		out.Uint64(uint64(in.Id))
+	}
+	{
+		const prefix string = ",\"title\":"
+		out.RawString(prefix)
+		out.String(string(in.Title))
+	}
+	out.RawByte('}')
+}
+func easyjsonA17ce059DecodeGithubComGoParkMailRu20232VkladyshiPkgMemory4(in *jlexer.Lexer, out *Person) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "id":
+			out.Id = uint64(in.Uint64())
+		case "name":
+			out.Name = string(in.String())
+		case "birth_date":
+			out.BirthDate = string(in.String())
+		case "photo":
+			out.Photo = string(in.String())
+		case "country":
+			out.Country = string(in.String())
+		case "info":
+			out.Info = string(in.String())
+		case "placeholder":
+			if in.IsNull() {
+				in.Skip()
+				out.Placeholder = nil
+			} else {
+				if out.Placeholder == nil {
+					out.Placeholder = new(models.Placeholder)
+				}
+				(*out.Placeholder).UnmarshalEasyJSON(in)
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonA17ce059EncodeGithubComGoParkMailRu20232VkladyshiPkgMemory4(out *jwriter.Writer, in Person) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"id\":"
+		out.RawString(prefix[1:])
+		out.Uint64(uint64(in.Id))
+	}
+	{
+		const prefix string = ",\"name\":"
+		out.RawString(prefix)
+		out.String(string(in.Name))
+	}
+	{
+		const prefix string = ",\"birth_date\":"
+		out.RawString(prefix)
+		out.String(string(in.BirthDate))
+	}
+	{
+		const prefix string = ",\"photo\":"
+		out.RawString(prefix)
+		out.String(string(in.Photo))
+	}
+	{
+		const prefix string = ",\"country\":"
+		out.RawString(prefix)
+		out.String(string(in.Country))
+	}
+	{
+		const prefix string = ",\"info\":"
+		out.RawString(prefix)
+		out.String(string(in.Info))
+	}
+	{
+		const prefix string = ",\"placeholder\":"
+		out.RawString(prefix)
+		if in.Placeholder == nil {
+			out.RawString("null")
+		} else {
+			(*in.Placeholder).MarshalEasyJSON(out)
+		}
+	}
+	out.RawByte('}')
+}
+func easyjsonA17ce059DecodeGithubComGoParkMailRu20232VkladyshiPkgMemory3(in *jlexer.Lexer, out *FilmGenre) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "id_film":
+			out.IdFilm = uint64(in.Uint64())
+		case "id_genre":
+			out.IdGenre = uint64(in.Uint64())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonA17ce059EncodeGithubComGoParkMailRu20232VkladyshiPkgMemory3(out *jwriter.Writer, in FilmGenre) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"id_film\":"
+		out.RawString(prefix[1:])
+		out.Uint64(uint64(in.IdFilm))
+	}
+	{
+		const prefix string = ",\"id_genre\":"
+		out.RawString(prefix)
+		out.Uint64(uint64(in.IdGenre))
+	}
+	out.RawByte('}')
+}
+func easyjsonA17ce059DecodeGithubComGoParkMailRu20232VkladyshiPkgMemory2(in *jlexer.Lexer, out *Film) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "id":
+			out.Id = uint64(in.Uint64())
+		case "title":
+			out.Title = string(in.String())
+		case "info":
+			out.Info = string(in.String())
+		case "poster":
+			out.Poster = string(in.String())
+		case "release_date":
+			out.ReleaseDate = string(in.String())
+		case "country":
+			out.Country = string(in.String())
+		case "mpaa":
+			out.Mpaa = string(in.String())
+		case "original_title":
+			out.OriginalTitle = string(in.String())
+		case "runtime":
+			out.Runtime = uint32(in.Uint32())
+		case "budget":
+			out.Budget = uint64(in.Uint64())
+		case "box_office":
+			out.BoxOffice = uint64(in.Uint64())
+		case "languages":
+			if in.IsNull() {
+				in.Skip()
+				out.Languages = nil
+			} else {
+				in.Delim('[')
+				if out.Languages == nil {
+					if !in.IsDelim(']') {
+						out.Languages = make([]string, 0, 4)
+					} else {
+						out.Languages = []string{}
+					}
+				} else {
+					out.Languages = (out.Languages)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v43 string
+					v43 = string(in.String())
+					out.Languages = append(out.Languages, v43)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "trailers":
+			if in.IsNull() {
+				in.Skip()
+				out.Trailers = nil
+			} else {
+				in.Delim('[')
+				if out.Trailers == nil {
+					if !in.IsDelim(']') {
+						out.Trailers = make([]string, 0, 4)
+					} else {
+						out.Trailers = []string{}
+					}
+				} else {
+					out.Trailers = (out.Trailers)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v44 string
+					v44 = string(in.String())
+					out.Trailers = append(out.Trailers, v44)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "imdb_id":
+			out.ImdbId = string(in.String())
+		case "kinopoisk_id":
+			out.KinopoiskId = string(in.String())
+		case "placeholder":
+			if in.IsNull() {
+				in.Skip()
+				out.Placeholder = nil
+			} else {
+				if out.Placeholder == nil {
+					out.Placeholder = new(models.Placeholder)
+				}
+				(*out.Placeholder).UnmarshalEasyJSON(in)
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonA17ce059EncodeGithubComGoParkMailRu20232VkladyshiPkgMemory2(out *jwriter.Writer, in Film) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"id\":"
+		out.RawString(prefix[1:])
+		out.Uint64(uint64(in.Id))
+	}
+	{
+		const prefix string = ",\"title\":"
+		out.RawString(prefix)
+		out.String(string(in.Title))
+	}
+	{
+		const prefix string = ",\"info\":"
+		out.RawString(prefix)
+		out.String(string(in.Info))
+	}
+	{
+		const prefix string = ",\"poster\":"
+		out.RawString(prefix)
+		out.String(string(in.Poster))
+	}
+	{
+		const prefix string = ",\"release_date\":"
+		out.RawString(prefix)
+		out.String(string(in.ReleaseDate))
+	}
+	{
+		const prefix string = ",\"country\":"
+		out.RawString(prefix)
+		out.String(string(in.Country))
+	}
+	{
+		const prefix string = ",\"mpaa\":"
+		out.RawString(prefix)
+		out.String(string(in.Mpaa))
+	}
+	{
+		const prefix string = ",\"original_title\":"
+		out.RawString(prefix)
+		out.String(string(in.OriginalTitle))
+	}
+	{
+		const prefix string = ",\"runtime\":"
+		out.RawString(prefix)
+		out.Uint32(uint32(in.Runtime))
+	}
+	{
+		const prefix string = ",\"budget\":"
+		out.RawString(prefix)
+		out.Uint64(uint64(in.Budget))
+	}
+	{
+		const prefix string = ",\"box_office\":"
+		out.RawString(prefix)
+		out.Uint64(uint64(in.BoxOffice))
+	}
+	{
+		const prefix string = ",\"languages\":"
+		out.RawString(prefix)
+		if in.Languages == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v45, v46 := range in.Languages {
+				if v45 > 0 {
+					out.RawByte(',')
+				}
+				out.String(string(v46))
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"trailers\":"
+		out.RawString(prefix)
+		if in.Trailers == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v47, v48 := range in.Trailers {
+				if v47 > 0 {
+					out.RawByte(',')
+				}
+				out.String(string(v48))
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"imdb_id\":"
+		out.RawString(prefix)
+		out.String(string(in.ImdbId))
+	}
+	{
+		const prefix string = ",\"kinopoisk_id\":"
+		out.RawString(prefix)
+		out.String(string(in.KinopoiskId))
+	}
+	{
+		const prefix string = ",\"placeholder\":"
+		out.RawString(prefix)
+		if in.Placeholder == nil {
+			out.RawString("null")
+		} else {
+			(*in.Placeholder).MarshalEasyJSON(out)
+		}
+	}
+	out.RawByte('}')
+}
+func easyjsonA17ce059DecodeGithubComGoParkMailRu20232VkladyshiPkgMemory1(in *jlexer.Lexer, out *Genre) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "id":
+			out.Id = uint64(in.Uint64())
+		case "title":
+			out.Title = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonA17ce059EncodeGithubComGoParkMailRu20232VkladyshiPkgMemory1(out *jwriter.Writer, in Genre) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"id\":"
+		out.RawString(prefix[1:])
+		out.Uint64(uint64(in.Id))
+	}
+	{
+		const prefix string = ",\"title\":"
+		out.RawString(prefix)
+		out.String(string(in.Title))
+	}
+	out.RawByte('}')
+}
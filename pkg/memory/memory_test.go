@@ -0,0 +1,135 @@
+package memory
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestLoad(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "seed.json")
+	err := os.WriteFile(path, []byte(`{"genre": [{"id": 1, "title": "драма"}], "film": [{"id": 1, "title": "Солярис"}]}`), 0o600)
+	if err != nil {
+		t.Fatalf("cant write seed: %s", err)
+	}
+
+	store, err := Load(path)
+	if err != nil {
+		t.Fatalf("Load error: %s", err)
+	}
+	if len(store.Genres) != 1 || store.Film(1) == nil || store.Film(1).Title != "Солярис" {
+		t.Errorf("unexpected store %v", store.Seed)
+	}
+	if !reflect.DeepEqual(store.Professions, defaultProfessions) {
+		t.Errorf("expected default professions, have %v", store.Professions)
+	}
+
+	opened, err := Open(path)
+	if err != nil {
+		t.Fatalf("Open error: %s", err)
+	}
+	again, _ := Open(path)
+	if opened != again {
+		t.Errorf("expected one store per path")
+	}
+
+	err = os.WriteFile(path, []byte(`{"film": 1}`), 0o600)
+	if err != nil {
+		t.Fatalf("cant write seed: %s", err)
+	}
+	_, err = Load(path)
+	if err == nil {
+		t.Errorf("expected error for malformed seed")
+	}
+}
+
+func TestHelpers(t *testing.T) {
+	rows := []int{1, 2, 3, 4, 5}
+	if have := Paginate(rows, 1, 2); !reflect.DeepEqual(have, []int{2, 3}) {
+		t.Errorf("Paginate(1, 2) = %v", have)
+	}
+	if have := Paginate(rows, 4, 10); !reflect.DeepEqual(have, []int{5}) {
+		t.Errorf("Paginate(4, 10) = %v", have)
+	}
+	if have := Paginate(rows, 10, 10); len(have) != 0 {
+		t.Errorf("Paginate(10, 10) = %v", have)
+	}
+
+	films := []Film{{Id: 3}, {Id: 7}, {Id: 2}}
+	if have := NextId(films, func(f Film) uint64 { return f.Id }); have != 8 {
+		t.Errorf("NextId = %d, want 8", have)
+	}
+
+	if have := Date("1972-03-20"); have != "1972-03-20T00:00:00Z" {
+		t.Errorf("Date = %s", have)
+	}
+
+	store := &Store{Seed: Seed{Comments: []Comment{
+		{IdUser: 1, IdFilm: 1, Rating: 9},
+		{IdUser: 2, IdFilm: 1, Rating: 6},
+		{IdUser: 1, IdFilm: 2, Rating: 1},
+	}}}
+	rating, number := store.Rating(1)
+	if rating != 7.5 || number != 2 {
+		t.Errorf("Rating = %v, %v", rating, number)
+	}
+}
+
+func TestKV(t *testing.T) {
+	now := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	kv := NewKV()
+	kv.now = func() time.Time { return now }
+
+	kv.Set("sid", "login", time.Hour)
+	kv.Set("forever", "1", 0)
+	if data, ok := kv.Get("sid"); !ok || data != "login" {
+		t.Errorf("Get = %q, %v", data, ok)
+	}
+	if _, expiresAt, _ := kv.GetWithExpiry("sid"); !expiresAt.Equal(now.Add(time.Hour)) {
+		t.Errorf("GetWithExpiry expires at %v", expiresAt)
+	}
+	if _, expiresAt, _ := kv.GetWithExpiry("forever"); !expiresAt.IsZero() {
+		t.Errorf("GetWithExpiry expires at %v for a key without ttl", expiresAt)
+	}
+
+	now = now.Add(time.Hour)
+	if _, ok := kv.Get("sid"); ok {
+		t.Errorf("expected the key to expire")
+	}
+	if _, ok := kv.Get("forever"); !ok {
+		t.Errorf("expected the key without ttl to stay")
+	}
+	if !kv.Del("forever") || kv.Del("forever") {
+		t.Errorf("Del should report whether the key existed")
+	}
+
+	kv.HSet("nearfilms:1", "2", "1")
+	kv.HSet("nearfilms:1", "3", "1")
+	if !kv.HExists("nearfilms:1", "2") || kv.HExists("nearfilms:1", "4") {
+		t.Errorf("unexpected HExists result")
+	}
+	if !kv.HDel("nearfilms:1", "2") || kv.HDel("nearfilms:1", "2") {
+		t.Errorf("HDel should report whether the field existed")
+	}
+	if have := kv.HGetAll("nearfilms:1"); !reflect.DeepEqual(have, map[string]string{"3": "1"}) {
+		t.Errorf("HGetAll = %v", have)
+	}
+
+	messages, cancel := kv.Subscribe("events")
+	if kv.Publish("events", "logout") != 1 || <-messages != "logout" {
+		t.Errorf("expected the subscriber to get the message")
+	}
+	cancel()
+	if kv.Publish("events", "logout") != 0 {
+		t.Errorf("expected no subscribers after cancel")
+	}
+	if _, ok := <-messages; ok {
+		t.Errorf("expected the channel to be closed")
+	}
+
+	if OpenKV("localhost:6379/0") != OpenKV("localhost:6379/0") {
+		t.Errorf("expected one database per name")
+	}
+}
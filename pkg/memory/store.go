@@ -0,0 +1,296 @@
+package memory
+
+import (
+	"fmt"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/go-park-mail-ru/2023_2_Vkladyshi/pkg/models"
+	"github.com/mailru/easyjson"
+)
+
+// Rows mirror the columns of the Postgres tables of the same name.
+type (
+	Genre struct {
+		Id    uint64 `json:"id"`
+		Title string `json:"title"`
+	}
+
+	Film struct {
+		Id            uint64              `json:"id"`
+		Title         string              `json:"title"`
+		Info          string              `json:"info"`
+		Poster        string              `json:"poster"`
+		ReleaseDate   string              `json:"release_date"`
+		Country       string              `json:"country"`
+		Mpaa          string              `json:"mpaa"`
+		OriginalTitle string              `json:"original_title"`
+		Runtime       uint32              `json:"runtime"`
+		Budget        uint64              `json:"budget"`
+		BoxOffice     uint64              `json:"box_office"`
+		Languages     []string            `json:"languages"`
+		Trailers      []string            `json:"trailers"`
+		ImdbId        string              `json:"imdb_id"`
+		KinopoiskId   string              `json:"kinopoisk_id"`
+		Placeholder   *models.Placeholder `json:"placeholder"`
+	}
+
+	FilmGenre struct {
+		IdFilm  uint64 `json:"id_film"`
+		IdGenre uint64 `json:"id_genre"`
+	}
+
+	Person struct {
+		Id          uint64              `json:"id"`
+		Name        string              `json:"name"`
+		BirthDate   string              `json:"birth_date"`
+		Photo       string              `json:"photo"`
+		Country     string              `json:"country"`
+		Info        string              `json:"info"`
+		Placeholder *models.Placeholder `json:"placeholder"`
+	}
+
+	Profession struct {
+		Id    uint64 `json:"id"`
+		Title string `json:"title"`
+	}
+
+	Credit struct {
+		IdFilm       uint64 `json:"id_film"`
+		IdPerson     uint64 `json:"id_person"`
+		IdProfession uint64 `json:"id_profession"`
+		Character    string `json:"character_name"`
+	}
+
+	CalendarDate struct {
+		IdFilm uint64 `json:"id"`
+		Month  uint8  `json:"release_month"`
+		Day    uint8  `json:"release_day"`
+	}
+
+	Translation struct {
+		Id    uint64 `json:"id"`
+		Lang  string `json:"lang"`
+		Title string `json:"title"`
+		Info  string `json:"info"`
+	}
+
+	Comment struct {
+		IdUser  uint64    `json:"id_user"`
+		IdFilm  uint64    `json:"id_film"`
+		Rating  uint16    `json:"rating"`
+		Comment string    `json:"comment"`
+		Date    time.Time `json:"date"`
+	}
+
+	Favorite struct {
+		IdUser uint64 `json:"id_user"`
+		Id     uint64 `json:"id"`
+	}
+
+	Profile struct {
+		Id               uint64    `json:"id"`
+		Name             string    `json:"name"`
+		BirthDate        string    `json:"birth_date"`
+		Photo            string    `json:"photo"`
+		Login            string    `json:"login"`
+		Password         string    `json:"password"`
+		Email            string    `json:"email"`
+		RegistrationDate time.Time `json:"registration_date"`
+		Role             string    `json:"role"`
+		IsSubscribed     bool      `json:"is_subscribed"`
+	}
+)
+
+// Seed is the content of a seed file. Every table is optional.
+//
+//easyjson:json
+type Seed struct {
+	Genres             []Genre        `json:"genre"`
+	Films              []Film         `json:"film"`
+	FilmGenres         []FilmGenre    `json:"films_genre"`
+	Crew               []Person       `json:"crew"`
+	Professions        []Profession   `json:"profession"`
+	Credits            []Credit       `json:"person_in_film"`
+	Calendar           []CalendarDate `json:"calendar"`
+	FilmTranslations   []Translation  `json:"film_translation"`
+	PersonTranslations []Translation  `json:"person_translation"`
+	GenreTranslations  []Translation  `json:"genre_translation"`
+	Comments           []Comment      `json:"users_comment"`
+	FavoriteFilms      []Favorite     `json:"users_favorite_film"`
+	FavoriteActors     []Favorite     `json:"users_favorite_actor"`
+	Profiles           []Profile      `json:"profile"`
+}
+
+// Store keeps the tables of all services in memory. Repositories lock it
+// for the whole query, like a single Postgres transaction.
+type Store struct {
+	sync.RWMutex
+	Seed
+}
+
+// The professions the crew queries look up by title, as the baseline
+// migration inserts them.
+var defaultProfessions = []Profession{
+	{Id: 1, Title: "актёр"},
+	{Id: 2, Title: "режиссёр"},
+	{Id: 3, Title: "сценарист"},
+}
+
+var (
+	storesMutex sync.Mutex
+	stores      = map[string]*Store{}
+)
+
+// Open returns the store loaded from a seed file. Every repository of the
+// process opened with the same path shares one store, so films, comments and
+// profiles see each other's writes. An empty path gives an empty store.
+func Open(path string) (*Store, error) {
+	storesMutex.Lock()
+	defer storesMutex.Unlock()
+
+	if store, ok := stores[path]; ok {
+		return store, nil
+	}
+
+	store, err := Load(path)
+	if err != nil {
+		return nil, err
+	}
+	stores[path] = store
+
+	return store, nil
+}
+
+func Load(path string) (*Store, error) {
+	store := &Store{}
+	if path != "" {
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return nil, fmt.Errorf("read seed err: %w", err)
+		}
+		err = easyjson.Unmarshal(data, &store.Seed)
+		if err != nil {
+			return nil, fmt.Errorf("parse seed err: %w", err)
+		}
+	}
+
+	if len(store.Professions) == 0 {
+		store.Professions = append(store.Professions, defaultProfessions...)
+	}
+
+	return store, nil
+}
+
+// NextId returns the id a new row gets, like a serial column would.
+func NextId[T any](rows []T, id func(T) uint64) uint64 {
+	var max uint64
+	for _, row := range rows {
+		if id(row) > max {
+			max = id(row)
+		}
+	}
+
+	return max + 1
+}
+
+// Paginate applies OFFSET and LIMIT.
+func Paginate[T any](rows []T, offset uint64, limit uint64) []T {
+	if offset >= uint64(len(rows)) {
+		return rows[:0]
+	}
+	rows = rows[offset:]
+	if limit < uint64(len(rows)) {
+		rows = rows[:limit]
+	}
+
+	return rows
+}
+
+// Date formats a date column the way it is scanned from Postgres into a
+// string. Empty and malformed dates are returned as they are.
+func Date(value string) string {
+	date, err := time.Parse(time.DateOnly, value)
+	if err != nil {
+		return value
+	}
+
+	return date.Format(time.RFC3339Nano)
+}
+
+func (store *Store) Film(id uint64) *Film {
+	for i := range store.Films {
+		if store.Films[i].Id == id {
+			return &store.Films[i]
+		}
+	}
+
+	return nil
+}
+
+func (store *Store) Person(id uint64) *Person {
+	for i := range store.Crew {
+		if store.Crew[i].Id == id {
+			return &store.Crew[i]
+		}
+	}
+
+	return nil
+}
+
+func (store *Store) Genre(id uint64) *Genre {
+	for i := range store.Genres {
+		if store.Genres[i].Id == id {
+			return &store.Genres[i]
+		}
+	}
+
+	return nil
+}
+
+func (store *Store) Profession(id uint64) *Profession {
+	for i := range store.Professions {
+		if store.Professions[i].Id == id {
+			return &store.Professions[i]
+		}
+	}
+
+	return nil
+}
+
+func (store *Store) ProfessionId(title string) uint64 {
+	for _, profession := range store.Professions {
+		if profession.Title == title {
+			return profession.Id
+		}
+	}
+
+	return 0
+}
+
+func (store *Store) Profile(login string) *Profile {
+	for i := range store.Profiles {
+		if store.Profiles[i].Login == login {
+			return &store.Profiles[i]
+		}
+	}
+
+	return nil
+}
+
+// Rating returns the average rating of a film and the number of ratings.
+func (store *Store) Rating(filmId uint64) (float64, uint64) {
+	var sum, count uint64
+	for _, comment := range store.Comments {
+		if comment.IdFilm == filmId {
+			sum += uint64(comment.Rating)
+			count++
+		}
+	}
+	if count == 0 {
+		return 0, 0
+	}
+
+	return float64(sum) / float64(count), count
+}
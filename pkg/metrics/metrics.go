@@ -1,6 +1,8 @@
 package metrics
 
 import (
+	"sync"
+
 	"github.com/prometheus/client_golang/prometheus"
 )
 
@@ -9,7 +11,22 @@ type Metrics struct {
 	Hits *prometheus.CounterVec
 }
 
+var (
+	once   sync.Once
+	shared *Metrics
+)
+
+// GetMetrics returns the collectors of the process. They are registered once,
+// so services running in one process share them.
 func GetMetrics() *Metrics {
+	once.Do(func() {
+		shared = newMetrics()
+	})
+
+	return shared
+}
+
+func newMetrics() *Metrics {
 	description := []string{"status", "path"}
 
 	metrics := &Metrics{
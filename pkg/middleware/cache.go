@@ -0,0 +1,140 @@
+package middleware
+
+import (
+	"container/list"
+	"context"
+	"sync"
+	"time"
+)
+
+// SessionCache keeps the latest validated sessions for a short time, so
+// that AuthCheck does not ask the authorization service on every request.
+type SessionCache struct {
+	mutex    sync.Mutex
+	capacity int
+	ttl      time.Duration
+	now      func() time.Time
+	items    map[string]*list.Element
+	order    *list.List // the most recently used session first
+}
+
+type cacheEntry struct {
+	sid       string
+	principal *Principal
+	expiresAt time.Time
+}
+
+func NewSessionCache(capacity int, ttl time.Duration) *SessionCache {
+	return &SessionCache{
+		capacity: capacity,
+		ttl:      ttl,
+		now:      time.Now,
+		items:    map[string]*list.Element{},
+		order:    list.New(),
+	}
+}
+
+func (cache *SessionCache) Get(sid string) (*Principal, bool) {
+	cache.mutex.Lock()
+	defer cache.mutex.Unlock()
+
+	item, ok := cache.items[sid]
+	if !ok {
+		return nil, false
+	}
+	entry := item.Value.(*cacheEntry)
+	if !cache.now().Before(entry.expiresAt) {
+		cache.remove(item)
+		return nil, false
+	}
+	cache.order.MoveToFront(item)
+
+	return entry.principal, true
+}
+
+// Add caches the principal for the ttl of the cache, but not beyond the
+// expiry of the session itself.
+func (cache *SessionCache) Add(sid string, principal *Principal) {
+	cache.mutex.Lock()
+	defer cache.mutex.Unlock()
+
+	expiresAt := cache.now().Add(cache.ttl)
+	if !principal.ExpiresAt.IsZero() && principal.ExpiresAt.Before(expiresAt) {
+		expiresAt = principal.ExpiresAt
+	}
+
+	if item, ok := cache.items[sid]; ok {
+		cache.remove(item)
+	}
+	cache.items[sid] = cache.order.PushFront(&cacheEntry{sid: sid, principal: principal, expiresAt: expiresAt})
+
+	for cache.order.Len() > cache.capacity {
+		cache.remove(cache.order.Back())
+	}
+}
+
+// Remove forgets one session, for example after a logout.
+func (cache *SessionCache) Remove(sid string) {
+	cache.mutex.Lock()
+	defer cache.mutex.Unlock()
+
+	if item, ok := cache.items[sid]; ok {
+		cache.remove(item)
+	}
+}
+
+// RemoveLogin forgets every session of the user, for example after the
+// role or the login has changed.
+func (cache *SessionCache) RemoveLogin(login string) {
+	cache.mutex.Lock()
+	defer cache.mutex.Unlock()
+
+	for item := cache.order.Front(); item != nil; {
+		next := item.Next()
+		if item.Value.(*cacheEntry).principal.Login == login {
+			cache.remove(item)
+		}
+		item = next
+	}
+}
+
+func (cache *SessionCache) Len() int {
+	cache.mutex.Lock()
+	defer cache.mutex.Unlock()
+
+	return cache.order.Len()
+}
+
+func (cache *SessionCache) remove(item *list.Element) {
+	cache.order.Remove(item)
+	delete(cache.items, item.Value.(*cacheEntry).sid)
+}
+
+type cachedCore struct {
+	core  Core
+	cache *SessionCache
+}
+
+// CacheSessions makes core answer repeated session checks from cache, a nil
+// cache leaves core as it is. Failed checks are not cached.
+func CacheSessions(core Core, cache *SessionCache) Core {
+	if cache == nil {
+		return core
+	}
+
+	return &cachedCore{core: core, cache: cache}
+}
+
+func (c *cachedCore) ValidateSession(ctx context.Context, sid string) (*Principal, error) {
+	if principal, ok := c.cache.Get(sid); ok {
+		return principal, nil
+	}
+
+	principal, err := c.core.ValidateSession(ctx, sid)
+	if err != nil {
+		return nil, err
+	}
+	c.cache.Add(sid, principal)
+
+	return principal, nil
+}
@@ -0,0 +1,120 @@
+package middleware
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"testing"
+	"time"
+
+	"github.com/go-park-mail-ru/2023_2_Vkladyshi/configs"
+	"github.com/go-park-mail-ru/2023_2_Vkladyshi/pkg/memory"
+)
+
+func TestSessionCache(t *testing.T) {
+	now := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	cache := NewSessionCache(2, time.Minute)
+	cache.now = func() time.Time { return now }
+
+	cache.Add("a", &Principal{Id: 1, Login: "viewer"})
+	cache.Add("b", &Principal{Id: 1, Login: "viewer"})
+	if _, ok := cache.Get("a"); !ok {
+		t.Errorf("expected a to be cached")
+	}
+
+	cache.Add("c", &Principal{Id: 2, Login: "admin", ExpiresAt: now.Add(time.Second)})
+	if _, ok := cache.Get("b"); ok {
+		t.Errorf("expected the least recently used session to be evicted")
+	}
+	if cache.Len() != 2 {
+		t.Errorf("Len = %d", cache.Len())
+	}
+
+	now = now.Add(time.Second)
+	if _, ok := cache.Get("c"); ok {
+		t.Errorf("expected the session to expire with the session itself")
+	}
+
+	now = now.Add(time.Minute)
+	if _, ok := cache.Get("a"); ok {
+		t.Errorf("expected the session to expire after the ttl")
+	}
+
+	cache.Add("a", &Principal{Id: 1, Login: "viewer"})
+	cache.Add("b", &Principal{Id: 1, Login: "viewer"})
+	cache.Apply(SessionEvent{Login: "viewer"})
+	if cache.Len() != 0 {
+		t.Errorf("expected every session of the user to be removed")
+	}
+}
+
+type countingCore struct {
+	calls int
+}
+
+func (c *countingCore) ValidateSession(ctx context.Context, sid string) (*Principal, error) {
+	c.calls++
+	if sid != "good" {
+		return nil, errors.New("no session")
+	}
+
+	return &Principal{Id: 7, Login: "viewer"}, nil
+}
+
+func TestCacheSessions(t *testing.T) {
+	inner := &countingCore{}
+	if CacheSessions(inner, nil) != Core(inner) {
+		t.Errorf("expected no cache to keep the core")
+	}
+
+	core := CacheSessions(inner, NewSessionCache(10, time.Minute))
+	for i := 0; i < 3; i++ {
+		principal, err := core.ValidateSession(context.Background(), "good")
+		if err != nil || principal.Id != 7 {
+			t.Errorf("ValidateSession = %v, %v", principal, err)
+		}
+		_, _ = core.ValidateSession(context.Background(), "bad")
+	}
+	if inner.calls != 4 {
+		t.Errorf("expected one call for the good session and one per bad, have %d", inner.calls)
+	}
+}
+
+func TestSessionEvents(t *testing.T) {
+	for _, event := range []SessionEvent{{Sid: "s:1"}, {Login: "viewer"}} {
+		parsed, err := ParseSessionEvent(event.String())
+		if err != nil || parsed != event {
+			t.Errorf("ParseSessionEvent(%q) = %v, %v", event.String(), parsed, err)
+		}
+	}
+	for _, message := range []string{"", "sid:", "role:admin"} {
+		if _, err := ParseSessionEvent(message); err == nil {
+			t.Errorf("expected error for %q", message)
+		}
+	}
+
+	config := configs.DbRedisCfg{Host: t.Name(), Backend: "memory"}
+	kv := memory.OpenKV(fmt.Sprintf("%s/%d", config.Host, config.DbNumber))
+	cache := NewSessionCache(10, time.Minute)
+	cache.Add("sid", &Principal{Id: 7, Login: "viewer"})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan error)
+	go func() {
+		done <- ListenSessionEvents(ctx, config, cache, slog.New(slog.NewTextHandler(io.Discard, nil)))
+	}()
+
+	for kv.Publish(SessionEventsChannel, SessionEvent{Sid: "sid"}.String()) == 0 {
+		time.Sleep(time.Millisecond)
+	}
+	for cache.Len() != 0 {
+		time.Sleep(time.Millisecond)
+	}
+
+	cancel()
+	if err := <-done; err != nil {
+		t.Errorf("ListenSessionEvents error: %s", err)
+	}
+}
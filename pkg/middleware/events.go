@@ -0,0 +1,133 @@
+package middleware
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"strings"
+	"time"
+
+	"github.com/go-park-mail-ru/2023_2_Vkladyshi/configs"
+	"github.com/go-park-mail-ru/2023_2_Vkladyshi/pkg/memory"
+	"github.com/go-redis/redis/v8"
+)
+
+// SessionEventsChannel is the pub/sub channel of the session database where
+// the authorization service announces sessions that are no longer valid.
+const SessionEventsChannel = "session_events"
+
+// SessionEvent ends one session by Sid or changes every session of the
+// user by Login.
+type SessionEvent struct {
+	Sid   string
+	Login string
+}
+
+func (event SessionEvent) String() string {
+	if event.Sid != "" {
+		return "sid:" + event.Sid
+	}
+
+	return "login:" + event.Login
+}
+
+func ParseSessionEvent(message string) (SessionEvent, error) {
+	kind, value, found := strings.Cut(message, ":")
+	if !found || value == "" {
+		return SessionEvent{}, fmt.Errorf("bad session event %q", message)
+	}
+
+	switch kind {
+	case "sid":
+		return SessionEvent{Sid: value}, nil
+	case "login":
+		return SessionEvent{Login: value}, nil
+	}
+
+	return SessionEvent{}, fmt.Errorf("bad session event %q", message)
+}
+
+func (cache *SessionCache) Apply(event SessionEvent) {
+	if event.Sid != "" {
+		cache.Remove(event.Sid)
+	}
+	if event.Login != "" {
+		cache.RemoveLogin(event.Login)
+	}
+}
+
+// ListenSessionEvents applies the session events to cache until ctx is
+// done. Events published while the connection is down are lost, the ttl
+// of the cache bounds how long such a session stays valid.
+func ListenSessionEvents(ctx context.Context, config configs.DbRedisCfg, cache *SessionCache, lg *slog.Logger) error {
+	switch config.Backend {
+	case "redis":
+		client := redis.NewClient(&redis.Options{
+			Addr:     config.Host,
+			Password: config.Password,
+			DB:       config.DbNumber,
+		})
+		defer client.Close()
+
+		pubsub := client.Subscribe(ctx, SessionEventsChannel)
+		defer pubsub.Close()
+		if _, err := pubsub.Receive(ctx); err != nil {
+			return fmt.Errorf("subscribe session events err: %w", err)
+		}
+
+		messages := pubsub.Channel()
+		for {
+			select {
+			case <-ctx.Done():
+				return nil
+			case message := <-messages:
+				cache.applyMessage(message.Payload, lg)
+			}
+		}
+	case "memory":
+		// The same database name as the memory session repository uses.
+		kv := memory.OpenKV(fmt.Sprintf("%s/%d", config.Host, config.DbNumber))
+		messages, cancel := kv.Subscribe(SessionEventsChannel)
+		defer cancel()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return nil
+			case message := <-messages:
+				cache.applyMessage(message, lg)
+			}
+		}
+	}
+
+	return fmt.Errorf("unknown session backend %q", config.Backend)
+}
+
+func (cache *SessionCache) applyMessage(message string, lg *slog.Logger) {
+	event, err := ParseSessionEvent(message)
+	if err != nil {
+		lg.Error("session event error", "err", err.Error())
+		return
+	}
+	cache.Apply(event)
+}
+
+// StartSessionCache creates the cache of config and keeps it in sync with
+// the session events in a worker started with start, until the context of
+// the worker is done. It returns nil when the cache is turned off.
+func StartSessionCache(start func(name string, worker func(ctx context.Context)), config configs.SessionCacheCfg,
+	sessionConfig configs.DbRedisCfg, lg *slog.Logger) *SessionCache {
+	if config.Size <= 0 {
+		return nil
+	}
+
+	cache := NewSessionCache(config.Size, time.Duration(config.Ttl)*time.Second)
+	start("session events", func(ctx context.Context) {
+		err := ListenSessionEvents(ctx, sessionConfig, cache, lg)
+		if err != nil {
+			lg.Error("listen session events error", "err", err.Error())
+		}
+	})
+
+	return cache
+}
@@ -5,32 +5,61 @@ import (
 	"errors"
 	"log/slog"
 	"net/http"
+	"time"
 )
 
 type contextKey string
 
-const UserIDKey contextKey = "userId"
+const PrincipalKey contextKey = "principal"
+
+// Principal is the signed in user of a request.
+type Principal struct {
+	Id        uint64
+	Login     string
+	Name      string
+	Role      string
+	ExpiresAt time.Time
+}
 
 type Core interface {
-	GetUserId(ctx context.Context, sid string) (uint64, error)
+	ValidateSession(ctx context.Context, sid string) (*Principal, error)
+}
+
+func WithPrincipal(ctx context.Context, principal *Principal) context.Context {
+	return context.WithValue(ctx, PrincipalKey, principal)
 }
 
+// PrincipalFrom returns the user put into the context by AuthCheck, false
+// for anonymous requests.
+func PrincipalFrom(ctx context.Context) (*Principal, bool) {
+	principal, ok := ctx.Value(PrincipalKey).(*Principal)
+
+	return principal, ok && principal != nil
+}
+
+// AuthCheck puts the user of the session cookie into the request context.
+// Requests already checked by the gateway are passed on as they are.
 func AuthCheck(next http.Handler, core Core, lg *slog.Logger) http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if fromGateway(r.Context()) {
+			next.ServeHTTP(w, r)
+			return
+		}
+
 		session, err := r.Cookie("session_id")
 		if errors.Is(err, http.ErrNoCookie) {
 			next.ServeHTTP(w, r)
 			return
 		}
 
-		userId, err := core.GetUserId(r.Context(), session.Value)
+		principal, err := core.ValidateSession(r.Context(), session.Value)
 		if err != nil {
 			lg.Error("auth check error", "err", err.Error())
 			next.ServeHTTP(w, r)
 			return
 		}
 
-		r = r.WithContext(context.WithValue(r.Context(), UserIDKey, userId))
+		r = r.WithContext(WithPrincipal(r.Context(), principal))
 
 		next.ServeHTTP(w, r)
 	})
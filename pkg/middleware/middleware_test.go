@@ -0,0 +1,111 @@
+package middleware
+
+import (
+	"context"
+	"errors"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+)
+
+type core struct{}
+
+func (c core) ValidateSession(ctx context.Context, sid string) (*Principal, error) {
+	if sid != "good" {
+		return nil, errors.New("no session")
+	}
+
+	return &Principal{Id: 7, Login: "viewer", Role: "user"}, nil
+}
+
+func TestAuthCheck(t *testing.T) {
+	testCases := map[string]struct {
+		cookie *http.Cookie
+		login  string
+	}{
+		"no cookie":   {cookie: nil, login: ""},
+		"bad session": {cookie: &http.Cookie{Name: "session_id", Value: "bad"}, login: ""},
+		"signed in":   {cookie: &http.Cookie{Name: "session_id", Value: "good"}, login: "viewer"},
+	}
+
+	lg := slog.New(slog.NewTextHandler(io.Discard, nil))
+	for name, curr := range testCases {
+		var login string
+		handler := AuthCheck(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if principal, ok := PrincipalFrom(r.Context()); ok {
+				login = principal.Login
+			}
+		}), core{}, lg)
+
+		r := httptest.NewRequest(http.MethodGet, "/api/v1/film", nil)
+		if curr.cookie != nil {
+			r.AddCookie(curr.cookie)
+		}
+		handler.ServeHTTP(httptest.NewRecorder(), r)
+
+		if login != curr.login {
+			t.Errorf("%s: wanted login %q, got %q", name, curr.login, login)
+		}
+	}
+}
+
+func TestTrustGateway(t *testing.T) {
+	testCases := map[string]struct {
+		token  string
+		id     string
+		role   string
+		cookie string
+		user   string
+		calls  int
+	}{
+		"from gateway":             {token: "secret", id: "3", role: "admin", cookie: "bad", user: "3/admin", calls: 0},
+		"anonymous from gateway":   {token: "secret", cookie: "good", user: "", calls: 0},
+		"bad id from gateway":      {token: "secret", id: "x", role: "admin", user: "", calls: 0},
+		"forged headers":           {token: "guess", id: "3", role: "admin", cookie: "good", user: "7/", calls: 1},
+		"no token":                 {id: "3", role: "admin", user: "", calls: 0},
+		"no token with the cookie": {cookie: "good", user: "7/", calls: 1},
+	}
+
+	lg := slog.New(slog.NewTextHandler(io.Discard, nil))
+	for name, curr := range testCases {
+		var user string
+		sessions := &countingCore{}
+		handler := TrustGateway(AuthCheck(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if principal, ok := PrincipalFrom(r.Context()); ok {
+				user = strconv.FormatUint(principal.Id, 10) + "/" + principal.Role
+			}
+		}), sessions, lg), "secret")
+
+		r := httptest.NewRequest(http.MethodGet, "/api/v1/film", nil)
+		if curr.token != "" {
+			r.Header.Set(GatewayTokenHeader, curr.token)
+		}
+		r.Header.Set(UserIdHeader, curr.id)
+		r.Header.Set(UserRoleHeader, curr.role)
+		if curr.cookie != "" {
+			r.AddCookie(&http.Cookie{Name: "session_id", Value: curr.cookie})
+		}
+		handler.ServeHTTP(httptest.NewRecorder(), r)
+
+		if user != curr.user {
+			t.Errorf("%s: wanted user %q, got %q", name, curr.user, user)
+		}
+		if sessions.calls != curr.calls {
+			t.Errorf("%s: wanted %d session checks, got %d", name, curr.calls, sessions.calls)
+		}
+	}
+
+	var trusted bool
+	handler := TrustGateway(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		trusted = fromGateway(r.Context())
+	}), "")
+	r := httptest.NewRequest(http.MethodGet, "/api/v1/film", nil)
+	r.Header.Set(GatewayTokenHeader, "")
+	handler.ServeHTTP(httptest.NewRecorder(), r)
+	if trusted {
+		t.Errorf("an empty secret must not trust anything")
+	}
+}
@@ -0,0 +1,354 @@
+package migrate
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"path"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	_ "github.com/jackc/pgx/stdlib"
+)
+
+var (
+	ErrOutdated   = errors.New("database schema is outdated, run migrate up")
+	ErrNoApplied  = errors.New("no applied migrations")
+	ErrBadCommand = errors.New("usage: migrate up|down|status")
+)
+
+// Applied versions of every service are kept in one table, so services
+// sharing a database do not step on each other.
+const createTable = "CREATE TABLE IF NOT EXISTS schema_migrations (" +
+	"service TEXT NOT NULL, " +
+	"version BIGINT NOT NULL, " +
+	"name TEXT NOT NULL, " +
+	"applied_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP, " +
+	"PRIMARY KEY (service, version))"
+
+type Migration struct {
+	Version uint64
+	Name    string
+	Up      string
+	Down    string
+}
+
+type Status struct {
+	Migration
+	AppliedAt *time.Time
+}
+
+type Migrator struct {
+	db         *sql.DB
+	service    string
+	migrations []Migration
+}
+
+// New reads migrations from files named 0001_name.up.sql and
+// 0001_name.down.sql in the root of fsys.
+func New(db *sql.DB, service string, fsys fs.FS) (*Migrator, error) {
+	migrations, err := load(fsys)
+	if err != nil {
+		return nil, fmt.Errorf("load migrations err: %w", err)
+	}
+
+	return &Migrator{db: db, service: service, migrations: migrations}, nil
+}
+
+// Open connects to the service database. The connection is only used for
+// migrations and is closed by Close.
+func Open(dsn string, service string, fsys fs.FS) (*Migrator, error) {
+	db, err := sql.Open("pgx", dsn)
+	if err != nil {
+		return nil, fmt.Errorf("sql open err: %w", err)
+	}
+	err = db.Ping()
+	if err != nil {
+		db.Close()
+		return nil, fmt.Errorf("sql ping err: %w", err)
+	}
+
+	m, err := New(db, service, fsys)
+	if err != nil {
+		db.Close()
+		return nil, err
+	}
+
+	return m, nil
+}
+
+func (m *Migrator) Close() error {
+	return m.db.Close()
+}
+
+func load(fsys fs.FS) ([]Migration, error) {
+	entries, err := fs.ReadDir(fsys, ".")
+	if err != nil {
+		return nil, err
+	}
+
+	byVersion := map[uint64]*Migration{}
+	for _, entry := range entries {
+		name := entry.Name()
+		if entry.IsDir() || path.Ext(name) != ".sql" {
+			continue
+		}
+
+		base, direction, ok := strings.Cut(strings.TrimSuffix(name, ".sql"), ".")
+		if !ok || direction != "up" && direction != "down" {
+			return nil, fmt.Errorf("bad migration name %s", name)
+		}
+		number, title, ok := strings.Cut(base, "_")
+		if !ok {
+			return nil, fmt.Errorf("bad migration name %s", name)
+		}
+		version, err := strconv.ParseUint(number, 10, 64)
+		if err != nil || version == 0 {
+			return nil, fmt.Errorf("bad migration version %s", name)
+		}
+
+		query, err := fs.ReadFile(fsys, name)
+		if err != nil {
+			return nil, err
+		}
+
+		migration, ok := byVersion[version]
+		if !ok {
+			migration = &Migration{Version: version, Name: title}
+			byVersion[version] = migration
+		}
+		if migration.Name != title {
+			return nil, fmt.Errorf("migration %d has two names: %s and %s", version, migration.Name, title)
+		}
+		if direction == "up" {
+			migration.Up = string(query)
+		} else {
+			migration.Down = string(query)
+		}
+	}
+
+	migrations := make([]Migration, 0, len(byVersion))
+	for _, migration := range byVersion {
+		if migration.Up == "" {
+			return nil, fmt.Errorf("migration %d has no up file", migration.Version)
+		}
+		migrations = append(migrations, *migration)
+	}
+	sort.Slice(migrations, func(i, j int) bool {
+		return migrations[i].Version < migrations[j].Version
+	})
+
+	return migrations, nil
+}
+
+func (m *Migrator) applied() (map[uint64]time.Time, error) {
+	_, err := m.db.Exec(createTable)
+	if err != nil {
+		return nil, fmt.Errorf("create migrations table err: %w", err)
+	}
+
+	rows, err := m.db.Query("SELECT version, applied_at FROM schema_migrations WHERE service = $1", m.service)
+	if err != nil {
+		return nil, fmt.Errorf("get applied migrations err: %w", err)
+	}
+	defer rows.Close()
+
+	applied := map[uint64]time.Time{}
+	for rows.Next() {
+		var version uint64
+		var appliedAt time.Time
+		err := rows.Scan(&version, &appliedAt)
+		if err != nil {
+			return nil, fmt.Errorf("get applied migrations scan err: %w", err)
+		}
+		applied[version] = appliedAt
+	}
+
+	return applied, rows.Err()
+}
+
+func (m *Migrator) Status() ([]Status, error) {
+	applied, err := m.applied()
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]Status, 0, len(m.migrations))
+	for _, migration := range m.migrations {
+		status := Status{Migration: migration}
+		if appliedAt, ok := applied[migration.Version]; ok {
+			status.AppliedAt = &appliedAt
+		}
+		result = append(result, status)
+	}
+
+	return result, nil
+}
+
+// Check fails with ErrOutdated when a migration known to this binary is not
+// applied yet. Services call it on startup instead of migrating themselves.
+func (m *Migrator) Check() error {
+	status, err := m.Status()
+	if err != nil {
+		return err
+	}
+
+	for _, item := range status {
+		if item.AppliedAt == nil {
+			return fmt.Errorf("%w: %s misses %04d_%s", ErrOutdated, m.service, item.Version, item.Name)
+		}
+	}
+
+	return nil
+}
+
+// Up applies the pending migrations in order, each in its own transaction.
+func (m *Migrator) Up() ([]Migration, error) {
+	status, err := m.Status()
+	if err != nil {
+		return nil, err
+	}
+
+	done := []Migration{}
+	for _, item := range status {
+		if item.AppliedAt != nil {
+			continue
+		}
+
+		ran, err := m.run(item.Version, false, item.Up,
+			"INSERT INTO schema_migrations(service, version, name) VALUES($1, $2, $3)",
+			m.service, item.Version, item.Name)
+		if err != nil {
+			return done, fmt.Errorf("migration %04d_%s up err: %w", item.Version, item.Name, err)
+		}
+		if ran {
+			done = append(done, item.Migration)
+		}
+	}
+
+	return done, nil
+}
+
+// Down reverts the latest applied migration.
+func (m *Migrator) Down() (*Migration, error) {
+	status, err := m.Status()
+	if err != nil {
+		return nil, err
+	}
+
+	for i := len(status) - 1; i >= 0; i-- {
+		item := status[i]
+		if item.AppliedAt == nil {
+			continue
+		}
+
+		if strings.TrimSpace(item.Down) == "" {
+			return nil, fmt.Errorf("migration %04d_%s has no down file", item.Version, item.Name)
+		}
+		_, err := m.run(item.Version, true, item.Down,
+			"DELETE FROM schema_migrations WHERE service = $1 AND version = $2", m.service, item.Version)
+		if err != nil {
+			return nil, fmt.Errorf("migration %04d_%s down err: %w", item.Version, item.Name, err)
+		}
+		return &item.Migration, nil
+	}
+
+	return nil, ErrNoApplied
+}
+
+// run executes a migration together with its bookkeeping query. The advisory
+// lock keeps two replicas from migrating at the same time; the one that waited
+// skips a migration the other has already run.
+func (m *Migrator) run(version uint64, applied bool, query string, record string, args ...any) (bool, error) {
+	tx, err := m.db.Begin()
+	if err != nil {
+		return false, err
+	}
+	defer tx.Rollback()
+
+	_, err = tx.Exec("SELECT pg_advisory_xact_lock(hashtext('schema_migrations'))")
+	if err != nil {
+		return false, err
+	}
+	var exists bool
+	err = tx.QueryRow("SELECT EXISTS(SELECT 1 FROM schema_migrations WHERE service = $1 AND version = $2)",
+		m.service, version).Scan(&exists)
+	if err != nil {
+		return false, err
+	}
+	if exists != applied {
+		return false, nil
+	}
+
+	_, err = tx.Exec(query)
+	if err != nil {
+		return false, err
+	}
+	_, err = tx.Exec(record, args...)
+	if err != nil {
+		return false, err
+	}
+
+	return true, tx.Commit()
+}
+
+// CheckCommand fails with ErrBadCommand unless Command knows args, so a
+// binary can report a typo before connecting to the database.
+func CheckCommand(args []string) error {
+	if len(args) != 1 {
+		return ErrBadCommand
+	}
+
+	switch args[0] {
+	case "up", "down", "status":
+		return nil
+	default:
+		return ErrBadCommand
+	}
+}
+
+// Command runs the migrate subcommand of a service binary.
+func Command(m *Migrator, args []string, out io.Writer) error {
+	err := CheckCommand(args)
+	if err != nil {
+		return err
+	}
+
+	switch args[0] {
+	case "up":
+		done, err := m.Up()
+		for _, migration := range done {
+			fmt.Fprintf(out, "applied %04d_%s\n", migration.Version, migration.Name)
+		}
+		if err == nil && len(done) == 0 {
+			fmt.Fprintln(out, "schema is up to date")
+		}
+		return err
+	case "down":
+		migration, err := m.Down()
+		if err != nil {
+			return err
+		}
+		fmt.Fprintf(out, "reverted %04d_%s\n", migration.Version, migration.Name)
+		return nil
+	case "status":
+		status, err := m.Status()
+		if err != nil {
+			return err
+		}
+		for _, item := range status {
+			state := "pending"
+			if item.AppliedAt != nil {
+				state = "applied " + item.AppliedAt.Format(time.DateTime)
+			}
+			fmt.Fprintf(out, "%04d_%s\t%s\n", item.Version, item.Name, state)
+		}
+		return nil
+	default:
+		return ErrBadCommand
+	}
+}
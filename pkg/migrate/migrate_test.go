@@ -0,0 +1,206 @@
+package migrate
+
+import (
+	"bytes"
+	"errors"
+	"regexp"
+	"testing"
+	"testing/fstest"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+)
+
+var files = fstest.MapFS{
+	"0002_ratings.up.sql":   {Data: []byte("ALTER TABLE film ADD COLUMN rating REAL")},
+	"0002_ratings.down.sql": {Data: []byte("ALTER TABLE film DROP COLUMN rating")},
+	"0001_baseline.up.sql":  {Data: []byte("CREATE TABLE film (id SERIAL PRIMARY KEY)")},
+	"README":                {Data: []byte("not a migration")},
+}
+
+func expectApplied(mock sqlmock.Sqlmock, versions ...uint64) {
+	mock.ExpectExec(regexp.QuoteMeta(createTable)).WillReturnResult(sqlmock.NewResult(0, 0))
+	rows := sqlmock.NewRows([]string{"Version", "AppliedAt"})
+	for _, version := range versions {
+		rows = rows.AddRow(version, time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC))
+	}
+	mock.ExpectQuery(
+		regexp.QuoteMeta("SELECT version, applied_at FROM schema_migrations WHERE service = $1")).
+		WithArgs("films").
+		WillReturnRows(rows)
+}
+
+// expectRun expects a migration transaction; an empty query means the
+// migration is skipped after the lock.
+func expectRun(mock sqlmock.Sqlmock, version uint64, exists bool, query string, record string) {
+	mock.ExpectBegin()
+	mock.ExpectExec(regexp.QuoteMeta("SELECT pg_advisory_xact_lock(hashtext('schema_migrations'))")).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT EXISTS(SELECT 1 FROM schema_migrations")).
+		WithArgs("films", version).
+		WillReturnRows(sqlmock.NewRows([]string{"Exists"}).AddRow(exists))
+	if query != "" {
+		mock.ExpectExec(regexp.QuoteMeta(query)).WillReturnResult(sqlmock.NewResult(0, 0))
+		mock.ExpectExec(regexp.QuoteMeta(record)).WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectCommit()
+	} else {
+		mock.ExpectRollback()
+	}
+}
+
+func TestLoad(t *testing.T) {
+	migrations, err := load(files)
+	if err != nil {
+		t.Fatalf("load error: %s", err)
+	}
+	if len(migrations) != 2 || migrations[0].Version != 1 || migrations[1].Name != "ratings" {
+		t.Errorf("unexpected migrations %v", migrations)
+	}
+
+	testCases := map[string]fstest.MapFS{
+		"no direction": {"0001_baseline.sql": {Data: []byte("SELECT 1")}},
+		"no version":   {"baseline.up.sql": {Data: []byte("SELECT 1")}},
+		"zero version": {"0000_baseline.up.sql": {Data: []byte("SELECT 1")}},
+		"no up":        {"0001_baseline.down.sql": {Data: []byte("SELECT 1")}},
+		"two names": {
+			"0001_baseline.up.sql": {Data: []byte("SELECT 1")},
+			"0001_initial.up.sql":  {Data: []byte("SELECT 1")},
+		},
+	}
+	for name, fsys := range testCases {
+		_, err := load(fsys)
+		if err == nil {
+			t.Errorf("%s: expected error, got nil", name)
+		}
+	}
+}
+
+func TestCheck(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("cant create mock: %s", err)
+	}
+	defer db.Close()
+
+	m, err := New(db, "films", files)
+	if err != nil {
+		t.Fatalf("New error: %s", err)
+	}
+
+	expectApplied(mock, 1, 2)
+	err = m.Check()
+	if err != nil {
+		t.Errorf("Check error: %s", err)
+	}
+
+	expectApplied(mock, 1)
+	err = m.Check()
+	if !errors.Is(err, ErrOutdated) {
+		t.Errorf("expected ErrOutdated, have %v", err)
+	}
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("there were unfulfilled expectations: %s", err)
+	}
+}
+
+func TestUpDown(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("cant create mock: %s", err)
+	}
+	defer db.Close()
+
+	m, err := New(db, "films", files)
+	if err != nil {
+		t.Fatalf("New error: %s", err)
+	}
+
+	insert := "INSERT INTO schema_migrations(service, version, name) VALUES($1, $2, $3)"
+	expectApplied(mock)
+	expectRun(mock, 1, false, "CREATE TABLE film", insert)
+	// Another replica has applied the second migration in the meantime.
+	expectRun(mock, 2, true, "", insert)
+
+	done, err := m.Up()
+	if err != nil {
+		t.Errorf("Up error: %s", err)
+	}
+	if len(done) != 1 || done[0].Version != 1 {
+		t.Errorf("expected only the baseline to run, have %v", done)
+	}
+
+	expectApplied(mock, 1, 2)
+	expectRun(mock, 2, true, "ALTER TABLE film DROP COLUMN rating",
+		"DELETE FROM schema_migrations WHERE service = $1 AND version = $2")
+
+	reverted, err := m.Down()
+	if err != nil {
+		t.Errorf("Down error: %s", err)
+	}
+	if reverted == nil || reverted.Version != 2 {
+		t.Errorf("expected 0002 to be reverted, have %v", reverted)
+	}
+
+	// The baseline has no down file and is never reverted.
+	expectApplied(mock, 1)
+	reverted, err = m.Down()
+	if err == nil || reverted != nil {
+		t.Errorf("expected the baseline to stay, have %v %v", reverted, err)
+	}
+
+	expectApplied(mock)
+	_, err = m.Down()
+	if !errors.Is(err, ErrNoApplied) {
+		t.Errorf("expected ErrNoApplied, have %v", err)
+	}
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("there were unfulfilled expectations: %s", err)
+	}
+}
+
+func TestCommand(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("cant create mock: %s", err)
+	}
+	defer db.Close()
+
+	m, err := New(db, "films", files)
+	if err != nil {
+		t.Fatalf("New error: %s", err)
+	}
+
+	expectApplied(mock, 1)
+	var out bytes.Buffer
+	err = Command(m, []string{"status"}, &out)
+	if err != nil {
+		t.Errorf("Command error: %s", err)
+	}
+	expect := "0001_baseline\tapplied 2026-01-02 03:04:05\n0002_ratings\tpending\n"
+	if out.String() != expect {
+		t.Errorf("status not match, want %q, have %q", expect, out.String())
+	}
+
+	for _, args := range [][]string{nil, {"sideways"}, {"up", "2"}} {
+		err = Command(m, args, &out)
+		if !errors.Is(err, ErrBadCommand) {
+			t.Errorf("%v: expected ErrBadCommand, have %v", args, err)
+		}
+		err = CheckCommand(args)
+		if !errors.Is(err, ErrBadCommand) {
+			t.Errorf("%v: expected CheckCommand to fail, have %v", args, err)
+		}
+	}
+	for _, command := range []string{"up", "down", "status"} {
+		err = CheckCommand([]string{command})
+		if err != nil {
+			t.Errorf("%s: unexpected CheckCommand error %s", command, err)
+		}
+	}
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("there were unfulfilled expectations: %s", err)
+	}
+}
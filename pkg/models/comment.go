@@ -9,3 +9,10 @@ type CommentItem struct {
 	Comment  string `json:"text"`
 	Photo    string `json:"photo"`
 }
+
+// FilmStats sums up the ratings of one film.
+type FilmStats struct {
+	IdFilm  uint64  `json:"id_film"`
+	Count   uint64  `json:"count"`
+	Average float64 `json:"average"`
+}
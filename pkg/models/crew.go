@@ -9,6 +9,8 @@ type (
 		Photo     string `json:"photo"`
 		Country   string `json:"country"`
 		Info      string `json:"info_text"`
+
+		Placeholder *Placeholder `json:"placeholder,omitempty"`
 	}
 
 	Character struct {
@@ -16,5 +18,22 @@ type (
 		ActorPhoto    string `json:"actor_photo"`
 		NameActor     string `json:"actor_name"`
 		NameCharacter string `json:"character_name"`
+
+		Placeholder *Placeholder `json:"placeholder,omitempty"`
+	}
+	Collaborator struct {
+		IdActor    uint64 `json:"actor_id"`
+		ActorPhoto string `json:"actor_photo"`
+		NameActor  string `json:"actor_name"`
+		Count      uint64 `json:"count"`
 	}
 )
+
+type CrewLink struct {
+	IdFilm     uint64
+	Title      string
+	Poster     string
+	IdActor    uint64
+	NameActor  string
+	ActorPhoto string
+}
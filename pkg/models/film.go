@@ -10,9 +10,43 @@ type FilmItem struct {
 	Country     string  `json:"country"`
 	Mpaa        string  `json:"mpaa"`
 	Rating      float64 `json:"rating"`
+
+	ReviewsCount uint64 `json:"reviews_count,omitempty"`
+
+	OriginalTitle string   `json:"original_title,omitempty"`
+	Runtime       uint32   `json:"runtime,omitempty"`
+	Budget        uint64   `json:"budget,omitempty"`
+	BoxOffice     uint64   `json:"box_office,omitempty"`
+	Languages     []string `json:"languages,omitempty"`
+	Trailers      []string `json:"trailers,omitempty"`
+	ImdbId        string   `json:"imdb_id,omitempty"`
+	KinopoiskId   string   `json:"kinopoisk_id,omitempty"`
+
+	PosterVariants map[string]string `json:"poster_variants,omitempty"`
+	Placeholder    *Placeholder      `json:"placeholder,omitempty"`
+}
+
+// FilmEdit holds the fields of a film to change, nil fields are kept. A zero
+// value clears the field.
+type FilmEdit struct {
+	Id            uint64
+	Title         *string
+	Info          *string
+	Poster        *string
+	ReleaseDate   *string
+	Country       *string
+	Mpaa          *string
+	OriginalTitle *string
+	Runtime       *uint32
+	Budget        *uint64
+	BoxOffice     *uint64
+	Languages     *[]string
+	Trailers      *[]string
+	ImdbId        *string
+	KinopoiskId   *string
 }
 
 type NearFilm struct {
 	IdFilm uint64
 	IdUser uint64
-}
\ No newline at end of file
+}
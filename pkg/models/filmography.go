@@ -0,0 +1,12 @@
+package models
+
+//easyjson:json
+type FilmographyItem struct {
+	IdFilm        uint64  `json:"id"`
+	Title         string  `json:"title"`
+	Poster        string  `json:"poster"`
+	ReleaseYear   uint16  `json:"release_year"`
+	Rating        float64 `json:"rating"`
+	Profession    string  `json:"profession"`
+	NameCharacter string  `json:"character_name"`
+}
@@ -54,6 +54,26 @@ func easyjsonD2b7633eDecodeGithubComGoParkMailRu20232VkladyshiPkgModels(in *jlex
 			out.Email = string(in.String())
 		case "role":
 			out.Role = string(in.String())
+		case "photo_variants":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				in.Delim('{')
+				if !in.IsDelim('}') {
+					out.PhotoVariants = make(map[string]string)
+				} else {
+					out.PhotoVariants = nil
+				}
+				for !in.IsDelim('}') {
+					key := string(in.String())
+					in.WantColon()
+					var v1 string
+					v1 = string(in.String())
+					(out.PhotoVariants)[key] = v1
+					in.WantComma()
+				}
+				in.Delim('}')
+			}
 		default:
 			in.SkipRecursive()
 		}
@@ -113,6 +133,25 @@ func easyjsonD2b7633eEncodeGithubComGoParkMailRu20232VkladyshiPkgModels(out *jwr
 		out.RawString(prefix)
 		out.String(string(in.Role))
 	}
+	if len(in.PhotoVariants) != 0 {
+		const prefix string = ",\"photo_variants\":"
+		out.RawString(prefix)
+		{
+			out.RawByte('{')
+			v2First := true
+			for v2Name, v2Value := range in.PhotoVariants {
+				if v2First {
+					v2First = false
+				} else {
+					out.RawByte(',')
+				}
+				out.String(string(v2Name))
+				out.RawByte(':')
+				out.String(string(v2Value))
+			}
+			out.RawByte('}')
+		}
+	}
 	out.RawByte('}')
 }
 
@@ -139,7 +178,112 @@ func (v *UserItem) UnmarshalJSON(data []byte) error {
 func (v *UserItem) UnmarshalEasyJSON(l *jlexer.Lexer) {
 	easyjsonD2b7633eDecodeGithubComGoParkMailRu20232VkladyshiPkgModels(l, v)
 }
-func easyjsonD2b7633eDecodeGithubComGoParkMailRu20232VkladyshiPkgModels1(in *jlexer.Lexer, out *ProfessionItem) {
+func easyjsonD2b7633eDecodeGithubComGoParkMailRu20232VkladyshiPkgModels1(in *jlexer.Lexer, out *SessionItem) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "id":
+			out.Id = string(in.String())
+		case "user_agent":
+			out.UserAgent = string(in.String())
+		case "ip":
+			out.Ip = string(in.String())
+		case "created_at":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.CreatedAt).UnmarshalJSON(data))
+			}
+		case "last_active":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.LastActive).UnmarshalJSON(data))
+			}
+		case "current":
+			out.Current = bool(in.Bool())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonD2b7633eEncodeGithubComGoParkMailRu20232VkladyshiPkgModels1(out *jwriter.Writer, in SessionItem) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"id\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Id))
+	}
+	{
+		const prefix string = ",\"user_agent\":"
+		out.RawString(prefix)
+		out.String(string(in.UserAgent))
+	}
+	{
+		const prefix string = ",\"ip\":"
+		out.RawString(prefix)
+		out.String(string(in.Ip))
+	}
+	{
+		const prefix string = ",\"created_at\":"
+		out.RawString(prefix)
+		out.Raw((in.CreatedAt).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"last_active\":"
+		out.RawString(prefix)
+		out.Raw((in.LastActive).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"current\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.Current))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v SessionItem) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20232VkladyshiPkgModels1(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v SessionItem) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20232VkladyshiPkgModels1(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *SessionItem) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20232VkladyshiPkgModels1(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *SessionItem) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20232VkladyshiPkgModels1(l, v)
+}
+func easyjsonD2b7633eDecodeGithubComGoParkMailRu20232VkladyshiPkgModels2(in *jlexer.Lexer, out *ProfessionItem) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -172,7 +316,7 @@ func easyjsonD2b7633eDecodeGithubComGoParkMailRu20232VkladyshiPkgModels1(in *jle
 		in.Consumed()
 	}
 }
-func easyjsonD2b7633eEncodeGithubComGoParkMailRu20232VkladyshiPkgModels1(out *jwriter.Writer, in ProfessionItem) {
+func easyjsonD2b7633eEncodeGithubComGoParkMailRu20232VkladyshiPkgModels2(out *jwriter.Writer, in ProfessionItem) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -192,27 +336,107 @@ func easyjsonD2b7633eEncodeGithubComGoParkMailRu20232VkladyshiPkgModels1(out *jw
 // MarshalJSON supports json.Marshaler interface
 func (v ProfessionItem) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonD2b7633eEncodeGithubComGoParkMailRu20232VkladyshiPkgModels1(&w, v)
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20232VkladyshiPkgModels2(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v ProfessionItem) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonD2b7633eEncodeGithubComGoParkMailRu20232VkladyshiPkgModels1(w, v)
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20232VkladyshiPkgModels2(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *ProfessionItem) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonD2b7633eDecodeGithubComGoParkMailRu20232VkladyshiPkgModels1(&r, v)
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20232VkladyshiPkgModels2(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *ProfessionItem) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonD2b7633eDecodeGithubComGoParkMailRu20232VkladyshiPkgModels1(l, v)
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20232VkladyshiPkgModels2(l, v)
+}
+func easyjsonD2b7633eDecodeGithubComGoParkMailRu20232VkladyshiPkgModels3(in *jlexer.Lexer, out *Placeholder) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "blurhash":
+			out.Blurhash = string(in.String())
+		case "dominant_color":
+			out.Dominant = string(in.String())
+		case "accent_color":
+			out.Accent = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonD2b7633eEncodeGithubComGoParkMailRu20232VkladyshiPkgModels3(out *jwriter.Writer, in Placeholder) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"blurhash\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Blurhash))
+	}
+	{
+		const prefix string = ",\"dominant_color\":"
+		out.RawString(prefix)
+		out.String(string(in.Dominant))
+	}
+	{
+		const prefix string = ",\"accent_color\":"
+		out.RawString(prefix)
+		out.String(string(in.Accent))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v Placeholder) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20232VkladyshiPkgModels3(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v Placeholder) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20232VkladyshiPkgModels3(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *Placeholder) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20232VkladyshiPkgModels3(&r, v)
+	return r.Error()
 }
-func easyjsonD2b7633eDecodeGithubComGoParkMailRu20232VkladyshiPkgModels2(in *jlexer.Lexer, out *GenreItem) {
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *Placeholder) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20232VkladyshiPkgModels3(l, v)
+}
+func easyjsonD2b7633eDecodeGithubComGoParkMailRu20232VkladyshiPkgModels4(in *jlexer.Lexer, out *GenreItem) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -245,7 +469,7 @@ func easyjsonD2b7633eDecodeGithubComGoParkMailRu20232VkladyshiPkgModels2(in *jle
 		in.Consumed()
 	}
 }
-func easyjsonD2b7633eEncodeGithubComGoParkMailRu20232VkladyshiPkgModels2(out *jwriter.Writer, in GenreItem) {
+func easyjsonD2b7633eEncodeGithubComGoParkMailRu20232VkladyshiPkgModels4(out *jwriter.Writer, in GenreItem) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -265,27 +489,135 @@ func easyjsonD2b7633eEncodeGithubComGoParkMailRu20232VkladyshiPkgModels2(out *jw
 // MarshalJSON supports json.Marshaler interface
 func (v GenreItem) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonD2b7633eEncodeGithubComGoParkMailRu20232VkladyshiPkgModels2(&w, v)
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20232VkladyshiPkgModels4(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v GenreItem) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonD2b7633eEncodeGithubComGoParkMailRu20232VkladyshiPkgModels2(w, v)
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20232VkladyshiPkgModels4(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *GenreItem) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonD2b7633eDecodeGithubComGoParkMailRu20232VkladyshiPkgModels2(&r, v)
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20232VkladyshiPkgModels4(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *GenreItem) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonD2b7633eDecodeGithubComGoParkMailRu20232VkladyshiPkgModels2(l, v)
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20232VkladyshiPkgModels4(l, v)
+}
+func easyjsonD2b7633eDecodeGithubComGoParkMailRu20232VkladyshiPkgModels5(in *jlexer.Lexer, out *FilmographyItem) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "id":
+			out.IdFilm = uint64(in.Uint64())
+		case "title":
+			out.Title = string(in.String())
+		case "poster":
+			out.Poster = string(in.String())
+		case "release_year":
+			out.ReleaseYear = uint16(in.Uint16())
+		case "rating":
+			out.Rating = float64(in.Float64())
+		case "profession":
+			out.Profession = string(in.String())
+		case "character_name":
+			out.NameCharacter = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonD2b7633eEncodeGithubComGoParkMailRu20232VkladyshiPkgModels5(out *jwriter.Writer, in FilmographyItem) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"id\":"
+		out.RawString(prefix[1:])
+		out.Uint64(uint64(in.IdFilm))
+	}
+	{
+		const prefix string = ",\"title\":"
+		out.RawString(prefix)
+		out.String(string(in.Title))
+	}
+	{
+		const prefix string = ",\"poster\":"
+		out.RawString(prefix)
+		out.String(string(in.Poster))
+	}
+	{
+		const prefix string = ",\"release_year\":"
+		out.RawString(prefix)
+		out.Uint16(uint16(in.ReleaseYear))
+	}
+	{
+		const prefix string = ",\"rating\":"
+		out.RawString(prefix)
+		out.Float64(float64(in.Rating))
+	}
+	{
+		const prefix string = ",\"profession\":"
+		out.RawString(prefix)
+		out.String(string(in.Profession))
+	}
+	{
+		const prefix string = ",\"character_name\":"
+		out.RawString(prefix)
+		out.String(string(in.NameCharacter))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v FilmographyItem) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20232VkladyshiPkgModels5(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
 }
-func easyjsonD2b7633eDecodeGithubComGoParkMailRu20232VkladyshiPkgModels3(in *jlexer.Lexer, out *FilmItem) {
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v FilmographyItem) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20232VkladyshiPkgModels5(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *FilmographyItem) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20232VkladyshiPkgModels5(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *FilmographyItem) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20232VkladyshiPkgModels5(l, v)
+}
+func easyjsonD2b7633eDecodeGithubComGoParkMailRu20232VkladyshiPkgModels6(in *jlexer.Lexer, out *FilmItem) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -320,6 +652,96 @@ func easyjsonD2b7633eDecodeGithubComGoParkMailRu20232VkladyshiPkgModels3(in *jle
 			out.Mpaa = string(in.String())
 		case "rating":
 			out.Rating = float64(in.Float64())
+		case "reviews_count":
+			out.ReviewsCount = uint64(in.Uint64())
+		case "original_title":
+			out.OriginalTitle = string(in.String())
+		case "runtime":
+			out.Runtime = uint32(in.Uint32())
+		case "budget":
+			out.Budget = uint64(in.Uint64())
+		case "box_office":
+			out.BoxOffice = uint64(in.Uint64())
+		case "languages":
+			if in.IsNull() {
+				in.Skip()
+				out.Languages = nil
+			} else {
+				in.Delim('[')
+				if out.Languages == nil {
+					if !in.IsDelim(']') {
+						out.Languages = make([]string, 0, 4)
+					} else {
+						out.Languages = []string{}
+					}
+				} else {
+					out.Languages = (out.Languages)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v3 string
+					v3 = string(in.String())
+					out.Languages = append(out.Languages, v3)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "trailers":
+			if in.IsNull() {
+				in.Skip()
+				out.Trailers = nil
+			} else {
+				in.Delim('[')
+				if out.Trailers == nil {
+					if !in.IsDelim(']') {
+						out.Trailers = make([]string, 0, 4)
+					} else {
+						out.Trailers = []string{}
+					}
+				} else {
+					out.Trailers = (out.Trailers)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v4 string
+					v4 = string(in.String())
+					out.Trailers = append(out.Trailers, v4)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "imdb_id":
+			out.ImdbId = string(in.String())
+		case "kinopoisk_id":
+			out.KinopoiskId = string(in.String())
+		case "poster_variants":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				in.Delim('{')
+				if !in.IsDelim('}') {
+					out.PosterVariants = make(map[string]string)
+				} else {
+					out.PosterVariants = nil
+				}
+				for !in.IsDelim('}') {
+					key := string(in.String())
+					in.WantColon()
+					var v5 string
+					v5 = string(in.String())
+					(out.PosterVariants)[key] = v5
+					in.WantComma()
+				}
+				in.Delim('}')
+			}
+		case "placeholder":
+			if in.IsNull() {
+				in.Skip()
+				out.Placeholder = nil
+			} else {
+				if out.Placeholder == nil {
+					out.Placeholder = new(Placeholder)
+				}
+				(*out.Placeholder).UnmarshalEasyJSON(in)
+			}
 		default:
 			in.SkipRecursive()
 		}
@@ -330,7 +752,7 @@ func easyjsonD2b7633eDecodeGithubComGoParkMailRu20232VkladyshiPkgModels3(in *jle
 		in.Consumed()
 	}
 }
-func easyjsonD2b7633eEncodeGithubComGoParkMailRu20232VkladyshiPkgModels3(out *jwriter.Writer, in FilmItem) {
+func easyjsonD2b7633eEncodeGithubComGoParkMailRu20232VkladyshiPkgModels6(out *jwriter.Writer, in FilmItem) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -374,33 +796,120 @@ func easyjsonD2b7633eEncodeGithubComGoParkMailRu20232VkladyshiPkgModels3(out *jw
 		out.RawString(prefix)
 		out.Float64(float64(in.Rating))
 	}
+	if in.ReviewsCount != 0 {
+		const prefix string = ",\"reviews_count\":"
+		out.RawString(prefix)
+		out.Uint64(uint64(in.ReviewsCount))
+	}
+	if in.OriginalTitle != "" {
+		const prefix string = ",\"original_title\":"
+		out.RawString(prefix)
+		out.String(string(in.OriginalTitle))
+	}
+	if in.Runtime != 0 {
+		const prefix string = ",\"runtime\":"
+		out.RawString(prefix)
+		out.Uint32(uint32(in.Runtime))
+	}
+	if in.Budget != 0 {
+		const prefix string = ",\"budget\":"
+		out.RawString(prefix)
+		out.Uint64(uint64(in.Budget))
+	}
+	if in.BoxOffice != 0 {
+		const prefix string = ",\"box_office\":"
+		out.RawString(prefix)
+		out.Uint64(uint64(in.BoxOffice))
+	}
+	if len(in.Languages) != 0 {
+		const prefix string = ",\"languages\":"
+		out.RawString(prefix)
+		{
+			out.RawByte('[')
+			for v6, v7 := range in.Languages {
+				if v6 > 0 {
+					out.RawByte(',')
+				}
+				out.String(string(v7))
+			}
+			out.RawByte(']')
+		}
+	}
+	if len(in.Trailers) != 0 {
+		const prefix string = ",\"trailers\":"
+		out.RawString(prefix)
+		{
+			out.RawByte('[')
+			for v8, v9 := range in.Trailers {
+				if v8 > 0 {
+					out.RawByte(',')
+				}
+				out.String(string(v9))
+			}
+			out.RawByte(']')
+		}
+	}
+	if in.ImdbId != "" {
+		const prefix string = ",\"imdb_id\":"
+		out.RawString(prefix)
+		out.String(string(in.ImdbId))
+	}
+	if in.KinopoiskId != "" {
+		const prefix string = ",\"kinopoisk_id\":"
+		out.RawString(prefix)
+		out.String(string(in.KinopoiskId))
+	}
+	if len(in.PosterVariants) != 0 {
+		const prefix string = ",\"poster_variants\":"
+		out.RawString(prefix)
+		{
+			out.RawByte('{')
+			v10First := true
+			for v10Name, v10Value := range in.PosterVariants {
+				if v10First {
+					v10First = false
+				} else {
+					out.RawByte(',')
+				}
+				out.String(string(v10Name))
+				out.RawByte(':')
+				out.String(string(v10Value))
+			}
+			out.RawByte('}')
+		}
+	}
+	if in.Placeholder != nil {
+		const prefix string = ",\"placeholder\":"
+		out.RawString(prefix)
+		(*in.Placeholder).MarshalEasyJSON(out)
+	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
 func (v FilmItem) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonD2b7633eEncodeGithubComGoParkMailRu20232VkladyshiPkgModels3(&w, v)
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20232VkladyshiPkgModels6(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v FilmItem) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonD2b7633eEncodeGithubComGoParkMailRu20232VkladyshiPkgModels3(w, v)
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20232VkladyshiPkgModels6(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *FilmItem) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonD2b7633eDecodeGithubComGoParkMailRu20232VkladyshiPkgModels3(&r, v)
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20232VkladyshiPkgModels6(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *FilmItem) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonD2b7633eDecodeGithubComGoParkMailRu20232VkladyshiPkgModels3(l, v)
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20232VkladyshiPkgModels6(l, v)
 }
-func easyjsonD2b7633eDecodeGithubComGoParkMailRu20232VkladyshiPkgModels4(in *jlexer.Lexer, out *DayItem) {
+func easyjsonD2b7633eDecodeGithubComGoParkMailRu20232VkladyshiPkgModels7(in *jlexer.Lexer, out *DayItem) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -437,7 +946,7 @@ func easyjsonD2b7633eDecodeGithubComGoParkMailRu20232VkladyshiPkgModels4(in *jle
 		in.Consumed()
 	}
 }
-func easyjsonD2b7633eEncodeGithubComGoParkMailRu20232VkladyshiPkgModels4(out *jwriter.Writer, in DayItem) {
+func easyjsonD2b7633eEncodeGithubComGoParkMailRu20232VkladyshiPkgModels7(out *jwriter.Writer, in DayItem) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -467,27 +976,27 @@ func easyjsonD2b7633eEncodeGithubComGoParkMailRu20232VkladyshiPkgModels4(out *jw
 // MarshalJSON supports json.Marshaler interface
 func (v DayItem) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonD2b7633eEncodeGithubComGoParkMailRu20232VkladyshiPkgModels4(&w, v)
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20232VkladyshiPkgModels7(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v DayItem) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonD2b7633eEncodeGithubComGoParkMailRu20232VkladyshiPkgModels4(w, v)
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20232VkladyshiPkgModels7(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *DayItem) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonD2b7633eDecodeGithubComGoParkMailRu20232VkladyshiPkgModels4(&r, v)
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20232VkladyshiPkgModels7(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *DayItem) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonD2b7633eDecodeGithubComGoParkMailRu20232VkladyshiPkgModels4(l, v)
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20232VkladyshiPkgModels7(l, v)
 }
-func easyjsonD2b7633eDecodeGithubComGoParkMailRu20232VkladyshiPkgModels5(in *jlexer.Lexer, out *CrewItem) {
+func easyjsonD2b7633eDecodeGithubComGoParkMailRu20232VkladyshiPkgModels8(in *jlexer.Lexer, out *CrewItem) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -518,6 +1027,16 @@ func easyjsonD2b7633eDecodeGithubComGoParkMailRu20232VkladyshiPkgModels5(in *jle
 			out.Country = string(in.String())
 		case "info_text":
 			out.Info = string(in.String())
+		case "placeholder":
+			if in.IsNull() {
+				in.Skip()
+				out.Placeholder = nil
+			} else {
+				if out.Placeholder == nil {
+					out.Placeholder = new(Placeholder)
+				}
+				(*out.Placeholder).UnmarshalEasyJSON(in)
+			}
 		default:
 			in.SkipRecursive()
 		}
@@ -528,7 +1047,7 @@ func easyjsonD2b7633eDecodeGithubComGoParkMailRu20232VkladyshiPkgModels5(in *jle
 		in.Consumed()
 	}
 }
-func easyjsonD2b7633eEncodeGithubComGoParkMailRu20232VkladyshiPkgModels5(out *jwriter.Writer, in CrewItem) {
+func easyjsonD2b7633eEncodeGithubComGoParkMailRu20232VkladyshiPkgModels8(out *jwriter.Writer, in CrewItem) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -562,33 +1081,38 @@ func easyjsonD2b7633eEncodeGithubComGoParkMailRu20232VkladyshiPkgModels5(out *jw
 		out.RawString(prefix)
 		out.String(string(in.Info))
 	}
+	if in.Placeholder != nil {
+		const prefix string = ",\"placeholder\":"
+		out.RawString(prefix)
+		(*in.Placeholder).MarshalEasyJSON(out)
+	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
 func (v CrewItem) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonD2b7633eEncodeGithubComGoParkMailRu20232VkladyshiPkgModels5(&w, v)
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20232VkladyshiPkgModels8(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v CrewItem) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonD2b7633eEncodeGithubComGoParkMailRu20232VkladyshiPkgModels5(w, v)
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20232VkladyshiPkgModels8(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *CrewItem) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonD2b7633eDecodeGithubComGoParkMailRu20232VkladyshiPkgModels5(&r, v)
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20232VkladyshiPkgModels8(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *CrewItem) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonD2b7633eDecodeGithubComGoParkMailRu20232VkladyshiPkgModels5(l, v)
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20232VkladyshiPkgModels8(l, v)
 }
-func easyjsonD2b7633eDecodeGithubComGoParkMailRu20232VkladyshiPkgModels6(in *jlexer.Lexer, out *CommentItem) {
+func easyjsonD2b7633eDecodeGithubComGoParkMailRu20232VkladyshiPkgModels9(in *jlexer.Lexer, out *CommentItem) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -629,7 +1153,7 @@ func easyjsonD2b7633eDecodeGithubComGoParkMailRu20232VkladyshiPkgModels6(in *jle
 		in.Consumed()
 	}
 }
-func easyjsonD2b7633eEncodeGithubComGoParkMailRu20232VkladyshiPkgModels6(out *jwriter.Writer, in CommentItem) {
+func easyjsonD2b7633eEncodeGithubComGoParkMailRu20232VkladyshiPkgModels9(out *jwriter.Writer, in CommentItem) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -669,27 +1193,114 @@ func easyjsonD2b7633eEncodeGithubComGoParkMailRu20232VkladyshiPkgModels6(out *jw
 // MarshalJSON supports json.Marshaler interface
 func (v CommentItem) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonD2b7633eEncodeGithubComGoParkMailRu20232VkladyshiPkgModels6(&w, v)
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20232VkladyshiPkgModels9(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v CommentItem) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonD2b7633eEncodeGithubComGoParkMailRu20232VkladyshiPkgModels6(w, v)
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20232VkladyshiPkgModels9(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *CommentItem) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonD2b7633eDecodeGithubComGoParkMailRu20232VkladyshiPkgModels6(&r, v)
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20232VkladyshiPkgModels9(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *CommentItem) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonD2b7633eDecodeGithubComGoParkMailRu20232VkladyshiPkgModels6(l, v)
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20232VkladyshiPkgModels9(l, v)
 }
-func easyjsonD2b7633eDecodeGithubComGoParkMailRu20232VkladyshiPkgModels7(in *jlexer.Lexer, out *Character) {
+func easyjsonD2b7633eDecodeGithubComGoParkMailRu20232VkladyshiPkgModels10(in *jlexer.Lexer, out *Collaborator) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "actor_id":
+			out.IdActor = uint64(in.Uint64())
+		case "actor_photo":
+			out.ActorPhoto = string(in.String())
+		case "actor_name":
+			out.NameActor = string(in.String())
+		case "count":
+			out.Count = uint64(in.Uint64())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonD2b7633eEncodeGithubComGoParkMailRu20232VkladyshiPkgModels10(out *jwriter.Writer, in Collaborator) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"actor_id\":"
+		out.RawString(prefix[1:])
+		out.Uint64(uint64(in.IdActor))
+	}
+	{
+		const prefix string = ",\"actor_photo\":"
+		out.RawString(prefix)
+		out.String(string(in.ActorPhoto))
+	}
+	{
+		const prefix string = ",\"actor_name\":"
+		out.RawString(prefix)
+		out.String(string(in.NameActor))
+	}
+	{
+		const prefix string = ",\"count\":"
+		out.RawString(prefix)
+		out.Uint64(uint64(in.Count))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v Collaborator) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20232VkladyshiPkgModels10(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v Collaborator) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20232VkladyshiPkgModels10(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *Collaborator) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20232VkladyshiPkgModels10(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *Collaborator) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20232VkladyshiPkgModels10(l, v)
+}
+func easyjsonD2b7633eDecodeGithubComGoParkMailRu20232VkladyshiPkgModels11(in *jlexer.Lexer, out *Character) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -716,6 +1327,16 @@ func easyjsonD2b7633eDecodeGithubComGoParkMailRu20232VkladyshiPkgModels7(in *jle
 			out.NameActor = string(in.String())
 		case "character_name":
 			out.NameCharacter = string(in.String())
+		case "placeholder":
+			if in.IsNull() {
+				in.Skip()
+				out.Placeholder = nil
+			} else {
+				if out.Placeholder == nil {
+					out.Placeholder = new(Placeholder)
+				}
+				(*out.Placeholder).UnmarshalEasyJSON(in)
+			}
 		default:
 			in.SkipRecursive()
 		}
@@ -726,7 +1347,7 @@ func easyjsonD2b7633eDecodeGithubComGoParkMailRu20232VkladyshiPkgModels7(in *jle
 		in.Consumed()
 	}
 }
-func easyjsonD2b7633eEncodeGithubComGoParkMailRu20232VkladyshiPkgModels7(out *jwriter.Writer, in Character) {
+func easyjsonD2b7633eEncodeGithubComGoParkMailRu20232VkladyshiPkgModels11(out *jwriter.Writer, in Character) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -750,29 +1371,34 @@ func easyjsonD2b7633eEncodeGithubComGoParkMailRu20232VkladyshiPkgModels7(out *jw
 		out.RawString(prefix)
 		out.String(string(in.NameCharacter))
 	}
+	if in.Placeholder != nil {
+		const prefix string = ",\"placeholder\":"
+		out.RawString(prefix)
+		(*in.Placeholder).MarshalEasyJSON(out)
+	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
 func (v Character) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonD2b7633eEncodeGithubComGoParkMailRu20232VkladyshiPkgModels7(&w, v)
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20232VkladyshiPkgModels11(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v Character) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonD2b7633eEncodeGithubComGoParkMailRu20232VkladyshiPkgModels7(w, v)
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20232VkladyshiPkgModels11(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *Character) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonD2b7633eDecodeGithubComGoParkMailRu20232VkladyshiPkgModels7(&r, v)
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20232VkladyshiPkgModels11(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *Character) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonD2b7633eDecodeGithubComGoParkMailRu20232VkladyshiPkgModels7(l, v)
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20232VkladyshiPkgModels11(l, v)
 }
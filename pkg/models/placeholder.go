@@ -0,0 +1,10 @@
+package models
+
+// Placeholder is what a card renders while the real image is loading.
+//
+//easyjson:json
+type Placeholder struct {
+	Blurhash string `json:"blurhash"`
+	Dominant string `json:"dominant_color"`
+	Accent   string `json:"accent_color"`
+}
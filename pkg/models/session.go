@@ -7,3 +7,13 @@ type Session struct {
 	SID       string
 	ExpiresAt time.Time
 }
+
+//easyjson:json
+type SessionItem struct {
+	Id         string    `json:"id"`
+	UserAgent  string    `json:"user_agent"`
+	Ip         string    `json:"ip"`
+	CreatedAt  time.Time `json:"created_at"`
+	LastActive time.Time `json:"last_active"`
+	Current    bool      `json:"current"`
+}
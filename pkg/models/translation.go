@@ -0,0 +1,8 @@
+package models
+
+type Translation struct {
+	Id    uint64
+	Lang  string
+	Title string
+	Info  string
+}
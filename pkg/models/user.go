@@ -11,4 +11,6 @@ type UserItem struct {
 	RegistrationDate string `json:"registration_date"`
 	Email            string `json:"email"`
 	Role             string `json:"role"`
+
+	PhotoVariants map[string]string `json:"photo_variants,omitempty"`
 }
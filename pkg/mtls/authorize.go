@@ -0,0 +1,81 @@
+package mtls
+
+import (
+	"context"
+	"strings"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+)
+
+// Identity returns the common name of the verified client certificate of
+// the call.
+func Identity(ctx context.Context) (string, bool) {
+	p, ok := peer.FromContext(ctx)
+	if !ok {
+		return "", false
+	}
+	info, ok := p.AuthInfo.(credentials.TLSInfo)
+	if !ok || len(info.State.VerifiedChains) == 0 || len(info.State.VerifiedChains[0]) == 0 {
+		return "", false
+	}
+
+	return info.State.VerifiedChains[0][0].Subject.CommonName, true
+}
+
+// allowed looks up the full method first, so that a method can narrow the
+// identities of its service.
+func allowed(allow map[string][]string, method string, identity string) bool {
+	method = strings.TrimPrefix(method, "/")
+	service, _, _ := strings.Cut(method, "/")
+
+	for _, key := range []string{method, service} {
+		identities, ok := allow[key]
+		if !ok {
+			continue
+		}
+		for _, name := range identities {
+			if name == identity || name == "*" {
+				return true
+			}
+		}
+		return false
+	}
+
+	return false
+}
+
+func authorize(ctx context.Context, allow map[string][]string, method string) error {
+	identity, ok := Identity(ctx)
+	if !ok {
+		return status.Error(codes.Unauthenticated, "no client certificate")
+	}
+	if !allowed(allow, method, identity) {
+		return status.Errorf(codes.PermissionDenied, "%s may not call %s", identity, method)
+	}
+
+	return nil
+}
+
+func UnaryAuthorize(allow map[string][]string) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		if err := authorize(ctx, allow, info.FullMethod); err != nil {
+			return nil, err
+		}
+
+		return handler(ctx, req)
+	}
+}
+
+func StreamAuthorize(allow map[string][]string) grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		if err := authorize(ss.Context(), allow, info.FullMethod); err != nil {
+			return err
+		}
+
+		return handler(srv, ss)
+	}
+}
@@ -0,0 +1,181 @@
+package mtls
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/go-park-mail-ru/2023_2_Vkladyshi/configs"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+)
+
+// reloadInterval is how often the files are checked for changes, at most
+// once per handshake.
+const reloadInterval = 10 * time.Second
+
+// certStore keeps the certificate, the key and the CA pool of config and
+// loads them again when one of the files changes. A file that fails to load
+// leaves the previous certificates in use.
+type certStore struct {
+	mutex    sync.Mutex
+	config   configs.TlsCfg
+	interval time.Duration
+	now      func() time.Time
+	checked  time.Time
+	modTimes []time.Time
+	cert     *tls.Certificate
+	pool     *x509.CertPool
+}
+
+func newCertStore(config configs.TlsCfg) (*certStore, error) {
+	store := &certStore{config: config, interval: reloadInterval, now: time.Now}
+	if _, err := store.reload(); err != nil {
+		return nil, err
+	}
+
+	return store, nil
+}
+
+func (store *certStore) files() []string {
+	return []string{store.config.Cert, store.config.Key, store.config.Ca}
+}
+
+// reload loads the files if they have changed since the last load.
+func (store *certStore) reload() (bool, error) {
+	modTimes := make([]time.Time, 0, 3)
+	for _, file := range store.files() {
+		info, err := os.Stat(file)
+		if err != nil {
+			return false, fmt.Errorf("tls file err: %w", err)
+		}
+		modTimes = append(modTimes, info.ModTime())
+	}
+
+	changed := store.cert == nil
+	for i := range store.modTimes {
+		changed = changed || !store.modTimes[i].Equal(modTimes[i])
+	}
+	if !changed {
+		return false, nil
+	}
+
+	cert, err := tls.LoadX509KeyPair(store.config.Cert, store.config.Key)
+	if err != nil {
+		return false, fmt.Errorf("tls certificate err: %w", err)
+	}
+	ca, err := os.ReadFile(store.config.Ca)
+	if err != nil {
+		return false, fmt.Errorf("tls ca err: %w", err)
+	}
+	pool := x509.NewCertPool()
+	if !pool.AppendCertsFromPEM(ca) {
+		return false, fmt.Errorf("tls ca err: no certificates in %s", store.config.Ca)
+	}
+
+	store.cert, store.pool, store.modTimes = &cert, pool, modTimes
+
+	return true, nil
+}
+
+func (store *certStore) get() (*tls.Certificate, *x509.CertPool) {
+	store.mutex.Lock()
+	defer store.mutex.Unlock()
+
+	if now := store.now(); now.Sub(store.checked) >= store.interval {
+		store.checked = now
+		store.reload()
+	}
+
+	return store.cert, store.pool
+}
+
+func verifyPeer(certs []*x509.Certificate, pool *x509.CertPool, name string, usage x509.ExtKeyUsage) error {
+	if len(certs) == 0 {
+		return fmt.Errorf("no peer certificate")
+	}
+
+	intermediates := x509.NewCertPool()
+	for _, cert := range certs[1:] {
+		intermediates.AddCert(cert)
+	}
+	_, err := certs[0].Verify(x509.VerifyOptions{
+		Roots:         pool,
+		Intermediates: intermediates,
+		DNSName:       name,
+		KeyUsages:     []x509.ExtKeyUsage{usage},
+	})
+
+	return err
+}
+
+// ServerOptions makes a gRPC server require client certificates signed by
+// the CA of config and let services call only the methods config.Allow gives
+// them. Without a certificate in config the server stays unencrypted and
+// open, as in local development.
+func ServerOptions(config configs.TlsCfg) ([]grpc.ServerOption, error) {
+	if config.Cert == "" {
+		return nil, nil
+	}
+
+	store, err := newCertStore(config)
+	if err != nil {
+		return nil, err
+	}
+
+	tlsConfig := &tls.Config{
+		MinVersion: tls.VersionTLS12,
+		GetConfigForClient: func(*tls.ClientHelloInfo) (*tls.Config, error) {
+			cert, pool := store.get()
+			return &tls.Config{
+				MinVersion:   tls.VersionTLS12,
+				Certificates: []tls.Certificate{*cert},
+				ClientCAs:    pool,
+				ClientAuth:   tls.RequireAndVerifyClientCert,
+				NextProtos:   []string{"h2"},
+			}, nil
+		},
+	}
+
+	return []grpc.ServerOption{
+		grpc.Creds(credentials.NewTLS(tlsConfig)),
+		grpc.ChainUnaryInterceptor(UnaryAuthorize(config.Allow)),
+		grpc.ChainStreamInterceptor(StreamAuthorize(config.Allow)),
+	}, nil
+}
+
+// DialOptions makes a client present the certificate of config and accept
+// only a server whose certificate is signed by the CA of config for
+// serverName, the identity of the service it calls. Without a certificate in
+// config the connection stays unencrypted.
+func DialOptions(config configs.TlsCfg, serverName string) ([]grpc.DialOption, error) {
+	if config.Cert == "" {
+		return nil, nil
+	}
+
+	store, err := newCertStore(config)
+	if err != nil {
+		return nil, err
+	}
+
+	tlsConfig := &tls.Config{
+		MinVersion: tls.VersionTLS12,
+		ServerName: serverName,
+		// The server is verified in VerifyConnection against the current CA
+		// pool, which RootCAs could not follow after a reload.
+		InsecureSkipVerify: true,
+		GetClientCertificate: func(*tls.CertificateRequestInfo) (*tls.Certificate, error) {
+			cert, _ := store.get()
+			return cert, nil
+		},
+		VerifyConnection: func(state tls.ConnectionState) error {
+			_, pool := store.get()
+			return verifyPeer(state.PeerCertificates, pool, serverName, x509.ExtKeyUsageServerAuth)
+		},
+	}
+
+	return []grpc.DialOption{grpc.WithTransportCredentials(credentials.NewTLS(tlsConfig))}, nil
+}
@@ -0,0 +1,250 @@
+package mtls
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/go-park-mail-ru/2023_2_Vkladyshi/configs"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/status"
+)
+
+type authority struct {
+	cert *x509.Certificate
+	key  *ecdsa.PrivateKey
+	file string
+}
+
+var serial int64
+
+func newCert(t *testing.T, template *x509.Certificate, parent *authority) (*x509.Certificate, *ecdsa.PrivateKey, []byte, []byte) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	serial++
+	template.SerialNumber = big.NewInt(serial)
+	template.NotBefore = time.Now().Add(-time.Hour)
+	template.NotAfter = time.Now().Add(time.Hour)
+
+	parentCert, parentKey := template, key
+	if parent != nil {
+		parentCert, parentKey = parent.cert, parent.key
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, parentCert, &key.PublicKey, parentKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cert, err := x509.ParseCertificate(der)
+	if err != nil {
+		t.Fatal(err)
+	}
+	keyDer, err := x509.MarshalECPrivateKey(key)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return cert, key, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}),
+		pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDer})
+}
+
+func newAuthority(t *testing.T, dir string, name string) *authority {
+	cert, key, certPem, _ := newCert(t, &x509.Certificate{
+		Subject:               pkix.Name{CommonName: name},
+		IsCA:                  true,
+		BasicConstraintsValid: true,
+		KeyUsage:              x509.KeyUsageCertSign,
+	}, nil)
+
+	file := filepath.Join(dir, name+".crt")
+	writeFile(t, file, certPem)
+
+	return &authority{cert: cert, key: key, file: file}
+}
+
+func writeFile(t *testing.T, file string, data []byte) {
+	if err := os.WriteFile(file, data, 0o600); err != nil {
+		t.Fatal(err)
+	}
+}
+
+// issue writes a certificate of the service name signed by ca and returns
+// the config that uses it.
+func issue(t *testing.T, dir string, ca *authority, name string, usage x509.ExtKeyUsage) configs.TlsCfg {
+	_, _, certPem, keyPem := newCert(t, &x509.Certificate{
+		Subject:     pkix.Name{CommonName: name},
+		DNSNames:    []string{name},
+		KeyUsage:    x509.KeyUsageDigitalSignature,
+		ExtKeyUsage: []x509.ExtKeyUsage{usage},
+	}, ca)
+
+	config := configs.TlsCfg{
+		Cert: filepath.Join(dir, name+".crt"),
+		Key:  filepath.Join(dir, name+".key"),
+		Ca:   ca.file,
+	}
+	writeFile(t, config.Cert, certPem)
+	writeFile(t, config.Key, keyPem)
+
+	return config
+}
+
+func serve(t *testing.T, config configs.TlsCfg) string {
+	opts, err := ServerOptions(config)
+	if err != nil {
+		t.Fatalf("ServerOptions error: %s", err)
+	}
+	s := grpc.NewServer(opts...)
+	healthpb.RegisterHealthServer(s, health.NewServer())
+
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	go s.Serve(lis)
+	t.Cleanup(s.Stop)
+
+	return lis.Addr().String()
+}
+
+func check(t *testing.T, adress string, opts ...grpc.DialOption) error {
+	conn, err := grpc.Dial(adress, opts...)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer conn.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 2*time.Second)
+	defer cancel()
+	_, err = healthpb.NewHealthClient(conn).Check(ctx, &healthpb.HealthCheckRequest{})
+
+	return err
+}
+
+func TestMutualTls(t *testing.T) {
+	dir := t.TempDir()
+	ca := newAuthority(t, dir, "ca")
+	serverConfig := issue(t, dir, ca, "auth", x509.ExtKeyUsageServerAuth)
+	serverConfig.Allow = map[string][]string{"grpc.health.v1.Health/Check": {"films"}}
+	adress := serve(t, serverConfig)
+
+	dial := func(config configs.TlsCfg, serverName string) []grpc.DialOption {
+		opts, err := DialOptions(config, serverName)
+		if err != nil {
+			t.Fatalf("DialOptions error: %s", err)
+		}
+		return opts
+	}
+
+	films := issue(t, dir, ca, "films", x509.ExtKeyUsageClientAuth)
+	if err := check(t, adress, dial(films, "auth")...); err != nil {
+		t.Errorf("expected films to be allowed, got %s", err)
+	}
+
+	intruder := issue(t, dir, ca, "intruder", x509.ExtKeyUsageClientAuth)
+	if err := check(t, adress, dial(intruder, "auth")...); status.Code(err) != codes.PermissionDenied {
+		t.Errorf("expected permission denied, got %v", err)
+	}
+
+	if err := check(t, adress, dial(films, "comments")...); err == nil {
+		t.Errorf("expected the server to be checked against the service name")
+	}
+
+	other := issue(t, t.TempDir(), newAuthority(t, t.TempDir(), "other"), "films", x509.ExtKeyUsageClientAuth)
+	if err := check(t, adress, dial(other, "auth")...); err == nil {
+		t.Errorf("expected a certificate of another ca to be rejected")
+	}
+
+	if err := check(t, adress, grpc.WithTransportCredentials(insecure.NewCredentials())); err == nil {
+		t.Errorf("expected a client without tls to be rejected")
+	}
+}
+
+func TestTlsOff(t *testing.T) {
+	adress := serve(t, configs.TlsCfg{})
+
+	opts, err := DialOptions(configs.TlsCfg{}, "auth")
+	if err != nil || len(opts) != 0 {
+		t.Errorf("expected no dial options, got %v, %v", opts, err)
+	}
+	if err := check(t, adress, grpc.WithTransportCredentials(insecure.NewCredentials())); err != nil {
+		t.Errorf("expected an open server, got %s", err)
+	}
+}
+
+func TestReload(t *testing.T) {
+	dir := t.TempDir()
+	ca := newAuthority(t, dir, "ca")
+	config := issue(t, dir, ca, "films", x509.ExtKeyUsageClientAuth)
+
+	store, err := newCertStore(config)
+	if err != nil {
+		t.Fatalf("newCertStore error: %s", err)
+	}
+	store.interval = 0
+
+	renewed := issue(t, t.TempDir(), ca, "comments", x509.ExtKeyUsageClientAuth)
+	for _, files := range [][2]string{{renewed.Cert, config.Cert}, {renewed.Key, config.Key}} {
+		data, err := os.ReadFile(files[0])
+		if err != nil {
+			t.Fatal(err)
+		}
+		writeFile(t, files[1], data)
+		later := time.Now().Add(time.Minute)
+		if err := os.Chtimes(files[1], later, later); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	cert, _ := store.get()
+	if leaf, _ := x509.ParseCertificate(cert.Certificate[0]); leaf.Subject.CommonName != "comments" {
+		t.Errorf("expected the renewed certificate, have %s", leaf.Subject.CommonName)
+	}
+
+	writeFile(t, config.Cert, []byte("broken"))
+	cert, _ = store.get()
+	if leaf, _ := x509.ParseCertificate(cert.Certificate[0]); leaf.Subject.CommonName != "comments" {
+		t.Errorf("expected a broken file to keep the certificate, have %s", leaf.Subject.CommonName)
+	}
+}
+
+func TestAllowed(t *testing.T) {
+	allow := map[string][]string{
+		"auth.Authorization":                 {"gateway"},
+		"auth.Authorization/ValidateSession": {"films", "comments"},
+		"grpc.health.v1.Health":              {"*"},
+	}
+	cases := []struct {
+		method   string
+		identity string
+		allowed  bool
+	}{
+		{"/auth.Authorization/ValidateSession", "films", true},
+		{"/auth.Authorization/ValidateSession", "gateway", false},
+		{"/auth.Authorization/GetId", "gateway", true},
+		{"/auth.Authorization/GetId", "films", false},
+		{"/grpc.health.v1.Health/Watch", "anyone", true},
+		{"/films.Films/FilmExists", "comments", false},
+	}
+
+	for _, c := range cases {
+		if allowed(allow, c.method, c.identity) != c.allowed {
+			t.Errorf("allowed(%s, %s) != %v", c.method, c.identity, c.allowed)
+		}
+	}
+}
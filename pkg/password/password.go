@@ -0,0 +1,119 @@
+package password
+
+import (
+	"crypto/rand"
+	"crypto/subtle"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/go-park-mail-ru/2023_2_Vkladyshi/configs"
+	"golang.org/x/crypto/argon2"
+)
+
+// Prefix starts every hash, stored passwords without it are legacy plain
+// text.
+const Prefix = "$argon2id$"
+
+const (
+	saltLength = 16
+	keyLength  = 32
+)
+
+// The defaults follow the OWASP recommendation for Argon2id.
+const (
+	defaultMemory      = 19 * 1024
+	defaultIterations  = 2
+	defaultParallelism = 1
+)
+
+var ErrBadHash = errors.New("bad password hash")
+
+type params struct {
+	memory      uint32
+	iterations  uint32
+	parallelism uint8
+}
+
+// Hasher hashes passwords with Argon2id and a random salt per password, in
+// the PHC string format: $argon2id$v=19$m=19456,t=2,p=1$salt$key.
+type Hasher struct {
+	params params
+}
+
+func NewHasher(config configs.PasswordHashCfg) *Hasher {
+	p := params{memory: config.Memory, iterations: config.Iterations, parallelism: config.Parallelism}
+	if p.memory == 0 {
+		p.memory = defaultMemory
+	}
+	if p.iterations == 0 {
+		p.iterations = defaultIterations
+	}
+	if p.parallelism == 0 {
+		p.parallelism = defaultParallelism
+	}
+
+	return &Hasher{params: p}
+}
+
+func (h *Hasher) Hash(password string) (string, error) {
+	salt := make([]byte, saltLength)
+	if _, err := rand.Read(salt); err != nil {
+		return "", fmt.Errorf("generate salt err: %w", err)
+	}
+	key := argon2.IDKey([]byte(password), salt, h.params.iterations, h.params.memory, h.params.parallelism, keyLength)
+
+	return fmt.Sprintf("%sv=%d$m=%d,t=%d,p=%d$%s$%s", Prefix, argon2.Version,
+		h.params.memory, h.params.iterations, h.params.parallelism,
+		base64.RawStdEncoding.EncodeToString(salt), base64.RawStdEncoding.EncodeToString(key)), nil
+}
+
+// Verify tells whether password matches stored. rehash is set when stored
+// should be replaced by a new hash of password: it is plain text or was
+// hashed with other parameters.
+func (h *Hasher) Verify(stored string, password string) (ok bool, rehash bool, err error) {
+	if !IsHashed(stored) {
+		ok = subtle.ConstantTimeCompare([]byte(stored), []byte(password)) == 1
+		return ok, ok, nil
+	}
+
+	p, salt, key, err := decode(stored)
+	if err != nil {
+		return false, false, err
+	}
+	other := argon2.IDKey([]byte(password), salt, p.iterations, p.memory, p.parallelism, uint32(len(key)))
+	ok = subtle.ConstantTimeCompare(key, other) == 1
+
+	return ok, ok && (p != h.params || len(key) != keyLength), nil
+}
+
+func IsHashed(stored string) bool {
+	return strings.HasPrefix(stored, Prefix)
+}
+
+func decode(stored string) (params, []byte, []byte, error) {
+	var p params
+	parts := strings.Split(stored, "$")
+	if len(parts) != 6 {
+		return p, nil, nil, ErrBadHash
+	}
+
+	var version int
+	if _, err := fmt.Sscanf(parts[2], "v=%d", &version); err != nil || version != argon2.Version {
+		return p, nil, nil, ErrBadHash
+	}
+	if _, err := fmt.Sscanf(parts[3], "m=%d,t=%d,p=%d", &p.memory, &p.iterations, &p.parallelism); err != nil {
+		return p, nil, nil, ErrBadHash
+	}
+	salt, err := base64.RawStdEncoding.DecodeString(parts[4])
+	if err != nil {
+		return p, nil, nil, ErrBadHash
+	}
+	key, err := base64.RawStdEncoding.DecodeString(parts[5])
+	if err != nil || len(key) == 0 {
+		return p, nil, nil, ErrBadHash
+	}
+
+	return p, salt, key, nil
+}
@@ -0,0 +1,71 @@
+package password
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/go-park-mail-ru/2023_2_Vkladyshi/configs"
+)
+
+// Tests use a small cost, hashing with the defaults takes too long.
+var cheap = configs.PasswordHashCfg{Memory: 64, Iterations: 1, Parallelism: 1}
+
+func TestHash(t *testing.T) {
+	h := NewHasher(cheap)
+
+	first, err := h.Hash("secret")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	second, _ := h.Hash("secret")
+	if first == second {
+		t.Errorf("expected a new salt for every hash")
+	}
+	if !strings.HasPrefix(first, "$argon2id$v=19$m=64,t=1,p=1$") || !IsHashed(first) {
+		t.Errorf("unexpected hash %s", first)
+	}
+
+	ok, rehash, err := h.Verify(first, "secret")
+	if !ok || rehash || err != nil {
+		t.Errorf("Verify = %v, %v, %v", ok, rehash, err)
+	}
+	ok, rehash, err = h.Verify(first, "wrong")
+	if ok || rehash || err != nil {
+		t.Errorf("Verify wrong password = %v, %v, %v", ok, rehash, err)
+	}
+}
+
+func TestRehash(t *testing.T) {
+	h := NewHasher(cheap)
+
+	ok, rehash, err := h.Verify("secret", "secret")
+	if !ok || !rehash || err != nil {
+		t.Errorf("expected plain text to be rehashed, got %v, %v, %v", ok, rehash, err)
+	}
+	ok, rehash, _ = h.Verify("secret", "wrong")
+	if ok || rehash {
+		t.Errorf("expected a wrong password not to be rehashed")
+	}
+
+	old, _ := NewHasher(configs.PasswordHashCfg{Memory: 32, Iterations: 1, Parallelism: 1}).Hash("secret")
+	ok, rehash, err = h.Verify(old, "secret")
+	if !ok || !rehash || err != nil {
+		t.Errorf("expected a hash of another cost to be rehashed, got %v, %v, %v", ok, rehash, err)
+	}
+}
+
+func TestBadHash(t *testing.T) {
+	h := NewHasher(cheap)
+
+	for _, stored := range []string{
+		"$argon2id$",
+		"$argon2id$v=18$m=64,t=1,p=1$c2FsdA$a2V5",
+		"$argon2id$v=19$m=64$c2FsdA$a2V5",
+		"$argon2id$v=19$m=64,t=1,p=1$!!$a2V5",
+		"$argon2id$v=19$m=64,t=1,p=1$c2FsdA$",
+	} {
+		if _, _, err := h.Verify(stored, "secret"); err != ErrBadHash {
+			t.Errorf("%s: expected ErrBadHash, got %v", stored, err)
+		}
+	}
+}
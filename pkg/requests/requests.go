@@ -29,16 +29,46 @@ type (
 	}
 
 	FindFilmRequest struct {
-		Title      string   `json:"title"`
-		DateFrom   string   `json:"date_from"`
-		DateTo     string   `json:"date_to"`
-		RatingFrom float32  `json:"rating_from"`
-		RatingTo   float32  `json:"rating_to"`
-		Mpaa       string   `json:"mpaa"`
-		Genres     []uint32 `json:"genres"`
-		Actors     []string `json:"actors"`
-		Page       uint64   `json:"page"`
-		PerPage    uint64   `json:"per_page"`
+		Title       string   `json:"title"`
+		DateFrom    string   `json:"date_from"`
+		DateTo      string   `json:"date_to"`
+		RatingFrom  float32  `json:"rating_from"`
+		RatingTo    float32  `json:"rating_to"`
+		Mpaa        string   `json:"mpaa"`
+		Genres      []uint32 `json:"genres"`
+		Actors      []string `json:"actors"`
+		RuntimeFrom uint32   `json:"runtime_from"`
+		RuntimeTo   uint32   `json:"runtime_to"`
+		Language    string   `json:"language"`
+		Page        uint64   `json:"page"`
+		PerPage     uint64   `json:"per_page"`
+	}
+
+	AddTranslationRequest struct {
+		Entity string `json:"entity"`
+		Id     uint64 `json:"id"`
+		Lang   string `json:"lang"`
+		Title  string `json:"title"`
+		Info   string `json:"info"`
+	}
+
+	// EditFilmRequest changes the fields present in the body, so a field
+	// sent as 0, "" or [] is cleared.
+	EditFilmRequest struct {
+		FilmId        uint64    `json:"film_id"`
+		Title         *string   `json:"title,omitempty"`
+		Info          *string   `json:"info,omitempty"`
+		ReleaseDate   *string   `json:"release_date,omitempty"`
+		Country       *string   `json:"country,omitempty"`
+		Mpaa          *string   `json:"mpaa,omitempty"`
+		OriginalTitle *string   `json:"original_title,omitempty"`
+		Runtime       *uint32   `json:"runtime,omitempty"`
+		Budget        *uint64   `json:"budget,omitempty"`
+		BoxOffice     *uint64   `json:"box_office,omitempty"`
+		Languages     *[]string `json:"languages,omitempty"`
+		Trailers      *[]string `json:"trailers,omitempty"`
+		ImdbId        *string   `json:"imdb_id,omitempty"`
+		KinopoiskId   *string   `json:"kinopoisk_id,omitempty"`
 	}
 
 	FindActorRequest struct {
@@ -57,7 +87,11 @@ type (
 	}
 
 	DeleteCommentRequest struct {
-		IdUser    uint64 `json:"user_id"`
-		IdFilm    uint64 `json:"film_id"`
+		IdUser uint64 `json:"user_id"`
+		IdFilm uint64 `json:"film_id"`
+	}
+
+	RevokeSessionRequest struct {
+		Id string `json:"id"`
 	}
-)
\ No newline at end of file
+)
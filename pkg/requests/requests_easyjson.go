@@ -18,7 +18,87 @@ var (
 	_ easyjson.Marshaler
 )
 
-func easyjson11d1a9baDecodeGithubComGoParkMailRu20232VkladyshiPkgRequests(in *jlexer.Lexer, out *UsersResponse) {
+func easyjson11d1a9baDecodeGithubComGoParkMailRu20232VkladyshiPkgRequests(in *jlexer.Lexer, out *UsersStatisticsResponse) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "genre_id":
+			out.GenreId = uint64(in.Uint64())
+		case "count":
+			out.Count = uint64(in.Uint64())
+		case "avg":
+			out.Avg = float64(in.Float64())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson11d1a9baEncodeGithubComGoParkMailRu20232VkladyshiPkgRequests(out *jwriter.Writer, in UsersStatisticsResponse) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"genre_id\":"
+		out.RawString(prefix[1:])
+		out.Uint64(uint64(in.GenreId))
+	}
+	{
+		const prefix string = ",\"count\":"
+		out.RawString(prefix)
+		out.Uint64(uint64(in.Count))
+	}
+	{
+		const prefix string = ",\"avg\":"
+		out.RawString(prefix)
+		out.Float64(float64(in.Avg))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v UsersStatisticsResponse) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson11d1a9baEncodeGithubComGoParkMailRu20232VkladyshiPkgRequests(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v UsersStatisticsResponse) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson11d1a9baEncodeGithubComGoParkMailRu20232VkladyshiPkgRequests(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *UsersStatisticsResponse) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson11d1a9baDecodeGithubComGoParkMailRu20232VkladyshiPkgRequests(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *UsersStatisticsResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson11d1a9baDecodeGithubComGoParkMailRu20232VkladyshiPkgRequests(l, v)
+}
+func easyjson11d1a9baDecodeGithubComGoParkMailRu20232VkladyshiPkgRequests1(in *jlexer.Lexer, out *UsersResponse) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -70,7 +150,7 @@ func easyjson11d1a9baDecodeGithubComGoParkMailRu20232VkladyshiPkgRequests(in *jl
 		in.Consumed()
 	}
 }
-func easyjson11d1a9baEncodeGithubComGoParkMailRu20232VkladyshiPkgRequests(out *jwriter.Writer, in UsersResponse) {
+func easyjson11d1a9baEncodeGithubComGoParkMailRu20232VkladyshiPkgRequests1(out *jwriter.Writer, in UsersResponse) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -96,27 +176,27 @@ func easyjson11d1a9baEncodeGithubComGoParkMailRu20232VkladyshiPkgRequests(out *j
 // MarshalJSON supports json.Marshaler interface
 func (v UsersResponse) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjson11d1a9baEncodeGithubComGoParkMailRu20232VkladyshiPkgRequests(&w, v)
+	easyjson11d1a9baEncodeGithubComGoParkMailRu20232VkladyshiPkgRequests1(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v UsersResponse) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson11d1a9baEncodeGithubComGoParkMailRu20232VkladyshiPkgRequests(w, v)
+	easyjson11d1a9baEncodeGithubComGoParkMailRu20232VkladyshiPkgRequests1(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *UsersResponse) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjson11d1a9baDecodeGithubComGoParkMailRu20232VkladyshiPkgRequests(&r, v)
+	easyjson11d1a9baDecodeGithubComGoParkMailRu20232VkladyshiPkgRequests1(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *UsersResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson11d1a9baDecodeGithubComGoParkMailRu20232VkladyshiPkgRequests(l, v)
+	easyjson11d1a9baDecodeGithubComGoParkMailRu20232VkladyshiPkgRequests1(l, v)
 }
-func easyjson11d1a9baDecodeGithubComGoParkMailRu20232VkladyshiPkgRequests1(in *jlexer.Lexer, out *SubcribeResponse) {
+func easyjson11d1a9baDecodeGithubComGoParkMailRu20232VkladyshiPkgRequests2(in *jlexer.Lexer, out *SubcribeResponse) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -147,7 +227,7 @@ func easyjson11d1a9baDecodeGithubComGoParkMailRu20232VkladyshiPkgRequests1(in *j
 		in.Consumed()
 	}
 }
-func easyjson11d1a9baEncodeGithubComGoParkMailRu20232VkladyshiPkgRequests1(out *jwriter.Writer, in SubcribeResponse) {
+func easyjson11d1a9baEncodeGithubComGoParkMailRu20232VkladyshiPkgRequests2(out *jwriter.Writer, in SubcribeResponse) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -162,27 +242,27 @@ func easyjson11d1a9baEncodeGithubComGoParkMailRu20232VkladyshiPkgRequests1(out *
 // MarshalJSON supports json.Marshaler interface
 func (v SubcribeResponse) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjson11d1a9baEncodeGithubComGoParkMailRu20232VkladyshiPkgRequests1(&w, v)
+	easyjson11d1a9baEncodeGithubComGoParkMailRu20232VkladyshiPkgRequests2(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v SubcribeResponse) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson11d1a9baEncodeGithubComGoParkMailRu20232VkladyshiPkgRequests1(w, v)
+	easyjson11d1a9baEncodeGithubComGoParkMailRu20232VkladyshiPkgRequests2(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *SubcribeResponse) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjson11d1a9baDecodeGithubComGoParkMailRu20232VkladyshiPkgRequests1(&r, v)
+	easyjson11d1a9baDecodeGithubComGoParkMailRu20232VkladyshiPkgRequests2(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *SubcribeResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson11d1a9baDecodeGithubComGoParkMailRu20232VkladyshiPkgRequests1(l, v)
+	easyjson11d1a9baDecodeGithubComGoParkMailRu20232VkladyshiPkgRequests2(l, v)
 }
-func easyjson11d1a9baDecodeGithubComGoParkMailRu20232VkladyshiPkgRequests2(in *jlexer.Lexer, out *SignupRequest) {
+func easyjson11d1a9baDecodeGithubComGoParkMailRu20232VkladyshiPkgRequests3(in *jlexer.Lexer, out *SignupRequest) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -221,7 +301,7 @@ func easyjson11d1a9baDecodeGithubComGoParkMailRu20232VkladyshiPkgRequests2(in *j
 		in.Consumed()
 	}
 }
-func easyjson11d1a9baEncodeGithubComGoParkMailRu20232VkladyshiPkgRequests2(out *jwriter.Writer, in SignupRequest) {
+func easyjson11d1a9baEncodeGithubComGoParkMailRu20232VkladyshiPkgRequests3(out *jwriter.Writer, in SignupRequest) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -256,27 +336,27 @@ func easyjson11d1a9baEncodeGithubComGoParkMailRu20232VkladyshiPkgRequests2(out *
 // MarshalJSON supports json.Marshaler interface
 func (v SignupRequest) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjson11d1a9baEncodeGithubComGoParkMailRu20232VkladyshiPkgRequests2(&w, v)
+	easyjson11d1a9baEncodeGithubComGoParkMailRu20232VkladyshiPkgRequests3(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v SignupRequest) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson11d1a9baEncodeGithubComGoParkMailRu20232VkladyshiPkgRequests2(w, v)
+	easyjson11d1a9baEncodeGithubComGoParkMailRu20232VkladyshiPkgRequests3(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *SignupRequest) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjson11d1a9baDecodeGithubComGoParkMailRu20232VkladyshiPkgRequests2(&r, v)
+	easyjson11d1a9baDecodeGithubComGoParkMailRu20232VkladyshiPkgRequests3(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *SignupRequest) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson11d1a9baDecodeGithubComGoParkMailRu20232VkladyshiPkgRequests2(l, v)
+	easyjson11d1a9baDecodeGithubComGoParkMailRu20232VkladyshiPkgRequests3(l, v)
 }
-func easyjson11d1a9baDecodeGithubComGoParkMailRu20232VkladyshiPkgRequests3(in *jlexer.Lexer, out *SigninRequest) {
+func easyjson11d1a9baDecodeGithubComGoParkMailRu20232VkladyshiPkgRequests4(in *jlexer.Lexer, out *SigninRequest) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -309,7 +389,7 @@ func easyjson11d1a9baDecodeGithubComGoParkMailRu20232VkladyshiPkgRequests3(in *j
 		in.Consumed()
 	}
 }
-func easyjson11d1a9baEncodeGithubComGoParkMailRu20232VkladyshiPkgRequests3(out *jwriter.Writer, in SigninRequest) {
+func easyjson11d1a9baEncodeGithubComGoParkMailRu20232VkladyshiPkgRequests4(out *jwriter.Writer, in SigninRequest) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -329,27 +409,27 @@ func easyjson11d1a9baEncodeGithubComGoParkMailRu20232VkladyshiPkgRequests3(out *
 // MarshalJSON supports json.Marshaler interface
 func (v SigninRequest) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjson11d1a9baEncodeGithubComGoParkMailRu20232VkladyshiPkgRequests3(&w, v)
+	easyjson11d1a9baEncodeGithubComGoParkMailRu20232VkladyshiPkgRequests4(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v SigninRequest) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson11d1a9baEncodeGithubComGoParkMailRu20232VkladyshiPkgRequests3(w, v)
+	easyjson11d1a9baEncodeGithubComGoParkMailRu20232VkladyshiPkgRequests4(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *SigninRequest) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjson11d1a9baDecodeGithubComGoParkMailRu20232VkladyshiPkgRequests3(&r, v)
+	easyjson11d1a9baDecodeGithubComGoParkMailRu20232VkladyshiPkgRequests4(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *SigninRequest) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson11d1a9baDecodeGithubComGoParkMailRu20232VkladyshiPkgRequests3(l, v)
+	easyjson11d1a9baDecodeGithubComGoParkMailRu20232VkladyshiPkgRequests4(l, v)
 }
-func easyjson11d1a9baDecodeGithubComGoParkMailRu20232VkladyshiPkgRequests4(in *jlexer.Lexer, out *Response) {
+func easyjson11d1a9baDecodeGithubComGoParkMailRu20232VkladyshiPkgRequests5(in *jlexer.Lexer, out *SessionsResponse) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -368,15 +448,28 @@ func easyjson11d1a9baDecodeGithubComGoParkMailRu20232VkladyshiPkgRequests4(in *j
 			continue
 		}
 		switch key {
-		case "status":
-			out.Status = int(in.Int())
-		case "body":
-			if m, ok := out.Body.(easyjson.Unmarshaler); ok {
-				m.UnmarshalEasyJSON(in)
-			} else if m, ok := out.Body.(json.Unmarshaler); ok {
-				_ = m.UnmarshalJSON(in.Raw())
+		case "sessions":
+			if in.IsNull() {
+				in.Skip()
+				out.Sessions = nil
 			} else {
-				out.Body = in.Interface()
+				in.Delim('[')
+				if out.Sessions == nil {
+					if !in.IsDelim(']') {
+						out.Sessions = make([]models.SessionItem, 0, 0)
+					} else {
+						out.Sessions = []models.SessionItem{}
+					}
+				} else {
+					out.Sessions = (out.Sessions)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v4 models.SessionItem
+					(v4).UnmarshalEasyJSON(in)
+					out.Sessions = append(out.Sessions, v4)
+					in.WantComma()
+				}
+				in.Delim(']')
 			}
 		default:
 			in.SkipRecursive()
@@ -388,53 +481,53 @@ func easyjson11d1a9baDecodeGithubComGoParkMailRu20232VkladyshiPkgRequests4(in *j
 		in.Consumed()
 	}
 }
-func easyjson11d1a9baEncodeGithubComGoParkMailRu20232VkladyshiPkgRequests4(out *jwriter.Writer, in Response) {
+func easyjson11d1a9baEncodeGithubComGoParkMailRu20232VkladyshiPkgRequests5(out *jwriter.Writer, in SessionsResponse) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"status\":"
+		const prefix string = ",\"sessions\":"
 		out.RawString(prefix[1:])
-		out.Int(int(in.Status))
-	}
-	{
-		const prefix string = ",\"body\":"
-		out.RawString(prefix)
-		if m, ok := in.Body.(easyjson.Marshaler); ok {
-			m.MarshalEasyJSON(out)
-		} else if m, ok := in.Body.(json.Marshaler); ok {
-			out.Raw(m.MarshalJSON())
+		if in.Sessions == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
 		} else {
-			out.Raw(json.Marshal(in.Body))
+			out.RawByte('[')
+			for v5, v6 := range in.Sessions {
+				if v5 > 0 {
+					out.RawByte(',')
+				}
+				(v6).MarshalEasyJSON(out)
+			}
+			out.RawByte(']')
 		}
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v Response) MarshalJSON() ([]byte, error) {
+func (v SessionsResponse) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjson11d1a9baEncodeGithubComGoParkMailRu20232VkladyshiPkgRequests4(&w, v)
+	easyjson11d1a9baEncodeGithubComGoParkMailRu20232VkladyshiPkgRequests5(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v Response) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson11d1a9baEncodeGithubComGoParkMailRu20232VkladyshiPkgRequests4(w, v)
+func (v SessionsResponse) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson11d1a9baEncodeGithubComGoParkMailRu20232VkladyshiPkgRequests5(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *Response) UnmarshalJSON(data []byte) error {
+func (v *SessionsResponse) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjson11d1a9baDecodeGithubComGoParkMailRu20232VkladyshiPkgRequests4(&r, v)
+	easyjson11d1a9baDecodeGithubComGoParkMailRu20232VkladyshiPkgRequests5(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *Response) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson11d1a9baDecodeGithubComGoParkMailRu20232VkladyshiPkgRequests4(l, v)
+func (v *SessionsResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson11d1a9baDecodeGithubComGoParkMailRu20232VkladyshiPkgRequests5(l, v)
 }
-func easyjson11d1a9baDecodeGithubComGoParkMailRu20232VkladyshiPkgRequests5(in *jlexer.Lexer, out *ProfileResponse) {
+func easyjson11d1a9baDecodeGithubComGoParkMailRu20232VkladyshiPkgRequests6(in *jlexer.Lexer, out *RevokeSessionsResponse) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -453,16 +546,8 @@ func easyjson11d1a9baDecodeGithubComGoParkMailRu20232VkladyshiPkgRequests5(in *j
 			continue
 		}
 		switch key {
-		case "email":
-			out.Email = string(in.String())
-		case "name":
-			out.Name = string(in.String())
-		case "login":
-			out.Login = string(in.String())
-		case "photo":
-			out.Photo = string(in.String())
-		case "birthday":
-			out.BirthDate = string(in.String())
+		case "revoked":
+			out.Revoked = int(in.Int())
 		default:
 			in.SkipRecursive()
 		}
@@ -473,62 +558,42 @@ func easyjson11d1a9baDecodeGithubComGoParkMailRu20232VkladyshiPkgRequests5(in *j
 		in.Consumed()
 	}
 }
-func easyjson11d1a9baEncodeGithubComGoParkMailRu20232VkladyshiPkgRequests5(out *jwriter.Writer, in ProfileResponse) {
+func easyjson11d1a9baEncodeGithubComGoParkMailRu20232VkladyshiPkgRequests6(out *jwriter.Writer, in RevokeSessionsResponse) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"email\":"
+		const prefix string = ",\"revoked\":"
 		out.RawString(prefix[1:])
-		out.String(string(in.Email))
-	}
-	{
-		const prefix string = ",\"name\":"
-		out.RawString(prefix)
-		out.String(string(in.Name))
-	}
-	{
-		const prefix string = ",\"login\":"
-		out.RawString(prefix)
-		out.String(string(in.Login))
-	}
-	{
-		const prefix string = ",\"photo\":"
-		out.RawString(prefix)
-		out.String(string(in.Photo))
-	}
-	{
-		const prefix string = ",\"birthday\":"
-		out.RawString(prefix)
-		out.String(string(in.BirthDate))
+		out.Int(int(in.Revoked))
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v ProfileResponse) MarshalJSON() ([]byte, error) {
+func (v RevokeSessionsResponse) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjson11d1a9baEncodeGithubComGoParkMailRu20232VkladyshiPkgRequests5(&w, v)
+	easyjson11d1a9baEncodeGithubComGoParkMailRu20232VkladyshiPkgRequests6(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v ProfileResponse) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson11d1a9baEncodeGithubComGoParkMailRu20232VkladyshiPkgRequests5(w, v)
+func (v RevokeSessionsResponse) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson11d1a9baEncodeGithubComGoParkMailRu20232VkladyshiPkgRequests6(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *ProfileResponse) UnmarshalJSON(data []byte) error {
+func (v *RevokeSessionsResponse) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjson11d1a9baDecodeGithubComGoParkMailRu20232VkladyshiPkgRequests5(&r, v)
+	easyjson11d1a9baDecodeGithubComGoParkMailRu20232VkladyshiPkgRequests6(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *ProfileResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson11d1a9baDecodeGithubComGoParkMailRu20232VkladyshiPkgRequests5(l, v)
+func (v *RevokeSessionsResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson11d1a9baDecodeGithubComGoParkMailRu20232VkladyshiPkgRequests6(l, v)
 }
-func easyjson11d1a9baDecodeGithubComGoParkMailRu20232VkladyshiPkgRequests6(in *jlexer.Lexer, out *FindFilmRequest) {
+func easyjson11d1a9baDecodeGithubComGoParkMailRu20232VkladyshiPkgRequests7(in *jlexer.Lexer, out *RevokeSessionRequest) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -547,68 +612,8 @@ func easyjson11d1a9baDecodeGithubComGoParkMailRu20232VkladyshiPkgRequests6(in *j
 			continue
 		}
 		switch key {
-		case "title":
-			out.Title = string(in.String())
-		case "date_from":
-			out.DateFrom = string(in.String())
-		case "date_to":
-			out.DateTo = string(in.String())
-		case "rating_from":
-			out.RatingFrom = float32(in.Float32())
-		case "rating_to":
-			out.RatingTo = float32(in.Float32())
-		case "mpaa":
-			out.Mpaa = string(in.String())
-		case "genres":
-			if in.IsNull() {
-				in.Skip()
-				out.Genres = nil
-			} else {
-				in.Delim('[')
-				if out.Genres == nil {
-					if !in.IsDelim(']') {
-						out.Genres = make([]uint32, 0, 16)
-					} else {
-						out.Genres = []uint32{}
-					}
-				} else {
-					out.Genres = (out.Genres)[:0]
-				}
-				for !in.IsDelim(']') {
-					var v4 uint32
-					v4 = uint32(in.Uint32())
-					out.Genres = append(out.Genres, v4)
-					in.WantComma()
-				}
-				in.Delim(']')
-			}
-		case "actors":
-			if in.IsNull() {
-				in.Skip()
-				out.Actors = nil
-			} else {
-				in.Delim('[')
-				if out.Actors == nil {
-					if !in.IsDelim(']') {
-						out.Actors = make([]string, 0, 4)
-					} else {
-						out.Actors = []string{}
-					}
-				} else {
-					out.Actors = (out.Actors)[:0]
-				}
-				for !in.IsDelim(']') {
-					var v5 string
-					v5 = string(in.String())
-					out.Actors = append(out.Actors, v5)
-					in.WantComma()
-				}
-				in.Delim(']')
-			}
-		case "page":
-			out.Page = uint64(in.Uint64())
-		case "per_page":
-			out.PerPage = uint64(in.Uint64())
+		case "id":
+			out.Id = string(in.String())
 		default:
 			in.SkipRecursive()
 		}
@@ -619,109 +624,42 @@ func easyjson11d1a9baDecodeGithubComGoParkMailRu20232VkladyshiPkgRequests6(in *j
 		in.Consumed()
 	}
 }
-func easyjson11d1a9baEncodeGithubComGoParkMailRu20232VkladyshiPkgRequests6(out *jwriter.Writer, in FindFilmRequest) {
+func easyjson11d1a9baEncodeGithubComGoParkMailRu20232VkladyshiPkgRequests7(out *jwriter.Writer, in RevokeSessionRequest) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"title\":"
+		const prefix string = ",\"id\":"
 		out.RawString(prefix[1:])
-		out.String(string(in.Title))
-	}
-	{
-		const prefix string = ",\"date_from\":"
-		out.RawString(prefix)
-		out.String(string(in.DateFrom))
-	}
-	{
-		const prefix string = ",\"date_to\":"
-		out.RawString(prefix)
-		out.String(string(in.DateTo))
-	}
-	{
-		const prefix string = ",\"rating_from\":"
-		out.RawString(prefix)
-		out.Float32(float32(in.RatingFrom))
-	}
-	{
-		const prefix string = ",\"rating_to\":"
-		out.RawString(prefix)
-		out.Float32(float32(in.RatingTo))
-	}
-	{
-		const prefix string = ",\"mpaa\":"
-		out.RawString(prefix)
-		out.String(string(in.Mpaa))
-	}
-	{
-		const prefix string = ",\"genres\":"
-		out.RawString(prefix)
-		if in.Genres == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
-			out.RawString("null")
-		} else {
-			out.RawByte('[')
-			for v6, v7 := range in.Genres {
-				if v6 > 0 {
-					out.RawByte(',')
-				}
-				out.Uint32(uint32(v7))
-			}
-			out.RawByte(']')
-		}
-	}
-	{
-		const prefix string = ",\"actors\":"
-		out.RawString(prefix)
-		if in.Actors == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
-			out.RawString("null")
-		} else {
-			out.RawByte('[')
-			for v8, v9 := range in.Actors {
-				if v8 > 0 {
-					out.RawByte(',')
-				}
-				out.String(string(v9))
-			}
-			out.RawByte(']')
-		}
-	}
-	{
-		const prefix string = ",\"page\":"
-		out.RawString(prefix)
-		out.Uint64(uint64(in.Page))
-	}
-	{
-		const prefix string = ",\"per_page\":"
-		out.RawString(prefix)
-		out.Uint64(uint64(in.PerPage))
+		out.String(string(in.Id))
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v FindFilmRequest) MarshalJSON() ([]byte, error) {
+func (v RevokeSessionRequest) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjson11d1a9baEncodeGithubComGoParkMailRu20232VkladyshiPkgRequests6(&w, v)
+	easyjson11d1a9baEncodeGithubComGoParkMailRu20232VkladyshiPkgRequests7(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v FindFilmRequest) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson11d1a9baEncodeGithubComGoParkMailRu20232VkladyshiPkgRequests6(w, v)
+func (v RevokeSessionRequest) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson11d1a9baEncodeGithubComGoParkMailRu20232VkladyshiPkgRequests7(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *FindFilmRequest) UnmarshalJSON(data []byte) error {
+func (v *RevokeSessionRequest) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjson11d1a9baDecodeGithubComGoParkMailRu20232VkladyshiPkgRequests6(&r, v)
+	easyjson11d1a9baDecodeGithubComGoParkMailRu20232VkladyshiPkgRequests7(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *FindFilmRequest) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson11d1a9baDecodeGithubComGoParkMailRu20232VkladyshiPkgRequests6(l, v)
+func (v *RevokeSessionRequest) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson11d1a9baDecodeGithubComGoParkMailRu20232VkladyshiPkgRequests7(l, v)
 }
-func easyjson11d1a9baDecodeGithubComGoParkMailRu20232VkladyshiPkgRequests7(in *jlexer.Lexer, out *FindActorRequest) {
+func easyjson11d1a9baDecodeGithubComGoParkMailRu20232VkladyshiPkgRequests8(in *jlexer.Lexer, out *Response) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -740,62 +678,16 @@ func easyjson11d1a9baDecodeGithubComGoParkMailRu20232VkladyshiPkgRequests7(in *j
 			continue
 		}
 		switch key {
-		case "name":
-			out.Name = string(in.String())
-		case "birthday":
-			out.BirthDate = string(in.String())
-		case "amplua":
-			if in.IsNull() {
-				in.Skip()
-				out.Career = nil
-			} else {
-				in.Delim('[')
-				if out.Career == nil {
-					if !in.IsDelim(']') {
-						out.Career = make([]string, 0, 4)
-					} else {
-						out.Career = []string{}
-					}
-				} else {
-					out.Career = (out.Career)[:0]
-				}
-				for !in.IsDelim(']') {
-					var v10 string
-					v10 = string(in.String())
-					out.Career = append(out.Career, v10)
-					in.WantComma()
-				}
-				in.Delim(']')
-			}
-		case "films":
-			if in.IsNull() {
-				in.Skip()
-				out.Films = nil
+		case "status":
+			out.Status = int(in.Int())
+		case "body":
+			if m, ok := out.Body.(easyjson.Unmarshaler); ok {
+				m.UnmarshalEasyJSON(in)
+			} else if m, ok := out.Body.(json.Unmarshaler); ok {
+				_ = m.UnmarshalJSON(in.Raw())
 			} else {
-				in.Delim('[')
-				if out.Films == nil {
-					if !in.IsDelim(']') {
-						out.Films = make([]string, 0, 4)
-					} else {
-						out.Films = []string{}
-					}
-				} else {
-					out.Films = (out.Films)[:0]
-				}
-				for !in.IsDelim(']') {
-					var v11 string
-					v11 = string(in.String())
-					out.Films = append(out.Films, v11)
-					in.WantComma()
-				}
-				in.Delim(']')
+				out.Body = in.Interface()
 			}
-		case "country":
-			out.Country = string(in.String())
-		case "page":
-			out.Page = uint64(in.Uint64())
-		case "per_page":
-			out.PerPage = uint64(in.Uint64())
 		default:
 			in.SkipRecursive()
 		}
@@ -806,94 +698,53 @@ func easyjson11d1a9baDecodeGithubComGoParkMailRu20232VkladyshiPkgRequests7(in *j
 		in.Consumed()
 	}
 }
-func easyjson11d1a9baEncodeGithubComGoParkMailRu20232VkladyshiPkgRequests7(out *jwriter.Writer, in FindActorRequest) {
+func easyjson11d1a9baEncodeGithubComGoParkMailRu20232VkladyshiPkgRequests8(out *jwriter.Writer, in Response) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"name\":"
+		const prefix string = ",\"status\":"
 		out.RawString(prefix[1:])
-		out.String(string(in.Name))
-	}
-	{
-		const prefix string = ",\"birthday\":"
-		out.RawString(prefix)
-		out.String(string(in.BirthDate))
-	}
-	{
-		const prefix string = ",\"amplua\":"
-		out.RawString(prefix)
-		if in.Career == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
-			out.RawString("null")
-		} else {
-			out.RawByte('[')
-			for v12, v13 := range in.Career {
-				if v12 > 0 {
-					out.RawByte(',')
-				}
-				out.String(string(v13))
-			}
-			out.RawByte(']')
-		}
+		out.Int(int(in.Status))
 	}
 	{
-		const prefix string = ",\"films\":"
+		const prefix string = ",\"body\":"
 		out.RawString(prefix)
-		if in.Films == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
-			out.RawString("null")
+		if m, ok := in.Body.(easyjson.Marshaler); ok {
+			m.MarshalEasyJSON(out)
+		} else if m, ok := in.Body.(json.Marshaler); ok {
+			out.Raw(m.MarshalJSON())
 		} else {
-			out.RawByte('[')
-			for v14, v15 := range in.Films {
-				if v14 > 0 {
-					out.RawByte(',')
-				}
-				out.String(string(v15))
-			}
-			out.RawByte(']')
+			out.Raw(json.Marshal(in.Body))
 		}
 	}
-	{
-		const prefix string = ",\"country\":"
-		out.RawString(prefix)
-		out.String(string(in.Country))
-	}
-	{
-		const prefix string = ",\"page\":"
-		out.RawString(prefix)
-		out.Uint64(uint64(in.Page))
-	}
-	{
-		const prefix string = ",\"per_page\":"
-		out.RawString(prefix)
-		out.Uint64(uint64(in.PerPage))
-	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v FindActorRequest) MarshalJSON() ([]byte, error) {
+func (v Response) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjson11d1a9baEncodeGithubComGoParkMailRu20232VkladyshiPkgRequests7(&w, v)
+	easyjson11d1a9baEncodeGithubComGoParkMailRu20232VkladyshiPkgRequests8(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v FindActorRequest) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson11d1a9baEncodeGithubComGoParkMailRu20232VkladyshiPkgRequests7(w, v)
+func (v Response) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson11d1a9baEncodeGithubComGoParkMailRu20232VkladyshiPkgRequests8(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *FindActorRequest) UnmarshalJSON(data []byte) error {
+func (v *Response) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjson11d1a9baDecodeGithubComGoParkMailRu20232VkladyshiPkgRequests7(&r, v)
+	easyjson11d1a9baDecodeGithubComGoParkMailRu20232VkladyshiPkgRequests8(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *FindActorRequest) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson11d1a9baDecodeGithubComGoParkMailRu20232VkladyshiPkgRequests7(l, v)
+func (v *Response) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson11d1a9baDecodeGithubComGoParkMailRu20232VkladyshiPkgRequests8(l, v)
 }
-func easyjson11d1a9baDecodeGithubComGoParkMailRu20232VkladyshiPkgRequests8(in *jlexer.Lexer, out *FilmsResponse) {
+func easyjson11d1a9baDecodeGithubComGoParkMailRu20232VkladyshiPkgRequests9(in *jlexer.Lexer, out *ProfileResponse) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -912,36 +763,35 @@ func easyjson11d1a9baDecodeGithubComGoParkMailRu20232VkladyshiPkgRequests8(in *j
 			continue
 		}
 		switch key {
-		case "current_page":
-			out.Page = uint64(in.Uint64())
-		case "page_size":
-			out.PageSize = uint64(in.Uint64())
-		case "collection_name":
-			out.CollectionName = string(in.String())
-		case "total":
-			out.Total = uint64(in.Uint64())
-		case "films":
+		case "email":
+			out.Email = string(in.String())
+		case "name":
+			out.Name = string(in.String())
+		case "login":
+			out.Login = string(in.String())
+		case "photo":
+			out.Photo = string(in.String())
+		case "birthday":
+			out.BirthDate = string(in.String())
+		case "photo_variants":
 			if in.IsNull() {
 				in.Skip()
-				out.Films = nil
 			} else {
-				in.Delim('[')
-				if out.Films == nil {
-					if !in.IsDelim(']') {
-						out.Films = make([]models.FilmItem, 0, 0)
-					} else {
-						out.Films = []models.FilmItem{}
-					}
+				in.Delim('{')
+				if !in.IsDelim('}') {
+					out.PhotoVariants = make(map[string]string)
 				} else {
-					out.Films = (out.Films)[:0]
+					out.PhotoVariants = nil
 				}
-				for !in.IsDelim(']') {
-					var v16 models.FilmItem
-					(v16).UnmarshalEasyJSON(in)
-					out.Films = append(out.Films, v16)
+				for !in.IsDelim('}') {
+					key := string(in.String())
+					in.WantColon()
+					var v7 string
+					v7 = string(in.String())
+					(out.PhotoVariants)[key] = v7
 					in.WantComma()
 				}
-				in.Delim(']')
+				in.Delim('}')
 			}
 		default:
 			in.SkipRecursive()
@@ -953,73 +803,81 @@ func easyjson11d1a9baDecodeGithubComGoParkMailRu20232VkladyshiPkgRequests8(in *j
 		in.Consumed()
 	}
 }
-func easyjson11d1a9baEncodeGithubComGoParkMailRu20232VkladyshiPkgRequests8(out *jwriter.Writer, in FilmsResponse) {
+func easyjson11d1a9baEncodeGithubComGoParkMailRu20232VkladyshiPkgRequests9(out *jwriter.Writer, in ProfileResponse) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"current_page\":"
+		const prefix string = ",\"email\":"
 		out.RawString(prefix[1:])
-		out.Uint64(uint64(in.Page))
+		out.String(string(in.Email))
 	}
 	{
-		const prefix string = ",\"page_size\":"
+		const prefix string = ",\"name\":"
 		out.RawString(prefix)
-		out.Uint64(uint64(in.PageSize))
+		out.String(string(in.Name))
 	}
 	{
-		const prefix string = ",\"collection_name\":"
+		const prefix string = ",\"login\":"
 		out.RawString(prefix)
-		out.String(string(in.CollectionName))
+		out.String(string(in.Login))
 	}
 	{
-		const prefix string = ",\"total\":"
+		const prefix string = ",\"photo\":"
 		out.RawString(prefix)
-		out.Uint64(uint64(in.Total))
+		out.String(string(in.Photo))
 	}
 	{
-		const prefix string = ",\"films\":"
+		const prefix string = ",\"birthday\":"
 		out.RawString(prefix)
-		if in.Films == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
-			out.RawString("null")
-		} else {
-			out.RawByte('[')
-			for v17, v18 := range in.Films {
-				if v17 > 0 {
+		out.String(string(in.BirthDate))
+	}
+	if len(in.PhotoVariants) != 0 {
+		const prefix string = ",\"photo_variants\":"
+		out.RawString(prefix)
+		{
+			out.RawByte('{')
+			v8First := true
+			for v8Name, v8Value := range in.PhotoVariants {
+				if v8First {
+					v8First = false
+				} else {
 					out.RawByte(',')
 				}
-				(v18).MarshalEasyJSON(out)
+				out.String(string(v8Name))
+				out.RawByte(':')
+				out.String(string(v8Value))
 			}
-			out.RawByte(']')
+			out.RawByte('}')
 		}
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v FilmsResponse) MarshalJSON() ([]byte, error) {
+func (v ProfileResponse) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjson11d1a9baEncodeGithubComGoParkMailRu20232VkladyshiPkgRequests8(&w, v)
+	easyjson11d1a9baEncodeGithubComGoParkMailRu20232VkladyshiPkgRequests9(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v FilmsResponse) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson11d1a9baEncodeGithubComGoParkMailRu20232VkladyshiPkgRequests8(w, v)
+func (v ProfileResponse) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson11d1a9baEncodeGithubComGoParkMailRu20232VkladyshiPkgRequests9(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *FilmsResponse) UnmarshalJSON(data []byte) error {
+func (v *ProfileResponse) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjson11d1a9baDecodeGithubComGoParkMailRu20232VkladyshiPkgRequests8(&r, v)
+	easyjson11d1a9baDecodeGithubComGoParkMailRu20232VkladyshiPkgRequests9(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *FilmsResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson11d1a9baDecodeGithubComGoParkMailRu20232VkladyshiPkgRequests8(l, v)
+func (v *ProfileResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson11d1a9baDecodeGithubComGoParkMailRu20232VkladyshiPkgRequests9(l, v)
 }
-func easyjson11d1a9baDecodeGithubComGoParkMailRu20232VkladyshiPkgRequests9(in *jlexer.Lexer, out *FilmResponse) {
+func easyjson11d1a9baDecodeGithubComGoParkMailRu20232VkladyshiPkgRequests10(in *jlexer.Lexer, out *PosterResponse) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -1038,77 +896,157 @@ func easyjson11d1a9baDecodeGithubComGoParkMailRu20232VkladyshiPkgRequests9(in *j
 			continue
 		}
 		switch key {
-		case "film":
-			(out.Film).UnmarshalEasyJSON(in)
-		case "genre":
+		case "poster":
+			out.Poster = string(in.String())
+		case "poster_variants":
 			if in.IsNull() {
 				in.Skip()
-				out.Genres = nil
 			} else {
-				in.Delim('[')
-				if out.Genres == nil {
-					if !in.IsDelim(']') {
-						out.Genres = make([]models.GenreItem, 0, 2)
-					} else {
-						out.Genres = []models.GenreItem{}
-					}
+				in.Delim('{')
+				if !in.IsDelim('}') {
+					out.PosterVariants = make(map[string]string)
 				} else {
-					out.Genres = (out.Genres)[:0]
+					out.PosterVariants = nil
 				}
-				for !in.IsDelim(']') {
-					var v19 models.GenreItem
-					(v19).UnmarshalEasyJSON(in)
-					out.Genres = append(out.Genres, v19)
+				for !in.IsDelim('}') {
+					key := string(in.String())
+					in.WantColon()
+					var v9 string
+					v9 = string(in.String())
+					(out.PosterVariants)[key] = v9
 					in.WantComma()
 				}
-				in.Delim(']')
+				in.Delim('}')
 			}
-		case "rating":
-			out.Rating = float64(in.Float64())
-		case "number":
-			out.Number = uint64(in.Uint64())
-		case "directors":
+		case "placeholder":
 			if in.IsNull() {
 				in.Skip()
-				out.Directors = nil
+				out.Placeholder = nil
 			} else {
-				in.Delim('[')
-				if out.Directors == nil {
-					if !in.IsDelim(']') {
-						out.Directors = make([]models.CrewItem, 0, 0)
-					} else {
-						out.Directors = []models.CrewItem{}
-					}
-				} else {
-					out.Directors = (out.Directors)[:0]
+				if out.Placeholder == nil {
+					out.Placeholder = new(models.Placeholder)
 				}
-				for !in.IsDelim(']') {
-					var v20 models.CrewItem
-					(v20).UnmarshalEasyJSON(in)
-					out.Directors = append(out.Directors, v20)
-					in.WantComma()
+				(*out.Placeholder).UnmarshalEasyJSON(in)
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson11d1a9baEncodeGithubComGoParkMailRu20232VkladyshiPkgRequests10(out *jwriter.Writer, in PosterResponse) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"poster\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Poster))
+	}
+	if len(in.PosterVariants) != 0 {
+		const prefix string = ",\"poster_variants\":"
+		out.RawString(prefix)
+		{
+			out.RawByte('{')
+			v10First := true
+			for v10Name, v10Value := range in.PosterVariants {
+				if v10First {
+					v10First = false
+				} else {
+					out.RawByte(',')
 				}
-				in.Delim(']')
+				out.String(string(v10Name))
+				out.RawByte(':')
+				out.String(string(v10Value))
 			}
-		case "scenarists":
+			out.RawByte('}')
+		}
+	}
+	if in.Placeholder != nil {
+		const prefix string = ",\"placeholder\":"
+		out.RawString(prefix)
+		(*in.Placeholder).MarshalEasyJSON(out)
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v PosterResponse) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson11d1a9baEncodeGithubComGoParkMailRu20232VkladyshiPkgRequests10(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v PosterResponse) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson11d1a9baEncodeGithubComGoParkMailRu20232VkladyshiPkgRequests10(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *PosterResponse) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson11d1a9baDecodeGithubComGoParkMailRu20232VkladyshiPkgRequests10(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *PosterResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson11d1a9baDecodeGithubComGoParkMailRu20232VkladyshiPkgRequests10(l, v)
+}
+func easyjson11d1a9baDecodeGithubComGoParkMailRu20232VkladyshiPkgRequests11(in *jlexer.Lexer, out *FindFilmRequest) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "title":
+			out.Title = string(in.String())
+		case "date_from":
+			out.DateFrom = string(in.String())
+		case "date_to":
+			out.DateTo = string(in.String())
+		case "rating_from":
+			out.RatingFrom = float32(in.Float32())
+		case "rating_to":
+			out.RatingTo = float32(in.Float32())
+		case "mpaa":
+			out.Mpaa = string(in.String())
+		case "genres":
 			if in.IsNull() {
 				in.Skip()
-				out.Scenarists = nil
+				out.Genres = nil
 			} else {
 				in.Delim('[')
-				if out.Scenarists == nil {
+				if out.Genres == nil {
 					if !in.IsDelim(']') {
-						out.Scenarists = make([]models.CrewItem, 0, 0)
+						out.Genres = make([]uint32, 0, 16)
 					} else {
-						out.Scenarists = []models.CrewItem{}
+						out.Genres = []uint32{}
 					}
 				} else {
-					out.Scenarists = (out.Scenarists)[:0]
+					out.Genres = (out.Genres)[:0]
 				}
 				for !in.IsDelim(']') {
-					var v21 models.CrewItem
-					(v21).UnmarshalEasyJSON(in)
-					out.Scenarists = append(out.Scenarists, v21)
+					var v11 uint32
+					v11 = uint32(in.Uint32())
+					out.Genres = append(out.Genres, v11)
 					in.WantComma()
 				}
 				in.Delim(']')
@@ -1116,26 +1054,36 @@ func easyjson11d1a9baDecodeGithubComGoParkMailRu20232VkladyshiPkgRequests9(in *j
 		case "actors":
 			if in.IsNull() {
 				in.Skip()
-				out.Characters = nil
+				out.Actors = nil
 			} else {
 				in.Delim('[')
-				if out.Characters == nil {
+				if out.Actors == nil {
 					if !in.IsDelim(']') {
-						out.Characters = make([]models.Character, 0, 1)
+						out.Actors = make([]string, 0, 4)
 					} else {
-						out.Characters = []models.Character{}
+						out.Actors = []string{}
 					}
 				} else {
-					out.Characters = (out.Characters)[:0]
+					out.Actors = (out.Actors)[:0]
 				}
 				for !in.IsDelim(']') {
-					var v22 models.Character
-					(v22).UnmarshalEasyJSON(in)
-					out.Characters = append(out.Characters, v22)
+					var v12 string
+					v12 = string(in.String())
+					out.Actors = append(out.Actors, v12)
 					in.WantComma()
 				}
 				in.Delim(']')
 			}
+		case "runtime_from":
+			out.RuntimeFrom = uint32(in.Uint32())
+		case "runtime_to":
+			out.RuntimeTo = uint32(in.Uint32())
+		case "language":
+			out.Language = string(in.String())
+		case "page":
+			out.Page = uint64(in.Uint64())
+		case "per_page":
+			out.PerPage = uint64(in.Uint64())
 		default:
 			in.SkipRecursive()
 		}
@@ -1146,116 +1094,1594 @@ func easyjson11d1a9baDecodeGithubComGoParkMailRu20232VkladyshiPkgRequests9(in *j
 		in.Consumed()
 	}
 }
-func easyjson11d1a9baEncodeGithubComGoParkMailRu20232VkladyshiPkgRequests9(out *jwriter.Writer, in FilmResponse) {
+func easyjson11d1a9baEncodeGithubComGoParkMailRu20232VkladyshiPkgRequests11(out *jwriter.Writer, in FindFilmRequest) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"film\":"
+		const prefix string = ",\"title\":"
 		out.RawString(prefix[1:])
-		(in.Film).MarshalEasyJSON(out)
+		out.String(string(in.Title))
 	}
 	{
-		const prefix string = ",\"genre\":"
+		const prefix string = ",\"date_from\":"
 		out.RawString(prefix)
-		if in.Genres == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
-			out.RawString("null")
-		} else {
-			out.RawByte('[')
-			for v23, v24 := range in.Genres {
-				if v23 > 0 {
-					out.RawByte(',')
-				}
-				(v24).MarshalEasyJSON(out)
-			}
-			out.RawByte(']')
-		}
+		out.String(string(in.DateFrom))
 	}
 	{
-		const prefix string = ",\"rating\":"
+		const prefix string = ",\"date_to\":"
 		out.RawString(prefix)
-		out.Float64(float64(in.Rating))
+		out.String(string(in.DateTo))
 	}
 	{
-		const prefix string = ",\"number\":"
+		const prefix string = ",\"rating_from\":"
 		out.RawString(prefix)
-		out.Uint64(uint64(in.Number))
+		out.Float32(float32(in.RatingFrom))
 	}
 	{
-		const prefix string = ",\"directors\":"
+		const prefix string = ",\"rating_to\":"
 		out.RawString(prefix)
-		if in.Directors == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+		out.Float32(float32(in.RatingTo))
+	}
+	{
+		const prefix string = ",\"mpaa\":"
+		out.RawString(prefix)
+		out.String(string(in.Mpaa))
+	}
+	{
+		const prefix string = ",\"genres\":"
+		out.RawString(prefix)
+		if in.Genres == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
 			out.RawString("null")
 		} else {
 			out.RawByte('[')
-			for v25, v26 := range in.Directors {
-				if v25 > 0 {
+			for v13, v14 := range in.Genres {
+				if v13 > 0 {
 					out.RawByte(',')
 				}
-				(v26).MarshalEasyJSON(out)
+				out.Uint32(uint32(v14))
 			}
 			out.RawByte(']')
 		}
 	}
 	{
-		const prefix string = ",\"scenarists\":"
+		const prefix string = ",\"actors\":"
 		out.RawString(prefix)
-		if in.Scenarists == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+		if in.Actors == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
 			out.RawString("null")
 		} else {
 			out.RawByte('[')
-			for v27, v28 := range in.Scenarists {
-				if v27 > 0 {
+			for v15, v16 := range in.Actors {
+				if v15 > 0 {
 					out.RawByte(',')
 				}
-				(v28).MarshalEasyJSON(out)
+				out.String(string(v16))
 			}
 			out.RawByte(']')
 		}
 	}
 	{
-		const prefix string = ",\"actors\":"
+		const prefix string = ",\"runtime_from\":"
+		out.RawString(prefix)
+		out.Uint32(uint32(in.RuntimeFrom))
+	}
+	{
+		const prefix string = ",\"runtime_to\":"
+		out.RawString(prefix)
+		out.Uint32(uint32(in.RuntimeTo))
+	}
+	{
+		const prefix string = ",\"language\":"
+		out.RawString(prefix)
+		out.String(string(in.Language))
+	}
+	{
+		const prefix string = ",\"page\":"
+		out.RawString(prefix)
+		out.Uint64(uint64(in.Page))
+	}
+	{
+		const prefix string = ",\"per_page\":"
+		out.RawString(prefix)
+		out.Uint64(uint64(in.PerPage))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v FindFilmRequest) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson11d1a9baEncodeGithubComGoParkMailRu20232VkladyshiPkgRequests11(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v FindFilmRequest) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson11d1a9baEncodeGithubComGoParkMailRu20232VkladyshiPkgRequests11(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *FindFilmRequest) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson11d1a9baDecodeGithubComGoParkMailRu20232VkladyshiPkgRequests11(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *FindFilmRequest) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson11d1a9baDecodeGithubComGoParkMailRu20232VkladyshiPkgRequests11(l, v)
+}
+func easyjson11d1a9baDecodeGithubComGoParkMailRu20232VkladyshiPkgRequests12(in *jlexer.Lexer, out *FindActorRequest) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "name":
+			out.Name = string(in.String())
+		case "birthday":
+			out.BirthDate = string(in.String())
+		case "amplua":
+			if in.IsNull() {
+				in.Skip()
+				out.Career = nil
+			} else {
+				in.Delim('[')
+				if out.Career == nil {
+					if !in.IsDelim(']') {
+						out.Career = make([]string, 0, 4)
+					} else {
+						out.Career = []string{}
+					}
+				} else {
+					out.Career = (out.Career)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v17 string
+					v17 = string(in.String())
+					out.Career = append(out.Career, v17)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "films":
+			if in.IsNull() {
+				in.Skip()
+				out.Films = nil
+			} else {
+				in.Delim('[')
+				if out.Films == nil {
+					if !in.IsDelim(']') {
+						out.Films = make([]string, 0, 4)
+					} else {
+						out.Films = []string{}
+					}
+				} else {
+					out.Films = (out.Films)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v18 string
+					v18 = string(in.String())
+					out.Films = append(out.Films, v18)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "country":
+			out.Country = string(in.String())
+		case "page":
+			out.Page = uint64(in.Uint64())
+		case "per_page":
+			out.PerPage = uint64(in.Uint64())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson11d1a9baEncodeGithubComGoParkMailRu20232VkladyshiPkgRequests12(out *jwriter.Writer, in FindActorRequest) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"name\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Name))
+	}
+	{
+		const prefix string = ",\"birthday\":"
+		out.RawString(prefix)
+		out.String(string(in.BirthDate))
+	}
+	{
+		const prefix string = ",\"amplua\":"
+		out.RawString(prefix)
+		if in.Career == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v19, v20 := range in.Career {
+				if v19 > 0 {
+					out.RawByte(',')
+				}
+				out.String(string(v20))
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"films\":"
+		out.RawString(prefix)
+		if in.Films == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v21, v22 := range in.Films {
+				if v21 > 0 {
+					out.RawByte(',')
+				}
+				out.String(string(v22))
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"country\":"
+		out.RawString(prefix)
+		out.String(string(in.Country))
+	}
+	{
+		const prefix string = ",\"page\":"
+		out.RawString(prefix)
+		out.Uint64(uint64(in.Page))
+	}
+	{
+		const prefix string = ",\"per_page\":"
+		out.RawString(prefix)
+		out.Uint64(uint64(in.PerPage))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v FindActorRequest) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson11d1a9baEncodeGithubComGoParkMailRu20232VkladyshiPkgRequests12(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v FindActorRequest) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson11d1a9baEncodeGithubComGoParkMailRu20232VkladyshiPkgRequests12(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *FindActorRequest) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson11d1a9baDecodeGithubComGoParkMailRu20232VkladyshiPkgRequests12(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *FindActorRequest) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson11d1a9baDecodeGithubComGoParkMailRu20232VkladyshiPkgRequests12(l, v)
+}
+func easyjson11d1a9baDecodeGithubComGoParkMailRu20232VkladyshiPkgRequests13(in *jlexer.Lexer, out *FilmsResponse) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "current_page":
+			out.Page = uint64(in.Uint64())
+		case "page_size":
+			out.PageSize = uint64(in.Uint64())
+		case "collection_name":
+			out.CollectionName = string(in.String())
+		case "total":
+			out.Total = uint64(in.Uint64())
+		case "films":
+			if in.IsNull() {
+				in.Skip()
+				out.Films = nil
+			} else {
+				in.Delim('[')
+				if out.Films == nil {
+					if !in.IsDelim(']') {
+						out.Films = make([]models.FilmItem, 0, 0)
+					} else {
+						out.Films = []models.FilmItem{}
+					}
+				} else {
+					out.Films = (out.Films)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v23 models.FilmItem
+					(v23).UnmarshalEasyJSON(in)
+					out.Films = append(out.Films, v23)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson11d1a9baEncodeGithubComGoParkMailRu20232VkladyshiPkgRequests13(out *jwriter.Writer, in FilmsResponse) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"current_page\":"
+		out.RawString(prefix[1:])
+		out.Uint64(uint64(in.Page))
+	}
+	{
+		const prefix string = ",\"page_size\":"
+		out.RawString(prefix)
+		out.Uint64(uint64(in.PageSize))
+	}
+	{
+		const prefix string = ",\"collection_name\":"
+		out.RawString(prefix)
+		out.String(string(in.CollectionName))
+	}
+	{
+		const prefix string = ",\"total\":"
+		out.RawString(prefix)
+		out.Uint64(uint64(in.Total))
+	}
+	{
+		const prefix string = ",\"films\":"
+		out.RawString(prefix)
+		if in.Films == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v24, v25 := range in.Films {
+				if v24 > 0 {
+					out.RawByte(',')
+				}
+				(v25).MarshalEasyJSON(out)
+			}
+			out.RawByte(']')
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v FilmsResponse) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson11d1a9baEncodeGithubComGoParkMailRu20232VkladyshiPkgRequests13(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v FilmsResponse) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson11d1a9baEncodeGithubComGoParkMailRu20232VkladyshiPkgRequests13(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *FilmsResponse) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson11d1a9baDecodeGithubComGoParkMailRu20232VkladyshiPkgRequests13(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *FilmsResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson11d1a9baDecodeGithubComGoParkMailRu20232VkladyshiPkgRequests13(l, v)
+}
+func easyjson11d1a9baDecodeGithubComGoParkMailRu20232VkladyshiPkgRequests14(in *jlexer.Lexer, out *FilmographyGroup) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "profession":
+			out.Profession = string(in.String())
+		case "total":
+			out.Total = uint64(in.Uint64())
+		case "films":
+			if in.IsNull() {
+				in.Skip()
+				out.Films = nil
+			} else {
+				in.Delim('[')
+				if out.Films == nil {
+					if !in.IsDelim(']') {
+						out.Films = make([]models.FilmographyItem, 0, 0)
+					} else {
+						out.Films = []models.FilmographyItem{}
+					}
+				} else {
+					out.Films = (out.Films)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v26 models.FilmographyItem
+					(v26).UnmarshalEasyJSON(in)
+					out.Films = append(out.Films, v26)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson11d1a9baEncodeGithubComGoParkMailRu20232VkladyshiPkgRequests14(out *jwriter.Writer, in FilmographyGroup) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"profession\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Profession))
+	}
+	{
+		const prefix string = ",\"total\":"
+		out.RawString(prefix)
+		out.Uint64(uint64(in.Total))
+	}
+	{
+		const prefix string = ",\"films\":"
+		out.RawString(prefix)
+		if in.Films == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v27, v28 := range in.Films {
+				if v27 > 0 {
+					out.RawByte(',')
+				}
+				(v28).MarshalEasyJSON(out)
+			}
+			out.RawByte(']')
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v FilmographyGroup) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson11d1a9baEncodeGithubComGoParkMailRu20232VkladyshiPkgRequests14(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v FilmographyGroup) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson11d1a9baEncodeGithubComGoParkMailRu20232VkladyshiPkgRequests14(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *FilmographyGroup) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson11d1a9baDecodeGithubComGoParkMailRu20232VkladyshiPkgRequests14(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *FilmographyGroup) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson11d1a9baDecodeGithubComGoParkMailRu20232VkladyshiPkgRequests14(l, v)
+}
+func easyjson11d1a9baDecodeGithubComGoParkMailRu20232VkladyshiPkgRequests15(in *jlexer.Lexer, out *FilmResponse) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "film":
+			(out.Film).UnmarshalEasyJSON(in)
+		case "genre":
+			if in.IsNull() {
+				in.Skip()
+				out.Genres = nil
+			} else {
+				in.Delim('[')
+				if out.Genres == nil {
+					if !in.IsDelim(']') {
+						out.Genres = make([]models.GenreItem, 0, 2)
+					} else {
+						out.Genres = []models.GenreItem{}
+					}
+				} else {
+					out.Genres = (out.Genres)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v29 models.GenreItem
+					(v29).UnmarshalEasyJSON(in)
+					out.Genres = append(out.Genres, v29)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "rating":
+			out.Rating = float64(in.Float64())
+		case "number":
+			out.Number = uint64(in.Uint64())
+		case "directors":
+			if in.IsNull() {
+				in.Skip()
+				out.Directors = nil
+			} else {
+				in.Delim('[')
+				if out.Directors == nil {
+					if !in.IsDelim(']') {
+						out.Directors = make([]models.CrewItem, 0, 0)
+					} else {
+						out.Directors = []models.CrewItem{}
+					}
+				} else {
+					out.Directors = (out.Directors)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v30 models.CrewItem
+					(v30).UnmarshalEasyJSON(in)
+					out.Directors = append(out.Directors, v30)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "scenarists":
+			if in.IsNull() {
+				in.Skip()
+				out.Scenarists = nil
+			} else {
+				in.Delim('[')
+				if out.Scenarists == nil {
+					if !in.IsDelim(']') {
+						out.Scenarists = make([]models.CrewItem, 0, 0)
+					} else {
+						out.Scenarists = []models.CrewItem{}
+					}
+				} else {
+					out.Scenarists = (out.Scenarists)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v31 models.CrewItem
+					(v31).UnmarshalEasyJSON(in)
+					out.Scenarists = append(out.Scenarists, v31)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "actors":
+			if in.IsNull() {
+				in.Skip()
+				out.Characters = nil
+			} else {
+				in.Delim('[')
+				if out.Characters == nil {
+					if !in.IsDelim(']') {
+						out.Characters = make([]models.Character, 0, 1)
+					} else {
+						out.Characters = []models.Character{}
+					}
+				} else {
+					out.Characters = (out.Characters)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v32 models.Character
+					(v32).UnmarshalEasyJSON(in)
+					out.Characters = append(out.Characters, v32)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson11d1a9baEncodeGithubComGoParkMailRu20232VkladyshiPkgRequests15(out *jwriter.Writer, in FilmResponse) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"film\":"
+		out.RawString(prefix[1:])
+		(in.Film).MarshalEasyJSON(out)
+	}
+	{
+		const prefix string = ",\"genre\":"
+		out.RawString(prefix)
+		if in.Genres == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v33, v34 := range in.Genres {
+				if v33 > 0 {
+					out.RawByte(',')
+				}
+				(v34).MarshalEasyJSON(out)
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"rating\":"
+		out.RawString(prefix)
+		out.Float64(float64(in.Rating))
+	}
+	{
+		const prefix string = ",\"number\":"
+		out.RawString(prefix)
+		out.Uint64(uint64(in.Number))
+	}
+	{
+		const prefix string = ",\"directors\":"
+		out.RawString(prefix)
+		if in.Directors == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v35, v36 := range in.Directors {
+				if v35 > 0 {
+					out.RawByte(',')
+				}
+				(v36).MarshalEasyJSON(out)
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"scenarists\":"
+		out.RawString(prefix)
+		if in.Scenarists == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v37, v38 := range in.Scenarists {
+				if v37 > 0 {
+					out.RawByte(',')
+				}
+				(v38).MarshalEasyJSON(out)
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"actors\":"
+		out.RawString(prefix)
+		if in.Characters == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v39, v40 := range in.Characters {
+				if v39 > 0 {
+					out.RawByte(',')
+				}
+				(v40).MarshalEasyJSON(out)
+			}
+			out.RawByte(']')
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v FilmResponse) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson11d1a9baEncodeGithubComGoParkMailRu20232VkladyshiPkgRequests15(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v FilmResponse) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson11d1a9baEncodeGithubComGoParkMailRu20232VkladyshiPkgRequests15(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *FilmResponse) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson11d1a9baDecodeGithubComGoParkMailRu20232VkladyshiPkgRequests15(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *FilmResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson11d1a9baDecodeGithubComGoParkMailRu20232VkladyshiPkgRequests15(l, v)
+}
+func easyjson11d1a9baDecodeGithubComGoParkMailRu20232VkladyshiPkgRequests16(in *jlexer.Lexer, out *FilmPageResponse) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "comments":
+			if in.IsNull() {
+				in.Skip()
+				out.Comments = nil
+			} else {
+				in.Delim('[')
+				if out.Comments == nil {
+					if !in.IsDelim(']') {
+						out.Comments = make([]models.CommentItem, 0, 0)
+					} else {
+						out.Comments = []models.CommentItem{}
+					}
+				} else {
+					out.Comments = (out.Comments)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v41 models.CommentItem
+					(v41).UnmarshalEasyJSON(in)
+					out.Comments = append(out.Comments, v41)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "own_comment":
+			if in.IsNull() {
+				in.Skip()
+				out.OwnComment = nil
+			} else {
+				if out.OwnComment == nil {
+					out.OwnComment = new(models.CommentItem)
+				}
+				(*out.OwnComment).UnmarshalEasyJSON(in)
+			}
+		case "is_favorite":
+			out.IsFavorite = bool(in.Bool())
+		case "unavailable":
+			if in.IsNull() {
+				in.Skip()
+				out.Unavailable = nil
+			} else {
+				in.Delim('[')
+				if out.Unavailable == nil {
+					if !in.IsDelim(']') {
+						out.Unavailable = make([]string, 0, 4)
+					} else {
+						out.Unavailable = []string{}
+					}
+				} else {
+					out.Unavailable = (out.Unavailable)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v42 string
+					v42 = string(in.String())
+					out.Unavailable = append(out.Unavailable, v42)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "film":
+			(out.Film).UnmarshalEasyJSON(in)
+		case "genre":
+			if in.IsNull() {
+				in.Skip()
+				out.Genres = nil
+			} else {
+				in.Delim('[')
+				if out.Genres == nil {
+					if !in.IsDelim(']') {
+						out.Genres = make([]models.GenreItem, 0, 2)
+					} else {
+						out.Genres = []models.GenreItem{}
+					}
+				} else {
+					out.Genres = (out.Genres)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v43 models.GenreItem
+					(v43).UnmarshalEasyJSON(in)
+					out.Genres = append(out.Genres, v43)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "rating":
+			out.Rating = float64(in.Float64())
+		case "number":
+			out.Number = uint64(in.Uint64())
+		case "directors":
+			if in.IsNull() {
+				in.Skip()
+				out.Directors = nil
+			} else {
+				in.Delim('[')
+				if out.Directors == nil {
+					if !in.IsDelim(']') {
+						out.Directors = make([]models.CrewItem, 0, 0)
+					} else {
+						out.Directors = []models.CrewItem{}
+					}
+				} else {
+					out.Directors = (out.Directors)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v44 models.CrewItem
+					(v44).UnmarshalEasyJSON(in)
+					out.Directors = append(out.Directors, v44)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "scenarists":
+			if in.IsNull() {
+				in.Skip()
+				out.Scenarists = nil
+			} else {
+				in.Delim('[')
+				if out.Scenarists == nil {
+					if !in.IsDelim(']') {
+						out.Scenarists = make([]models.CrewItem, 0, 0)
+					} else {
+						out.Scenarists = []models.CrewItem{}
+					}
+				} else {
+					out.Scenarists = (out.Scenarists)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v45 models.CrewItem
+					(v45).UnmarshalEasyJSON(in)
+					out.Scenarists = append(out.Scenarists, v45)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "actors":
+			if in.IsNull() {
+				in.Skip()
+				out.Characters = nil
+			} else {
+				in.Delim('[')
+				if out.Characters == nil {
+					if !in.IsDelim(']') {
+						out.Characters = make([]models.Character, 0, 1)
+					} else {
+						out.Characters = []models.Character{}
+					}
+				} else {
+					out.Characters = (out.Characters)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v46 models.Character
+					(v46).UnmarshalEasyJSON(in)
+					out.Characters = append(out.Characters, v46)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson11d1a9baEncodeGithubComGoParkMailRu20232VkladyshiPkgRequests16(out *jwriter.Writer, in FilmPageResponse) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"comments\":"
+		out.RawString(prefix[1:])
+		if in.Comments == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v47, v48 := range in.Comments {
+				if v47 > 0 {
+					out.RawByte(',')
+				}
+				(v48).MarshalEasyJSON(out)
+			}
+			out.RawByte(']')
+		}
+	}
+	if in.OwnComment != nil {
+		const prefix string = ",\"own_comment\":"
+		out.RawString(prefix)
+		(*in.OwnComment).MarshalEasyJSON(out)
+	}
+	{
+		const prefix string = ",\"is_favorite\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.IsFavorite))
+	}
+	if len(in.Unavailable) != 0 {
+		const prefix string = ",\"unavailable\":"
+		out.RawString(prefix)
+		{
+			out.RawByte('[')
+			for v49, v50 := range in.Unavailable {
+				if v49 > 0 {
+					out.RawByte(',')
+				}
+				out.String(string(v50))
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"film\":"
+		out.RawString(prefix)
+		(in.Film).MarshalEasyJSON(out)
+	}
+	{
+		const prefix string = ",\"genre\":"
+		out.RawString(prefix)
+		if in.Genres == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v51, v52 := range in.Genres {
+				if v51 > 0 {
+					out.RawByte(',')
+				}
+				(v52).MarshalEasyJSON(out)
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"rating\":"
+		out.RawString(prefix)
+		out.Float64(float64(in.Rating))
+	}
+	{
+		const prefix string = ",\"number\":"
+		out.RawString(prefix)
+		out.Uint64(uint64(in.Number))
+	}
+	{
+		const prefix string = ",\"directors\":"
+		out.RawString(prefix)
+		if in.Directors == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v53, v54 := range in.Directors {
+				if v53 > 0 {
+					out.RawByte(',')
+				}
+				(v54).MarshalEasyJSON(out)
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"scenarists\":"
+		out.RawString(prefix)
+		if in.Scenarists == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v55, v56 := range in.Scenarists {
+				if v55 > 0 {
+					out.RawByte(',')
+				}
+				(v56).MarshalEasyJSON(out)
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"actors\":"
+		out.RawString(prefix)
+		if in.Characters == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v57, v58 := range in.Characters {
+				if v57 > 0 {
+					out.RawByte(',')
+				}
+				(v58).MarshalEasyJSON(out)
+			}
+			out.RawByte(']')
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v FilmPageResponse) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson11d1a9baEncodeGithubComGoParkMailRu20232VkladyshiPkgRequests16(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v FilmPageResponse) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson11d1a9baEncodeGithubComGoParkMailRu20232VkladyshiPkgRequests16(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *FilmPageResponse) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson11d1a9baDecodeGithubComGoParkMailRu20232VkladyshiPkgRequests16(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *FilmPageResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson11d1a9baDecodeGithubComGoParkMailRu20232VkladyshiPkgRequests16(l, v)
+}
+func easyjson11d1a9baDecodeGithubComGoParkMailRu20232VkladyshiPkgRequests17(in *jlexer.Lexer, out *EditProfileRequest) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "login":
+			out.Login = string(in.String())
+		case "email":
+			out.Email = string(in.String())
+		case "photo":
+			if in.IsNull() {
+				in.Skip()
+				out.Photo = nil
+			} else {
+				out.Photo = in.Bytes()
+			}
+		case "password":
+			out.Password = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson11d1a9baEncodeGithubComGoParkMailRu20232VkladyshiPkgRequests17(out *jwriter.Writer, in EditProfileRequest) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"login\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Login))
+	}
+	{
+		const prefix string = ",\"email\":"
+		out.RawString(prefix)
+		out.String(string(in.Email))
+	}
+	{
+		const prefix string = ",\"photo\":"
+		out.RawString(prefix)
+		out.Base64Bytes(in.Photo)
+	}
+	{
+		const prefix string = ",\"password\":"
+		out.RawString(prefix)
+		out.String(string(in.Password))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v EditProfileRequest) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson11d1a9baEncodeGithubComGoParkMailRu20232VkladyshiPkgRequests17(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v EditProfileRequest) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson11d1a9baEncodeGithubComGoParkMailRu20232VkladyshiPkgRequests17(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *EditProfileRequest) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson11d1a9baDecodeGithubComGoParkMailRu20232VkladyshiPkgRequests17(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *EditProfileRequest) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson11d1a9baDecodeGithubComGoParkMailRu20232VkladyshiPkgRequests17(l, v)
+}
+func easyjson11d1a9baDecodeGithubComGoParkMailRu20232VkladyshiPkgRequests18(in *jlexer.Lexer, out *EditFilmRequest) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "film_id":
+			out.FilmId = uint64(in.Uint64())
+		case "title":
+			if in.IsNull() {
+				in.Skip()
+				out.Title = nil
+			} else {
+				if out.Title == nil {
+					out.Title = new(string)
+				}
+				*out.Title = string(in.String())
+			}
+		case "info":
+			if in.IsNull() {
+				in.Skip()
+				out.Info = nil
+			} else {
+				if out.Info == nil {
+					out.Info = new(string)
+				}
+				*out.Info = string(in.String())
+			}
+		case "release_date":
+			if in.IsNull() {
+				in.Skip()
+				out.ReleaseDate = nil
+			} else {
+				if out.ReleaseDate == nil {
+					out.ReleaseDate = new(string)
+				}
+				*out.ReleaseDate = string(in.String())
+			}
+		case "country":
+			if in.IsNull() {
+				in.Skip()
+				out.Country = nil
+			} else {
+				if out.Country == nil {
+					out.Country = new(string)
+				}
+				*out.Country = string(in.String())
+			}
+		case "mpaa":
+			if in.IsNull() {
+				in.Skip()
+				out.Mpaa = nil
+			} else {
+				if out.Mpaa == nil {
+					out.Mpaa = new(string)
+				}
+				*out.Mpaa = string(in.String())
+			}
+		case "original_title":
+			if in.IsNull() {
+				in.Skip()
+				out.OriginalTitle = nil
+			} else {
+				if out.OriginalTitle == nil {
+					out.OriginalTitle = new(string)
+				}
+				*out.OriginalTitle = string(in.String())
+			}
+		case "runtime":
+			if in.IsNull() {
+				in.Skip()
+				out.Runtime = nil
+			} else {
+				if out.Runtime == nil {
+					out.Runtime = new(uint32)
+				}
+				*out.Runtime = uint32(in.Uint32())
+			}
+		case "budget":
+			if in.IsNull() {
+				in.Skip()
+				out.Budget = nil
+			} else {
+				if out.Budget == nil {
+					out.Budget = new(uint64)
+				}
+				*out.Budget = uint64(in.Uint64())
+			}
+		case "box_office":
+			if in.IsNull() {
+				in.Skip()
+				out.BoxOffice = nil
+			} else {
+				if out.BoxOffice == nil {
+					out.BoxOffice = new(uint64)
+				}
+				*out.BoxOffice = uint64(in.Uint64())
+			}
+		case "languages":
+			if in.IsNull() {
+				in.Skip()
+				out.Languages = nil
+			} else {
+				if out.Languages == nil {
+					out.Languages = new([]string)
+				}
+				if in.IsNull() {
+					in.Skip()
+					*out.Languages = nil
+				} else {
+					in.Delim('[')
+					if *out.Languages == nil {
+						if !in.IsDelim(']') {
+							*out.Languages = make([]string, 0, 4)
+						} else {
+							*out.Languages = []string{}
+						}
+					} else {
+						*out.Languages = (*out.Languages)[:0]
+					}
+					for !in.IsDelim(']') {
+						var v62 string
+						v62 = string(in.String())
+						*out.Languages = append(*out.Languages, v62)
+						in.WantComma()
+					}
+					in.Delim(']')
+				}
+			}
+		case "trailers":
+			if in.IsNull() {
+				in.Skip()
+				out.Trailers = nil
+			} else {
+				if out.Trailers == nil {
+					out.Trailers = new([]string)
+				}
+				if in.IsNull() {
+					in.Skip()
+					*out.Trailers = nil
+				} else {
+					in.Delim('[')
+					if *out.Trailers == nil {
+						if !in.IsDelim(']') {
+							*out.Trailers = make([]string, 0, 4)
+						} else {
+							*out.Trailers = []string{}
+						}
+					} else {
+						*out.Trailers = (*out.Trailers)[:0]
+					}
+					for !in.IsDelim(']') {
+						var v63 string
+						v63 = string(in.String())
+						*out.Trailers = append(*out.Trailers, v63)
+						in.WantComma()
+					}
+					in.Delim(']')
+				}
+			}
+		case "imdb_id":
+			if in.IsNull() {
+				in.Skip()
+				out.ImdbId = nil
+			} else {
+				if out.ImdbId == nil {
+					out.ImdbId = new(string)
+				}
+				*out.ImdbId = string(in.String())
+			}
+		case "kinopoisk_id":
+			if in.IsNull() {
+				in.Skip()
+				out.KinopoiskId = nil
+			} else {
+				if out.KinopoiskId == nil {
+					out.KinopoiskId = new(string)
+				}
+				*out.KinopoiskId = string(in.String())
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson11d1a9baEncodeGithubComGoParkMailRu20232VkladyshiPkgRequests18(out *jwriter.Writer, in EditFilmRequest) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"film_id\":"
+		out.RawString(prefix[1:])
+		out.Uint64(uint64(in.FilmId))
+	}
+	if in.Title != nil {
+		const prefix string = ",\"title\":"
+		out.RawString(prefix)
+		out.String(string(*in.Title))
+	}
+	if in.Info != nil {
+		const prefix string = ",\"info\":"
+		out.RawString(prefix)
+		out.String(string(*in.Info))
+	}
+	if in.ReleaseDate != nil {
+		const prefix string = ",\"release_date\":"
+		out.RawString(prefix)
+		out.String(string(*in.ReleaseDate))
+	}
+	if in.Country != nil {
+		const prefix string = ",\"country\":"
+		out.RawString(prefix)
+		out.String(string(*in.Country))
+	}
+	if in.Mpaa != nil {
+		const prefix string = ",\"mpaa\":"
+		out.RawString(prefix)
+		out.String(string(*in.Mpaa))
+	}
+	if in.OriginalTitle != nil {
+		const prefix string = ",\"original_title\":"
+		out.RawString(prefix)
+		out.String(string(*in.OriginalTitle))
+	}
+	if in.Runtime != nil {
+		const prefix string = ",\"runtime\":"
+		out.RawString(prefix)
+		out.Uint32(uint32(*in.Runtime))
+	}
+	if in.Budget != nil {
+		const prefix string = ",\"budget\":"
+		out.RawString(prefix)
+		out.Uint64(uint64(*in.Budget))
+	}
+	if in.BoxOffice != nil {
+		const prefix string = ",\"box_office\":"
+		out.RawString(prefix)
+		out.Uint64(uint64(*in.BoxOffice))
+	}
+	if in.Languages != nil {
+		const prefix string = ",\"languages\":"
+		out.RawString(prefix)
+		if *in.Languages == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v64, v65 := range *in.Languages {
+				if v64 > 0 {
+					out.RawByte(',')
+				}
+				out.String(string(v65))
+			}
+			out.RawByte(']')
+		}
+	}
+	if in.Trailers != nil {
+		const prefix string = ",\"trailers\":"
+		out.RawString(prefix)
+		if *in.Trailers == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v66, v67 := range *in.Trailers {
+				if v66 > 0 {
+					out.RawByte(',')
+				}
+				out.String(string(v67))
+			}
+			out.RawByte(']')
+		}
+	}
+	if in.ImdbId != nil {
+		const prefix string = ",\"imdb_id\":"
+		out.RawString(prefix)
+		out.String(string(*in.ImdbId))
+	}
+	if in.KinopoiskId != nil {
+		const prefix string = ",\"kinopoisk_id\":"
+		out.RawString(prefix)
+		out.String(string(*in.KinopoiskId))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v EditFilmRequest) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson11d1a9baEncodeGithubComGoParkMailRu20232VkladyshiPkgRequests18(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v EditFilmRequest) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson11d1a9baEncodeGithubComGoParkMailRu20232VkladyshiPkgRequests18(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *EditFilmRequest) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson11d1a9baDecodeGithubComGoParkMailRu20232VkladyshiPkgRequests18(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *EditFilmRequest) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson11d1a9baDecodeGithubComGoParkMailRu20232VkladyshiPkgRequests18(l, v)
+}
+func easyjson11d1a9baDecodeGithubComGoParkMailRu20232VkladyshiPkgRequests19(in *jlexer.Lexer, out *DeleteCommentRequest) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "user_id":
+			out.IdUser = uint64(in.Uint64())
+		case "film_id":
+			out.IdFilm = uint64(in.Uint64())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson11d1a9baEncodeGithubComGoParkMailRu20232VkladyshiPkgRequests19(out *jwriter.Writer, in DeleteCommentRequest) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"user_id\":"
+		out.RawString(prefix[1:])
+		out.Uint64(uint64(in.IdUser))
+	}
+	{
+		const prefix string = ",\"film_id\":"
 		out.RawString(prefix)
-		if in.Characters == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
-			out.RawString("null")
-		} else {
-			out.RawByte('[')
-			for v29, v30 := range in.Characters {
-				if v29 > 0 {
-					out.RawByte(',')
-				}
-				(v30).MarshalEasyJSON(out)
-			}
-			out.RawByte(']')
-		}
+		out.Uint64(uint64(in.IdFilm))
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v FilmResponse) MarshalJSON() ([]byte, error) {
+func (v DeleteCommentRequest) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjson11d1a9baEncodeGithubComGoParkMailRu20232VkladyshiPkgRequests9(&w, v)
+	easyjson11d1a9baEncodeGithubComGoParkMailRu20232VkladyshiPkgRequests19(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v FilmResponse) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson11d1a9baEncodeGithubComGoParkMailRu20232VkladyshiPkgRequests9(w, v)
+func (v DeleteCommentRequest) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson11d1a9baEncodeGithubComGoParkMailRu20232VkladyshiPkgRequests19(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *FilmResponse) UnmarshalJSON(data []byte) error {
+func (v *DeleteCommentRequest) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjson11d1a9baDecodeGithubComGoParkMailRu20232VkladyshiPkgRequests9(&r, v)
+	easyjson11d1a9baDecodeGithubComGoParkMailRu20232VkladyshiPkgRequests19(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *FilmResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson11d1a9baDecodeGithubComGoParkMailRu20232VkladyshiPkgRequests9(l, v)
+func (v *DeleteCommentRequest) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson11d1a9baDecodeGithubComGoParkMailRu20232VkladyshiPkgRequests19(l, v)
 }
-func easyjson11d1a9baDecodeGithubComGoParkMailRu20232VkladyshiPkgRequests10(in *jlexer.Lexer, out *EditProfileRequest) {
+func easyjson11d1a9baDecodeGithubComGoParkMailRu20232VkladyshiPkgRequests20(in *jlexer.Lexer, out *CommentResponse) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -1274,19 +2700,29 @@ func easyjson11d1a9baDecodeGithubComGoParkMailRu20232VkladyshiPkgRequests10(in *
 			continue
 		}
 		switch key {
-		case "login":
-			out.Login = string(in.String())
-		case "email":
-			out.Email = string(in.String())
-		case "photo":
+		case "comment":
 			if in.IsNull() {
 				in.Skip()
-				out.Photo = nil
+				out.Comments = nil
 			} else {
-				out.Photo = in.Bytes()
+				in.Delim('[')
+				if out.Comments == nil {
+					if !in.IsDelim(']') {
+						out.Comments = make([]models.CommentItem, 0, 0)
+					} else {
+						out.Comments = []models.CommentItem{}
+					}
+				} else {
+					out.Comments = (out.Comments)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v68 models.CommentItem
+					(v68).UnmarshalEasyJSON(in)
+					out.Comments = append(out.Comments, v68)
+					in.WantComma()
+				}
+				in.Delim(']')
 			}
-		case "password":
-			out.Password = string(in.String())
 		default:
 			in.SkipRecursive()
 		}
@@ -1297,57 +2733,53 @@ func easyjson11d1a9baDecodeGithubComGoParkMailRu20232VkladyshiPkgRequests10(in *
 		in.Consumed()
 	}
 }
-func easyjson11d1a9baEncodeGithubComGoParkMailRu20232VkladyshiPkgRequests10(out *jwriter.Writer, in EditProfileRequest) {
+func easyjson11d1a9baEncodeGithubComGoParkMailRu20232VkladyshiPkgRequests20(out *jwriter.Writer, in CommentResponse) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"login\":"
+		const prefix string = ",\"comment\":"
 		out.RawString(prefix[1:])
-		out.String(string(in.Login))
-	}
-	{
-		const prefix string = ",\"email\":"
-		out.RawString(prefix)
-		out.String(string(in.Email))
-	}
-	{
-		const prefix string = ",\"photo\":"
-		out.RawString(prefix)
-		out.Base64Bytes(in.Photo)
-	}
-	{
-		const prefix string = ",\"password\":"
-		out.RawString(prefix)
-		out.String(string(in.Password))
+		if in.Comments == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v69, v70 := range in.Comments {
+				if v69 > 0 {
+					out.RawByte(',')
+				}
+				(v70).MarshalEasyJSON(out)
+			}
+			out.RawByte(']')
+		}
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v EditProfileRequest) MarshalJSON() ([]byte, error) {
+func (v CommentResponse) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjson11d1a9baEncodeGithubComGoParkMailRu20232VkladyshiPkgRequests10(&w, v)
+	easyjson11d1a9baEncodeGithubComGoParkMailRu20232VkladyshiPkgRequests20(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v EditProfileRequest) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson11d1a9baEncodeGithubComGoParkMailRu20232VkladyshiPkgRequests10(w, v)
+func (v CommentResponse) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson11d1a9baEncodeGithubComGoParkMailRu20232VkladyshiPkgRequests20(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *EditProfileRequest) UnmarshalJSON(data []byte) error {
+func (v *CommentResponse) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjson11d1a9baDecodeGithubComGoParkMailRu20232VkladyshiPkgRequests10(&r, v)
+	easyjson11d1a9baDecodeGithubComGoParkMailRu20232VkladyshiPkgRequests20(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *EditProfileRequest) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson11d1a9baDecodeGithubComGoParkMailRu20232VkladyshiPkgRequests10(l, v)
+func (v *CommentResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson11d1a9baDecodeGithubComGoParkMailRu20232VkladyshiPkgRequests20(l, v)
 }
-func easyjson11d1a9baDecodeGithubComGoParkMailRu20232VkladyshiPkgRequests11(in *jlexer.Lexer, out *DeleteCommentRequest) {
+func easyjson11d1a9baDecodeGithubComGoParkMailRu20232VkladyshiPkgRequests21(in *jlexer.Lexer, out *CommentRequest) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -1366,10 +2798,12 @@ func easyjson11d1a9baDecodeGithubComGoParkMailRu20232VkladyshiPkgRequests11(in *
 			continue
 		}
 		switch key {
-		case "user_id":
-			out.IdUser = uint64(in.Uint64())
 		case "film_id":
-			out.IdFilm = uint64(in.Uint64())
+			out.FilmId = uint64(in.Uint64())
+		case "rating":
+			out.Rating = uint16(in.Uint16())
+		case "text":
+			out.Text = string(in.String())
 		default:
 			in.SkipRecursive()
 		}
@@ -1380,47 +2814,52 @@ func easyjson11d1a9baDecodeGithubComGoParkMailRu20232VkladyshiPkgRequests11(in *
 		in.Consumed()
 	}
 }
-func easyjson11d1a9baEncodeGithubComGoParkMailRu20232VkladyshiPkgRequests11(out *jwriter.Writer, in DeleteCommentRequest) {
+func easyjson11d1a9baEncodeGithubComGoParkMailRu20232VkladyshiPkgRequests21(out *jwriter.Writer, in CommentRequest) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"user_id\":"
+		const prefix string = ",\"film_id\":"
 		out.RawString(prefix[1:])
-		out.Uint64(uint64(in.IdUser))
+		out.Uint64(uint64(in.FilmId))
 	}
 	{
-		const prefix string = ",\"film_id\":"
+		const prefix string = ",\"rating\":"
 		out.RawString(prefix)
-		out.Uint64(uint64(in.IdFilm))
+		out.Uint16(uint16(in.Rating))
+	}
+	{
+		const prefix string = ",\"text\":"
+		out.RawString(prefix)
+		out.String(string(in.Text))
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v DeleteCommentRequest) MarshalJSON() ([]byte, error) {
+func (v CommentRequest) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjson11d1a9baEncodeGithubComGoParkMailRu20232VkladyshiPkgRequests11(&w, v)
+	easyjson11d1a9baEncodeGithubComGoParkMailRu20232VkladyshiPkgRequests21(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v DeleteCommentRequest) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson11d1a9baEncodeGithubComGoParkMailRu20232VkladyshiPkgRequests11(w, v)
+func (v CommentRequest) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson11d1a9baEncodeGithubComGoParkMailRu20232VkladyshiPkgRequests21(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *DeleteCommentRequest) UnmarshalJSON(data []byte) error {
+func (v *CommentRequest) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjson11d1a9baDecodeGithubComGoParkMailRu20232VkladyshiPkgRequests11(&r, v)
+	easyjson11d1a9baDecodeGithubComGoParkMailRu20232VkladyshiPkgRequests21(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *DeleteCommentRequest) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson11d1a9baDecodeGithubComGoParkMailRu20232VkladyshiPkgRequests11(l, v)
+func (v *CommentRequest) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson11d1a9baDecodeGithubComGoParkMailRu20232VkladyshiPkgRequests21(l, v)
 }
-func easyjson11d1a9baDecodeGithubComGoParkMailRu20232VkladyshiPkgRequests12(in *jlexer.Lexer, out *CommentResponse) {
+func easyjson11d1a9baDecodeGithubComGoParkMailRu20232VkladyshiPkgRequests22(in *jlexer.Lexer, out *CollaboratorsResponse) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -1439,25 +2878,25 @@ func easyjson11d1a9baDecodeGithubComGoParkMailRu20232VkladyshiPkgRequests12(in *
 			continue
 		}
 		switch key {
-		case "comment":
+		case "collaborators":
 			if in.IsNull() {
 				in.Skip()
-				out.Comments = nil
+				out.Collaborators = nil
 			} else {
 				in.Delim('[')
-				if out.Comments == nil {
+				if out.Collaborators == nil {
 					if !in.IsDelim(']') {
-						out.Comments = make([]models.CommentItem, 0, 0)
+						out.Collaborators = make([]models.Collaborator, 0, 1)
 					} else {
-						out.Comments = []models.CommentItem{}
+						out.Collaborators = []models.Collaborator{}
 					}
 				} else {
-					out.Comments = (out.Comments)[:0]
+					out.Collaborators = (out.Collaborators)[:0]
 				}
 				for !in.IsDelim(']') {
-					var v34 models.CommentItem
-					(v34).UnmarshalEasyJSON(in)
-					out.Comments = append(out.Comments, v34)
+					var v71 models.Collaborator
+					(v71).UnmarshalEasyJSON(in)
+					out.Collaborators = append(out.Collaborators, v71)
 					in.WantComma()
 				}
 				in.Delim(']')
@@ -1472,22 +2911,22 @@ func easyjson11d1a9baDecodeGithubComGoParkMailRu20232VkladyshiPkgRequests12(in *
 		in.Consumed()
 	}
 }
-func easyjson11d1a9baEncodeGithubComGoParkMailRu20232VkladyshiPkgRequests12(out *jwriter.Writer, in CommentResponse) {
+func easyjson11d1a9baEncodeGithubComGoParkMailRu20232VkladyshiPkgRequests22(out *jwriter.Writer, in CollaboratorsResponse) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"comment\":"
+		const prefix string = ",\"collaborators\":"
 		out.RawString(prefix[1:])
-		if in.Comments == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+		if in.Collaborators == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
 			out.RawString("null")
 		} else {
 			out.RawByte('[')
-			for v35, v36 := range in.Comments {
-				if v35 > 0 {
+			for v72, v73 := range in.Collaborators {
+				if v72 > 0 {
 					out.RawByte(',')
 				}
-				(v36).MarshalEasyJSON(out)
+				(v73).MarshalEasyJSON(out)
 			}
 			out.RawByte(']')
 		}
@@ -1496,29 +2935,29 @@ func easyjson11d1a9baEncodeGithubComGoParkMailRu20232VkladyshiPkgRequests12(out
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v CommentResponse) MarshalJSON() ([]byte, error) {
+func (v CollaboratorsResponse) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjson11d1a9baEncodeGithubComGoParkMailRu20232VkladyshiPkgRequests12(&w, v)
+	easyjson11d1a9baEncodeGithubComGoParkMailRu20232VkladyshiPkgRequests22(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v CommentResponse) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson11d1a9baEncodeGithubComGoParkMailRu20232VkladyshiPkgRequests12(w, v)
+func (v CollaboratorsResponse) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson11d1a9baEncodeGithubComGoParkMailRu20232VkladyshiPkgRequests22(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *CommentResponse) UnmarshalJSON(data []byte) error {
+func (v *CollaboratorsResponse) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjson11d1a9baDecodeGithubComGoParkMailRu20232VkladyshiPkgRequests12(&r, v)
+	easyjson11d1a9baDecodeGithubComGoParkMailRu20232VkladyshiPkgRequests22(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *CommentResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson11d1a9baDecodeGithubComGoParkMailRu20232VkladyshiPkgRequests12(l, v)
+func (v *CollaboratorsResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson11d1a9baDecodeGithubComGoParkMailRu20232VkladyshiPkgRequests22(l, v)
 }
-func easyjson11d1a9baDecodeGithubComGoParkMailRu20232VkladyshiPkgRequests13(in *jlexer.Lexer, out *CommentRequest) {
+func easyjson11d1a9baDecodeGithubComGoParkMailRu20232VkladyshiPkgRequests23(in *jlexer.Lexer, out *ChangeRoleRequest) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -1537,12 +2976,10 @@ func easyjson11d1a9baDecodeGithubComGoParkMailRu20232VkladyshiPkgRequests13(in *
 			continue
 		}
 		switch key {
-		case "film_id":
-			out.FilmId = uint64(in.Uint64())
-		case "rating":
-			out.Rating = uint16(in.Uint16())
-		case "text":
-			out.Text = string(in.String())
+		case "login":
+			out.Login = string(in.String())
+		case "role":
+			out.Role = string(in.String())
 		default:
 			in.SkipRecursive()
 		}
@@ -1553,52 +2990,166 @@ func easyjson11d1a9baDecodeGithubComGoParkMailRu20232VkladyshiPkgRequests13(in *
 		in.Consumed()
 	}
 }
-func easyjson11d1a9baEncodeGithubComGoParkMailRu20232VkladyshiPkgRequests13(out *jwriter.Writer, in CommentRequest) {
+func easyjson11d1a9baEncodeGithubComGoParkMailRu20232VkladyshiPkgRequests23(out *jwriter.Writer, in ChangeRoleRequest) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"film_id\":"
+		const prefix string = ",\"login\":"
 		out.RawString(prefix[1:])
-		out.Uint64(uint64(in.FilmId))
+		out.String(string(in.Login))
 	}
 	{
-		const prefix string = ",\"rating\":"
+		const prefix string = ",\"role\":"
 		out.RawString(prefix)
-		out.Uint16(uint16(in.Rating))
+		out.String(string(in.Role))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v ChangeRoleRequest) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson11d1a9baEncodeGithubComGoParkMailRu20232VkladyshiPkgRequests23(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v ChangeRoleRequest) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson11d1a9baEncodeGithubComGoParkMailRu20232VkladyshiPkgRequests23(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *ChangeRoleRequest) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson11d1a9baDecodeGithubComGoParkMailRu20232VkladyshiPkgRequests23(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *ChangeRoleRequest) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson11d1a9baDecodeGithubComGoParkMailRu20232VkladyshiPkgRequests23(l, v)
+}
+func easyjson11d1a9baDecodeGithubComGoParkMailRu20232VkladyshiPkgRequests24(in *jlexer.Lexer, out *CalendarResponse) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "monthName":
+			out.MonthName = string(in.String())
+		case "monthText":
+			out.MonthText = string(in.String())
+		case "currentDay":
+			out.CurrentDay = uint8(in.Uint8())
+		case "days":
+			if in.IsNull() {
+				in.Skip()
+				out.Days = nil
+			} else {
+				in.Delim('[')
+				if out.Days == nil {
+					if !in.IsDelim(']') {
+						out.Days = make([]models.DayItem, 0, 1)
+					} else {
+						out.Days = []models.DayItem{}
+					}
+				} else {
+					out.Days = (out.Days)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v74 models.DayItem
+					(v74).UnmarshalEasyJSON(in)
+					out.Days = append(out.Days, v74)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
 	}
+}
+func easyjson11d1a9baEncodeGithubComGoParkMailRu20232VkladyshiPkgRequests24(out *jwriter.Writer, in CalendarResponse) {
+	out.RawByte('{')
+	first := true
+	_ = first
 	{
-		const prefix string = ",\"text\":"
+		const prefix string = ",\"monthName\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.MonthName))
+	}
+	{
+		const prefix string = ",\"monthText\":"
 		out.RawString(prefix)
-		out.String(string(in.Text))
+		out.String(string(in.MonthText))
+	}
+	{
+		const prefix string = ",\"currentDay\":"
+		out.RawString(prefix)
+		out.Uint8(uint8(in.CurrentDay))
+	}
+	{
+		const prefix string = ",\"days\":"
+		out.RawString(prefix)
+		if in.Days == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v75, v76 := range in.Days {
+				if v75 > 0 {
+					out.RawByte(',')
+				}
+				(v76).MarshalEasyJSON(out)
+			}
+			out.RawByte(']')
+		}
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v CommentRequest) MarshalJSON() ([]byte, error) {
+func (v CalendarResponse) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjson11d1a9baEncodeGithubComGoParkMailRu20232VkladyshiPkgRequests13(&w, v)
+	easyjson11d1a9baEncodeGithubComGoParkMailRu20232VkladyshiPkgRequests24(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v CommentRequest) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson11d1a9baEncodeGithubComGoParkMailRu20232VkladyshiPkgRequests13(w, v)
+func (v CalendarResponse) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson11d1a9baEncodeGithubComGoParkMailRu20232VkladyshiPkgRequests24(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *CommentRequest) UnmarshalJSON(data []byte) error {
+func (v *CalendarResponse) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjson11d1a9baDecodeGithubComGoParkMailRu20232VkladyshiPkgRequests13(&r, v)
+	easyjson11d1a9baDecodeGithubComGoParkMailRu20232VkladyshiPkgRequests24(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *CommentRequest) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson11d1a9baDecodeGithubComGoParkMailRu20232VkladyshiPkgRequests13(l, v)
+func (v *CalendarResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson11d1a9baDecodeGithubComGoParkMailRu20232VkladyshiPkgRequests24(l, v)
 }
-func easyjson11d1a9baDecodeGithubComGoParkMailRu20232VkladyshiPkgRequests14(in *jlexer.Lexer, out *ChangeRoleRequest) {
+func easyjson11d1a9baDecodeGithubComGoParkMailRu20232VkladyshiPkgRequests25(in *jlexer.Lexer, out *AuthCheckResponse) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -1631,7 +3182,7 @@ func easyjson11d1a9baDecodeGithubComGoParkMailRu20232VkladyshiPkgRequests14(in *
 		in.Consumed()
 	}
 }
-func easyjson11d1a9baEncodeGithubComGoParkMailRu20232VkladyshiPkgRequests14(out *jwriter.Writer, in ChangeRoleRequest) {
+func easyjson11d1a9baEncodeGithubComGoParkMailRu20232VkladyshiPkgRequests25(out *jwriter.Writer, in AuthCheckResponse) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -1649,29 +3200,29 @@ func easyjson11d1a9baEncodeGithubComGoParkMailRu20232VkladyshiPkgRequests14(out
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v ChangeRoleRequest) MarshalJSON() ([]byte, error) {
+func (v AuthCheckResponse) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjson11d1a9baEncodeGithubComGoParkMailRu20232VkladyshiPkgRequests14(&w, v)
+	easyjson11d1a9baEncodeGithubComGoParkMailRu20232VkladyshiPkgRequests25(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v ChangeRoleRequest) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson11d1a9baEncodeGithubComGoParkMailRu20232VkladyshiPkgRequests14(w, v)
+func (v AuthCheckResponse) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson11d1a9baEncodeGithubComGoParkMailRu20232VkladyshiPkgRequests25(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *ChangeRoleRequest) UnmarshalJSON(data []byte) error {
+func (v *AuthCheckResponse) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjson11d1a9baDecodeGithubComGoParkMailRu20232VkladyshiPkgRequests14(&r, v)
+	easyjson11d1a9baDecodeGithubComGoParkMailRu20232VkladyshiPkgRequests25(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *ChangeRoleRequest) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson11d1a9baDecodeGithubComGoParkMailRu20232VkladyshiPkgRequests14(l, v)
+func (v *AuthCheckResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson11d1a9baDecodeGithubComGoParkMailRu20232VkladyshiPkgRequests25(l, v)
 }
-func easyjson11d1a9baDecodeGithubComGoParkMailRu20232VkladyshiPkgRequests15(in *jlexer.Lexer, out *CalendarResponse) {
+func easyjson11d1a9baDecodeGithubComGoParkMailRu20232VkladyshiPkgRequests26(in *jlexer.Lexer, out *AddTranslationRequest) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -1690,35 +3241,16 @@ func easyjson11d1a9baDecodeGithubComGoParkMailRu20232VkladyshiPkgRequests15(in *
 			continue
 		}
 		switch key {
-		case "monthName":
-			out.MonthName = string(in.String())
-		case "monthText":
-			out.MonthText = string(in.String())
-		case "currentDay":
-			out.CurrentDay = uint8(in.Uint8())
-		case "days":
-			if in.IsNull() {
-				in.Skip()
-				out.Days = nil
-			} else {
-				in.Delim('[')
-				if out.Days == nil {
-					if !in.IsDelim(']') {
-						out.Days = make([]models.DayItem, 0, 1)
-					} else {
-						out.Days = []models.DayItem{}
-					}
-				} else {
-					out.Days = (out.Days)[:0]
-				}
-				for !in.IsDelim(']') {
-					var v37 models.DayItem
-					(v37).UnmarshalEasyJSON(in)
-					out.Days = append(out.Days, v37)
-					in.WantComma()
-				}
-				in.Delim(']')
-			}
+		case "entity":
+			out.Entity = string(in.String())
+		case "id":
+			out.Id = uint64(in.Uint64())
+		case "lang":
+			out.Lang = string(in.String())
+		case "title":
+			out.Title = string(in.String())
+		case "info":
+			out.Info = string(in.String())
 		default:
 			in.SkipRecursive()
 		}
@@ -1729,68 +3261,62 @@ func easyjson11d1a9baDecodeGithubComGoParkMailRu20232VkladyshiPkgRequests15(in *
 		in.Consumed()
 	}
 }
-func easyjson11d1a9baEncodeGithubComGoParkMailRu20232VkladyshiPkgRequests15(out *jwriter.Writer, in CalendarResponse) {
+func easyjson11d1a9baEncodeGithubComGoParkMailRu20232VkladyshiPkgRequests26(out *jwriter.Writer, in AddTranslationRequest) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"monthName\":"
+		const prefix string = ",\"entity\":"
 		out.RawString(prefix[1:])
-		out.String(string(in.MonthName))
+		out.String(string(in.Entity))
 	}
 	{
-		const prefix string = ",\"monthText\":"
+		const prefix string = ",\"id\":"
 		out.RawString(prefix)
-		out.String(string(in.MonthText))
+		out.Uint64(uint64(in.Id))
 	}
 	{
-		const prefix string = ",\"currentDay\":"
+		const prefix string = ",\"lang\":"
 		out.RawString(prefix)
-		out.Uint8(uint8(in.CurrentDay))
+		out.String(string(in.Lang))
 	}
 	{
-		const prefix string = ",\"days\":"
+		const prefix string = ",\"title\":"
 		out.RawString(prefix)
-		if in.Days == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
-			out.RawString("null")
-		} else {
-			out.RawByte('[')
-			for v38, v39 := range in.Days {
-				if v38 > 0 {
-					out.RawByte(',')
-				}
-				(v39).MarshalEasyJSON(out)
-			}
-			out.RawByte(']')
-		}
+		out.String(string(in.Title))
+	}
+	{
+		const prefix string = ",\"info\":"
+		out.RawString(prefix)
+		out.String(string(in.Info))
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v CalendarResponse) MarshalJSON() ([]byte, error) {
+func (v AddTranslationRequest) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjson11d1a9baEncodeGithubComGoParkMailRu20232VkladyshiPkgRequests15(&w, v)
+	easyjson11d1a9baEncodeGithubComGoParkMailRu20232VkladyshiPkgRequests26(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v CalendarResponse) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson11d1a9baEncodeGithubComGoParkMailRu20232VkladyshiPkgRequests15(w, v)
+func (v AddTranslationRequest) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson11d1a9baEncodeGithubComGoParkMailRu20232VkladyshiPkgRequests26(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *CalendarResponse) UnmarshalJSON(data []byte) error {
+func (v *AddTranslationRequest) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjson11d1a9baDecodeGithubComGoParkMailRu20232VkladyshiPkgRequests15(&r, v)
+	easyjson11d1a9baDecodeGithubComGoParkMailRu20232VkladyshiPkgRequests26(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *CalendarResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson11d1a9baDecodeGithubComGoParkMailRu20232VkladyshiPkgRequests15(l, v)
+func (v *AddTranslationRequest) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson11d1a9baDecodeGithubComGoParkMailRu20232VkladyshiPkgRequests26(l, v)
 }
-func easyjson11d1a9baDecodeGithubComGoParkMailRu20232VkladyshiPkgRequests16(in *jlexer.Lexer, out *AuthCheckResponse) {
+func easyjson11d1a9baDecodeGithubComGoParkMailRu20232VkladyshiPkgRequests27(in *jlexer.Lexer, out *ActorsResponse) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -1809,10 +3335,31 @@ func easyjson11d1a9baDecodeGithubComGoParkMailRu20232VkladyshiPkgRequests16(in *
 			continue
 		}
 		switch key {
-		case "login":
-			out.Login = string(in.String())
-		case "role":
-			out.Role = string(in.String())
+		case "actors":
+			if in.IsNull() {
+				in.Skip()
+				out.Actors = nil
+			} else {
+				in.Delim('[')
+				if out.Actors == nil {
+					if !in.IsDelim(']') {
+						out.Actors = make([]models.Character, 0, 1)
+					} else {
+						out.Actors = []models.Character{}
+					}
+				} else {
+					out.Actors = (out.Actors)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v77 models.Character
+					(v77).UnmarshalEasyJSON(in)
+					out.Actors = append(out.Actors, v77)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "total":
+			out.Total = uint64(in.Uint64())
 		default:
 			in.SkipRecursive()
 		}
@@ -1823,47 +3370,58 @@ func easyjson11d1a9baDecodeGithubComGoParkMailRu20232VkladyshiPkgRequests16(in *
 		in.Consumed()
 	}
 }
-func easyjson11d1a9baEncodeGithubComGoParkMailRu20232VkladyshiPkgRequests16(out *jwriter.Writer, in AuthCheckResponse) {
+func easyjson11d1a9baEncodeGithubComGoParkMailRu20232VkladyshiPkgRequests27(out *jwriter.Writer, in ActorsResponse) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"login\":"
+		const prefix string = ",\"actors\":"
 		out.RawString(prefix[1:])
-		out.String(string(in.Login))
+		if in.Actors == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v78, v79 := range in.Actors {
+				if v78 > 0 {
+					out.RawByte(',')
+				}
+				(v79).MarshalEasyJSON(out)
+			}
+			out.RawByte(']')
+		}
 	}
 	{
-		const prefix string = ",\"role\":"
+		const prefix string = ",\"total\":"
 		out.RawString(prefix)
-		out.String(string(in.Role))
+		out.Uint64(uint64(in.Total))
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v AuthCheckResponse) MarshalJSON() ([]byte, error) {
+func (v ActorsResponse) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjson11d1a9baEncodeGithubComGoParkMailRu20232VkladyshiPkgRequests16(&w, v)
+	easyjson11d1a9baEncodeGithubComGoParkMailRu20232VkladyshiPkgRequests27(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v AuthCheckResponse) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson11d1a9baEncodeGithubComGoParkMailRu20232VkladyshiPkgRequests16(w, v)
+func (v ActorsResponse) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson11d1a9baEncodeGithubComGoParkMailRu20232VkladyshiPkgRequests27(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *AuthCheckResponse) UnmarshalJSON(data []byte) error {
+func (v *ActorsResponse) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjson11d1a9baDecodeGithubComGoParkMailRu20232VkladyshiPkgRequests16(&r, v)
+	easyjson11d1a9baDecodeGithubComGoParkMailRu20232VkladyshiPkgRequests27(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *AuthCheckResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson11d1a9baDecodeGithubComGoParkMailRu20232VkladyshiPkgRequests16(l, v)
+func (v *ActorsResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson11d1a9baDecodeGithubComGoParkMailRu20232VkladyshiPkgRequests27(l, v)
 }
-func easyjson11d1a9baDecodeGithubComGoParkMailRu20232VkladyshiPkgRequests17(in *jlexer.Lexer, out *ActorsResponse) {
+func easyjson11d1a9baDecodeGithubComGoParkMailRu20232VkladyshiPkgRequests28(in *jlexer.Lexer, out *ActorsPathResponse) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -1882,6 +3440,8 @@ func easyjson11d1a9baDecodeGithubComGoParkMailRu20232VkladyshiPkgRequests17(in *
 			continue
 		}
 		switch key {
+		case "degrees":
+			out.Degrees = uint64(in.Uint64())
 		case "actors":
 			if in.IsNull() {
 				in.Skip()
@@ -1898,15 +3458,36 @@ func easyjson11d1a9baDecodeGithubComGoParkMailRu20232VkladyshiPkgRequests17(in *
 					out.Actors = (out.Actors)[:0]
 				}
 				for !in.IsDelim(']') {
-					var v40 models.Character
-					(v40).UnmarshalEasyJSON(in)
-					out.Actors = append(out.Actors, v40)
+					var v80 models.Character
+					(v80).UnmarshalEasyJSON(in)
+					out.Actors = append(out.Actors, v80)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "films":
+			if in.IsNull() {
+				in.Skip()
+				out.Films = nil
+			} else {
+				in.Delim('[')
+				if out.Films == nil {
+					if !in.IsDelim(']') {
+						out.Films = make([]models.FilmItem, 0, 0)
+					} else {
+						out.Films = []models.FilmItem{}
+					}
+				} else {
+					out.Films = (out.Films)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v81 models.FilmItem
+					(v81).UnmarshalEasyJSON(in)
+					out.Films = append(out.Films, v81)
 					in.WantComma()
 				}
 				in.Delim(']')
 			}
-		case "total":
-			out.Total = uint64(in.Uint64())
 		default:
 			in.SkipRecursive()
 		}
@@ -1917,58 +3498,74 @@ func easyjson11d1a9baDecodeGithubComGoParkMailRu20232VkladyshiPkgRequests17(in *
 		in.Consumed()
 	}
 }
-func easyjson11d1a9baEncodeGithubComGoParkMailRu20232VkladyshiPkgRequests17(out *jwriter.Writer, in ActorsResponse) {
+func easyjson11d1a9baEncodeGithubComGoParkMailRu20232VkladyshiPkgRequests28(out *jwriter.Writer, in ActorsPathResponse) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"actors\":"
+		const prefix string = ",\"degrees\":"
 		out.RawString(prefix[1:])
+		out.Uint64(uint64(in.Degrees))
+	}
+	{
+		const prefix string = ",\"actors\":"
+		out.RawString(prefix)
 		if in.Actors == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
 			out.RawString("null")
 		} else {
 			out.RawByte('[')
-			for v41, v42 := range in.Actors {
-				if v41 > 0 {
+			for v82, v83 := range in.Actors {
+				if v82 > 0 {
 					out.RawByte(',')
 				}
-				(v42).MarshalEasyJSON(out)
+				(v83).MarshalEasyJSON(out)
 			}
 			out.RawByte(']')
 		}
 	}
 	{
-		const prefix string = ",\"total\":"
+		const prefix string = ",\"films\":"
 		out.RawString(prefix)
-		out.Uint64(uint64(in.Total))
+		if in.Films == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v84, v85 := range in.Films {
+				if v84 > 0 {
+					out.RawByte(',')
+				}
+				(v85).MarshalEasyJSON(out)
+			}
+			out.RawByte(']')
+		}
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v ActorsResponse) MarshalJSON() ([]byte, error) {
+func (v ActorsPathResponse) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjson11d1a9baEncodeGithubComGoParkMailRu20232VkladyshiPkgRequests17(&w, v)
+	easyjson11d1a9baEncodeGithubComGoParkMailRu20232VkladyshiPkgRequests28(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v ActorsResponse) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson11d1a9baEncodeGithubComGoParkMailRu20232VkladyshiPkgRequests17(w, v)
+func (v ActorsPathResponse) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson11d1a9baEncodeGithubComGoParkMailRu20232VkladyshiPkgRequests28(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *ActorsResponse) UnmarshalJSON(data []byte) error {
+func (v *ActorsPathResponse) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjson11d1a9baDecodeGithubComGoParkMailRu20232VkladyshiPkgRequests17(&r, v)
+	easyjson11d1a9baDecodeGithubComGoParkMailRu20232VkladyshiPkgRequests28(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *ActorsResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson11d1a9baDecodeGithubComGoParkMailRu20232VkladyshiPkgRequests17(l, v)
+func (v *ActorsPathResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson11d1a9baDecodeGithubComGoParkMailRu20232VkladyshiPkgRequests28(l, v)
 }
-func easyjson11d1a9baDecodeGithubComGoParkMailRu20232VkladyshiPkgRequests18(in *jlexer.Lexer, out *ActorResponse) {
+func easyjson11d1a9baDecodeGithubComGoParkMailRu20232VkladyshiPkgRequests29(in *jlexer.Lexer, out *ActorResponse) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -2007,9 +3604,9 @@ func easyjson11d1a9baDecodeGithubComGoParkMailRu20232VkladyshiPkgRequests18(in *
 					out.Career = (out.Career)[:0]
 				}
 				for !in.IsDelim(']') {
-					var v43 models.ProfessionItem
-					(v43).UnmarshalEasyJSON(in)
-					out.Career = append(out.Career, v43)
+					var v86 models.ProfessionItem
+					(v86).UnmarshalEasyJSON(in)
+					out.Career = append(out.Career, v86)
 					in.WantComma()
 				}
 				in.Delim(']')
@@ -2020,6 +3617,64 @@ func easyjson11d1a9baDecodeGithubComGoParkMailRu20232VkladyshiPkgRequests18(in *
 			out.Country = string(in.String())
 		case "info_text":
 			out.Info = string(in.String())
+		case "filmography":
+			if in.IsNull() {
+				in.Skip()
+				out.Filmography = nil
+			} else {
+				in.Delim('[')
+				if out.Filmography == nil {
+					if !in.IsDelim(']') {
+						out.Filmography = make([]FilmographyGroup, 0, 1)
+					} else {
+						out.Filmography = []FilmographyGroup{}
+					}
+				} else {
+					out.Filmography = (out.Filmography)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v87 FilmographyGroup
+					(v87).UnmarshalEasyJSON(in)
+					out.Filmography = append(out.Filmography, v87)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "films_total":
+			out.FilmsTotal = uint64(in.Uint64())
+		case "known_for":
+			if in.IsNull() {
+				in.Skip()
+				out.KnownFor = nil
+			} else {
+				in.Delim('[')
+				if out.KnownFor == nil {
+					if !in.IsDelim(']') {
+						out.KnownFor = make([]models.FilmItem, 0, 0)
+					} else {
+						out.KnownFor = []models.FilmItem{}
+					}
+				} else {
+					out.KnownFor = (out.KnownFor)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v88 models.FilmItem
+					(v88).UnmarshalEasyJSON(in)
+					out.KnownFor = append(out.KnownFor, v88)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "placeholder":
+			if in.IsNull() {
+				in.Skip()
+				out.Placeholder = nil
+			} else {
+				if out.Placeholder == nil {
+					out.Placeholder = new(models.Placeholder)
+				}
+				(*out.Placeholder).UnmarshalEasyJSON(in)
+			}
 		default:
 			in.SkipRecursive()
 		}
@@ -2030,7 +3685,7 @@ func easyjson11d1a9baDecodeGithubComGoParkMailRu20232VkladyshiPkgRequests18(in *
 		in.Consumed()
 	}
 }
-func easyjson11d1a9baEncodeGithubComGoParkMailRu20232VkladyshiPkgRequests18(out *jwriter.Writer, in ActorResponse) {
+func easyjson11d1a9baEncodeGithubComGoParkMailRu20232VkladyshiPkgRequests29(out *jwriter.Writer, in ActorResponse) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -2051,11 +3706,11 @@ func easyjson11d1a9baEncodeGithubComGoParkMailRu20232VkladyshiPkgRequests18(out
 			out.RawString("null")
 		} else {
 			out.RawByte('[')
-			for v44, v45 := range in.Career {
-				if v44 > 0 {
+			for v89, v90 := range in.Career {
+				if v89 > 0 {
 					out.RawByte(',')
 				}
-				(v45).MarshalEasyJSON(out)
+				(v90).MarshalEasyJSON(out)
 			}
 			out.RawByte(']')
 		}
@@ -2075,29 +3730,71 @@ func easyjson11d1a9baEncodeGithubComGoParkMailRu20232VkladyshiPkgRequests18(out
 		out.RawString(prefix)
 		out.String(string(in.Info))
 	}
+	{
+		const prefix string = ",\"filmography\":"
+		out.RawString(prefix)
+		if in.Filmography == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v91, v92 := range in.Filmography {
+				if v91 > 0 {
+					out.RawByte(',')
+				}
+				(v92).MarshalEasyJSON(out)
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"films_total\":"
+		out.RawString(prefix)
+		out.Uint64(uint64(in.FilmsTotal))
+	}
+	{
+		const prefix string = ",\"known_for\":"
+		out.RawString(prefix)
+		if in.KnownFor == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v93, v94 := range in.KnownFor {
+				if v93 > 0 {
+					out.RawByte(',')
+				}
+				(v94).MarshalEasyJSON(out)
+			}
+			out.RawByte(']')
+		}
+	}
+	if in.Placeholder != nil {
+		const prefix string = ",\"placeholder\":"
+		out.RawString(prefix)
+		(*in.Placeholder).MarshalEasyJSON(out)
+	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
 func (v ActorResponse) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjson11d1a9baEncodeGithubComGoParkMailRu20232VkladyshiPkgRequests18(&w, v)
+	easyjson11d1a9baEncodeGithubComGoParkMailRu20232VkladyshiPkgRequests29(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v ActorResponse) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson11d1a9baEncodeGithubComGoParkMailRu20232VkladyshiPkgRequests18(w, v)
+	easyjson11d1a9baEncodeGithubComGoParkMailRu20232VkladyshiPkgRequests29(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *ActorResponse) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjson11d1a9baDecodeGithubComGoParkMailRu20232VkladyshiPkgRequests18(&r, v)
+	easyjson11d1a9baDecodeGithubComGoParkMailRu20232VkladyshiPkgRequests29(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *ActorResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson11d1a9baDecodeGithubComGoParkMailRu20232VkladyshiPkgRequests18(l, v)
+	easyjson11d1a9baDecodeGithubComGoParkMailRu20232VkladyshiPkgRequests29(l, v)
 }
@@ -36,13 +36,32 @@ type (
 		Characters []models.Character `json:"actors"`
 	}
 
+	FilmPageResponse struct {
+		FilmResponse
+		Comments    []models.CommentItem `json:"comments"`
+		OwnComment  *models.CommentItem  `json:"own_comment,omitempty"`
+		IsFavorite  bool                 `json:"is_favorite"`
+		Unavailable []string             `json:"unavailable,omitempty"`
+	}
+
 	ActorResponse struct {
-		Name      string                  `json:"name"`
-		Photo     string                  `json:"poster_href"`
-		Career    []models.ProfessionItem `json:"career"`
-		BirthDate string                  `json:"birthday"`
-		Country   string                  `json:"country"`
-		Info      string                  `json:"info_text"`
+		Name        string                  `json:"name"`
+		Photo       string                  `json:"poster_href"`
+		Career      []models.ProfessionItem `json:"career"`
+		BirthDate   string                  `json:"birthday"`
+		Country     string                  `json:"country"`
+		Info        string                  `json:"info_text"`
+		Filmography []FilmographyGroup      `json:"filmography"`
+		FilmsTotal  uint64                  `json:"films_total"`
+		KnownFor    []models.FilmItem       `json:"known_for"`
+
+		Placeholder *models.Placeholder `json:"placeholder,omitempty"`
+	}
+
+	FilmographyGroup struct {
+		Profession string                   `json:"profession"`
+		Total      uint64                   `json:"total"`
+		Films      []models.FilmographyItem `json:"films"`
 	}
 
 	ActorsResponse struct {
@@ -50,6 +69,22 @@ type (
 		Total  uint64             `json:"total"`
 	}
 
+	ActorsPathResponse struct {
+		Degrees uint64             `json:"degrees"`
+		Actors  []models.Character `json:"actors"`
+		Films   []models.FilmItem  `json:"films"`
+	}
+
+	CollaboratorsResponse struct {
+		Collaborators []models.Collaborator `json:"collaborators"`
+	}
+
+	PosterResponse struct {
+		Poster         string              `json:"poster"`
+		PosterVariants map[string]string   `json:"poster_variants,omitempty"`
+		Placeholder    *models.Placeholder `json:"placeholder,omitempty"`
+	}
+
 	CommentResponse struct {
 		Comments []models.CommentItem `json:"comment"`
 	}
@@ -60,6 +95,8 @@ type (
 		Login     string `json:"login"`
 		Photo     string `json:"photo"`
 		BirthDate string `json:"birthday"`
+
+		PhotoVariants map[string]string `json:"photo_variants,omitempty"`
 	}
 
 	AuthCheckResponse struct {
@@ -82,6 +119,14 @@ type (
 		Users []models.UserItem `json:"users"`
 	}
 
+	SessionsResponse struct {
+		Sessions []models.SessionItem `json:"sessions"`
+	}
+
+	RevokeSessionsResponse struct {
+		Revoked int `json:"revoked"`
+	}
+
 	UsersStatisticsResponse struct {
 		GenreId uint64  `json:"genre_id"`
 		Count   uint64  `json:"count"`
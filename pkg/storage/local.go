@@ -0,0 +1,207 @@
+package storage
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+	"path"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/go-park-mail-ru/2023_2_Vkladyshi/configs"
+)
+
+var ErrBadKey = errors.New("bad storage key")
+
+type Local struct {
+	root    string
+	baseURL string
+	secret  []byte
+	private []string
+}
+
+func NewLocal(cfg configs.LocalStoreCfg) *Local {
+	return &Local{
+		root:    cfg.Root,
+		baseURL: strings.TrimSuffix(cfg.BaseURL, "/"),
+		secret:  []byte(cfg.Secret),
+		private: cfg.Private,
+	}
+}
+
+func (l *Local) path(key string) (string, error) {
+	if key == "" || path.Clean("/"+key) != "/"+key {
+		return "", ErrBadKey
+	}
+
+	return filepath.Join(l.root, filepath.FromSlash(key)), nil
+}
+
+func (l *Local) Put(ctx context.Context, key string, data []byte, contentType string) error {
+	name, err := l.path(key)
+	if err != nil {
+		return err
+	}
+
+	err = os.MkdirAll(filepath.Dir(name), 0755)
+	if err != nil {
+		return fmt.Errorf("local put err: %w", err)
+	}
+
+	tmp, err := os.CreateTemp(filepath.Dir(name), ".upload-*")
+	if err != nil {
+		return fmt.Errorf("local put err: %w", err)
+	}
+	defer os.Remove(tmp.Name())
+
+	_, err = tmp.Write(data)
+	if closeErr := tmp.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		return fmt.Errorf("local put err: %w", err)
+	}
+
+	err = os.Chmod(tmp.Name(), 0644)
+	if err != nil {
+		return fmt.Errorf("local put err: %w", err)
+	}
+	err = os.Rename(tmp.Name(), name)
+	if err != nil {
+		return fmt.Errorf("local put err: %w", err)
+	}
+
+	return nil
+}
+
+func (l *Local) Get(ctx context.Context, key string) ([]byte, error) {
+	name, err := l.path(key)
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := os.ReadFile(name)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, ErrNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("local get err: %w", err)
+	}
+
+	return data, nil
+}
+
+func (l *Local) Exists(ctx context.Context, key string) (bool, error) {
+	name, err := l.path(key)
+	if err != nil {
+		return false, err
+	}
+
+	_, err = os.Stat(name)
+	if errors.Is(err, os.ErrNotExist) {
+		return false, nil
+	}
+	if err != nil {
+		return false, fmt.Errorf("local exists err: %w", err)
+	}
+
+	return true, nil
+}
+
+func (l *Local) Delete(ctx context.Context, key string) error {
+	name, err := l.path(key)
+	if err != nil {
+		return err
+	}
+
+	err = os.Remove(name)
+	if err != nil && !errors.Is(err, os.ErrNotExist) {
+		return fmt.Errorf("local delete err: %w", err)
+	}
+
+	return nil
+}
+
+func (l *Local) URL(key string) string {
+	return l.baseURL + "/" + key
+}
+
+func (l *Local) Key(url string) (string, bool) {
+	return keyFromURL(l.baseURL, url)
+}
+
+func (l *Local) BaseURL() string {
+	return l.baseURL
+}
+
+func (l *Local) SignedURL(ctx context.Context, key string, expires time.Duration) (string, error) {
+	if _, err := l.path(key); err != nil {
+		return "", err
+	}
+
+	deadline := strconv.FormatInt(time.Now().Add(expires).Unix(), 10)
+	return l.URL(key) + "?expires=" + deadline + "&signature=" + l.sign(key, deadline), nil
+}
+
+func (l *Local) sign(key string, deadline string) string {
+	mac := hmac.New(sha256.New, l.secret)
+	mac.Write([]byte(key + "\n" + deadline))
+
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+func (l *Local) verify(key string, deadline string, signature string) bool {
+	unix, err := strconv.ParseInt(deadline, 10, 64)
+	if err != nil || time.Now().Unix() > unix {
+		return false
+	}
+
+	return hmac.Equal([]byte(signature), []byte(l.sign(key, deadline)))
+}
+
+func (l *Local) isPrivate(key string) bool {
+	for _, prefix := range l.private {
+		if strings.HasPrefix(key, prefix) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// ServeHTTP serves stored objects when the backend is mounted under BaseURL.
+// Objects under a private prefix are only served for a valid signed URL.
+func (l *Local) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet && r.Method != http.MethodHead {
+		w.WriteHeader(http.StatusMethodNotAllowed)
+		return
+	}
+
+	key, ok := l.Key(r.URL.Path)
+	if !ok {
+		w.WriteHeader(http.StatusNotFound)
+		return
+	}
+	name, err := l.path(key)
+	if err != nil {
+		w.WriteHeader(http.StatusNotFound)
+		return
+	}
+
+	query := r.URL.Query()
+	if l.isPrivate(key) || query.Has("signature") {
+		if !l.verify(key, query.Get("expires"), query.Get("signature")) {
+			w.WriteHeader(http.StatusForbidden)
+			return
+		}
+	}
+
+	http.ServeFile(w, r, name)
+}
@@ -0,0 +1,52 @@
+package storage
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/go-park-mail-ru/2023_2_Vkladyshi/configs"
+)
+
+var (
+	ErrNotFound      = errors.New("object not found")
+	ErrNoCredentials = errors.New("storage credentials are not set")
+)
+
+// Storage keeps media objects under slash separated keys such as
+// "icons/<hash>.jpg" and knows the public URL every key is served from.
+type Storage interface {
+	Put(ctx context.Context, key string, data []byte, contentType string) error
+	Get(ctx context.Context, key string) ([]byte, error)
+	Exists(ctx context.Context, key string) (bool, error)
+	Delete(ctx context.Context, key string) error
+	URL(key string) string
+	Key(url string) (string, bool)
+	SignedURL(ctx context.Context, key string, expires time.Duration) (string, error)
+}
+
+func New(cfg *configs.StorageCfg) (Storage, error) {
+	switch cfg.Backend {
+	case "local", "":
+		// Signed URLs of private objects are only as safe as the secret.
+		if len(cfg.Local.Private) > 0 && cfg.Local.Secret == "" {
+			return nil, ErrNoCredentials
+		}
+		return NewLocal(cfg.Local), nil
+	case "s3":
+		return NewS3(cfg.S3)
+	default:
+		return nil, fmt.Errorf("unknown storage backend %q", cfg.Backend)
+	}
+}
+
+func keyFromURL(base string, url string) (string, bool) {
+	key, ok := strings.CutPrefix(url, strings.TrimSuffix(base, "/")+"/")
+	if !ok || key == "" {
+		return "", false
+	}
+
+	return key, true
+}
@@ -0,0 +1,303 @@
+package storage
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/go-park-mail-ru/2023_2_Vkladyshi/configs"
+)
+
+func TestLocal(t *testing.T) {
+	ctx := context.Background()
+	store := NewLocal(configs.LocalStoreCfg{Root: t.TempDir(), BaseURL: "/media/"})
+
+	err := store.Put(ctx, "icons/a.jpg", []byte("a"), "image/jpeg")
+	if err != nil {
+		t.Fatalf("unexpected error %s", err)
+	}
+	found, err := store.Exists(ctx, "icons/a.jpg")
+	if err != nil || !found {
+		t.Errorf("expected object to exist, got %t %v", found, err)
+	}
+	data, err := store.Get(ctx, "icons/a.jpg")
+	if err != nil || string(data) != "a" {
+		t.Errorf("unexpected object %q %v", data, err)
+	}
+	_, err = store.Get(ctx, "icons/none.jpg")
+	if err != ErrNotFound {
+		t.Errorf("expected ErrNotFound, got %v", err)
+	}
+
+	if url := store.URL("icons/a.jpg"); url != "/media/icons/a.jpg" {
+		t.Errorf("unexpected url %s", url)
+	}
+	if key, ok := store.Key("/media/icons/a.jpg"); !ok || key != "icons/a.jpg" {
+		t.Errorf("unexpected key %s %t", key, ok)
+	}
+	if _, ok := store.Key("/icons/a.jpg"); ok {
+		t.Errorf("expected foreign url to be rejected")
+	}
+
+	err = store.Delete(ctx, "icons/a.jpg")
+	if err != nil {
+		t.Errorf("unexpected error %s", err)
+	}
+	found, _ = store.Exists(ctx, "icons/a.jpg")
+	if found {
+		t.Errorf("expected object to be deleted")
+	}
+	err = store.Delete(ctx, "icons/a.jpg")
+	if err != nil {
+		t.Errorf("deleting a missing object should not fail, got %s", err)
+	}
+
+	for _, key := range []string{"", "../a.jpg", "icons/../../a.jpg", "/icons/a.jpg"} {
+		err = store.Put(ctx, key, []byte("a"), "image/jpeg")
+		if err != ErrBadKey {
+			t.Errorf("%q: expected ErrBadKey, got %v", key, err)
+		}
+	}
+}
+
+func TestLocalServeHTTP(t *testing.T) {
+	ctx := context.Background()
+	store := NewLocal(configs.LocalStoreCfg{
+		Root:    t.TempDir(),
+		BaseURL: "/media",
+		Secret:  "secret",
+		Private: []string{"private/"},
+	})
+	_ = store.Put(ctx, "icons/a.jpg", []byte("public"), "image/jpeg")
+	_ = store.Put(ctx, "private/b.jpg", []byte("private"), "image/jpeg")
+
+	signed, err := store.SignedURL(ctx, "private/b.jpg", time.Minute)
+	if err != nil {
+		t.Fatalf("unexpected error %s", err)
+	}
+	expired, _ := store.SignedURL(ctx, "private/b.jpg", -time.Minute)
+	forged := strings.Replace(signed, "private/b.jpg", "icons/a.jpg", 1)
+
+	testCases := map[string]struct {
+		url    string
+		status int
+		body   string
+	}{
+		"public":    {url: "/media/icons/a.jpg", status: http.StatusOK, body: "public"},
+		"unsigned":  {url: "/media/private/b.jpg", status: http.StatusForbidden},
+		"signed":    {url: signed, status: http.StatusOK, body: "private"},
+		"expired":   {url: expired, status: http.StatusForbidden},
+		"forged":    {url: forged, status: http.StatusForbidden},
+		"missing":   {url: "/media/icons/none.jpg", status: http.StatusNotFound},
+		"traversal": {url: "/media/../configs/storage.yaml", status: http.StatusNotFound},
+	}
+
+	for name, curr := range testCases {
+		t.Run(name, func(t *testing.T) {
+			r := httptest.NewRequest(http.MethodGet, "/", nil)
+			r.URL.Path, r.URL.RawQuery, _ = strings.Cut(curr.url, "?")
+			w := httptest.NewRecorder()
+
+			store.ServeHTTP(w, r)
+
+			if w.Code != curr.status {
+				t.Errorf("unexpected status %d, wanted %d", w.Code, curr.status)
+			}
+			if curr.body != "" && w.Body.String() != curr.body {
+				t.Errorf("unexpected body %q", w.Body.String())
+			}
+		})
+	}
+}
+
+// fakeS3 is a minimal path-style S3 stand-in serving a single bucket.
+type fakeS3 struct {
+	mutex   sync.Mutex
+	objects map[string][]byte
+}
+
+func (f *fakeS3) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if r.URL.Query().Has("location") {
+		w.Write([]byte(`<LocationConstraint xmlns="http://s3.amazonaws.com/doc/2006-03-01/">us-east-1</LocationConstraint>`))
+		return
+	}
+
+	key := strings.TrimPrefix(r.URL.Path, "/media/")
+	switch r.Method {
+	case http.MethodPut:
+		body, err := readS3Body(r)
+		if err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		f.objects[key] = body
+		w.Header().Set("ETag", `"etag"`)
+	case http.MethodHead, http.MethodGet:
+		data, ok := f.objects[key]
+		if !ok {
+			w.Header().Set("Content-Type", "application/xml")
+			w.WriteHeader(http.StatusNotFound)
+			if r.Method == http.MethodGet {
+				w.Write([]byte(`<Error><Code>NoSuchKey</Code><Message>The specified key does not exist.</Message></Error>`))
+			}
+			return
+		}
+		w.Header().Set("ETag", `"etag"`)
+		w.Header().Set("Last-Modified", time.Now().UTC().Format(http.TimeFormat))
+		w.Header().Set("Content-Length", strconv.Itoa(len(data)))
+		if r.Method == http.MethodGet {
+			w.Write(data)
+		}
+	case http.MethodDelete:
+		delete(f.objects, key)
+		w.WriteHeader(http.StatusNoContent)
+	default:
+		w.WriteHeader(http.StatusMethodNotAllowed)
+	}
+}
+
+// readS3Body decodes the aws-chunked encoding used for streaming signatures.
+func readS3Body(r *http.Request) ([]byte, error) {
+	if !strings.HasPrefix(r.Header.Get("X-Amz-Content-Sha256"), "STREAMING-") {
+		return io.ReadAll(r.Body)
+	}
+
+	var body bytes.Buffer
+	reader := bufio.NewReader(r.Body)
+	for {
+		line, err := reader.ReadString('\n')
+		if err != nil {
+			return nil, err
+		}
+		sizeHex, _, _ := strings.Cut(strings.TrimSpace(line), ";")
+		size, err := strconv.ParseInt(sizeHex, 16, 64)
+		if err != nil {
+			return nil, err
+		}
+		if size == 0 {
+			return body.Bytes(), nil
+		}
+		_, err = io.CopyN(&body, reader, size)
+		if err != nil {
+			return nil, err
+		}
+		_, err = reader.Discard(2)
+		if err != nil {
+			return nil, err
+		}
+	}
+}
+
+func TestS3(t *testing.T) {
+	ctx := context.Background()
+	fake := &fakeS3{objects: map[string][]byte{}}
+	server := httptest.NewServer(fake)
+	defer server.Close()
+
+	store, err := NewS3(configs.S3StoreCfg{
+		Endpoint:  strings.TrimPrefix(server.URL, "http://"),
+		Region:    "us-east-1",
+		Bucket:    "media",
+		AccessKey: "access",
+		SecretKey: "secret",
+	})
+	if err != nil {
+		t.Fatalf("unexpected error %s", err)
+	}
+
+	err = store.Put(ctx, "icons/a.jpg", []byte("content"), "image/jpeg")
+	if err != nil {
+		t.Fatalf("unexpected error %s", err)
+	}
+	if string(fake.objects["icons/a.jpg"]) != "content" {
+		t.Errorf("unexpected stored object %q", fake.objects["icons/a.jpg"])
+	}
+
+	found, err := store.Exists(ctx, "icons/a.jpg")
+	if err != nil || !found {
+		t.Errorf("expected object to exist, got %t %v", found, err)
+	}
+	found, err = store.Exists(ctx, "icons/none.jpg")
+	if err != nil || found {
+		t.Errorf("expected object to be missing, got %t %v", found, err)
+	}
+	data, err := store.Get(ctx, "icons/a.jpg")
+	if err != nil || string(data) != "content" {
+		t.Errorf("unexpected object %q %v", data, err)
+	}
+	_, err = store.Get(ctx, "icons/none.jpg")
+	if err != ErrNotFound {
+		t.Errorf("expected ErrNotFound, got %v", err)
+	}
+
+	url := store.URL("icons/a.jpg")
+	if url != server.URL+"/media/icons/a.jpg" {
+		t.Errorf("unexpected url %s", url)
+	}
+	if key, ok := store.Key(url); !ok || key != "icons/a.jpg" {
+		t.Errorf("unexpected key %s %t", key, ok)
+	}
+
+	signed, err := store.SignedURL(ctx, "icons/a.jpg", time.Minute)
+	if err != nil {
+		t.Errorf("unexpected error %s", err)
+	}
+	if !strings.HasPrefix(signed, url+"?") || !strings.Contains(signed, "X-Amz-Signature=") {
+		t.Errorf("unexpected signed url %s", signed)
+	}
+
+	err = store.Delete(ctx, "icons/a.jpg")
+	if err != nil {
+		t.Errorf("unexpected error %s", err)
+	}
+	if _, ok := fake.objects["icons/a.jpg"]; ok {
+		t.Errorf("expected object to be deleted")
+	}
+}
+
+func TestNew(t *testing.T) {
+	store, err := New(&configs.StorageCfg{Backend: "local"})
+	if err != nil {
+		t.Errorf("unexpected error %s", err)
+	}
+	if _, ok := store.(*Local); !ok {
+		t.Errorf("expected local storage, got %T", store)
+	}
+
+	_, err = New(&configs.StorageCfg{Backend: "ftp"})
+	if err == nil {
+		t.Errorf("expected error for unknown backend")
+	}
+
+	_, err = New(&configs.StorageCfg{Backend: "s3", S3: configs.S3StoreCfg{Endpoint: "127.0.0.1:9000", Bucket: "media"}})
+	if err != ErrNoCredentials {
+		t.Errorf("expected ErrNoCredentials for s3 without keys, got %v", err)
+	}
+	_, err = New(&configs.StorageCfg{Backend: "s3", S3: configs.S3StoreCfg{Endpoint: "127.0.0.1:9000", Bucket: "media", AccessKey: "access"}})
+	if err != ErrNoCredentials {
+		t.Errorf("expected ErrNoCredentials for s3 without secret key, got %v", err)
+	}
+	store, err = New(&configs.StorageCfg{Backend: "s3", S3: configs.S3StoreCfg{Endpoint: "127.0.0.1:9000", Bucket: "media", AccessKey: "access", SecretKey: "secret"}})
+	if err != nil {
+		t.Errorf("unexpected error %s", err)
+	}
+	if _, ok := store.(*S3); !ok {
+		t.Errorf("expected s3 storage, got %T", store)
+	}
+
+	_, err = New(&configs.StorageCfg{Backend: "local", Local: configs.LocalStoreCfg{Private: []string{"private/"}}})
+	if err != ErrNoCredentials {
+		t.Errorf("expected ErrNoCredentials for private objects without secret, got %v", err)
+	}
+}
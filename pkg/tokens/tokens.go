@@ -0,0 +1,52 @@
+package tokens
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+)
+
+// size is the entropy of a token in bytes.
+const size = 32
+
+// legacyLength is the length of the tokens made of 32 random letters that
+// were handed out before, they stay valid until they expire.
+const legacyLength = 32
+
+// New returns a random token for a session id or a CSRF token.
+func New() (string, error) {
+	token := make([]byte, size)
+	if _, err := rand.Read(token); err != nil {
+		return "", fmt.Errorf("generate token err: %w", err)
+	}
+
+	return base64.RawURLEncoding.EncodeToString(token), nil
+}
+
+// Key is the storage key of token. Only the hash of a token is stored, so
+// the keys of a dump cannot be used as tokens. Legacy tokens were stored as
+// they are and are looked up that way until they expire; they consist of
+// letters only, so a stored hash is never taken for one.
+func Key(token string) string {
+	if isLegacy(token) {
+		return token
+	}
+	sum := sha256.Sum256([]byte(token))
+
+	return "sha256:" + hex.EncodeToString(sum[:])
+}
+
+func isLegacy(token string) bool {
+	if len(token) != legacyLength {
+		return false
+	}
+	for _, c := range token {
+		if (c < 'a' || c > 'z') && (c < 'A' || c > 'Z') {
+			return false
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,41 @@
+package tokens
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestNew(t *testing.T) {
+	first, err := New()
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	second, _ := New()
+	if first == second || len(first) != 43 {
+		t.Errorf("unexpected tokens %q and %q", first, second)
+	}
+	if isLegacy(first) {
+		t.Errorf("expected a new token not to look like a legacy one")
+	}
+}
+
+func TestKey(t *testing.T) {
+	token, _ := New()
+	key := Key(token)
+	if !strings.HasPrefix(key, "sha256:") || strings.Contains(key, token) || Key(token) != key {
+		t.Errorf("unexpected key %q of %q", key, token)
+	}
+	if Key(key) == key {
+		t.Errorf("expected a stored key not to be usable as a token")
+	}
+
+	legacy := "abcdefghijklmnopqrstuvwxyzABCDEF"
+	if Key(legacy) != legacy {
+		t.Errorf("expected a legacy token to be looked up as it is")
+	}
+	for _, token := range []string{"abcdefghijklmnopqrstuvwxyzABCDE", "abcdefghijklmnopqrstuvwxyzABCD1"} {
+		if Key(token) == token {
+			t.Errorf("expected %q to be hashed", token)
+		}
+	}
+}